@@ -46,6 +46,13 @@ type DomainValidationOption struct {
 type CertificateSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CertificateParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // CertificateExternalStatus keeps the state of external resource
@@ -181,6 +181,13 @@ func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateSpec.
@@ -180,6 +180,13 @@ type CertificateAuthorityExternalStatus struct {
 type CertificateAuthoritySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CertificateAuthorityParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // An CertificateAuthorityStatus represents the observed state of an CertificateAuthority manager.
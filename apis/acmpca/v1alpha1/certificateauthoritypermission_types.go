@@ -25,6 +25,13 @@ import (
 type CertificateAuthorityPermissionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CertificateAuthorityPermissionParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // An CertificateAuthorityPermissionStatus represents the observed state of an Certificate Authority Permission manager.
@@ -255,6 +255,13 @@ func (in *CertificateAuthorityPermissionSpec) DeepCopyInto(out *CertificateAutho
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityPermissionSpec.
@@ -288,6 +295,13 @@ func (in *CertificateAuthoritySpec) DeepCopyInto(out *CertificateAuthoritySpec)
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthoritySpec.
@@ -55,6 +55,13 @@ type APIKeyParameters struct {
 type APIKeySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       APIKeyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // APIKeyObservation defines the observed state of APIKey
@@ -93,6 +93,13 @@ type AuthorizerParameters struct {
 type AuthorizerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       AuthorizerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // AuthorizerObservation defines the observed state of Authorizer
@@ -48,6 +48,13 @@ type BasePathMappingParameters struct {
 type BasePathMappingSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       BasePathMappingParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // BasePathMappingObservation defines the observed state of BasePathMapping
@@ -56,6 +56,13 @@ type DeploymentParameters struct {
 type DeploymentSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DeploymentParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DeploymentObservation defines the observed state of Deployment
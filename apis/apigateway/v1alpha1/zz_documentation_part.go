@@ -45,6 +45,13 @@ type DocumentationPartParameters struct {
 type DocumentationPartSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DocumentationPartParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DocumentationPartObservation defines the observed state of DocumentationPart
@@ -43,6 +43,13 @@ type DocumentationVersionParameters struct {
 type DocumentationVersionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DocumentationVersionParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DocumentationVersionObservation defines the observed state of DocumentationVersion
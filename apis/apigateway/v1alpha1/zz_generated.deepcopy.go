@@ -191,6 +191,13 @@ func (in *APIKeySpec) DeepCopyInto(out *APIKeySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeySpec.
@@ -518,6 +525,13 @@ func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerSpec.
@@ -734,6 +748,13 @@ func (in *BasePathMappingSpec) DeepCopyInto(out *BasePathMappingSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BasePathMappingSpec.
@@ -2131,6 +2152,13 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
@@ -2367,6 +2395,13 @@ func (in *DocumentationPartSpec) DeepCopyInto(out *DocumentationPartSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationPartSpec.
@@ -2546,6 +2581,13 @@ func (in *DocumentationVersionSpec) DeepCopyInto(out *DocumentationVersionSpec)
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DocumentationVersionSpec.
@@ -2810,6 +2852,13 @@ func (in *DomainNameSpec) DeepCopyInto(out *DomainNameSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNameSpec.
@@ -3129,6 +3178,13 @@ func (in *GatewayResponseSpec) DeepCopyInto(out *GatewayResponseSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayResponseSpec.
@@ -3500,6 +3556,13 @@ func (in *IntegrationResponseSpec) DeepCopyInto(out *IntegrationResponseSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResponseSpec.
@@ -3534,6 +3597,13 @@ func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationSpec.
@@ -3865,6 +3935,13 @@ func (in *MethodResponseSpec) DeepCopyInto(out *MethodResponseSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MethodResponseSpec.
@@ -3989,6 +4066,13 @@ func (in *MethodSpec) DeepCopyInto(out *MethodSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MethodSpec.
@@ -4139,6 +4223,13 @@ func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
@@ -4450,6 +4541,13 @@ func (in *RequestValidatorSpec) DeepCopyInto(out *RequestValidatorSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidatorSpec.
@@ -4595,6 +4693,13 @@ func (in *ResourceSpec) DeepCopyInto(out *ResourceSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSpec.
@@ -4852,6 +4957,13 @@ func (in *RestAPISpec) DeepCopyInto(out *RestAPISpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestAPISpec.
@@ -5265,6 +5377,13 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -5787,6 +5906,13 @@ func (in *UsagePlanKeySpec) DeepCopyInto(out *UsagePlanKeySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsagePlanKeySpec.
@@ -5977,6 +6103,13 @@ func (in *UsagePlanSpec) DeepCopyInto(out *UsagePlanSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UsagePlanSpec.
@@ -6226,6 +6359,13 @@ func (in *VPCLinkSpec) DeepCopyInto(out *VPCLinkSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLinkSpec.
@@ -64,6 +64,13 @@ type IntegrationResponseParameters struct {
 type IntegrationResponseSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       IntegrationResponseParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // IntegrationResponseObservation defines the observed state of IntegrationResponse
@@ -58,6 +58,13 @@ type MethodResponseParameters struct {
 type MethodResponseSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       MethodResponseParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // MethodResponseObservation defines the observed state of MethodResponse
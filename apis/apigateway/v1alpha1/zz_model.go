@@ -47,6 +47,13 @@ type ModelParameters struct {
 type ModelSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ModelParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ModelObservation defines the observed state of Model
@@ -74,6 +74,13 @@ type RestAPIParameters struct {
 type RestAPISpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RestAPIParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RestAPIObservation defines the observed state of RestAPI
@@ -64,6 +64,13 @@ type APIParameters struct {
 type APISpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       APIParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // APIObservation defines the observed state of API
@@ -42,6 +42,13 @@ type DomainNameParameters struct {
 type DomainNameSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DomainNameParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DomainNameObservation defines the observed state of DomainName
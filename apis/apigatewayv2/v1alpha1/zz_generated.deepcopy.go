@@ -200,6 +200,13 @@ func (in *APIMappingSpec) DeepCopyInto(out *APIMappingSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIMappingSpec.
@@ -418,6 +425,13 @@ func (in *APISpec) DeepCopyInto(out *APISpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APISpec.
@@ -746,6 +760,13 @@ func (in *AuthorizerSpec) DeepCopyInto(out *AuthorizerSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerSpec.
@@ -1681,6 +1702,13 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentSpec.
@@ -1956,6 +1984,13 @@ func (in *DomainNameSpec) DeepCopyInto(out *DomainNameSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNameSpec.
@@ -2413,6 +2448,13 @@ func (in *IntegrationResponseSpec) DeepCopyInto(out *IntegrationResponseSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResponseSpec.
@@ -2514,6 +2556,13 @@ func (in *IntegrationSpec) DeepCopyInto(out *IntegrationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationSpec.
@@ -2854,6 +2903,13 @@ func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
@@ -3377,6 +3433,13 @@ func (in *RouteResponseSpec) DeepCopyInto(out *RouteResponseSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteResponseSpec.
@@ -3513,6 +3576,13 @@ func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
@@ -3847,6 +3917,13 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -4194,6 +4271,13 @@ func (in *VPCLinkSpec) DeepCopyInto(out *VPCLinkSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLinkSpec.
@@ -69,6 +69,13 @@ type IntegrationParameters struct {
 type IntegrationSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       IntegrationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // IntegrationObservation defines the observed state of Integration
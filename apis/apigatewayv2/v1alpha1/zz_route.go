@@ -57,6 +57,13 @@ type RouteParameters struct {
 type RouteSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RouteParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RouteObservation defines the observed state of Route
@@ -54,6 +54,13 @@ type StageParameters struct {
 type StageSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       StageParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // StageObservation defines the observed state of Stage
@@ -227,6 +227,13 @@ type AutoScalingGroupParameters struct {
 type AutoScalingGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       AutoScalingGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // AutoScalingGroupObservation defines the observed state of AutoScalingGroup
@@ -61,6 +61,7 @@ import (
 	firehosev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/firehose/v1alpha1"
 	globalacceleratorv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/globalaccelerator/v1alpha1"
 	gluev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/glue/v1alpha1"
+	iammanualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
 	iamv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1alpha1"
 	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	iotv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/iot/v1alpha1"
@@ -81,8 +82,10 @@ import (
 	route53v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53/v1alpha1"
 	route53resolvermanualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53resolver/manualv1alpha1"
 	route53resolverv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/route53resolver/v1alpha1"
+	s3manualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/s3/manualv1alpha1"
 	s3v1alpha2 "github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
 	s3v1beta1 "github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	s3controlmanualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/s3control/manualv1alpha1"
 	s3control "github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1"
 	secretsmanagerv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/secretsmanager/v1alpha1"
 	secretsmanagerv1beta1 "github.com/crossplane-contrib/provider-aws/apis/secretsmanager/v1beta1"
@@ -109,6 +112,7 @@ func init() {
 		elasticloadbalancingv1alpha1.SchemeBuilder.AddToScheme,
 		iamv1alpha1.SchemeBuilder.AddToScheme,
 		iamv1beta1.SchemeBuilder.AddToScheme,
+		iammanualv1alpha1.SchemeBuilder.AddToScheme,
 		elasticachev1alpha1.SchemeBuilder.AddToScheme,
 		elbv2manualv1alpha1.SchemeBuilder.AddToScheme,
 		elbv2v1alpha1.SchemeBuilder.AddToScheme,
@@ -120,6 +124,7 @@ func init() {
 		acmv1beta1.SchemeBuilder.AddToScheme,
 		s3v1alpha2.SchemeBuilder.AddToScheme,
 		s3v1beta1.SchemeBuilder.AddToScheme,
+		s3manualv1alpha1.SchemeBuilder.AddToScheme,
 		secretsmanagerv1alpha1.SchemeBuilder.AddToScheme,
 		secretsmanagerv1beta1.SchemeBuilder.AddToScheme,
 		servicediscoveryv1alpha1.SchemeBuilder.AddToScheme,
@@ -175,6 +180,7 @@ func init() {
 		autoscalingv1beta1.SchemeBuilder.AddToScheme,
 		servicecatalogv1alpha1.SchemeBuilder.AddToScheme,
 		s3control.SchemeBuilder.AddToScheme,
+		s3controlmanualv1alpha1.SchemeBuilder.AddToScheme,
 		firehosev1alpha1.SchemeBuilder.AddToScheme,
 		wafv2v1alpha1.SchemeBuilder.AddToScheme,
 	)
@@ -302,6 +302,13 @@ type JobParameters struct {
 type JobSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       JobParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // JobObservation keeps the state for the external resource
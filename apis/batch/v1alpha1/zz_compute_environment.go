@@ -64,6 +64,13 @@ type ComputeEnvironmentParameters struct {
 type ComputeEnvironmentSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ComputeEnvironmentParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ComputeEnvironmentObservation defines the observed state of ComputeEnvironment
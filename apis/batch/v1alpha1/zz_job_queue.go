@@ -57,6 +57,13 @@ type JobQueueParameters struct {
 type JobQueueSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       JobQueueParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // JobQueueObservation defines the observed state of JobQueue
@@ -49,6 +49,13 @@ type CacheSubnetGroupParameters struct {
 type CacheSubnetGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CacheSubnetGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // CacheSubnetGroupExternalStatus keeps the state for the external resource
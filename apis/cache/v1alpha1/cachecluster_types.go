@@ -322,6 +322,13 @@ type CacheClusterParameters struct {
 type CacheClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CacheClusterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // A CacheClusterStatus defines the observed state of a CacheCluster.
@@ -595,6 +595,13 @@ type ReplicationGroupParameters struct {
 type ReplicationGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ReplicationGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // A ReplicationGroupStatus defines the observed state of a ReplicationGroup.
@@ -39,6 +39,13 @@ type CachePolicyParameters struct {
 type CachePolicySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CachePolicyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // CachePolicyObservation defines the observed state of CachePolicy
@@ -40,6 +40,13 @@ type ResponseHeadersPolicyParameters struct {
 type ResponseHeadersPolicySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ResponseHeadersPolicyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ResponseHeadersPolicyObservation defines the observed state of ResponseHeadersPolicy
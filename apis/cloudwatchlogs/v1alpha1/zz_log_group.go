@@ -50,6 +50,13 @@ type LogGroupParameters struct {
 type LogGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       LogGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // LogGroupObservation defines the observed state of LogGroup
@@ -60,6 +60,13 @@ type IdentityPoolParameters struct {
 type IdentityPoolSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       IdentityPoolParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // IdentityPoolObservation defines the observed state of IdentityPool
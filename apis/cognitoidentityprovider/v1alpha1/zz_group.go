@@ -54,6 +54,13 @@ type GroupParameters struct {
 type GroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       GroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // GroupObservation defines the observed state of Group
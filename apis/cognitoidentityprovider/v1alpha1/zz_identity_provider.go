@@ -43,6 +43,13 @@ type IdentityProviderParameters struct {
 type IdentityProviderSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       IdentityProviderParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // IdentityProviderObservation defines the observed state of IdentityProvider
@@ -48,6 +48,13 @@ type ResourceServerParameters struct {
 type ResourceServerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ResourceServerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ResourceServerObservation defines the observed state of ResourceServer
@@ -143,6 +143,13 @@ type UserPoolParameters struct {
 type UserPoolSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       UserPoolParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // UserPoolObservation defines the observed state of UserPool
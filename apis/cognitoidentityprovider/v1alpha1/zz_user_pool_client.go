@@ -259,6 +259,13 @@ type UserPoolClientParameters struct {
 type UserPoolClientSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       UserPoolClientParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // UserPoolClientObservation defines the observed state of UserPoolClient
@@ -887,6 +887,13 @@ type RDSInstanceParameters struct {
 type RDSInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RDSInstanceParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RDSInstanceState represents the state of an RDS instance.
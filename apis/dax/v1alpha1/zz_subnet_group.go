@@ -38,6 +38,13 @@ type SubnetGroupParameters struct {
 type SubnetGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       SubnetGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // SubnetGroupObservation defines the observed state of SubnetGroup
@@ -44,6 +44,13 @@ type DBClusterParameterGroupParameters struct {
 type DBClusterParameterGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DBClusterParameterGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DBClusterParameterGroupObservation defines the observed state of DBClusterParameterGroup
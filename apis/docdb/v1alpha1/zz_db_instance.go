@@ -103,6 +103,13 @@ type DBInstanceParameters struct {
 type DBInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DBInstanceParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DBInstanceObservation defines the observed state of DBInstance
@@ -41,6 +41,13 @@ type DBSubnetGroupParameters struct {
 type DBSubnetGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DBSubnetGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DBSubnetGroupObservation defines the observed state of DBSubnetGroup
@@ -169,6 +169,13 @@ type TableParameters struct {
 type TableSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TableParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TableObservation defines the observed state of Table
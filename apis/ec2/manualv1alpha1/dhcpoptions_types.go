@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DHCPOptionsParameters define the desired state of an AWS VPC DHCP options
+// set. A DHCP options set cannot be modified after it is created, except for
+// its tags.
+type DHCPOptionsParameters struct {
+	// Region is the region you'd like your DHCPOptions to be created in.
+	Region string `json:"region"`
+
+	// The domain name that the instances of the VPC use, e.g.
+	// ec2.internal.
+	// +immutable
+	// +optional
+	DomainName *string `json:"domainName,omitempty"`
+
+	// The IP addresses of up to four domain name servers, or
+	// AmazonProvidedDNS.
+	// +immutable
+	// +optional
+	DomainNameServers []string `json:"domainNameServers,omitempty"`
+
+	// The IP addresses of up to four Network Time Protocol (NTP) servers.
+	// +immutable
+	// +optional
+	NTPServers []string `json:"ntpServers,omitempty"`
+
+	// The IP addresses of up to four NetBIOS name servers.
+	// +immutable
+	// +optional
+	NetBIOSNameServers []string `json:"netbiosNameServers,omitempty"`
+
+	// The NetBIOS node type. AWS recommends 2, as broadcast and multicast
+	// are not supported.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum="1";"2";"4";"8"
+	NetBIOSNodeType *string `json:"netbiosNodeType,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A DHCPOptionsSpec defines the desired state of a DHCPOptions.
+type DHCPOptionsSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DHCPOptionsParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// DHCPOptionsObservation keeps the state for the external resource
+type DHCPOptionsObservation struct {
+	// DHCPOptionsID is the ID of the DHCP options set.
+	DHCPOptionsID string `json:"dhcpOptionsId,omitempty"`
+
+	// The ID of the AWS account that owns the DHCP options set.
+	OwnerID string `json:"ownerId,omitempty"`
+}
+
+// A DHCPOptionsStatus represents the observed state of a DHCPOptions.
+type DHCPOptionsStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DHCPOptionsObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DHCPOptions is a managed resource that represents an AWS VPC DHCP options
+// set.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domainName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DHCPOptions struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DHCPOptionsSpec   `json:"spec"`
+	Status DHCPOptionsStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DHCPOptionsList contains a list of DHCPOptions
+type DHCPOptionsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DHCPOptions `json:"items"`
+}
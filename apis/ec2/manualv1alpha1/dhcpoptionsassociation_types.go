@@ -0,0 +1,110 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DHCPOptionsAssociationParameters define the desired state of the
+// association between a VPC and a DHCP options set.
+type DHCPOptionsAssociationParameters struct {
+	// Region is the region of the VPC and the DHCP options set.
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId.
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// DHCPOptionsID is the ID of the DHCP options set.
+	// +optional
+	// +crossplane:generate:reference:type=DHCPOptions
+	DHCPOptionsID *string `json:"dhcpOptionsId,omitempty"`
+
+	// DHCPOptionsIDRef references a DHCPOptions to retrieve its ID.
+	// +optional
+	DHCPOptionsIDRef *xpv1.Reference `json:"dhcpOptionsIdRef,omitempty"`
+
+	// DHCPOptionsIDSelector selects a reference to a DHCPOptions to
+	// retrieve its ID.
+	// +optional
+	DHCPOptionsIDSelector *xpv1.Selector `json:"dhcpOptionsIdSelector,omitempty"`
+}
+
+// A DHCPOptionsAssociationSpec defines the desired state of a
+// DHCPOptionsAssociation.
+type DHCPOptionsAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DHCPOptionsAssociationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// DHCPOptionsAssociationObservation keeps the state for the external resource
+type DHCPOptionsAssociationObservation struct{}
+
+// A DHCPOptionsAssociationStatus represents the observed state of a
+// DHCPOptionsAssociation.
+type DHCPOptionsAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DHCPOptionsAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DHCPOptionsAssociation is a managed resource that associates a DHCP
+// options set with a VPC. Deleting it associates the VPC with the default
+// DHCP options set again.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="DHCPOPTIONS",type="string",JSONPath=".spec.forProvider.dhcpOptionsId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DHCPOptionsAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DHCPOptionsAssociationSpec   `json:"spec"`
+	Status DHCPOptionsAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DHCPOptionsAssociationList contains a list of DHCPOptionsAssociations
+type DHCPOptionsAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DHCPOptionsAssociation `json:"items"`
+}
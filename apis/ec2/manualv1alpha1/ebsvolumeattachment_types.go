@@ -0,0 +1,127 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EBSVolumeAttachmentParameters define the desired state of the attachment of
+// an EBS volume to an EC2 instance.
+type EBSVolumeAttachmentParameters struct {
+	// Region is the region of the volume and the instance.
+	Region string `json:"region"`
+
+	// The device name, e.g. /dev/sdh or xvdh.
+	// +immutable
+	Device string `json:"device"`
+
+	// InstanceID is the ID of the instance.
+	// +immutable
+	// +optional
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance to retrieve its ID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to an Instance to retrieve its
+	// ID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// VolumeID is the ID of the EBS volume. The volume and instance must be
+	// within the same Availability Zone.
+	// +immutable
+	// +optional
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef references a Volume to retrieve its ID.
+	// +optional
+	VolumeIDRef *xpv1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume to retrieve its ID.
+	// +optional
+	VolumeIDSelector *xpv1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// ForceDetach forces the detachment on deletion if the previous
+	// detachment attempt did not occur cleanly. The instance does not get an
+	// opportunity to flush file system caches, which can lead to data loss.
+	// +optional
+	ForceDetach *bool `json:"forceDetach,omitempty"`
+}
+
+// An EBSVolumeAttachmentSpec defines the desired state of an EBSVolumeAttachment.
+type EBSVolumeAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EBSVolumeAttachmentParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// EBSVolumeAttachmentObservation keeps the state for the external resource
+type EBSVolumeAttachmentObservation struct {
+	// The attachment state of the volume.
+	State string `json:"state,omitempty"`
+
+	// The time stamp when the attachment initiated.
+	AttachTime *metav1.Time `json:"attachTime,omitempty"`
+
+	// Indicates whether the EBS volume is deleted on instance termination.
+	DeleteOnTermination bool `json:"deleteOnTermination,omitempty"`
+}
+
+// An EBSVolumeAttachmentStatus represents the observed state of an EBSVolumeAttachment.
+type EBSVolumeAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EBSVolumeAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EBSVolumeAttachment is a managed resource that attaches an EBS volume to an
+// EC2 instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".spec.forProvider.volumeId"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instanceId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EBSVolumeAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EBSVolumeAttachmentSpec   `json:"spec"`
+	Status EBSVolumeAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EBSVolumeAttachmentList contains a list of EBSVolumeAttachments
+type EBSVolumeAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EBSVolumeAttachment `json:"items"`
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ImageParameters define the desired state of an AMI. An AMI is either
+// created from an instance or registered from the snapshot of a root volume.
+type ImageParameters struct {
+	// Region is the region you'd like your Image to be created in.
+	Region string `json:"region"`
+
+	// A name for the AMI.
+	// +immutable
+	Name string `json:"name"`
+
+	// A description for the AMI.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// InstanceID is the ID of the instance to create the AMI from. Either
+	// instanceId or snapshotId has to be set.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Instance
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef references an Instance to retrieve its ID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to an Instance to retrieve its
+	// ID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// NoReboot prevents the instance from being shut down before the AMI is
+	// created. The file system integrity of the AMI is not guaranteed then.
+	// +immutable
+	// +optional
+	NoReboot *bool `json:"noReboot,omitempty"`
+
+	// SnapshotID is the ID of the snapshot of the root volume to register
+	// the AMI from.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Snapshot
+	SnapshotID *string `json:"snapshotId,omitempty"`
+
+	// SnapshotIDRef references a Snapshot to retrieve its ID.
+	// +optional
+	SnapshotIDRef *xpv1.Reference `json:"snapshotIdRef,omitempty"`
+
+	// SnapshotIDSelector selects a reference to a Snapshot to retrieve its
+	// ID.
+	// +optional
+	SnapshotIDSelector *xpv1.Selector `json:"snapshotIdSelector,omitempty"`
+
+	// The device name of the root volume of an AMI registered from a
+	// snapshot, e.g. /dev/xvda.
+	// +immutable
+	// +optional
+	RootDeviceName *string `json:"rootDeviceName,omitempty"`
+
+	// The architecture of an AMI registered from a snapshot.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=i386;x86_64;arm64;x86_64_mac;arm64_mac
+	Architecture *string `json:"architecture,omitempty"`
+
+	// The virtualization type of an AMI registered from a snapshot.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=hvm;paravirtual
+	VirtualizationType *string `json:"virtualizationType,omitempty"`
+
+	// The boot mode of an AMI registered from a snapshot.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=legacy-bios;uefi;uefi-preferred
+	BootMode *string `json:"bootMode,omitempty"`
+
+	// ENASupport enables enhanced networking with ENA for an AMI registered
+	// from a snapshot.
+	// +immutable
+	// +optional
+	ENASupport *bool `json:"enaSupport,omitempty"`
+
+	// LaunchPermissionAccountIDs is the exclusive list of AWS accounts the
+	// AMI is shared with.
+	// +optional
+	LaunchPermissionAccountIDs []string `json:"launchPermissionAccountIds,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ImageSpec defines the desired state of a Image.
+type ImageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImageParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// ImageObservation keeps the state for the external resource
+type ImageObservation struct {
+	// The ID of the AMI.
+	ImageID string `json:"imageId,omitempty"`
+
+	// The ID of the AWS account that owns the AMI.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The current state of the AMI.
+	State string `json:"state,omitempty"`
+
+	// The reason for the state change.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// The date and time the AMI was created.
+	CreationDate string `json:"creationDate,omitempty"`
+
+	// The IDs of the snapshots of the block devices of the AMI.
+	SnapshotIDs []string `json:"snapshotIds,omitempty"`
+}
+
+// A ImageStatus represents the observed state of a Image.
+type ImageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Image is a managed resource that represents an AMI.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Image struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImageSpec   `json:"spec"`
+	Status ImageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageList contains a list of Images
+type ImageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Image `json:"items"`
+}
@@ -21,12 +21,38 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// AnnotationKeyStoppedForUpdate is the key in the annotations map of an
+// Instance that is set while the controller stopped it to apply an update.
+const AnnotationKeyStoppedForUpdate = Group + "/stopped-for-update"
+
+// Desired power states of an Instance.
+const (
+	InstanceDesiredStateRunning    = "running"
+	InstanceDesiredStateStopped    = "stopped"
+	InstanceDesiredStateHibernated = "hibernated"
+)
+
 // InstanceParameters define the desired state of the Instances
 type InstanceParameters struct {
+	// AllowStopForUpdate allows the controller to stop the instance in order
+	// to apply changes to attributes that can only be modified while it is
+	// stopped, i.e. instanceType, userData, ebsOptimized, kernelId and
+	// ramDiskId. The instance is started again once the changes are applied.
+	// Without it, such changes are only applied while the instance is stopped.
+	// +optional
+	AllowStopForUpdate *bool `json:"allowStopForUpdate,omitempty"`
+
 	// The block device mapping entries.
 	// +optional
 	BlockDeviceMappings []BlockDeviceMapping `json:"blockDeviceMappings,omitempty"`
 
+	// DesiredState is the power state the instance is kept in. A hibernated
+	// instance must be enabled for hibernation, see hibernationOptions. The
+	// power state is left alone if DesiredState is not set.
+	// +optional
+	// +kubebuilder:validation:Enum=running;stopped;hibernated
+	DesiredState *string `json:"desiredState,omitempty"`
+
 	// Information about the Capacity Reservation targeting option. If you do not
 	// specify this parameter, the instance's Capacity Reservation preference defaults
 	// to open, which enables it to run in any open Capacity Reservation that has
@@ -166,8 +192,18 @@ type InstanceParameters struct {
 	// If you do not specify a key pair, you can't connect to the instance unless
 	// you choose an AMI that is configured to allow users another way to log in.
 	// +optional
+	// +crossplane:generate:reference:type=KeyPair
 	KeyName *string `json:"keyName,omitempty"`
 
+	// KeyNameRef is a reference to a KeyPair used to set the KeyName.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair used to set the
+	// KeyName.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
+
 	// The launch template to use to launch the instances. Any parameters that you
 	// specify in RunInstances override the same parameters in the launch template.
 	// You can specify either the name or ID of a launch template, but not both.
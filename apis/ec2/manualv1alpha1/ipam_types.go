@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPAMParameters define the desired state of an AWS VPC IP Address Manager.
+type IPAMParameters struct {
+	// Region is the home region of the IPAM.
+	Region string `json:"region"`
+
+	// A description for the IPAM.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// OperatingRegions are the regions in which the IPAM can discover and
+	// manage IP addresses. The home region of the IPAM must be one of them.
+	// +optional
+	OperatingRegions []string `json:"operatingRegions,omitempty"`
+
+	// Tier of the IPAM.
+	// +kubebuilder:validation:Enum=free;advanced
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// Cascade deletes the scopes and pools of the IPAM, and the CIDRs
+	// allocated from them, when the IPAM is deleted.
+	// +optional
+	Cascade *bool `json:"cascade,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IPAMSpec defines the desired state of an IPAM.
+type IPAMSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// IPAMObservation keeps the state for the external resource
+type IPAMObservation struct {
+	// The ID of the IPAM.
+	IPAMID string `json:"ipamId,omitempty"`
+
+	// The ARN of the IPAM.
+	IPAMArn string `json:"ipamArn,omitempty"`
+
+	// The ID of the AWS account that owns the IPAM.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The ID of the default private scope of the IPAM.
+	PrivateDefaultScopeID string `json:"privateDefaultScopeId,omitempty"`
+
+	// The ID of the default public scope of the IPAM.
+	PublicDefaultScopeID string `json:"publicDefaultScopeId,omitempty"`
+
+	// The number of scopes in the IPAM.
+	ScopeCount int32 `json:"scopeCount,omitempty"`
+
+	// The state of the IPAM.
+	State string `json:"state,omitempty"`
+}
+
+// An IPAMStatus represents the observed state of an IPAM.
+type IPAMStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IPAMObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAM is a managed resource that represents an AWS VPC IP Address
+// Manager.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAM struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMSpec   `json:"spec"`
+	Status IPAMStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMList contains a list of IPAMs
+type IPAMList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAM `json:"items"`
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IPAMPoolSourceResource is the resource a resource planning pool plans the
+// IP address space of.
+type IPAMPoolSourceResource struct {
+	// ResourceID is the ID of the source resource.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.VPC
+	ResourceID *string `json:"resourceId,omitempty"`
+
+	// ResourceIDRef references a VPC to retrieve its ID.
+	// +optional
+	ResourceIDRef *xpv1.Reference `json:"resourceIdRef,omitempty"`
+
+	// ResourceIDSelector selects a reference to a VPC to retrieve its ID.
+	// +optional
+	ResourceIDSelector *xpv1.Selector `json:"resourceIdSelector,omitempty"`
+
+	// ResourceOwner is the ID of the AWS account that owns the source
+	// resource.
+	// +optional
+	ResourceOwner *string `json:"resourceOwner,omitempty"`
+
+	// ResourceRegion is the region of the source resource.
+	// +optional
+	ResourceRegion *string `json:"resourceRegion,omitempty"`
+
+	// ResourceType is the type of the source resource.
+	// +kubebuilder:validation:Enum=vpc
+	ResourceType string `json:"resourceType"`
+}
+
+// IPAMPoolParameters define the desired state of an AWS IPAM pool.
+type IPAMPoolParameters struct {
+	// Region is the region you'd like your IPAM pool to be created in.
+	Region string `json:"region"`
+
+	// IPAMScopeID is the ID of the scope in which the pool is created.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=IPAMScope
+	IPAMScopeID *string `json:"ipamScopeId,omitempty"`
+
+	// IPAMScopeIDRef references an IPAMScope to retrieve its ID.
+	// +optional
+	IPAMScopeIDRef *xpv1.Reference `json:"ipamScopeIdRef,omitempty"`
+
+	// IPAMScopeIDSelector selects a reference to an IPAMScope to retrieve its
+	// ID.
+	// +optional
+	IPAMScopeIDSelector *xpv1.Selector `json:"ipamScopeIdSelector,omitempty"`
+
+	// The IP protocol assigned to this pool.
+	// +immutable
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	AddressFamily string `json:"addressFamily"`
+
+	// The locale of the pool. Only VPCs in this region can allocate CIDRs from
+	// the pool. It must be one of the operating regions of the IPAM.
+	// +immutable
+	// +optional
+	Locale *string `json:"locale,omitempty"`
+
+	// SourceIPAMPoolID is the ID of the pool the CIDRs of this pool are
+	// provisioned from.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=IPAMPool
+	SourceIPAMPoolID *string `json:"sourceIpamPoolId,omitempty"`
+
+	// SourceIPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	SourceIPAMPoolIDRef *xpv1.Reference `json:"sourceIpamPoolIdRef,omitempty"`
+
+	// SourceIPAMPoolIDSelector selects a reference to an IPAMPool to retrieve
+	// its ID.
+	// +optional
+	SourceIPAMPoolIDSelector *xpv1.Selector `json:"sourceIpamPoolIdSelector,omitempty"`
+
+	// SourceResource makes the pool a resource planning pool of the given
+	// resource. Subnets can only allocate their CIDR from a resource planning
+	// pool of their VPC.
+	// +immutable
+	// +optional
+	SourceResource *IPAMPoolSourceResource `json:"sourceResource,omitempty"`
+
+	// A description for the pool.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Indicates whether IPAM imports CIDRs of existing resources within the
+	// locale of the pool.
+	// +optional
+	AutoImport *bool `json:"autoImport,omitempty"`
+
+	// Indicates whether the CIDRs of an IPv6 pool are publicly advertisable.
+	// +immutable
+	// +optional
+	PubliclyAdvertisable *bool `json:"publiclyAdvertisable,omitempty"`
+
+	// The default netmask length for allocations from this pool.
+	// +optional
+	AllocationDefaultNetmaskLength *int32 `json:"allocationDefaultNetmaskLength,omitempty"`
+
+	// The maximum netmask length allowed for allocations from this pool.
+	// +optional
+	AllocationMaxNetmaskLength *int32 `json:"allocationMaxNetmaskLength,omitempty"`
+
+	// The minimum netmask length allowed for allocations from this pool.
+	// +optional
+	AllocationMinNetmaskLength *int32 `json:"allocationMinNetmaskLength,omitempty"`
+
+	// Tags that resources must have to allocate CIDRs from this pool.
+	// +optional
+	AllocationResourceTags []Tag `json:"allocationResourceTags,omitempty"`
+
+	// CIDRs to provision into the pool. CIDRs of a pool with a source pool
+	// must be within the CIDRs of that pool. CIDRs removed from this list are
+	// deprovisioned.
+	// +optional
+	CIDRs []string `json:"cidrs,omitempty"`
+
+	// Cascade deletes the CIDRs provisioned into the pool and the allocations
+	// made from it when the pool is deleted.
+	// +optional
+	Cascade *bool `json:"cascade,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IPAMPoolSpec defines the desired state of an IPAMPool.
+type IPAMPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMPoolParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// IPAMPoolObservation keeps the state for the external resource
+type IPAMPoolObservation struct {
+	// The ID of the pool.
+	IPAMPoolID string `json:"ipamPoolId,omitempty"`
+
+	// The ARN of the pool.
+	IPAMPoolArn string `json:"ipamPoolArn,omitempty"`
+
+	// The ARN of the IPAM the pool belongs to.
+	IPAMArn string `json:"ipamArn,omitempty"`
+
+	// The ARN of the scope the pool belongs to.
+	IPAMScopeArn string `json:"ipamScopeArn,omitempty"`
+
+	// The type of the scope the pool belongs to.
+	IPAMScopeType string `json:"ipamScopeType,omitempty"`
+
+	// The depth of the pool in the pool hierarchy.
+	PoolDepth int32 `json:"poolDepth,omitempty"`
+
+	// The CIDRs provisioned into the pool.
+	ProvisionedCIDRs []string `json:"provisionedCidrs,omitempty"`
+
+	// The state of the pool.
+	State string `json:"state,omitempty"`
+
+	// A message about the state of the pool, if applicable.
+	StateMessage string `json:"stateMessage,omitempty"`
+}
+
+// An IPAMPoolStatus represents the observed state of an IPAMPool.
+type IPAMPoolStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IPAMPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAMPool is a managed resource that represents an AWS IPAM pool. VPCs,
+// VPCCIDRBlocks and Subnets can allocate their IPv4 CIDR from it.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="FAMILY",type="string",JSONPath=".spec.forProvider.addressFamily"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAMPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMPoolSpec   `json:"spec"`
+	Status IPAMPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMPoolList contains a list of IPAMPools
+type IPAMPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAMPool `json:"items"`
+}
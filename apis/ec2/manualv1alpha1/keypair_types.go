@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KeyPairParameters define the desired state of an AWS EC2 key pair.
+// If neither PublicKeyMaterial nor PublicKeySecretRef is set, AWS generates
+// the key pair and its private key is published to the connection secret
+// once, when the key pair is created.
+type KeyPairParameters struct {
+	// Region is the region you'd like your KeyPair to be created in.
+	Region string `json:"region"`
+
+	// The type of key pair to generate. Ignored when a public key is
+	// imported.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=rsa;ed25519
+	KeyType *string `json:"keyType,omitempty"`
+
+	// The format of the generated private key. Ignored when a public key is
+	// imported.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=pem;ppk
+	KeyFormat *string `json:"keyFormat,omitempty"`
+
+	// PublicKeyMaterial is the public key to import, e.g. an OpenSSH
+	// public key.
+	// +immutable
+	// +optional
+	PublicKeyMaterial *string `json:"publicKeyMaterial,omitempty"`
+
+	// PublicKeySecretRef references a key of a Secret that contains the
+	// public key to import. PublicKeyMaterial takes precedence if both are
+	// set.
+	// +immutable
+	// +optional
+	PublicKeySecretRef *xpv1.SecretKeySelector `json:"publicKeySecretRef,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A KeyPairSpec defines the desired state of a KeyPair.
+type KeyPairSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyPairParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// KeyPairObservation keeps the state for the external resource
+type KeyPairObservation struct {
+	// KeyPairID is the ID of the key pair.
+	KeyPairID string `json:"keyPairId,omitempty"`
+
+	// The fingerprint of the key pair.
+	KeyFingerprint string `json:"keyFingerprint,omitempty"`
+
+	// The type of the key pair.
+	KeyType string `json:"keyType,omitempty"`
+}
+
+// A KeyPairStatus represents the observed state of a KeyPair.
+type KeyPairStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyPairObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyPair is a managed resource that represents an AWS EC2 key pair.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.atProvider.keyPairId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyPair struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyPairSpec   `json:"spec"`
+	Status KeyPairStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyPairList contains a list of KeyPairs
+type KeyPairList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyPair `json:"items"`
+}
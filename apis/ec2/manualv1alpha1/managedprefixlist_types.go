@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PrefixListEntry describes a CIDR of a managed prefix list.
+type PrefixListEntry struct {
+	// The CIDR block.
+	CIDR string `json:"cidr"`
+
+	// A description for the entry.
+	//
+	// Constraints: Up to 255 characters in length.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// ManagedPrefixListParameters define the desired state of an AWS managed
+// prefix list.
+type ManagedPrefixListParameters struct {
+	// Region is the region you'd like your ManagedPrefixList to be created in.
+	Region string `json:"region"`
+
+	// A name for the prefix list. It cannot start with com.amazonaws.
+	PrefixListName string `json:"prefixListName"`
+
+	// The IP address type.
+	// +immutable
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	AddressFamily string `json:"addressFamily"`
+
+	// The maximum number of entries for the prefix list. The entries of a
+	// prefix list count against the quota of the security groups and route
+	// tables that reference it.
+	// +kubebuilder:validation:Minimum=1
+	MaxEntries int32 `json:"maxEntries"`
+
+	// Entries is the exclusive set of entries of the prefix list. Entries
+	// that are not listed here are removed.
+	// +optional
+	Entries []PrefixListEntry `json:"entries,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ManagedPrefixListSpec defines the desired state of a ManagedPrefixList.
+type ManagedPrefixListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedPrefixListParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// ManagedPrefixListObservation keeps the state for the external resource
+type ManagedPrefixListObservation struct {
+	// The ID of the prefix list.
+	PrefixListID string `json:"prefixListId,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the prefix list.
+	PrefixListARN string `json:"prefixListArn,omitempty"`
+
+	// The ID of the owner of the prefix list.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The current state of the prefix list.
+	State string `json:"state,omitempty"`
+
+	// The state message.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// The version of the prefix list. It is incremented on every change of
+	// its entries.
+	Version int64 `json:"version,omitempty"`
+}
+
+// A ManagedPrefixListStatus represents the observed state of a
+// ManagedPrefixList.
+type ManagedPrefixListStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ManagedPrefixListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedPrefixList is a managed resource that represents an AWS managed
+// prefix list.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ManagedPrefixList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedPrefixListSpec   `json:"spec"`
+	Status ManagedPrefixListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedPrefixListList contains a list of ManagedPrefixLists
+type ManagedPrefixListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedPrefixList `json:"items"`
+}
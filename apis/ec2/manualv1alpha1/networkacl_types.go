@@ -0,0 +1,289 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkACLRule describes an ingress or egress entry of a network ACL.
+type NetworkACLRule struct {
+	// The rule number for the entry. ACL entries are processed in ascending
+	// order by rule number.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=32766
+	RuleNumber int32 `json:"ruleNumber"`
+
+	// The protocol number, or one of tcp, udp, icmp, icmpv6. A value of -1
+	// means all protocols. If you specify -1 or a protocol number other than
+	// tcp (6), udp (17), icmp (1) or icmpv6 (58), traffic on all ports is
+	// allowed, regardless of any ports or ICMP types or codes that you specify.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation.
+	// +optional
+	CIDRBlock *string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// The first port in the range. Required for the tcp and udp protocols.
+	// +optional
+	FromPort *int32 `json:"fromPort,omitempty"`
+
+	// The last port in the range. Required for the tcp and udp protocols.
+	// +optional
+	ToPort *int32 `json:"toPort,omitempty"`
+
+	// The ICMP type. A value of -1 means all types. Required for the icmp
+	// and icmpv6 protocols.
+	// +optional
+	ICMPType *int32 `json:"icmpType,omitempty"`
+
+	// The ICMP code. A value of -1 means all codes for the specified ICMP
+	// type. Required for the icmp and icmpv6 protocols.
+	// +optional
+	ICMPCode *int32 `json:"icmpCode,omitempty"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// Region is the region you'd like your NetworkACL to be created in.
+	Region string `json:"region"`
+
+	// VPCID is the ID of the VPC.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.VPC
+	VPCID *string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references a VPC to retrieve its vpcId
+	// +optional
+	// +immutable
+	VPCIDRef *xpv1.Reference `json:"vpcIdRef,omitempty"`
+
+	// VPCIDSelector selects a reference to a VPC to retrieve its vpcId
+	// +optional
+	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets associated with the network ACL.
+	// A subnet is always associated with exactly one network ACL, so subnets
+	// that are removed from this list are associated with the default network
+	// ACL of the VPC again.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetIDSelector
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a list of references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// Ingress is the exclusive set of inbound entries of the network ACL.
+	// Entries that are not listed here are removed.
+	// +optional
+	Ingress []NetworkACLRule `json:"ingress,omitempty"`
+
+	// Egress is the exclusive set of outbound entries of the network ACL.
+	// Entries that are not listed here are removed.
+	// +optional
+	Egress []NetworkACLRule `json:"egress,omitempty"`
+
+	// Dont manage the ingress entries of the network ACL, e.g. because they
+	// are managed with NetworkACLEntry resources.
+	// +optional
+	IgnoreIngress *bool `json:"ignoreIngress,omitempty"`
+
+	// Dont manage the egress entries of the network ACL, e.g. because they
+	// are managed with NetworkACLEntry resources.
+	// +optional
+	IgnoreEgress *bool `json:"ignoreEgress,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and a
+// subnet.
+type NetworkACLAssociation struct {
+	// The ID of the association between the network ACL and the subnet.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+}
+
+// NetworkACLObservation keeps the state for the external resource
+type NetworkACLObservation struct {
+	// NetworkACLID is the ID of the network ACL.
+	NetworkACLID string `json:"networkAclId,omitempty"`
+
+	// Indicates whether this is the default network ACL for the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The ID of the AWS account that owns the network ACL.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The subnets associated with the network ACL.
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VPC",type="string",JSONPath=".spec.forProvider.vpcId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
+
+// NetworkACLEntryParameters define the desired state of an entry of an AWS
+// VPC Network ACL.
+type NetworkACLEntryParameters struct {
+	// Region is the region you'd like your NetworkACLEntry to be created in.
+	Region string `json:"region"`
+
+	// NetworkACLID is the ID of the network ACL. If the network ACL is managed
+	// by a NetworkACL resource, enable ignoreIngress or ignoreEgress on it to
+	// prevent the entry from being removed.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=NetworkACL
+	NetworkACLID *string `json:"networkAclId,omitempty"`
+
+	// NetworkACLIDRef references a NetworkACL to retrieve its networkAclId
+	// +optional
+	// +immutable
+	NetworkACLIDRef *xpv1.Reference `json:"networkAclIdRef,omitempty"`
+
+	// NetworkACLIDSelector selects a reference to a NetworkACL to retrieve its
+	// networkAclId
+	// +optional
+	NetworkACLIDSelector *xpv1.Selector `json:"networkAclIdSelector,omitempty"`
+
+	// Indicates whether this is an egress entry, i.e. a rule applied to
+	// traffic leaving the subnet. Ingress entries are created by default.
+	// +optional
+	// +immutable
+	Egress *bool `json:"egress,omitempty"`
+
+	NetworkACLRule `json:",inline"`
+}
+
+// A NetworkACLEntrySpec defines the desired state of a NetworkACLEntry.
+type NetworkACLEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkACLEntryParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// NetworkACLEntryObservation keeps the state for the external resource
+type NetworkACLEntryObservation struct {
+	// The protocol number of the entry as reported by AWS.
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// A NetworkACLEntryStatus represents the observed state of a NetworkACLEntry.
+type NetworkACLEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkACLEntryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACLEntry is a managed resource that represents a single entry of an
+// AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ACL",type="string",JSONPath=".spec.forProvider.networkAclId"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type NetworkACLEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLEntrySpec   `json:"spec"`
+	Status NetworkACLEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLEntryList contains a list of NetworkACLEntries
+type NetworkACLEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACLEntry `json:"items"`
+}
@@ -64,8 +64,170 @@ var (
 	InstanceGroupVersionKind = SchemeGroupVersion.WithKind(InstanceKind)
 )
 
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLKind}.String()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
+// NetworkACLEntry type metadata.
+var (
+	NetworkACLEntryKind             = reflect.TypeOf(NetworkACLEntry{}).Name()
+	NetworkACLEntryGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkACLEntryKind}.String()
+	NetworkACLEntryKindAPIVersion   = NetworkACLEntryKind + "." + SchemeGroupVersion.String()
+	NetworkACLEntryGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLEntryKind)
+)
+
+// KeyPair type metadata.
+var (
+	KeyPairKind             = reflect.TypeOf(KeyPair{}).Name()
+	KeyPairGroupKind        = schema.GroupKind{Group: Group, Kind: KeyPairKind}.String()
+	KeyPairKindAPIVersion   = KeyPairKind + "." + SchemeGroupVersion.String()
+	KeyPairGroupVersionKind = SchemeGroupVersion.WithKind(KeyPairKind)
+)
+
+// DHCPOptionsAssociation type metadata.
+var (
+	DHCPOptionsAssociationKind             = reflect.TypeOf(DHCPOptionsAssociation{}).Name()
+	DHCPOptionsAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPOptionsAssociationKind}.String()
+	DHCPOptionsAssociationKindAPIVersion   = DHCPOptionsAssociationKind + "." + SchemeGroupVersion.String()
+	DHCPOptionsAssociationGroupVersionKind = SchemeGroupVersion.WithKind(DHCPOptionsAssociationKind)
+)
+
+// ManagedPrefixList type metadata.
+var (
+	ManagedPrefixListKind             = reflect.TypeOf(ManagedPrefixList{}).Name()
+	ManagedPrefixListGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedPrefixListKind}.String()
+	ManagedPrefixListKindAPIVersion   = ManagedPrefixListKind + "." + SchemeGroupVersion.String()
+	ManagedPrefixListGroupVersionKind = SchemeGroupVersion.WithKind(ManagedPrefixListKind)
+)
+
+// DHCPOptions type metadata.
+var (
+	DHCPOptionsKind             = reflect.TypeOf(DHCPOptions{}).Name()
+	DHCPOptionsGroupKind        = schema.GroupKind{Group: Group, Kind: DHCPOptionsKind}.String()
+	DHCPOptionsKindAPIVersion   = DHCPOptionsKind + "." + SchemeGroupVersion.String()
+	DHCPOptionsGroupVersionKind = SchemeGroupVersion.WithKind(DHCPOptionsKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// Image type metadata.
+var (
+	ImageKind             = reflect.TypeOf(Image{}).Name()
+	ImageGroupKind        = schema.GroupKind{Group: Group, Kind: ImageKind}.String()
+	ImageKindAPIVersion   = ImageKind + "." + SchemeGroupVersion.String()
+	ImageGroupVersionKind = SchemeGroupVersion.WithKind(ImageKind)
+)
+
+// TransitGatewayPeeringAttachment type metadata.
+var (
+	TransitGatewayPeeringAttachmentKind             = reflect.TypeOf(TransitGatewayPeeringAttachment{}).Name()
+	TransitGatewayPeeringAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayPeeringAttachmentKind}.String()
+	TransitGatewayPeeringAttachmentKindAPIVersion   = TransitGatewayPeeringAttachmentKind + "." + SchemeGroupVersion.String()
+	TransitGatewayPeeringAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayPeeringAttachmentKind)
+)
+
+// TransitGatewayPeeringAttachmentAccepter type metadata.
+var (
+	TransitGatewayPeeringAttachmentAccepterKind             = reflect.TypeOf(TransitGatewayPeeringAttachmentAccepter{}).Name()
+	TransitGatewayPeeringAttachmentAccepterGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayPeeringAttachmentAccepterKind}.String()
+	TransitGatewayPeeringAttachmentAccepterKindAPIVersion   = TransitGatewayPeeringAttachmentAccepterKind + "." + SchemeGroupVersion.String()
+	TransitGatewayPeeringAttachmentAccepterGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayPeeringAttachmentAccepterKind)
+)
+
+// TransitGatewayRouteTableAssociation type metadata.
+var (
+	TransitGatewayRouteTableAssociationKind             = reflect.TypeOf(TransitGatewayRouteTableAssociation{}).Name()
+	TransitGatewayRouteTableAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTableAssociationKind}.String()
+	TransitGatewayRouteTableAssociationKindAPIVersion   = TransitGatewayRouteTableAssociationKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTableAssociationGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTableAssociationKind)
+)
+
+// TransitGatewayRouteTablePropagation type metadata.
+var (
+	TransitGatewayRouteTablePropagationKind             = reflect.TypeOf(TransitGatewayRouteTablePropagation{}).Name()
+	TransitGatewayRouteTablePropagationGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayRouteTablePropagationKind}.String()
+	TransitGatewayRouteTablePropagationKindAPIVersion   = TransitGatewayRouteTablePropagationKind + "." + SchemeGroupVersion.String()
+	TransitGatewayRouteTablePropagationGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayRouteTablePropagationKind)
+)
+
+// TransitGatewayVPCAttachmentAccepter type metadata.
+var (
+	TransitGatewayVPCAttachmentAccepterKind             = reflect.TypeOf(TransitGatewayVPCAttachmentAccepter{}).Name()
+	TransitGatewayVPCAttachmentAccepterGroupKind        = schema.GroupKind{Group: Group, Kind: TransitGatewayVPCAttachmentAccepterKind}.String()
+	TransitGatewayVPCAttachmentAccepterKindAPIVersion   = TransitGatewayVPCAttachmentAccepterKind + "." + SchemeGroupVersion.String()
+	TransitGatewayVPCAttachmentAccepterGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayVPCAttachmentAccepterKind)
+)
+
+// VPCPeeringConnectionAccepter type metadata.
+var (
+	VPCPeeringConnectionAccepterKind             = reflect.TypeOf(VPCPeeringConnectionAccepter{}).Name()
+	VPCPeeringConnectionAccepterGroupKind        = schema.GroupKind{Group: Group, Kind: VPCPeeringConnectionAccepterKind}.String()
+	VPCPeeringConnectionAccepterKindAPIVersion   = VPCPeeringConnectionAccepterKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionAccepterGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionAccepterKind)
+)
+
+// IPAM type metadata.
+var (
+	IPAMKind             = reflect.TypeOf(IPAM{}).Name()
+	IPAMGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMKind}.String()
+	IPAMKindAPIVersion   = IPAMKind + "." + SchemeGroupVersion.String()
+	IPAMGroupVersionKind = SchemeGroupVersion.WithKind(IPAMKind)
+)
+
+// IPAMScope type metadata.
+var (
+	IPAMScopeKind             = reflect.TypeOf(IPAMScope{}).Name()
+	IPAMScopeGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMScopeKind}.String()
+	IPAMScopeKindAPIVersion   = IPAMScopeKind + "." + SchemeGroupVersion.String()
+	IPAMScopeGroupVersionKind = SchemeGroupVersion.WithKind(IPAMScopeKind)
+)
+
+// IPAMPool type metadata.
+var (
+	IPAMPoolKind             = reflect.TypeOf(IPAMPool{}).Name()
+	IPAMPoolGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMPoolKind}.String()
+	IPAMPoolKindAPIVersion   = IPAMPoolKind + "." + SchemeGroupVersion.String()
+	IPAMPoolGroupVersionKind = SchemeGroupVersion.WithKind(IPAMPoolKind)
+)
+
+// EBSVolumeAttachment type metadata.
+var (
+	EBSVolumeAttachmentKind             = reflect.TypeOf(EBSVolumeAttachment{}).Name()
+	EBSVolumeAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: EBSVolumeAttachmentKind}.String()
+	EBSVolumeAttachmentKindAPIVersion   = EBSVolumeAttachmentKind + "." + SchemeGroupVersion.String()
+	EBSVolumeAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(EBSVolumeAttachmentKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPCCIDRBlock{}, &VPCCIDRBlockList{})
 	SchemeBuilder.Register(&SecurityGroupRule{}, &SecurityGroupRuleList{})
 	SchemeBuilder.Register(&Instance{}, &InstanceList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
+	SchemeBuilder.Register(&NetworkACLEntry{}, &NetworkACLEntryList{})
+	SchemeBuilder.Register(&KeyPair{}, &KeyPairList{})
+	SchemeBuilder.Register(&DHCPOptionsAssociation{}, &DHCPOptionsAssociationList{})
+	SchemeBuilder.Register(&ManagedPrefixList{}, &ManagedPrefixListList{})
+	SchemeBuilder.Register(&DHCPOptions{}, &DHCPOptionsList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&Image{}, &ImageList{})
+	SchemeBuilder.Register(&TransitGatewayPeeringAttachment{}, &TransitGatewayPeeringAttachmentList{})
+	SchemeBuilder.Register(&TransitGatewayPeeringAttachmentAccepter{}, &TransitGatewayPeeringAttachmentAccepterList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTableAssociation{}, &TransitGatewayRouteTableAssociationList{})
+	SchemeBuilder.Register(&TransitGatewayRouteTablePropagation{}, &TransitGatewayRouteTablePropagationList{})
+	SchemeBuilder.Register(&TransitGatewayVPCAttachmentAccepter{}, &TransitGatewayVPCAttachmentAccepterList{})
+	SchemeBuilder.Register(&VPCPeeringConnectionAccepter{}, &VPCPeeringConnectionAccepterList{})
+	SchemeBuilder.Register(&IPAM{}, &IPAMList{})
+	SchemeBuilder.Register(&IPAMScope{}, &IPAMScopeList{})
+	SchemeBuilder.Register(&IPAMPool{}, &IPAMPoolList{})
+	SchemeBuilder.Register(&EBSVolumeAttachment{}, &EBSVolumeAttachmentList{})
 }
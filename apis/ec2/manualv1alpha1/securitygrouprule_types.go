@@ -50,9 +50,16 @@ type SecurityGroupRuleParameters struct {
 	// +kubebuilder:validation:Optional
 	Description *string `json:"description,omitempty"`
 
+	// +crossplane:generate:reference:type=ManagedPrefixList
 	// +kubebuilder:validation:Optional
 	PrefixListID *string `json:"prefixListId,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	PrefixListIDRef *xpv1.Reference `json:"prefixListIdRef,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PrefixListIDSelector *xpv1.Selector `json:"prefixListIdSelector,omitempty"`
+
 	// Region is the region you'd like your resource to be created in.
 	// +kubebuilder:validation:Required
 	Region *string `json:"region"`
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SnapshotParameters define the desired state of an EBS snapshot. A snapshot
+// is either taken from a volume or copied from another snapshot, which may be
+// in another region.
+type SnapshotParameters struct {
+	// Region is the region you'd like your Snapshot to be created in.
+	Region string `json:"region"`
+
+	// VolumeID is the ID of the EBS volume to snapshot.
+	// +immutable
+	// +optional
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef references a Volume to retrieve its ID.
+	// +optional
+	VolumeIDRef *xpv1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume to retrieve its ID.
+	// +optional
+	VolumeIDSelector *xpv1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// SourceSnapshotID is the ID of the snapshot to copy. Either volumeId or
+	// sourceSnapshotId has to be set.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Snapshot
+	SourceSnapshotID *string `json:"sourceSnapshotId,omitempty"`
+
+	// SourceSnapshotIDRef references a Snapshot to retrieve its ID.
+	// +optional
+	SourceSnapshotIDRef *xpv1.Reference `json:"sourceSnapshotIdRef,omitempty"`
+
+	// SourceSnapshotIDSelector selects a reference to a Snapshot to retrieve
+	// its ID.
+	// +optional
+	SourceSnapshotIDSelector *xpv1.Selector `json:"sourceSnapshotIdSelector,omitempty"`
+
+	// SourceRegion is the region of the snapshot to copy. Defaults to the
+	// region of this Snapshot.
+	// +immutable
+	// +optional
+	SourceRegion *string `json:"sourceRegion,omitempty"`
+
+	// Encrypted specifies whether the copy of the snapshot is encrypted.
+	// Copies of encrypted snapshots are always encrypted.
+	// +immutable
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+
+	// KMSKeyID is the identifier of the KMS key to encrypt the copy of the
+	// snapshot with. Defaults to the KMS key for EBS.
+	// +immutable
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// A description for the snapshot.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// SnapshotObservation keeps the state for the external resource
+type SnapshotObservation struct {
+	// The ID of the snapshot.
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// The ID of the AWS account that owns the snapshot.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The snapshot state.
+	State string `json:"state,omitempty"`
+
+	// Details of a failed snapshot.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// The progress of the snapshot, as a percentage.
+	Progress string `json:"progress,omitempty"`
+
+	// The size of the volume, in GiB.
+	VolumeSize int32 `json:"volumeSize,omitempty"`
+
+	// Indicates whether the snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents an EBS snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshots
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransitGatewayPeeringAttachmentParameters define the desired state of a
+// peering attachment between two transit gateways.
+type TransitGatewayPeeringAttachmentParameters struct {
+	// Region is the region of the requester transit gateway.
+	Region string `json:"region"`
+
+	// TransitGatewayID is the ID of the requester transit gateway.
+	// +immutable
+	// +optional
+	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +optional
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// TransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +optional
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
+	// PeerTransitGatewayID is the ID of the accepter transit gateway.
+	// +immutable
+	// +optional
+	PeerTransitGatewayID *string `json:"peerTransitGatewayId,omitempty"`
+
+	// PeerTransitGatewayIDRef references a TransitGateway to retrieve its ID.
+	// +optional
+	PeerTransitGatewayIDRef *xpv1.Reference `json:"peerTransitGatewayIdRef,omitempty"`
+
+	// PeerTransitGatewayIDSelector selects a reference to a TransitGateway to
+	// retrieve its ID.
+	// +optional
+	PeerTransitGatewayIDSelector *xpv1.Selector `json:"peerTransitGatewayIdSelector,omitempty"`
+
+	// PeerAccountID is the ID of the AWS account that owns the accepter
+	// transit gateway.
+	// +immutable
+	PeerAccountID string `json:"peerAccountId"`
+
+	// PeerRegion is the region of the accepter transit gateway.
+	// +immutable
+	PeerRegion string `json:"peerRegion"`
+
+	// Tags represents to current ec2 tags.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentSpec defines the desired state of a
+// TransitGatewayPeeringAttachment.
+type TransitGatewayPeeringAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayPeeringAttachmentParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// TransitGatewayPeeringAttachmentObservation keeps the state for the
+// external resource
+type TransitGatewayPeeringAttachmentObservation struct {
+	// The ID of the transit gateway peering attachment.
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// The state of the transit gateway peering attachment.
+	State string `json:"state,omitempty"`
+
+	// The status message of the transit gateway peering attachment.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The time the transit gateway peering attachment was created.
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentStatus represents the observed state of a
+// TransitGatewayPeeringAttachment.
+type TransitGatewayPeeringAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayPeeringAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayPeeringAttachment is a managed resource that requests the
+// peering of two transit gateways, which may be in different regions and
+// accounts. The peering has to be accepted by the owner of the accepter
+// transit gateway, e.g. with a TransitGatewayPeeringAttachmentAccepter.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayPeeringAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayPeeringAttachmentSpec   `json:"spec"`
+	Status TransitGatewayPeeringAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayPeeringAttachmentList contains a list of
+// TransitGatewayPeeringAttachments
+type TransitGatewayPeeringAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayPeeringAttachment `json:"items"`
+}
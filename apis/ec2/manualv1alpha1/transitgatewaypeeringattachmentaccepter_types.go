@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransitGatewayPeeringAttachmentAccepterParameters define the desired state
+// of the acceptance of a transit gateway peering attachment.
+type TransitGatewayPeeringAttachmentAccepterParameters struct {
+	// Region is the region of the accepter transit gateway.
+	Region string `json:"region"`
+
+	// TransitGatewayAttachmentID is the ID of the peering attachment to
+	// accept.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=TransitGatewayPeeringAttachment
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef references a
+	// TransitGatewayPeeringAttachment to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects a reference to a
+	// TransitGatewayPeeringAttachment to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags of the attachment in the accepter
+	// account.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentAccepterSpec defines the desired state of
+// a TransitGatewayPeeringAttachmentAccepter.
+type TransitGatewayPeeringAttachmentAccepterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayPeeringAttachmentAccepterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// TransitGatewayPeeringAttachmentAccepterObservation keeps the state for the
+// external resource
+type TransitGatewayPeeringAttachmentAccepterObservation struct {
+	// The state of the transit gateway peering attachment.
+	State string `json:"state,omitempty"`
+
+	// The ID of the accepter transit gateway.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// The ID of the requester transit gateway.
+	PeerTransitGatewayID string `json:"peerTransitGatewayId,omitempty"`
+
+	// The ID of the AWS account that owns the requester transit gateway.
+	PeerAccountID string `json:"peerAccountId,omitempty"`
+
+	// The region of the requester transit gateway.
+	PeerRegion string `json:"peerRegion,omitempty"`
+}
+
+// A TransitGatewayPeeringAttachmentAccepterStatus represents the observed
+// state of a TransitGatewayPeeringAttachmentAccepter.
+type TransitGatewayPeeringAttachmentAccepterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayPeeringAttachmentAccepterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayPeeringAttachmentAccepter is a managed resource that
+// accepts a transit gateway peering attachment on behalf of the owner of the
+// accepter transit gateway. Its providerConfigRef selects the credentials of
+// the accepter account, which may differ from the ones of the requester.
+// Deleting it deletes the peering attachment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayPeeringAttachmentAccepter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayPeeringAttachmentAccepterSpec   `json:"spec"`
+	Status TransitGatewayPeeringAttachmentAccepterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayPeeringAttachmentAccepterList contains a list of
+// TransitGatewayPeeringAttachmentAccepters
+type TransitGatewayPeeringAttachmentAccepterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayPeeringAttachmentAccepter `json:"items"`
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TransitGatewayVPCAttachmentAccepterParameters define the desired state of
+// the acceptance of a transit gateway VPC attachment.
+type TransitGatewayVPCAttachmentAccepterParameters struct {
+	// Region is the region of the transit gateway.
+	Region string `json:"region"`
+
+	// TransitGatewayAttachmentID is the ID of the VPC attachment to accept.
+	// +immutable
+	// +optional
+	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentIDRef references a TransitGatewayVPCAttachment
+	// to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDRef *xpv1.Reference `json:"transitGatewayAttachmentIdRef,omitempty"`
+
+	// TransitGatewayAttachmentIDSelector selects a reference to a
+	// TransitGatewayVPCAttachment to retrieve its ID.
+	// +optional
+	TransitGatewayAttachmentIDSelector *xpv1.Selector `json:"transitGatewayAttachmentIdSelector,omitempty"`
+
+	// Tags represents to current ec2 tags of the attachment in the account of
+	// the transit gateway.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentAccepterSpec defines the desired state of a
+// TransitGatewayVPCAttachmentAccepter.
+type TransitGatewayVPCAttachmentAccepterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TransitGatewayVPCAttachmentAccepterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// TransitGatewayVPCAttachmentAccepterObservation keeps the state for the
+// external resource
+type TransitGatewayVPCAttachmentAccepterObservation struct {
+	// The state of the transit gateway VPC attachment.
+	State string `json:"state,omitempty"`
+
+	// The ID of the transit gateway.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// The ID of the attached VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The ID of the AWS account that owns the attached VPC.
+	VPCOwnerID string `json:"vpcOwnerId,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentAccepterStatus represents the observed state of
+// a TransitGatewayVPCAttachmentAccepter.
+type TransitGatewayVPCAttachmentAccepterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TransitGatewayVPCAttachmentAccepterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayVPCAttachmentAccepter is a managed resource that accepts a
+// VPC attachment created by another account for a transit gateway shared
+// with it, e.g. via AWS RAM. Its providerConfigRef selects the credentials of
+// the account that owns the transit gateway. Deleting it deletes the VPC
+// attachment.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type TransitGatewayVPCAttachmentAccepter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentAccepterSpec   `json:"spec"`
+	Status TransitGatewayVPCAttachmentAccepterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentAccepterList contains a list of
+// TransitGatewayVPCAttachmentAccepters
+type TransitGatewayVPCAttachmentAccepterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachmentAccepter `json:"items"`
+}
@@ -78,6 +78,13 @@ type VPCCIDRBlockParameters struct {
 type VPCCIDRBlockSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       VPCCIDRBlockParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // VPCCIDRBlockObservation keeps the state for the external resource
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VPCPeeringConnectionAccepterOptions are the peering options of the accepter
+// VPC.
+type VPCPeeringConnectionAccepterOptions struct {
+	// Indicates whether the accepter VPC can resolve public DNS hostnames to
+	// private IP addresses when queried from instances in the requester VPC.
+	// +optional
+	AllowDNSResolutionFromRemoteVPC *bool `json:"allowDnsResolutionFromRemoteVpc,omitempty"`
+}
+
+// VPCPeeringConnectionAccepterParameters define the desired state of the
+// acceptance of a VPC peering connection.
+type VPCPeeringConnectionAccepterParameters struct {
+	// Region is the region of the accepter VPC.
+	Region string `json:"region"`
+
+	// VPCPeeringConnectionID is the ID of the VPC peering connection to
+	// accept.
+	// +immutable
+	// +optional
+	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// VPCPeeringConnectionIDRef references a VPCPeeringConnection to retrieve
+	// its ID.
+	// +optional
+	VPCPeeringConnectionIDRef *xpv1.Reference `json:"vpcPeeringConnectionIdRef,omitempty"`
+
+	// VPCPeeringConnectionIDSelector selects a reference to a
+	// VPCPeeringConnection to retrieve its ID.
+	// +optional
+	VPCPeeringConnectionIDSelector *xpv1.Selector `json:"vpcPeeringConnectionIdSelector,omitempty"`
+
+	// PeeringOptions of the accepter VPC. They are applied once the peering
+	// connection is active.
+	// +optional
+	PeeringOptions *VPCPeeringConnectionAccepterOptions `json:"peeringOptions,omitempty"`
+
+	// Tags represents to current ec2 tags of the peering connection in the
+	// accepter account.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCPeeringConnectionAccepterSpec defines the desired state of a
+// VPCPeeringConnectionAccepter.
+type VPCPeeringConnectionAccepterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VPCPeeringConnectionAccepterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// VPCPeeringConnectionAccepterObservation keeps the state for the external
+// resource
+type VPCPeeringConnectionAccepterObservation struct {
+	// The status of the VPC peering connection.
+	Status string `json:"status,omitempty"`
+
+	// The status message of the VPC peering connection.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// The ID of the accepter VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// The ID of the requester VPC.
+	PeerVPCID string `json:"peerVpcId,omitempty"`
+
+	// The ID of the AWS account that owns the requester VPC.
+	PeerOwnerID string `json:"peerOwnerId,omitempty"`
+
+	// The region of the requester VPC.
+	PeerRegion string `json:"peerRegion,omitempty"`
+}
+
+// A VPCPeeringConnectionAccepterStatus represents the observed state of a
+// VPCPeeringConnectionAccepter.
+type VPCPeeringConnectionAccepterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VPCPeeringConnectionAccepterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnectionAccepter is a managed resource that accepts a VPC
+// peering connection on behalf of the owner of the accepter VPC. Its
+// providerConfigRef selects the credentials of the accepter account, which
+// may differ from the ones of the requester. Deleting it deletes the peering
+// connection.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VPCPeeringConnectionAccepter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionAccepterSpec   `json:"spec"`
+	Status VPCPeeringConnectionAccepterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionAccepterList contains a list of
+// VPCPeeringConnectionAccepters
+type VPCPeeringConnectionAccepterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnectionAccepter `json:"items"`
+}
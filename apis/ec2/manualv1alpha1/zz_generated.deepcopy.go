@@ -166,391 +166,435 @@ func (in *CreditSpecificationRequest) DeepCopy() *CreditSpecificationRequest {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EBSBlockDevice) DeepCopyInto(out *EBSBlockDevice) {
+func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
 	*out = *in
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Encrypted != nil {
-		in, out := &in.Encrypted, &out.Encrypted
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IOps != nil {
-		in, out := &in.IOps, &out.IOps
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Throughput != nil {
-		in, out := &in.Throughput, &out.Throughput
-		*out = new(int32)
-		**out = **in
-	}
-	if in.KmsKeyID != nil {
-		in, out := &in.KmsKeyID, &out.KmsKeyID
-		*out = new(string)
-		**out = **in
-	}
-	if in.KMSKeyIDRef != nil {
-		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
-		*out = new(v1.Reference)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.KMSKeyIDSelector != nil {
-		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.SnapshotID != nil {
-		in, out := &in.SnapshotID, &out.SnapshotID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VolumeSize != nil {
-		in, out := &in.VolumeSize, &out.VolumeSize
-		*out = new(int32)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSBlockDevice.
-func (in *EBSBlockDevice) DeepCopy() *EBSBlockDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptions.
+func (in *DHCPOptions) DeepCopy() *DHCPOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(EBSBlockDevice)
+	out := new(DHCPOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *EBSInstanceBlockDevice) DeepCopyInto(out *EBSInstanceBlockDevice) {
+func (in *DHCPOptionsAssociation) DeepCopyInto(out *DHCPOptionsAssociation) {
 	*out = *in
-	if in.AttachTime != nil {
-		in, out := &in.AttachTime, &out.AttachTime
-		*out = (*in).DeepCopy()
-	}
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
-		**out = **in
-	}
-	if in.VolumeID != nil {
-		in, out := &in.VolumeID, &out.VolumeID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSInstanceBlockDevice.
-func (in *EBSInstanceBlockDevice) DeepCopy() *EBSInstanceBlockDevice {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsAssociation.
+func (in *DHCPOptionsAssociation) DeepCopy() *DHCPOptionsAssociation {
 	if in == nil {
 		return nil
 	}
-	out := new(EBSInstanceBlockDevice)
+	out := new(DHCPOptionsAssociation)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionsAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticGPUAssociation) DeepCopyInto(out *ElasticGPUAssociation) {
+func (in *DHCPOptionsAssociationList) DeepCopyInto(out *DHCPOptionsAssociationList) {
 	*out = *in
-	if in.ElasticGPUAssociationID != nil {
-		in, out := &in.ElasticGPUAssociationID, &out.ElasticGPUAssociationID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticGPUAssociationState != nil {
-		in, out := &in.ElasticGPUAssociationState, &out.ElasticGPUAssociationState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticGPUAssociationTime != nil {
-		in, out := &in.ElasticGPUAssociationTime, &out.ElasticGPUAssociationTime
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticGPUID != nil {
-		in, out := &in.ElasticGPUID, &out.ElasticGPUID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DHCPOptionsAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUAssociation.
-func (in *ElasticGPUAssociation) DeepCopy() *ElasticGPUAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsAssociationList.
+func (in *DHCPOptionsAssociationList) DeepCopy() *DHCPOptionsAssociationList {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticGPUAssociation)
+	out := new(DHCPOptionsAssociationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionsAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticGPUSpecification) DeepCopyInto(out *ElasticGPUSpecification) {
+func (in *DHCPOptionsAssociationObservation) DeepCopyInto(out *DHCPOptionsAssociationObservation) {
 	*out = *in
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUSpecification.
-func (in *ElasticGPUSpecification) DeepCopy() *ElasticGPUSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsAssociationObservation.
+func (in *DHCPOptionsAssociationObservation) DeepCopy() *DHCPOptionsAssociationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticGPUSpecification)
+	out := new(DHCPOptionsAssociationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticInferenceAccelerator) DeepCopyInto(out *ElasticInferenceAccelerator) {
+func (in *DHCPOptionsAssociationParameters) DeepCopyInto(out *DHCPOptionsAssociationParameters) {
 	*out = *in
-	if in.Count != nil {
-		in, out := &in.Count, &out.Count
-		*out = new(int32)
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
 		**out = **in
 	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DHCPOptionsID != nil {
+		in, out := &in.DHCPOptionsID, &out.DHCPOptionsID
 		*out = new(string)
 		**out = **in
 	}
+	if in.DHCPOptionsIDRef != nil {
+		in, out := &in.DHCPOptionsIDRef, &out.DHCPOptionsIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DHCPOptionsIDSelector != nil {
+		in, out := &in.DHCPOptionsIDSelector, &out.DHCPOptionsIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAccelerator.
-func (in *ElasticInferenceAccelerator) DeepCopy() *ElasticInferenceAccelerator {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsAssociationParameters.
+func (in *DHCPOptionsAssociationParameters) DeepCopy() *DHCPOptionsAssociationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticInferenceAccelerator)
+	out := new(DHCPOptionsAssociationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ElasticInferenceAcceleratorAssociation) DeepCopyInto(out *ElasticInferenceAcceleratorAssociation) {
+func (in *DHCPOptionsAssociationSpec) DeepCopyInto(out *DHCPOptionsAssociationSpec) {
 	*out = *in
-	if in.ElasticInferenceAcceleratorARN != nil {
-		in, out := &in.ElasticInferenceAcceleratorARN, &out.ElasticInferenceAcceleratorARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticInferenceAcceleratorAssociationID != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociationID, &out.ElasticInferenceAcceleratorAssociationID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticInferenceAcceleratorAssociationState != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociationState, &out.ElasticInferenceAcceleratorAssociationState
-		*out = new(string)
-		**out = **in
-	}
-	if in.ElasticInferenceAcceleratorAssociationTime != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociationTime, &out.ElasticInferenceAcceleratorAssociationTime
-		*out = (*in).DeepCopy()
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAcceleratorAssociation.
-func (in *ElasticInferenceAcceleratorAssociation) DeepCopy() *ElasticInferenceAcceleratorAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsAssociationSpec.
+func (in *DHCPOptionsAssociationSpec) DeepCopy() *DHCPOptionsAssociationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ElasticInferenceAcceleratorAssociation)
+	out := new(DHCPOptionsAssociationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GroupIdentifier) DeepCopyInto(out *GroupIdentifier) {
+func (in *DHCPOptionsAssociationStatus) DeepCopyInto(out *DHCPOptionsAssociationStatus) {
 	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupIdentifier.
-func (in *GroupIdentifier) DeepCopy() *GroupIdentifier {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsAssociationStatus.
+func (in *DHCPOptionsAssociationStatus) DeepCopy() *DHCPOptionsAssociationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GroupIdentifier)
+	out := new(DHCPOptionsAssociationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HibernationOptionsRequest) DeepCopyInto(out *HibernationOptionsRequest) {
+func (in *DHCPOptionsList) DeepCopyInto(out *DHCPOptionsList) {
 	*out = *in
-	if in.Configured != nil {
-		in, out := &in.Configured, &out.Configured
-		*out = new(bool)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DHCPOptions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationOptionsRequest.
-func (in *HibernationOptionsRequest) DeepCopy() *HibernationOptionsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsList.
+func (in *DHCPOptionsList) DeepCopy() *DHCPOptionsList {
 	if in == nil {
 		return nil
 	}
-	out := new(HibernationOptionsRequest)
+	out := new(DHCPOptionsList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DHCPOptionsList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+func (in *DHCPOptionsObservation) DeepCopyInto(out *DHCPOptionsObservation) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
-		*out = new(string)
-		**out = **in
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
-func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsObservation.
+func (in *DHCPOptionsObservation) DeepCopy() *DHCPOptionsObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMInstanceProfile)
+	out := new(DHCPOptionsObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IAMInstanceProfileSpecification) DeepCopyInto(out *IAMInstanceProfileSpecification) {
+func (in *DHCPOptionsParameters) DeepCopyInto(out *DHCPOptionsParameters) {
 	*out = *in
-	if in.ARN != nil {
-		in, out := &in.ARN, &out.ARN
+	if in.DomainName != nil {
+		in, out := &in.DomainName, &out.DomainName
 		*out = new(string)
 		**out = **in
 	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
+	if in.DomainNameServers != nil {
+		in, out := &in.DomainNameServers, &out.DomainNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NTPServers != nil {
+		in, out := &in.NTPServers, &out.NTPServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetBIOSNameServers != nil {
+		in, out := &in.NetBIOSNameServers, &out.NetBIOSNameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetBIOSNodeType != nil {
+		in, out := &in.NetBIOSNodeType, &out.NetBIOSNodeType
 		*out = new(string)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileSpecification.
-func (in *IAMInstanceProfileSpecification) DeepCopy() *IAMInstanceProfileSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsParameters.
+func (in *DHCPOptionsParameters) DeepCopy() *DHCPOptionsParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(IAMInstanceProfileSpecification)
+	out := new(DHCPOptionsParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Instance) DeepCopyInto(out *Instance) {
+func (in *DHCPOptionsSpec) DeepCopyInto(out *DHCPOptionsSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
-func (in *Instance) DeepCopy() *Instance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsSpec.
+func (in *DHCPOptionsSpec) DeepCopy() *DHCPOptionsSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Instance)
+	out := new(DHCPOptionsSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Instance) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DHCPOptionsStatus) DeepCopyInto(out *DHCPOptionsStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptionsStatus.
+func (in *DHCPOptionsStatus) DeepCopy() *DHCPOptionsStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(DHCPOptionsStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceBlockDeviceMapping) DeepCopyInto(out *InstanceBlockDeviceMapping) {
+func (in *EBSBlockDevice) DeepCopyInto(out *EBSBlockDevice) {
 	*out = *in
-	if in.DeviceName != nil {
-		in, out := &in.DeviceName, &out.DeviceName
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IOps != nil {
+		in, out := &in.IOps, &out.IOps
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int32)
+		**out = **in
+	}
+	if in.KmsKeyID != nil {
+		in, out := &in.KmsKeyID, &out.KmsKeyID
 		*out = new(string)
 		**out = **in
 	}
-	if in.EBS != nil {
-		in, out := &in.EBS, &out.EBS
-		*out = new(EBSInstanceBlockDevice)
+	if in.KMSKeyIDRef != nil {
+		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyIDSelector != nil {
+		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeSize != nil {
+		in, out := &in.VolumeSize, &out.VolumeSize
+		*out = new(int32)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceBlockDeviceMapping.
-func (in *InstanceBlockDeviceMapping) DeepCopy() *InstanceBlockDeviceMapping {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSBlockDevice.
+func (in *EBSBlockDevice) DeepCopy() *EBSBlockDevice {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceBlockDeviceMapping)
+	out := new(EBSBlockDevice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceIPv6Address) DeepCopyInto(out *InstanceIPv6Address) {
+func (in *EBSInstanceBlockDevice) DeepCopyInto(out *EBSInstanceBlockDevice) {
 	*out = *in
-	if in.IPv6Address != nil {
-		in, out := &in.IPv6Address, &out.IPv6Address
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIPv6Address.
-func (in *InstanceIPv6Address) DeepCopy() *InstanceIPv6Address {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSInstanceBlockDevice.
+func (in *EBSInstanceBlockDevice) DeepCopy() *EBSInstanceBlockDevice {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceIPv6Address)
+	out := new(EBSInstanceBlockDevice)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+func (in *EBSVolumeAttachment) DeepCopyInto(out *EBSVolumeAttachment) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Instance, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
-func (in *InstanceList) DeepCopy() *InstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolumeAttachment.
+func (in *EBSVolumeAttachment) DeepCopy() *EBSVolumeAttachment {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceList)
+	out := new(EBSVolumeAttachment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *InstanceList) DeepCopyObject() runtime.Object {
+func (in *EBSVolumeAttachment) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -558,499 +602,441 @@ func (in *InstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceMarketOptionsRequest) DeepCopyInto(out *InstanceMarketOptionsRequest) {
+func (in *EBSVolumeAttachmentList) DeepCopyInto(out *EBSVolumeAttachmentList) {
 	*out = *in
-	if in.SpotOptions != nil {
-		in, out := &in.SpotOptions, &out.SpotOptions
-		*out = new(SpotMarketOptions)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EBSVolumeAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMarketOptionsRequest.
-func (in *InstanceMarketOptionsRequest) DeepCopy() *InstanceMarketOptionsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolumeAttachmentList.
+func (in *EBSVolumeAttachmentList) DeepCopy() *EBSVolumeAttachmentList {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceMarketOptionsRequest)
+	out := new(EBSVolumeAttachmentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EBSVolumeAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceMetadataOptionsRequest) DeepCopyInto(out *InstanceMetadataOptionsRequest) {
+func (in *EBSVolumeAttachmentObservation) DeepCopyInto(out *EBSVolumeAttachmentObservation) {
 	*out = *in
-	if in.HTTPPutResponseHopLimit != nil {
-		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
-		*out = new(int32)
-		**out = **in
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptionsRequest.
-func (in *InstanceMetadataOptionsRequest) DeepCopy() *InstanceMetadataOptionsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolumeAttachmentObservation.
+func (in *EBSVolumeAttachmentObservation) DeepCopy() *EBSVolumeAttachmentObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceMetadataOptionsRequest)
+	out := new(EBSVolumeAttachmentObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterface) DeepCopyInto(out *InstanceNetworkInterface) {
+func (in *EBSVolumeAttachmentParameters) DeepCopyInto(out *EBSVolumeAttachmentParameters) {
 	*out = *in
-	if in.Association != nil {
-		in, out := &in.Association, &out.Association
-		*out = new(InstanceNetworkInterfaceAssociation)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Attachment != nil {
-		in, out := &in.Attachment, &out.Attachment
-		*out = new(InstanceNetworkInterfaceAttachment)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]GroupIdentifier, len(*in))
-		copy(*out, *in)
-	}
-	if in.InterfaceType != nil {
-		in, out := &in.InterfaceType, &out.InterfaceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPv6Addresses != nil {
-		in, out := &in.IPv6Addresses, &out.IPv6Addresses
-		*out = make([]InstanceIPv6Address, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.MacAddress != nil {
-		in, out := &in.MacAddress, &out.MacAddress
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
 		*out = new(string)
 		**out = **in
 	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
-		*out = new(string)
-		**out = **in
+	if in.InstanceIDRef != nil {
+		in, out := &in.InstanceIDRef, &out.InstanceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
+	if in.InstanceIDSelector != nil {
+		in, out := &in.InstanceIDSelector, &out.InstanceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
+	if in.VolumeIDRef != nil {
+		in, out := &in.VolumeIDRef, &out.VolumeIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PrivateIPAddresses != nil {
-		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
-		*out = make([]InstancePrivateIPAddress, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.VolumeIDSelector != nil {
+		in, out := &in.VolumeIDSelector, &out.VolumeIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.SourceDestCheck != nil {
-		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+	if in.ForceDetach != nil {
+		in, out := &in.ForceDetach, &out.ForceDetach
 		*out = new(bool)
 		**out = **in
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterface.
-func (in *InstanceNetworkInterface) DeepCopy() *InstanceNetworkInterface {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolumeAttachmentParameters.
+func (in *EBSVolumeAttachmentParameters) DeepCopy() *EBSVolumeAttachmentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterface)
+	out := new(EBSVolumeAttachmentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterfaceAssociation) DeepCopyInto(out *InstanceNetworkInterfaceAssociation) {
+func (in *EBSVolumeAttachmentSpec) DeepCopyInto(out *EBSVolumeAttachmentSpec) {
 	*out = *in
-	if in.IPOwnerID != nil {
-		in, out := &in.IPOwnerID, &out.IPOwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicDNSName != nil {
-		in, out := &in.PublicDNSName, &out.PublicDNSName
-		*out = new(string)
-		**out = **in
-	}
-	if in.PublicIP != nil {
-		in, out := &in.PublicIP, &out.PublicIP
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAssociation.
-func (in *InstanceNetworkInterfaceAssociation) DeepCopy() *InstanceNetworkInterfaceAssociation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolumeAttachmentSpec.
+func (in *EBSVolumeAttachmentSpec) DeepCopy() *EBSVolumeAttachmentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterfaceAssociation)
+	out := new(EBSVolumeAttachmentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterfaceAttachment) DeepCopyInto(out *InstanceNetworkInterfaceAttachment) {
+func (in *EBSVolumeAttachmentStatus) DeepCopyInto(out *EBSVolumeAttachmentStatus) {
 	*out = *in
-	if in.AttachTime != nil {
-		in, out := &in.AttachTime, &out.AttachTime
-		*out = (*in).DeepCopy()
-	}
-	if in.AttachmentID != nil {
-		in, out := &in.AttachmentID, &out.AttachmentID
-		*out = new(string)
-		**out = **in
-	}
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DeviceIndex != nil {
-		in, out := &in.DeviceIndex, &out.DeviceIndex
-		*out = new(int32)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAttachment.
-func (in *InstanceNetworkInterfaceAttachment) DeepCopy() *InstanceNetworkInterfaceAttachment {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EBSVolumeAttachmentStatus.
+func (in *EBSVolumeAttachmentStatus) DeepCopy() *EBSVolumeAttachmentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterfaceAttachment)
+	out := new(EBSVolumeAttachmentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceNetworkInterfaceSpecification) DeepCopyInto(out *InstanceNetworkInterfaceSpecification) {
+func (in *ElasticGPUAssociation) DeepCopyInto(out *ElasticGPUAssociation) {
 	*out = *in
-	if in.AssociatePublicIPAddress != nil {
-		in, out := &in.AssociatePublicIPAddress, &out.AssociatePublicIPAddress
-		*out = new(bool)
-		**out = **in
-	}
-	if in.DeleteOnTermination != nil {
-		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
+	if in.ElasticGPUAssociationID != nil {
+		in, out := &in.ElasticGPUAssociationID, &out.ElasticGPUAssociationID
 		*out = new(string)
 		**out = **in
 	}
-	if in.DeviceIndex != nil {
-		in, out := &in.DeviceIndex, &out.DeviceIndex
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Groups != nil {
-		in, out := &in.Groups, &out.Groups
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.InterfaceType != nil {
-		in, out := &in.InterfaceType, &out.InterfaceType
+	if in.ElasticGPUAssociationState != nil {
+		in, out := &in.ElasticGPUAssociationState, &out.ElasticGPUAssociationState
 		*out = new(string)
 		**out = **in
 	}
-	if in.IPv6AddressCount != nil {
-		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
-		*out = new(int32)
-		**out = **in
-	}
-	if in.IPv6Addresses != nil {
-		in, out := &in.IPv6Addresses, &out.IPv6Addresses
-		*out = make([]InstanceIPv6Address, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Ipv6PrefixCount != nil {
-		in, out := &in.Ipv6PrefixCount, &out.Ipv6PrefixCount
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Ipv6Prefixes != nil {
-		in, out := &in.Ipv6Prefixes, &out.Ipv6Prefixes
-		*out = make([]Ipv6PrefixSpecificationRequest, len(*in))
-		copy(*out, *in)
-	}
-	if in.NetworkInterfaceID != nil {
-		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+	if in.ElasticGPUAssociationTime != nil {
+		in, out := &in.ElasticGPUAssociationTime, &out.ElasticGPUAssociationTime
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+	if in.ElasticGPUID != nil {
+		in, out := &in.ElasticGPUID, &out.ElasticGPUID
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateIPAddresses != nil {
-		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
-		*out = make([]PrivateIPAddressSpecification, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.SecondaryPrivateIPAddressCount != nil {
-		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
-		*out = new(int32)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUAssociation.
+func (in *ElasticGPUAssociation) DeepCopy() *ElasticGPUAssociation {
+	if in == nil {
+		return nil
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+	out := new(ElasticGPUAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticGPUSpecification) DeepCopyInto(out *ElasticGPUSpecification) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceSpecification.
-func (in *InstanceNetworkInterfaceSpecification) DeepCopy() *InstanceNetworkInterfaceSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticGPUSpecification.
+func (in *ElasticGPUSpecification) DeepCopy() *ElasticGPUSpecification {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceNetworkInterfaceSpecification)
+	out := new(ElasticGPUSpecification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+func (in *ElasticInferenceAccelerator) DeepCopyInto(out *ElasticInferenceAccelerator) {
 	*out = *in
-	if in.AmiLaunchIndex != nil {
-		in, out := &in.AmiLaunchIndex, &out.AmiLaunchIndex
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
 		*out = new(int32)
 		**out = **in
 	}
-	if in.BlockDeviceMapping != nil {
-		in, out := &in.BlockDeviceMapping, &out.BlockDeviceMapping
-		*out = make([]InstanceBlockDeviceMapping, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.CapacityReservationID != nil {
-		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
-	if in.CapacityReservationSpecification != nil {
-		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
-		*out = new(CapacityReservationSpecificationResponse)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAccelerator.
+func (in *ElasticInferenceAccelerator) DeepCopy() *ElasticInferenceAccelerator {
+	if in == nil {
+		return nil
 	}
-	if in.ClientToken != nil {
-		in, out := &in.ClientToken, &out.ClientToken
+	out := new(ElasticInferenceAccelerator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticInferenceAcceleratorAssociation) DeepCopyInto(out *ElasticInferenceAcceleratorAssociation) {
+	*out = *in
+	if in.ElasticInferenceAcceleratorARN != nil {
+		in, out := &in.ElasticInferenceAcceleratorARN, &out.ElasticInferenceAcceleratorARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.CPUOptons != nil {
-		in, out := &in.CPUOptons, &out.CPUOptons
-		*out = new(CPUOptionsRequest)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.DisableAPITermination != nil {
-		in, out := &in.DisableAPITermination, &out.DisableAPITermination
-		*out = new(bool)
+	if in.ElasticInferenceAcceleratorAssociationID != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociationID, &out.ElasticInferenceAcceleratorAssociationID
+		*out = new(string)
 		**out = **in
 	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
-		*out = new(bool)
+	if in.ElasticInferenceAcceleratorAssociationState != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociationState, &out.ElasticInferenceAcceleratorAssociationState
+		*out = new(string)
 		**out = **in
 	}
-	if in.ElasticGPUAssociations != nil {
-		in, out := &in.ElasticGPUAssociations, &out.ElasticGPUAssociations
-		*out = make([]ElasticGPUAssociation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.ElasticInferenceAcceleratorAssociationTime != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociationTime, &out.ElasticInferenceAcceleratorAssociationTime
+		*out = (*in).DeepCopy()
 	}
-	if in.ElasticInferenceAcceleratorAssociations != nil {
-		in, out := &in.ElasticInferenceAcceleratorAssociations, &out.ElasticInferenceAcceleratorAssociations
-		*out = make([]ElasticInferenceAcceleratorAssociation, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticInferenceAcceleratorAssociation.
+func (in *ElasticInferenceAcceleratorAssociation) DeepCopy() *ElasticInferenceAcceleratorAssociation {
+	if in == nil {
+		return nil
 	}
-	if in.EnaSupport != nil {
-		in, out := &in.EnaSupport, &out.EnaSupport
+	out := new(ElasticInferenceAcceleratorAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GroupIdentifier) DeepCopyInto(out *GroupIdentifier) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupIdentifier.
+func (in *GroupIdentifier) DeepCopy() *GroupIdentifier {
+	if in == nil {
+		return nil
+	}
+	out := new(GroupIdentifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HibernationOptionsRequest) DeepCopyInto(out *HibernationOptionsRequest) {
+	*out = *in
+	if in.Configured != nil {
+		in, out := &in.Configured, &out.Configured
 		*out = new(bool)
 		**out = **in
 	}
-	if in.HibernationOptions != nil {
-		in, out := &in.HibernationOptions, &out.HibernationOptions
-		*out = new(HibernationOptionsRequest)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.IAMInstanceProfile != nil {
-		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
-		*out = new(IAMInstanceProfile)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HibernationOptionsRequest.
+func (in *HibernationOptionsRequest) DeepCopy() *HibernationOptionsRequest {
+	if in == nil {
+		return nil
 	}
-	if in.ImageID != nil {
-		in, out := &in.ImageID, &out.ImageID
+	out := new(HibernationOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfile) DeepCopyInto(out *IAMInstanceProfile) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.InstanceID != nil {
-		in, out := &in.InstanceID, &out.InstanceID
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
 		*out = new(string)
 		**out = **in
 	}
-	if in.InstanceInitiatedShutdownBehavior != nil {
-		in, out := &in.InstanceInitiatedShutdownBehavior, &out.InstanceInitiatedShutdownBehavior
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfile.
+func (in *IAMInstanceProfile) DeepCopy() *IAMInstanceProfile {
+	if in == nil {
+		return nil
 	}
-	if in.KernelID != nil {
-		in, out := &in.KernelID, &out.KernelID
+	out := new(IAMInstanceProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMInstanceProfileSpecification) DeepCopyInto(out *IAMInstanceProfileSpecification) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
 		*out = new(string)
 		**out = **in
 	}
-	if in.LaunchTime != nil {
-		in, out := &in.LaunchTime, &out.LaunchTime
-		*out = (*in).DeepCopy()
-	}
-	if in.Licenses != nil {
-		in, out := &in.Licenses, &out.Licenses
-		*out = make([]LicenseConfigurationRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.MetadataOptions != nil {
-		in, out := &in.MetadataOptions, &out.MetadataOptions
-		*out = new(InstanceMetadataOptionsRequest)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Monitoring != nil {
-		in, out := &in.Monitoring, &out.Monitoring
-		*out = new(Monitoring)
-		**out = **in
-	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]InstanceNetworkInterface, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.OutpostARN != nil {
-		in, out := &in.OutpostARN, &out.OutpostARN
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
 		*out = new(string)
 		**out = **in
 	}
-	if in.Placement != nil {
-		in, out := &in.Placement, &out.Placement
-		*out = new(Placement)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMInstanceProfileSpecification.
+func (in *IAMInstanceProfileSpecification) DeepCopy() *IAMInstanceProfileSpecification {
+	if in == nil {
+		return nil
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
-		*out = new(string)
-		**out = **in
+	out := new(IAMInstanceProfileSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAM) DeepCopyInto(out *IPAM) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM.
+func (in *IPAM) DeepCopy() *IPAM {
+	if in == nil {
+		return nil
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
+	out := new(IPAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAM) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.ProductCodes != nil {
-		in, out := &in.ProductCodes, &out.ProductCodes
-		*out = make([]ProductCode, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMList) DeepCopyInto(out *IPAMList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAM, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.PublicDNSName != nil {
-		in, out := &in.PublicDNSName, &out.PublicDNSName
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMList.
+func (in *IPAMList) DeepCopy() *IPAMList {
+	if in == nil {
+		return nil
 	}
-	if in.PublicIPAddress != nil {
-		in, out := &in.PublicIPAddress, &out.PublicIPAddress
-		*out = new(string)
-		**out = **in
+	out := new(IPAMList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.RAMDiskID != nil {
-		in, out := &in.RAMDiskID, &out.RAMDiskID
-		*out = new(string)
-		**out = **in
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMObservation) DeepCopyInto(out *IPAMObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMObservation.
+func (in *IPAMObservation) DeepCopy() *IPAMObservation {
+	if in == nil {
+		return nil
 	}
-	if in.RootDeviceName != nil {
-		in, out := &in.RootDeviceName, &out.RootDeviceName
+	out := new(IPAMObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMParameters) DeepCopyInto(out *IPAMParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.SecurityGroups != nil {
-		in, out := &in.SecurityGroups, &out.SecurityGroups
-		*out = make([]GroupIdentifier, len(*in))
+	if in.OperatingRegions != nil {
+		in, out := &in.OperatingRegions, &out.OperatingRegions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.SourceDestCheck != nil {
-		in, out := &in.SourceDestCheck, &out.SourceDestCheck
-		*out = new(bool)
-		**out = **in
-	}
-	if in.SpotInstanceRequestID != nil {
-		in, out := &in.SpotInstanceRequestID, &out.SpotInstanceRequestID
-		*out = new(string)
-		**out = **in
-	}
-	if in.SriovNetSupport != nil {
-		in, out := &in.SriovNetSupport, &out.SriovNetSupport
-		*out = new(string)
-		**out = **in
-	}
-	if in.StateReason != nil {
-		in, out := &in.StateReason, &out.StateReason
-		*out = new(StateReason)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.StateTransitionReason != nil {
-		in, out := &in.StateTransitionReason, &out.StateTransitionReason
+	if in.Tier != nil {
+		in, out := &in.Tier, &out.Tier
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
-		*out = new(string)
+	if in.Cascade != nil {
+		in, out := &in.Cascade, &out.Cascade
+		*out = new(bool)
 		**out = **in
 	}
 	if in.Tags != nil {
@@ -1058,500 +1044,3533 @@ func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
-	if in.UserData != nil {
-		in, out := &in.UserData, &out.UserData
-		*out = new(string)
-		**out = **in
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMParameters.
+func (in *IPAMParameters) DeepCopy() *IPAMParameters {
+	if in == nil {
+		return nil
 	}
+	out := new(IPAMParameters)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
-func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
+func (in *IPAMPool) DeepCopy() *IPAMPool {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceObservation)
+	out := new(IPAMPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+func (in *IPAMPoolList) DeepCopyInto(out *IPAMPoolList) {
 	*out = *in
-	if in.BlockDeviceMappings != nil {
-		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
-		*out = make([]BlockDeviceMapping, len(*in))
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMPool, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.CapacityReservationSpecification != nil {
-		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
-		*out = new(CapacityReservationSpecification)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolList.
+func (in *IPAMPoolList) DeepCopy() *IPAMPoolList {
+	if in == nil {
+		return nil
 	}
-	if in.ClientToken != nil {
-		in, out := &in.ClientToken, &out.ClientToken
+	out := new(IPAMPoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMPoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolObservation) DeepCopyInto(out *IPAMPoolObservation) {
+	*out = *in
+	if in.ProvisionedCIDRs != nil {
+		in, out := &in.ProvisionedCIDRs, &out.ProvisionedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolObservation.
+func (in *IPAMPoolObservation) DeepCopy() *IPAMPoolObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolParameters) DeepCopyInto(out *IPAMPoolParameters) {
+	*out = *in
+	if in.IPAMScopeID != nil {
+		in, out := &in.IPAMScopeID, &out.IPAMScopeID
 		*out = new(string)
 		**out = **in
 	}
-	if in.CPUOptions != nil {
-		in, out := &in.CPUOptions, &out.CPUOptions
-		*out = new(CPUOptionsRequest)
+	if in.IPAMScopeIDRef != nil {
+		in, out := &in.IPAMScopeIDRef, &out.IPAMScopeIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.CreditSpecification != nil {
-		in, out := &in.CreditSpecification, &out.CreditSpecification
-		*out = new(CreditSpecificationRequest)
+	if in.IPAMScopeIDSelector != nil {
+		in, out := &in.IPAMScopeIDSelector, &out.IPAMScopeIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.DisableAPITermination != nil {
-		in, out := &in.DisableAPITermination, &out.DisableAPITermination
-		*out = new(bool)
+	if in.Locale != nil {
+		in, out := &in.Locale, &out.Locale
+		*out = new(string)
 		**out = **in
 	}
-	if in.EBSOptimized != nil {
-		in, out := &in.EBSOptimized, &out.EBSOptimized
-		*out = new(bool)
+	if in.SourceIPAMPoolID != nil {
+		in, out := &in.SourceIPAMPoolID, &out.SourceIPAMPoolID
+		*out = new(string)
 		**out = **in
 	}
-	if in.ElasticGPUSpecification != nil {
-		in, out := &in.ElasticGPUSpecification, &out.ElasticGPUSpecification
-		*out = make([]ElasticGPUSpecification, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.ElasticInferenceAccelerators != nil {
-		in, out := &in.ElasticInferenceAccelerators, &out.ElasticInferenceAccelerators
-		*out = make([]ElasticInferenceAccelerator, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.SourceIPAMPoolIDRef != nil {
+		in, out := &in.SourceIPAMPoolIDRef, &out.SourceIPAMPoolIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.HibernationOptions != nil {
-		in, out := &in.HibernationOptions, &out.HibernationOptions
-		*out = new(HibernationOptionsRequest)
+	if in.SourceIPAMPoolIDSelector != nil {
+		in, out := &in.SourceIPAMPoolIDSelector, &out.SourceIPAMPoolIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.IAMInstanceProfile != nil {
-		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
-		*out = new(IAMInstanceProfileSpecification)
+	if in.SourceResource != nil {
+		in, out := &in.SourceResource, &out.SourceResource
+		*out = new(IPAMPoolSourceResource)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ImageID != nil {
-		in, out := &in.ImageID, &out.ImageID
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
 		*out = new(string)
 		**out = **in
 	}
-	if in.InstanceMarketOptions != nil {
-		in, out := &in.InstanceMarketOptions, &out.InstanceMarketOptions
-		*out = new(InstanceMarketOptionsRequest)
-		(*in).DeepCopyInto(*out)
+	if in.AutoImport != nil {
+		in, out := &in.AutoImport, &out.AutoImport
+		*out = new(bool)
+		**out = **in
 	}
-	if in.IPv6AddressCount != nil {
-		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+	if in.PubliclyAdvertisable != nil {
+		in, out := &in.PubliclyAdvertisable, &out.PubliclyAdvertisable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllocationDefaultNetmaskLength != nil {
+		in, out := &in.AllocationDefaultNetmaskLength, &out.AllocationDefaultNetmaskLength
 		*out = new(int32)
 		**out = **in
 	}
-	if in.IPv6Addresses != nil {
-		in, out := &in.IPv6Addresses, &out.IPv6Addresses
-		*out = make([]InstanceIPv6Address, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AllocationMaxNetmaskLength != nil {
+		in, out := &in.AllocationMaxNetmaskLength, &out.AllocationMaxNetmaskLength
+		*out = new(int32)
+		**out = **in
 	}
-	if in.KernelID != nil {
-		in, out := &in.KernelID, &out.KernelID
-		*out = new(string)
+	if in.AllocationMinNetmaskLength != nil {
+		in, out := &in.AllocationMinNetmaskLength, &out.AllocationMinNetmaskLength
+		*out = new(int32)
 		**out = **in
 	}
-	if in.KeyName != nil {
-		in, out := &in.KeyName, &out.KeyName
-		*out = new(string)
+	if in.AllocationResourceTags != nil {
+		in, out := &in.AllocationResourceTags, &out.AllocationResourceTags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRs != nil {
+		in, out := &in.CIDRs, &out.CIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cascade != nil {
+		in, out := &in.Cascade, &out.Cascade
+		*out = new(bool)
 		**out = **in
 	}
-	if in.LaunchTemplate != nil {
-		in, out := &in.LaunchTemplate, &out.LaunchTemplate
-		*out = new(LaunchTemplateSpecification)
-		(*in).DeepCopyInto(*out)
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
-	if in.LicenseSpecifications != nil {
-		in, out := &in.LicenseSpecifications, &out.LicenseSpecifications
-		*out = make([]LicenseConfigurationRequest, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolParameters.
+func (in *IPAMPoolParameters) DeepCopy() *IPAMPoolParameters {
+	if in == nil {
+		return nil
 	}
-	if in.MetadataOptions != nil {
-		in, out := &in.MetadataOptions, &out.MetadataOptions
-		*out = new(InstanceMetadataOptionsRequest)
-		(*in).DeepCopyInto(*out)
+	out := new(IPAMPoolParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolSourceResource) DeepCopyInto(out *IPAMPoolSourceResource) {
+	*out = *in
+	if in.ResourceID != nil {
+		in, out := &in.ResourceID, &out.ResourceID
+		*out = new(string)
+		**out = **in
 	}
-	if in.Monitoring != nil {
-		in, out := &in.Monitoring, &out.Monitoring
-		*out = new(RunInstancesMonitoringEnabled)
+	if in.ResourceIDRef != nil {
+		in, out := &in.ResourceIDRef, &out.ResourceIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.NetworkInterfaces != nil {
-		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
-		*out = make([]InstanceNetworkInterfaceSpecification, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Placement != nil {
-		in, out := &in.Placement, &out.Placement
-		*out = new(Placement)
+	if in.ResourceIDSelector != nil {
+		in, out := &in.ResourceIDSelector, &out.ResourceIDSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+	if in.ResourceOwner != nil {
+		in, out := &in.ResourceOwner, &out.ResourceOwner
 		*out = new(string)
 		**out = **in
 	}
-	if in.RAMDiskID != nil {
-		in, out := &in.RAMDiskID, &out.RAMDiskID
+	if in.ResourceRegion != nil {
+		in, out := &in.ResourceRegion, &out.ResourceRegion
 		*out = new(string)
 		**out = **in
 	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSourceResource.
+func (in *IPAMPoolSourceResource) DeepCopy() *IPAMPoolSourceResource {
+	if in == nil {
+		return nil
 	}
-	if in.SecurityGroupIDs != nil {
-		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(IPAMPoolSourceResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolSpec) DeepCopyInto(out *IPAMPoolSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	if in.SecurityGroupRefs != nil {
-		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
-		*out = make([]v1.Reference, len(*in))
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolSpec.
+func (in *IPAMPoolSpec) DeepCopy() *IPAMPoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMPoolStatus) DeepCopyInto(out *IPAMPoolStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPoolStatus.
+func (in *IPAMPoolStatus) DeepCopy() *IPAMPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMScope) DeepCopyInto(out *IPAMScope) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScope.
+func (in *IPAMScope) DeepCopy() *IPAMScope {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMScope)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMScope) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMScopeList) DeepCopyInto(out *IPAMScopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IPAMScope, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.SecurityGroupSelector != nil {
-		in, out := &in.SecurityGroupSelector, &out.SecurityGroupSelector
-		*out = new(v1.Selector)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeList.
+func (in *IPAMScopeList) DeepCopy() *IPAMScopeList {
+	if in == nil {
+		return nil
 	}
-	if in.SubnetID != nil {
-		in, out := &in.SubnetID, &out.SubnetID
+	out := new(IPAMScopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMScopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMScopeObservation) DeepCopyInto(out *IPAMScopeObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeObservation.
+func (in *IPAMScopeObservation) DeepCopy() *IPAMScopeObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMScopeObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMScopeParameters) DeepCopyInto(out *IPAMScopeParameters) {
+	*out = *in
+	if in.IPAMID != nil {
+		in, out := &in.IPAMID, &out.IPAMID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SubnetIDRef != nil {
-		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+	if in.IPAMIDRef != nil {
+		in, out := &in.IPAMIDRef, &out.IPAMIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SubnetIDSelector != nil {
-		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+	if in.IPAMIDSelector != nil {
+		in, out := &in.IPAMIDSelector, &out.IPAMIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
-	if in.TagSpecifications != nil {
-		in, out := &in.TagSpecifications, &out.TagSpecifications
-		*out = make([]TagSpecification, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.UserData != nil {
-		in, out := &in.UserData, &out.UserData
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
-func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeParameters.
+func (in *IPAMScopeParameters) DeepCopy() *IPAMScopeParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceParameters)
+	out := new(IPAMScopeParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstancePrivateIPAddress) DeepCopyInto(out *InstancePrivateIPAddress) {
+func (in *IPAMScopeSpec) DeepCopyInto(out *IPAMScopeSpec) {
 	*out = *in
-	if in.Association != nil {
-		in, out := &in.Association, &out.Association
-		*out = new(InstanceNetworkInterfaceAssociation)
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeSpec.
+func (in *IPAMScopeSpec) DeepCopy() *IPAMScopeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMScopeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMScopeStatus) DeepCopyInto(out *IPAMScopeStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScopeStatus.
+func (in *IPAMScopeStatus) DeepCopy() *IPAMScopeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMScopeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
+func (in *IPAMSpec) DeepCopy() *IPAMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMStatus.
+func (in *IPAMStatus) DeepCopy() *IPAMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Image) DeepCopyInto(out *Image) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
+func (in *Image) DeepCopy() *Image {
+	if in == nil {
+		return nil
+	}
+	out := new(Image)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Image) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageList) DeepCopyInto(out *ImageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Image, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageList.
+func (in *ImageList) DeepCopy() *ImageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ImageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageObservation) DeepCopyInto(out *ImageObservation) {
+	*out = *in
+	if in.SnapshotIDs != nil {
+		in, out := &in.SnapshotIDs, &out.SnapshotIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageObservation.
+func (in *ImageObservation) DeepCopy() *ImageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageParameters) DeepCopyInto(out *ImageParameters) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceIDRef != nil {
+		in, out := &in.InstanceIDRef, &out.InstanceIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceIDSelector != nil {
+		in, out := &in.InstanceIDSelector, &out.InstanceIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NoReboot != nil {
+		in, out := &in.NoReboot, &out.NoReboot
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SnapshotIDRef != nil {
+		in, out := &in.SnapshotIDRef, &out.SnapshotIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SnapshotIDSelector != nil {
+		in, out := &in.SnapshotIDSelector, &out.SnapshotIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootDeviceName != nil {
+		in, out := &in.RootDeviceName, &out.RootDeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualizationType != nil {
+		in, out := &in.VirtualizationType, &out.VirtualizationType
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootMode != nil {
+		in, out := &in.BootMode, &out.BootMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ENASupport != nil {
+		in, out := &in.ENASupport, &out.ENASupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LaunchPermissionAccountIDs != nil {
+		in, out := &in.LaunchPermissionAccountIDs, &out.LaunchPermissionAccountIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageParameters.
+func (in *ImageParameters) DeepCopy() *ImageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
+func (in *ImageSpec) DeepCopy() *ImageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageStatus.
+func (in *ImageStatus) DeepCopy() *ImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instance) DeepCopyInto(out *Instance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instance.
+func (in *Instance) DeepCopy() *Instance {
+	if in == nil {
+		return nil
+	}
+	out := new(Instance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Instance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceBlockDeviceMapping) DeepCopyInto(out *InstanceBlockDeviceMapping) {
+	*out = *in
+	if in.DeviceName != nil {
+		in, out := &in.DeviceName, &out.DeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.EBS != nil {
+		in, out := &in.EBS, &out.EBS
+		*out = new(EBSInstanceBlockDevice)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceBlockDeviceMapping.
+func (in *InstanceBlockDeviceMapping) DeepCopy() *InstanceBlockDeviceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceBlockDeviceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceIPv6Address) DeepCopyInto(out *InstanceIPv6Address) {
+	*out = *in
+	if in.IPv6Address != nil {
+		in, out := &in.IPv6Address, &out.IPv6Address
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceIPv6Address.
+func (in *InstanceIPv6Address) DeepCopy() *InstanceIPv6Address {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceIPv6Address)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceList) DeepCopyInto(out *InstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Instance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceList.
+func (in *InstanceList) DeepCopy() *InstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMarketOptionsRequest) DeepCopyInto(out *InstanceMarketOptionsRequest) {
+	*out = *in
+	if in.SpotOptions != nil {
+		in, out := &in.SpotOptions, &out.SpotOptions
+		*out = new(SpotMarketOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMarketOptionsRequest.
+func (in *InstanceMarketOptionsRequest) DeepCopy() *InstanceMarketOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMarketOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceMetadataOptionsRequest) DeepCopyInto(out *InstanceMetadataOptionsRequest) {
+	*out = *in
+	if in.HTTPPutResponseHopLimit != nil {
+		in, out := &in.HTTPPutResponseHopLimit, &out.HTTPPutResponseHopLimit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceMetadataOptionsRequest.
+func (in *InstanceMetadataOptionsRequest) DeepCopy() *InstanceMetadataOptionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceMetadataOptionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterface) DeepCopyInto(out *InstanceNetworkInterface) {
+	*out = *in
+	if in.Association != nil {
+		in, out := &in.Association, &out.Association
+		*out = new(InstanceNetworkInterfaceAssociation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Attachment != nil {
+		in, out := &in.Attachment, &out.Attachment
+		*out = new(InstanceNetworkInterfaceAttachment)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]GroupIdentifier, len(*in))
+		copy(*out, *in)
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]InstanceIPv6Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MacAddress != nil {
+		in, out := &in.MacAddress, &out.MacAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.OwnerID != nil {
+		in, out := &in.OwnerID, &out.OwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]InstancePrivateIPAddress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterface.
+func (in *InstanceNetworkInterface) DeepCopy() *InstanceNetworkInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterfaceAssociation) DeepCopyInto(out *InstanceNetworkInterfaceAssociation) {
+	*out = *in
+	if in.IPOwnerID != nil {
+		in, out := &in.IPOwnerID, &out.IPOwnerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicDNSName != nil {
+		in, out := &in.PublicDNSName, &out.PublicDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIP != nil {
+		in, out := &in.PublicIP, &out.PublicIP
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAssociation.
+func (in *InstanceNetworkInterfaceAssociation) DeepCopy() *InstanceNetworkInterfaceAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterfaceAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterfaceAttachment) DeepCopyInto(out *InstanceNetworkInterfaceAttachment) {
+	*out = *in
+	if in.AttachTime != nil {
+		in, out := &in.AttachTime, &out.AttachTime
+		*out = (*in).DeepCopy()
+	}
+	if in.AttachmentID != nil {
+		in, out := &in.AttachmentID, &out.AttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeviceIndex != nil {
+		in, out := &in.DeviceIndex, &out.DeviceIndex
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceAttachment.
+func (in *InstanceNetworkInterfaceAttachment) DeepCopy() *InstanceNetworkInterfaceAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterfaceAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceNetworkInterfaceSpecification) DeepCopyInto(out *InstanceNetworkInterfaceSpecification) {
+	*out = *in
+	if in.AssociatePublicIPAddress != nil {
+		in, out := &in.AssociatePublicIPAddress, &out.AssociatePublicIPAddress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteOnTermination != nil {
+		in, out := &in.DeleteOnTermination, &out.DeleteOnTermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeviceIndex != nil {
+		in, out := &in.DeviceIndex, &out.DeviceIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InterfaceType != nil {
+		in, out := &in.InterfaceType, &out.InterfaceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6AddressCount != nil {
+		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]InstanceIPv6Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Ipv6PrefixCount != nil {
+		in, out := &in.Ipv6PrefixCount, &out.Ipv6PrefixCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Ipv6Prefixes != nil {
+		in, out := &in.Ipv6Prefixes, &out.Ipv6Prefixes
+		*out = make([]Ipv6PrefixSpecificationRequest, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaceID != nil {
+		in, out := &in.NetworkInterfaceID, &out.NetworkInterfaceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddresses != nil {
+		in, out := &in.PrivateIPAddresses, &out.PrivateIPAddresses
+		*out = make([]PrivateIPAddressSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecondaryPrivateIPAddressCount != nil {
+		in, out := &in.SecondaryPrivateIPAddressCount, &out.SecondaryPrivateIPAddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceNetworkInterfaceSpecification.
+func (in *InstanceNetworkInterfaceSpecification) DeepCopy() *InstanceNetworkInterfaceSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceNetworkInterfaceSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceObservation) DeepCopyInto(out *InstanceObservation) {
+	*out = *in
+	if in.AmiLaunchIndex != nil {
+		in, out := &in.AmiLaunchIndex, &out.AmiLaunchIndex
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BlockDeviceMapping != nil {
+		in, out := &in.BlockDeviceMapping, &out.BlockDeviceMapping
+		*out = make([]InstanceBlockDeviceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CapacityReservationID != nil {
+		in, out := &in.CapacityReservationID, &out.CapacityReservationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityReservationSpecification != nil {
+		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
+		*out = new(CapacityReservationSpecificationResponse)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientToken != nil {
+		in, out := &in.ClientToken, &out.ClientToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUOptons != nil {
+		in, out := &in.CPUOptons, &out.CPUOptons
+		*out = new(CPUOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ElasticGPUAssociations != nil {
+		in, out := &in.ElasticGPUAssociations, &out.ElasticGPUAssociations
+		*out = make([]ElasticGPUAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ElasticInferenceAcceleratorAssociations != nil {
+		in, out := &in.ElasticInferenceAcceleratorAssociations, &out.ElasticInferenceAcceleratorAssociations
+		*out = make([]ElasticInferenceAcceleratorAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnaSupport != nil {
+		in, out := &in.EnaSupport, &out.EnaSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAMInstanceProfile != nil {
+		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
+		*out = new(IAMInstanceProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceID != nil {
+		in, out := &in.InstanceID, &out.InstanceID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceInitiatedShutdownBehavior != nil {
+		in, out := &in.InstanceInitiatedShutdownBehavior, &out.InstanceInitiatedShutdownBehavior
+		*out = new(string)
+		**out = **in
+	}
+	if in.KernelID != nil {
+		in, out := &in.KernelID, &out.KernelID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTime != nil {
+		in, out := &in.LaunchTime, &out.LaunchTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Licenses != nil {
+		in, out := &in.Licenses, &out.Licenses
+		*out = make([]LicenseConfigurationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataOptions != nil {
+		in, out := &in.MetadataOptions, &out.MetadataOptions
+		*out = new(InstanceMetadataOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(Monitoring)
+		**out = **in
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]InstanceNetworkInterface, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OutpostARN != nil {
+		in, out := &in.OutpostARN, &out.OutpostARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProductCodes != nil {
+		in, out := &in.ProductCodes, &out.ProductCodes
+		*out = make([]ProductCode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PublicDNSName != nil {
+		in, out := &in.PublicDNSName, &out.PublicDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPAddress != nil {
+		in, out := &in.PublicIPAddress, &out.PublicIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.RAMDiskID != nil {
+		in, out := &in.RAMDiskID, &out.RAMDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RootDeviceName != nil {
+		in, out := &in.RootDeviceName, &out.RootDeviceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make([]GroupIdentifier, len(*in))
+		copy(*out, *in)
+	}
+	if in.SourceDestCheck != nil {
+		in, out := &in.SourceDestCheck, &out.SourceDestCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SpotInstanceRequestID != nil {
+		in, out := &in.SpotInstanceRequestID, &out.SpotInstanceRequestID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SriovNetSupport != nil {
+		in, out := &in.SriovNetSupport, &out.SriovNetSupport
+		*out = new(string)
+		**out = **in
+	}
+	if in.StateReason != nil {
+		in, out := &in.StateReason, &out.StateReason
+		*out = new(StateReason)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StateTransitionReason != nil {
+		in, out := &in.StateTransitionReason, &out.StateTransitionReason
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceObservation.
+func (in *InstanceObservation) DeepCopy() *InstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceParameters) DeepCopyInto(out *InstanceParameters) {
+	*out = *in
+	if in.AllowStopForUpdate != nil {
+		in, out := &in.AllowStopForUpdate, &out.AllowStopForUpdate
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BlockDeviceMappings != nil {
+		in, out := &in.BlockDeviceMappings, &out.BlockDeviceMappings
+		*out = make([]BlockDeviceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DesiredState != nil {
+		in, out := &in.DesiredState, &out.DesiredState
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityReservationSpecification != nil {
+		in, out := &in.CapacityReservationSpecification, &out.CapacityReservationSpecification
+		*out = new(CapacityReservationSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientToken != nil {
+		in, out := &in.ClientToken, &out.ClientToken
+		*out = new(string)
+		**out = **in
+	}
+	if in.CPUOptions != nil {
+		in, out := &in.CPUOptions, &out.CPUOptions
+		*out = new(CPUOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CreditSpecification != nil {
+		in, out := &in.CreditSpecification, &out.CreditSpecification
+		*out = new(CreditSpecificationRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisableAPITermination != nil {
+		in, out := &in.DisableAPITermination, &out.DisableAPITermination
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EBSOptimized != nil {
+		in, out := &in.EBSOptimized, &out.EBSOptimized
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ElasticGPUSpecification != nil {
+		in, out := &in.ElasticGPUSpecification, &out.ElasticGPUSpecification
+		*out = make([]ElasticGPUSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ElasticInferenceAccelerators != nil {
+		in, out := &in.ElasticInferenceAccelerators, &out.ElasticInferenceAccelerators
+		*out = make([]ElasticInferenceAccelerator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HibernationOptions != nil {
+		in, out := &in.HibernationOptions, &out.HibernationOptions
+		*out = new(HibernationOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IAMInstanceProfile != nil {
+		in, out := &in.IAMInstanceProfile, &out.IAMInstanceProfile
+		*out = new(IAMInstanceProfileSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageID != nil {
+		in, out := &in.ImageID, &out.ImageID
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceMarketOptions != nil {
+		in, out := &in.InstanceMarketOptions, &out.InstanceMarketOptions
+		*out = new(InstanceMarketOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv6AddressCount != nil {
+		in, out := &in.IPv6AddressCount, &out.IPv6AddressCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPv6Addresses != nil {
+		in, out := &in.IPv6Addresses, &out.IPv6Addresses
+		*out = make([]InstanceIPv6Address, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KernelID != nil {
+		in, out := &in.KernelID, &out.KernelID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyNameRef != nil {
+		in, out := &in.KeyNameRef, &out.KeyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyNameSelector != nil {
+		in, out := &in.KeyNameSelector, &out.KeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LaunchTemplate != nil {
+		in, out := &in.LaunchTemplate, &out.LaunchTemplate
+		*out = new(LaunchTemplateSpecification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LicenseSpecifications != nil {
+		in, out := &in.LicenseSpecifications, &out.LicenseSpecifications
+		*out = make([]LicenseConfigurationRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetadataOptions != nil {
+		in, out := &in.MetadataOptions, &out.MetadataOptions
+		*out = new(InstanceMetadataOptionsRequest)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Monitoring != nil {
+		in, out := &in.Monitoring, &out.Monitoring
+		*out = new(RunInstancesMonitoringEnabled)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkInterfaces != nil {
+		in, out := &in.NetworkInterfaces, &out.NetworkInterfaces
+		*out = make([]InstanceNetworkInterfaceSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(Placement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+	if in.RAMDiskID != nil {
+		in, out := &in.RAMDiskID, &out.RAMDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupRefs != nil {
+		in, out := &in.SecurityGroupRefs, &out.SecurityGroupRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityGroupSelector != nil {
+		in, out := &in.SecurityGroupSelector, &out.SecurityGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetID != nil {
+		in, out := &in.SubnetID, &out.SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.TagSpecifications != nil {
+		in, out := &in.TagSpecifications, &out.TagSpecifications
+		*out = make([]TagSpecification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserData != nil {
+		in, out := &in.UserData, &out.UserData
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceParameters.
+func (in *InstanceParameters) DeepCopy() *InstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancePrivateIPAddress) DeepCopyInto(out *InstancePrivateIPAddress) {
+	*out = *in
+	if in.Association != nil {
+		in, out := &in.Association, &out.Association
+		*out = new(InstanceNetworkInterfaceAssociation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateDNSName != nil {
+		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePrivateIPAddress.
+func (in *InstancePrivateIPAddress) DeepCopy() *InstancePrivateIPAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancePrivateIPAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
+func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
+func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ipv6PrefixSpecificationRequest) DeepCopyInto(out *Ipv6PrefixSpecificationRequest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ipv6PrefixSpecificationRequest.
+func (in *Ipv6PrefixSpecificationRequest) DeepCopy() *Ipv6PrefixSpecificationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(Ipv6PrefixSpecificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPair) DeepCopyInto(out *KeyPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPair.
+func (in *KeyPair) DeepCopy() *KeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairList) DeepCopyInto(out *KeyPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairList.
+func (in *KeyPairList) DeepCopy() *KeyPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairObservation) DeepCopyInto(out *KeyPairObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairObservation.
+func (in *KeyPairObservation) DeepCopy() *KeyPairObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairParameters) DeepCopyInto(out *KeyPairParameters) {
+	*out = *in
+	if in.KeyType != nil {
+		in, out := &in.KeyType, &out.KeyType
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyFormat != nil {
+		in, out := &in.KeyFormat, &out.KeyFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeyMaterial != nil {
+		in, out := &in.PublicKeyMaterial, &out.PublicKeyMaterial
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicKeySecretRef != nil {
+		in, out := &in.PublicKeySecretRef, &out.PublicKeySecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairParameters.
+func (in *KeyPairParameters) DeepCopy() *KeyPairParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
+func (in *KeyPairSpec) DeepCopy() *KeyPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
+func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
+	*out = *in
+	if in.LaunchTemplateID != nil {
+		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
+		*out = new(string)
+		**out = **in
+	}
+	if in.LaunchTemplateName != nil {
+		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
+func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(LaunchTemplateSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseConfigurationRequest) DeepCopyInto(out *LicenseConfigurationRequest) {
+	*out = *in
+	if in.LicenseConfigurationARN != nil {
+		in, out := &in.LicenseConfigurationARN, &out.LicenseConfigurationARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseConfigurationRequest.
+func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseConfigurationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixList) DeepCopyInto(out *ManagedPrefixList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixList.
+func (in *ManagedPrefixList) DeepCopy() *ManagedPrefixList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedPrefixList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListList) DeepCopyInto(out *ManagedPrefixListList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ManagedPrefixList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListList.
+func (in *ManagedPrefixListList) DeepCopy() *ManagedPrefixListList {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixListList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ManagedPrefixListList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListObservation) DeepCopyInto(out *ManagedPrefixListObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListObservation.
+func (in *ManagedPrefixListObservation) DeepCopy() *ManagedPrefixListObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixListObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListParameters) DeepCopyInto(out *ManagedPrefixListParameters) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]PrefixListEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListParameters.
+func (in *ManagedPrefixListParameters) DeepCopy() *ManagedPrefixListParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixListParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListSpec) DeepCopyInto(out *ManagedPrefixListSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListSpec.
+func (in *ManagedPrefixListSpec) DeepCopy() *ManagedPrefixListSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixListSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedPrefixListStatus) DeepCopyInto(out *ManagedPrefixListStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixListStatus.
+func (in *ManagedPrefixListStatus) DeepCopy() *ManagedPrefixListStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedPrefixListStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
+func (in *Monitoring) DeepCopy() *Monitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(Monitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACL) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociation.
+func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLEntry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryList) DeepCopyInto(out *NetworkACLEntryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryList.
+func (in *NetworkACLEntryList) DeepCopy() *NetworkACLEntryList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLEntryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryObservation) DeepCopyInto(out *NetworkACLEntryObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryObservation.
+func (in *NetworkACLEntryObservation) DeepCopy() *NetworkACLEntryObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryParameters) DeepCopyInto(out *NetworkACLEntryParameters) {
+	*out = *in
+	if in.NetworkACLID != nil {
+		in, out := &in.NetworkACLID, &out.NetworkACLID
+		*out = new(string)
+		**out = **in
+	}
+	if in.NetworkACLIDRef != nil {
+		in, out := &in.NetworkACLIDRef, &out.NetworkACLIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkACLIDSelector != nil {
+		in, out := &in.NetworkACLIDSelector, &out.NetworkACLIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(bool)
+		**out = **in
+	}
+	in.NetworkACLRule.DeepCopyInto(&out.NetworkACLRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryParameters.
+func (in *NetworkACLEntryParameters) DeepCopy() *NetworkACLEntryParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntrySpec) DeepCopyInto(out *NetworkACLEntrySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntrySpec.
+func (in *NetworkACLEntrySpec) DeepCopy() *NetworkACLEntrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLEntryStatus) DeepCopyInto(out *NetworkACLEntryStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntryStatus.
+func (in *NetworkACLEntryStatus) DeepCopy() *NetworkACLEntryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLEntryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLList) DeepCopyInto(out *NetworkACLList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLList.
+func (in *NetworkACLList) DeepCopy() *NetworkACLList {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NetworkACLList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLObservation) DeepCopyInto(out *NetworkACLObservation) {
+	*out = *in
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLObservation.
+func (in *NetworkACLObservation) DeepCopy() *NetworkACLObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLParameters) DeepCopyInto(out *NetworkACLParameters) {
+	*out = *in
+	if in.VPCID != nil {
+		in, out := &in.VPCID, &out.VPCID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCIDSelector != nil {
+		in, out := &in.VPCIDSelector, &out.VPCIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SubnetIDSelector != nil {
+		in, out := &in.SubnetIDSelector, &out.SubnetIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = make([]NetworkACLRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IgnoreIngress != nil {
+		in, out := &in.IgnoreIngress, &out.IgnoreIngress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IgnoreEgress != nil {
+		in, out := &in.IgnoreEgress, &out.IgnoreEgress
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLParameters.
+func (in *NetworkACLParameters) DeepCopy() *NetworkACLParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLRule) DeepCopyInto(out *NetworkACLRule) {
+	*out = *in
+	if in.CIDRBlock != nil {
+		in, out := &in.CIDRBlock, &out.CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPType != nil {
+		in, out := &in.ICMPType, &out.ICMPType
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ICMPCode != nil {
+		in, out := &in.ICMPCode, &out.ICMPCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLRule.
+func (in *NetworkACLRule) DeepCopy() *NetworkACLRule {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkACLStatus) DeepCopyInto(out *NetworkACLStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLStatus.
+func (in *NetworkACLStatus) DeepCopy() *NetworkACLStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkACLStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Placement) DeepCopyInto(out *Placement) {
+	*out = *in
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.GroupName != nil {
+		in, out := &in.GroupName, &out.GroupName
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostResourceGroupARN != nil {
+		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.PartitionNumber != nil {
+		in, out := &in.PartitionNumber, &out.PartitionNumber
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SpreadDomain != nil {
+		in, out := &in.SpreadDomain, &out.SpreadDomain
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
+func (in *Placement) DeepCopy() *Placement {
+	if in == nil {
+		return nil
+	}
+	out := new(Placement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrefixListEntry) DeepCopyInto(out *PrefixListEntry) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListEntry.
+func (in *PrefixListEntry) DeepCopy() *PrefixListEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PrefixListEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
+	*out = *in
+	if in.Primary != nil {
+		in, out := &in.Primary, &out.Primary
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateIPAddress != nil {
+		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
+func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateIPAddressSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+	*out = *in
+	if in.ProductCodeID != nil {
+		in, out := &in.ProductCodeID, &out.ProductCodeID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
+func (in *ProductCode) DeepCopy() *ProductCode {
+	if in == nil {
+		return nil
+	}
+	out := new(ProductCode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
+func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+	if in == nil {
+		return nil
+	}
+	out := new(RunInstancesMonitoringEnabled)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
+func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleList) DeepCopyInto(out *SecurityGroupRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroupRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleList.
+func (in *SecurityGroupRuleList) DeepCopy() *SecurityGroupRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleObservation) DeepCopyInto(out *SecurityGroupRuleObservation) {
+	*out = *in
+	if in.SecurityGroupRuleID != nil {
+		in, out := &in.SecurityGroupRuleID, &out.SecurityGroupRuleID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleObservation.
+func (in *SecurityGroupRuleObservation) DeepCopy() *SecurityGroupRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleParameters) DeepCopyInto(out *SecurityGroupRuleParameters) {
+	*out = *in
+	if in.FromPort != nil {
+		in, out := &in.FromPort, &out.FromPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ToPort != nil {
+		in, out := &in.ToPort, &out.ToPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.CidrBlock != nil {
+		in, out := &in.CidrBlock, &out.CidrBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.Ipv6CidrBlock != nil {
+		in, out := &in.Ipv6CidrBlock, &out.Ipv6CidrBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListID != nil {
+		in, out := &in.PrefixListID, &out.PrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrefixListIDRef != nil {
+		in, out := &in.PrefixListIDRef, &out.PrefixListIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixListIDSelector != nil {
+		in, out := &in.PrefixListIDSelector, &out.PrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupID != nil {
+		in, out := &in.SecurityGroupID, &out.SecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecurityGroupIDRef != nil {
+		in, out := &in.SecurityGroupIDRef, &out.SecurityGroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroupIDSelector != nil {
+		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSecurityGroupID != nil {
+		in, out := &in.SourceSecurityGroupID, &out.SourceSecurityGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSecurityGroupIDRef != nil {
+		in, out := &in.SourceSecurityGroupIDRef, &out.SourceSecurityGroupIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSecurityGroupIDSelector != nil {
+		in, out := &in.SourceSecurityGroupIDSelector, &out.SourceSecurityGroupIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleParameters.
+func (in *SecurityGroupRuleParameters) DeepCopy() *SecurityGroupRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
+func (in *SecurityGroupRuleSpec) DeepCopy() *SecurityGroupRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleState) DeepCopyInto(out *SecurityGroupRuleState) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusMessage != nil {
+		in, out := &in.StatusMessage, &out.StatusMessage
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleState.
+func (in *SecurityGroupRuleState) DeepCopy() *SecurityGroupRuleState {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
+func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Snapshot) DeepCopyInto(out *Snapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Snapshot.
+func (in *Snapshot) DeepCopy() *Snapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(Snapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Snapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotList) DeepCopyInto(out *SnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Snapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotList.
+func (in *SnapshotList) DeepCopy() *SnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotObservation) DeepCopyInto(out *SnapshotObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotObservation.
+func (in *SnapshotObservation) DeepCopy() *SnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotParameters) DeepCopyInto(out *SnapshotParameters) {
+	*out = *in
+	if in.VolumeID != nil {
+		in, out := &in.VolumeID, &out.VolumeID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeIDRef != nil {
+		in, out := &in.VolumeIDRef, &out.VolumeIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VolumeIDSelector != nil {
+		in, out := &in.VolumeIDSelector, &out.VolumeIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSnapshotID != nil {
+		in, out := &in.SourceSnapshotID, &out.SourceSnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSnapshotIDRef != nil {
+		in, out := &in.SourceSnapshotIDRef, &out.SourceSnapshotIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceSnapshotIDSelector != nil {
+		in, out := &in.SourceSnapshotIDSelector, &out.SourceSnapshotIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceRegion != nil {
+		in, out := &in.SourceRegion, &out.SourceRegion
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotParameters.
+func (in *SnapshotParameters) DeepCopy() *SnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotSpec) DeepCopyInto(out *SnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotSpec.
+func (in *SnapshotSpec) DeepCopy() *SnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotStatus) DeepCopyInto(out *SnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotStatus.
+func (in *SnapshotStatus) DeepCopy() *SnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpotMarketOptions) DeepCopyInto(out *SpotMarketOptions) {
+	*out = *in
+	if in.BlockDurationMinutes != nil {
+		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxPrice != nil {
+		in, out := &in.MaxPrice, &out.MaxPrice
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotMarketOptions.
+func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SpotMarketOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StateReason) DeepCopyInto(out *StateReason) {
+	*out = *in
+	if in.Code != nil {
+		in, out := &in.Code, &out.Code
+		*out = new(string)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateReason.
+func (in *StateReason) DeepCopy() *StateReason {
+	if in == nil {
+		return nil
+	}
+	out := new(StateReason)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TagSpecification) DeepCopyInto(out *TagSpecification) {
+	*out = *in
+	if in.ResourceType != nil {
+		in, out := &in.ResourceType, &out.ResourceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpecification.
+func (in *TagSpecification) DeepCopy() *TagSpecification {
+	if in == nil {
+		return nil
+	}
+	out := new(TagSpecification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachment) DeepCopyInto(out *TransitGatewayPeeringAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachment.
+func (in *TransitGatewayPeeringAttachment) DeepCopy() *TransitGatewayPeeringAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepter) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepter.
+func (in *TransitGatewayPeeringAttachmentAccepter) DeepCopy() *TransitGatewayPeeringAttachmentAccepter {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentAccepter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterList) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayPeeringAttachmentAccepter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterList.
+func (in *TransitGatewayPeeringAttachmentAccepterList) DeepCopy() *TransitGatewayPeeringAttachmentAccepterList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentAccepterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterObservation) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterObservation.
+func (in *TransitGatewayPeeringAttachmentAccepterObservation) DeepCopy() *TransitGatewayPeeringAttachmentAccepterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterParameters) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterParameters) {
+	*out = *in
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterParameters.
+func (in *TransitGatewayPeeringAttachmentAccepterParameters) DeepCopy() *TransitGatewayPeeringAttachmentAccepterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterSpec) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterSpec.
+func (in *TransitGatewayPeeringAttachmentAccepterSpec) DeepCopy() *TransitGatewayPeeringAttachmentAccepterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentAccepterStatus) DeepCopyInto(out *TransitGatewayPeeringAttachmentAccepterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentAccepterStatus.
+func (in *TransitGatewayPeeringAttachmentAccepterStatus) DeepCopy() *TransitGatewayPeeringAttachmentAccepterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentAccepterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopyInto(out *TransitGatewayPeeringAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayPeeringAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentList.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopy() *TransitGatewayPeeringAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayPeeringAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentObservation) DeepCopyInto(out *TransitGatewayPeeringAttachmentObservation) {
+	*out = *in
+	if in.CreationTime != nil {
+		in, out := &in.CreationTime, &out.CreationTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentObservation.
+func (in *TransitGatewayPeeringAttachmentObservation) DeepCopy() *TransitGatewayPeeringAttachmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayPeeringAttachmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayPeeringAttachmentParameters) DeepCopyInto(out *TransitGatewayPeeringAttachmentParameters) {
+	*out = *in
+	if in.TransitGatewayID != nil {
+		in, out := &in.TransitGatewayID, &out.TransitGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
-		*out = new(bool)
-		**out = **in
+	if in.TransitGatewayIDSelector != nil {
+		in, out := &in.TransitGatewayIDSelector, &out.TransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.PrivateDNSName != nil {
-		in, out := &in.PrivateDNSName, &out.PrivateDNSName
+	if in.PeerTransitGatewayID != nil {
+		in, out := &in.PeerTransitGatewayID, &out.PeerTransitGatewayID
 		*out = new(string)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
+	if in.PeerTransitGatewayIDRef != nil {
+		in, out := &in.PeerTransitGatewayIDRef, &out.PeerTransitGatewayIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeerTransitGatewayIDSelector != nil {
+		in, out := &in.PeerTransitGatewayIDSelector, &out.PeerTransitGatewayIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancePrivateIPAddress.
-func (in *InstancePrivateIPAddress) DeepCopy() *InstancePrivateIPAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentParameters.
+func (in *TransitGatewayPeeringAttachmentParameters) DeepCopy() *TransitGatewayPeeringAttachmentParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(InstancePrivateIPAddress)
+	out := new(TransitGatewayPeeringAttachmentParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceSpec) DeepCopyInto(out *InstanceSpec) {
+func (in *TransitGatewayPeeringAttachmentSpec) DeepCopyInto(out *TransitGatewayPeeringAttachmentSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSpec.
-func (in *InstanceSpec) DeepCopy() *InstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentSpec.
+func (in *TransitGatewayPeeringAttachmentSpec) DeepCopy() *TransitGatewayPeeringAttachmentSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceSpec)
+	out := new(TransitGatewayPeeringAttachmentSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *InstanceStatus) DeepCopyInto(out *InstanceStatus) {
+func (in *TransitGatewayPeeringAttachmentStatus) DeepCopyInto(out *TransitGatewayPeeringAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceStatus.
-func (in *InstanceStatus) DeepCopy() *InstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachmentStatus.
+func (in *TransitGatewayPeeringAttachmentStatus) DeepCopy() *TransitGatewayPeeringAttachmentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(InstanceStatus)
+	out := new(TransitGatewayPeeringAttachmentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Ipv6PrefixSpecificationRequest) DeepCopyInto(out *Ipv6PrefixSpecificationRequest) {
+func (in *TransitGatewayRouteTableAssociation) DeepCopyInto(out *TransitGatewayRouteTableAssociation) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ipv6PrefixSpecificationRequest.
-func (in *Ipv6PrefixSpecificationRequest) DeepCopy() *Ipv6PrefixSpecificationRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociation.
+func (in *TransitGatewayRouteTableAssociation) DeepCopy() *TransitGatewayRouteTableAssociation {
 	if in == nil {
 		return nil
 	}
-	out := new(Ipv6PrefixSpecificationRequest)
+	out := new(TransitGatewayRouteTableAssociation)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LaunchTemplateSpecification) DeepCopyInto(out *LaunchTemplateSpecification) {
-	*out = *in
-	if in.LaunchTemplateID != nil {
-		in, out := &in.LaunchTemplateID, &out.LaunchTemplateID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LaunchTemplateName != nil {
-		in, out := &in.LaunchTemplateName, &out.LaunchTemplateName
-		*out = new(string)
-		**out = **in
-	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpecification.
-func (in *LaunchTemplateSpecification) DeepCopy() *LaunchTemplateSpecification {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableAssociation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(LaunchTemplateSpecification)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LicenseConfigurationRequest) DeepCopyInto(out *LicenseConfigurationRequest) {
+func (in *TransitGatewayRouteTableAssociationList) DeepCopyInto(out *TransitGatewayRouteTableAssociationList) {
 	*out = *in
-	if in.LicenseConfigurationARN != nil {
-		in, out := &in.LicenseConfigurationARN, &out.LicenseConfigurationARN
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayRouteTableAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseConfigurationRequest.
-func (in *LicenseConfigurationRequest) DeepCopy() *LicenseConfigurationRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationList.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopy() *TransitGatewayRouteTableAssociationList {
 	if in == nil {
 		return nil
 	}
-	out := new(LicenseConfigurationRequest)
+	out := new(TransitGatewayRouteTableAssociationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayRouteTableAssociationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Monitoring) DeepCopyInto(out *Monitoring) {
+func (in *TransitGatewayRouteTableAssociationObservation) DeepCopyInto(out *TransitGatewayRouteTableAssociationObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Monitoring.
-func (in *Monitoring) DeepCopy() *Monitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationObservation.
+func (in *TransitGatewayRouteTableAssociationObservation) DeepCopy() *TransitGatewayRouteTableAssociationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(Monitoring)
+	out := new(TransitGatewayRouteTableAssociationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Placement) DeepCopyInto(out *Placement) {
+func (in *TransitGatewayRouteTableAssociationParameters) DeepCopyInto(out *TransitGatewayRouteTableAssociationParameters) {
 	*out = *in
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
 		*out = new(string)
 		**out = **in
 	}
-	if in.AvailabilityZone != nil {
-		in, out := &in.AvailabilityZone, &out.AvailabilityZone
-		*out = new(string)
-		**out = **in
-	}
-	if in.GroupName != nil {
-		in, out := &in.GroupName, &out.GroupName
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.HostID != nil {
-		in, out := &in.HostID, &out.HostID
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.HostResourceGroupARN != nil {
-		in, out := &in.HostResourceGroupARN, &out.HostResourceGroupARN
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
 		**out = **in
 	}
-	if in.PartitionNumber != nil {
-		in, out := &in.PartitionNumber, &out.PartitionNumber
-		*out = new(int32)
-		**out = **in
-	}
-	if in.SpreadDomain != nil {
-		in, out := &in.SpreadDomain, &out.SpreadDomain
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Placement.
-func (in *Placement) DeepCopy() *Placement {
-	if in == nil {
-		return nil
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(Placement)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrivateIPAddressSpecification) DeepCopyInto(out *PrivateIPAddressSpecification) {
-	*out = *in
-	if in.Primary != nil {
-		in, out := &in.Primary, &out.Primary
+	if in.ReplaceExistingAssociation != nil {
+		in, out := &in.ReplaceExistingAssociation, &out.ReplaceExistingAssociation
 		*out = new(bool)
 		**out = **in
 	}
-	if in.PrivateIPAddress != nil {
-		in, out := &in.PrivateIPAddress, &out.PrivateIPAddress
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateIPAddressSpecification.
-func (in *PrivateIPAddressSpecification) DeepCopy() *PrivateIPAddressSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationParameters.
+func (in *TransitGatewayRouteTableAssociationParameters) DeepCopy() *TransitGatewayRouteTableAssociationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PrivateIPAddressSpecification)
+	out := new(TransitGatewayRouteTableAssociationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ProductCode) DeepCopyInto(out *ProductCode) {
+func (in *TransitGatewayRouteTableAssociationSpec) DeepCopyInto(out *TransitGatewayRouteTableAssociationSpec) {
 	*out = *in
-	if in.ProductCodeID != nil {
-		in, out := &in.ProductCodeID, &out.ProductCodeID
-		*out = new(string)
-		**out = **in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProductCode.
-func (in *ProductCode) DeepCopy() *ProductCode {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationSpec.
+func (in *TransitGatewayRouteTableAssociationSpec) DeepCopy() *TransitGatewayRouteTableAssociationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ProductCode)
+	out := new(TransitGatewayRouteTableAssociationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RunInstancesMonitoringEnabled) DeepCopyInto(out *RunInstancesMonitoringEnabled) {
+func (in *TransitGatewayRouteTableAssociationStatus) DeepCopyInto(out *TransitGatewayRouteTableAssociationStatus) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RunInstancesMonitoringEnabled.
-func (in *RunInstancesMonitoringEnabled) DeepCopy() *RunInstancesMonitoringEnabled {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociationStatus.
+func (in *TransitGatewayRouteTableAssociationStatus) DeepCopy() *TransitGatewayRouteTableAssociationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RunInstancesMonitoringEnabled)
+	out := new(TransitGatewayRouteTableAssociationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
+func (in *TransitGatewayRouteTablePropagation) DeepCopyInto(out *TransitGatewayRouteTablePropagation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1559,18 +4578,18 @@ func (in *SecurityGroupRule) DeepCopyInto(out *SecurityGroupRule) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRule.
-func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagation.
+func (in *TransitGatewayRouteTablePropagation) DeepCopy() *TransitGatewayRouteTablePropagation {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRule)
+	out := new(TransitGatewayRouteTablePropagation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
+func (in *TransitGatewayRouteTablePropagation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1578,31 +4597,31 @@ func (in *SecurityGroupRule) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleList) DeepCopyInto(out *SecurityGroupRuleList) {
+func (in *TransitGatewayRouteTablePropagationList) DeepCopyInto(out *TransitGatewayRouteTablePropagationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SecurityGroupRule, len(*in))
+		*out = make([]TransitGatewayRouteTablePropagation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleList.
-func (in *SecurityGroupRuleList) DeepCopy() *SecurityGroupRuleList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationList.
+func (in *TransitGatewayRouteTablePropagationList) DeepCopy() *TransitGatewayRouteTablePropagationList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleList)
+	out := new(TransitGatewayRouteTablePropagationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
+func (in *TransitGatewayRouteTablePropagationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1610,264 +4629,252 @@ func (in *SecurityGroupRuleList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleObservation) DeepCopyInto(out *SecurityGroupRuleObservation) {
+func (in *TransitGatewayRouteTablePropagationObservation) DeepCopyInto(out *TransitGatewayRouteTablePropagationObservation) {
 	*out = *in
-	if in.SecurityGroupRuleID != nil {
-		in, out := &in.SecurityGroupRuleID, &out.SecurityGroupRuleID
-		*out = new(string)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleObservation.
-func (in *SecurityGroupRuleObservation) DeepCopy() *SecurityGroupRuleObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationObservation.
+func (in *TransitGatewayRouteTablePropagationObservation) DeepCopy() *TransitGatewayRouteTablePropagationObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleObservation)
+	out := new(TransitGatewayRouteTablePropagationObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleParameters) DeepCopyInto(out *SecurityGroupRuleParameters) {
+func (in *TransitGatewayRouteTablePropagationParameters) DeepCopyInto(out *TransitGatewayRouteTablePropagationParameters) {
 	*out = *in
-	if in.FromPort != nil {
-		in, out := &in.FromPort, &out.FromPort
-		*out = new(int32)
-		**out = **in
-	}
-	if in.ToPort != nil {
-		in, out := &in.ToPort, &out.ToPort
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Type != nil {
-		in, out := &in.Type, &out.Type
-		*out = new(string)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
-	}
-	if in.CidrBlock != nil {
-		in, out := &in.CidrBlock, &out.CidrBlock
+	if in.TransitGatewayRouteTableID != nil {
+		in, out := &in.TransitGatewayRouteTableID, &out.TransitGatewayRouteTableID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Ipv6CidrBlock != nil {
-		in, out := &in.Ipv6CidrBlock, &out.Ipv6CidrBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrefixListID != nil {
-		in, out := &in.PrefixListID, &out.PrefixListID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Region != nil {
-		in, out := &in.Region, &out.Region
-		*out = new(string)
-		**out = **in
-	}
-	if in.SecurityGroupID != nil {
-		in, out := &in.SecurityGroupID, &out.SecurityGroupID
-		*out = new(string)
-		**out = **in
-	}
-	if in.SecurityGroupIDRef != nil {
-		in, out := &in.SecurityGroupIDRef, &out.SecurityGroupIDRef
+	if in.TransitGatewayRouteTableIDRef != nil {
+		in, out := &in.TransitGatewayRouteTableIDRef, &out.TransitGatewayRouteTableIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SecurityGroupIDSelector != nil {
-		in, out := &in.SecurityGroupIDSelector, &out.SecurityGroupIDSelector
+	if in.TransitGatewayRouteTableIDSelector != nil {
+		in, out := &in.TransitGatewayRouteTableIDSelector, &out.TransitGatewayRouteTableIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
-	}
-	if in.SourceSecurityGroupID != nil {
-		in, out := &in.SourceSecurityGroupID, &out.SourceSecurityGroupID
+	}
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
 		**out = **in
 	}
-	if in.SourceSecurityGroupIDRef != nil {
-		in, out := &in.SourceSecurityGroupIDRef, &out.SourceSecurityGroupIDRef
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
 		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.SourceSecurityGroupIDSelector != nil {
-		in, out := &in.SourceSecurityGroupIDSelector, &out.SourceSecurityGroupIDSelector
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleParameters.
-func (in *SecurityGroupRuleParameters) DeepCopy() *SecurityGroupRuleParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationParameters.
+func (in *TransitGatewayRouteTablePropagationParameters) DeepCopy() *TransitGatewayRouteTablePropagationParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleParameters)
+	out := new(TransitGatewayRouteTablePropagationParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleSpec) DeepCopyInto(out *SecurityGroupRuleSpec) {
+func (in *TransitGatewayRouteTablePropagationSpec) DeepCopyInto(out *TransitGatewayRouteTablePropagationSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleSpec.
-func (in *SecurityGroupRuleSpec) DeepCopy() *SecurityGroupRuleSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationSpec.
+func (in *TransitGatewayRouteTablePropagationSpec) DeepCopy() *TransitGatewayRouteTablePropagationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleSpec)
+	out := new(TransitGatewayRouteTablePropagationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleState) DeepCopyInto(out *SecurityGroupRuleState) {
+func (in *TransitGatewayRouteTablePropagationStatus) DeepCopyInto(out *TransitGatewayRouteTablePropagationStatus) {
 	*out = *in
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
-	}
-	if in.StatusMessage != nil {
-		in, out := &in.StatusMessage, &out.StatusMessage
-		*out = new(string)
-		**out = **in
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleState.
-func (in *SecurityGroupRuleState) DeepCopy() *SecurityGroupRuleState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagationStatus.
+func (in *TransitGatewayRouteTablePropagationStatus) DeepCopy() *TransitGatewayRouteTablePropagationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleState)
+	out := new(TransitGatewayRouteTablePropagationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupRuleStatus) DeepCopyInto(out *SecurityGroupRuleStatus) {
+func (in *TransitGatewayVPCAttachmentAccepter) DeepCopyInto(out *TransitGatewayVPCAttachmentAccepter) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupRuleStatus.
-func (in *SecurityGroupRuleStatus) DeepCopy() *SecurityGroupRuleStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentAccepter.
+func (in *TransitGatewayVPCAttachmentAccepter) DeepCopy() *TransitGatewayVPCAttachmentAccepter {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupRuleStatus)
+	out := new(TransitGatewayVPCAttachmentAccepter)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachmentAccepter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SpotMarketOptions) DeepCopyInto(out *SpotMarketOptions) {
+func (in *TransitGatewayVPCAttachmentAccepterList) DeepCopyInto(out *TransitGatewayVPCAttachmentAccepterList) {
 	*out = *in
-	if in.BlockDurationMinutes != nil {
-		in, out := &in.BlockDurationMinutes, &out.BlockDurationMinutes
-		*out = new(int32)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayVPCAttachmentAccepter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.MaxPrice != nil {
-		in, out := &in.MaxPrice, &out.MaxPrice
-		*out = new(string)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentAccepterList.
+func (in *TransitGatewayVPCAttachmentAccepterList) DeepCopy() *TransitGatewayVPCAttachmentAccepterList {
+	if in == nil {
+		return nil
 	}
-	if in.ValidUntil != nil {
-		in, out := &in.ValidUntil, &out.ValidUntil
-		*out = (*in).DeepCopy()
+	out := new(TransitGatewayVPCAttachmentAccepterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachmentAccepterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpotMarketOptions.
-func (in *SpotMarketOptions) DeepCopy() *SpotMarketOptions {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentAccepterObservation) DeepCopyInto(out *TransitGatewayVPCAttachmentAccepterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentAccepterObservation.
+func (in *TransitGatewayVPCAttachmentAccepterObservation) DeepCopy() *TransitGatewayVPCAttachmentAccepterObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(SpotMarketOptions)
+	out := new(TransitGatewayVPCAttachmentAccepterObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StateReason) DeepCopyInto(out *StateReason) {
+func (in *TransitGatewayVPCAttachmentAccepterParameters) DeepCopyInto(out *TransitGatewayVPCAttachmentAccepterParameters) {
 	*out = *in
-	if in.Code != nil {
-		in, out := &in.Code, &out.Code
+	if in.TransitGatewayAttachmentID != nil {
+		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
 		*out = new(string)
 		**out = **in
 	}
-	if in.Message != nil {
-		in, out := &in.Message, &out.Message
-		*out = new(string)
-		**out = **in
+	if in.TransitGatewayAttachmentIDRef != nil {
+		in, out := &in.TransitGatewayAttachmentIDRef, &out.TransitGatewayAttachmentIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransitGatewayAttachmentIDSelector != nil {
+		in, out := &in.TransitGatewayAttachmentIDSelector, &out.TransitGatewayAttachmentIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StateReason.
-func (in *StateReason) DeepCopy() *StateReason {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentAccepterParameters.
+func (in *TransitGatewayVPCAttachmentAccepterParameters) DeepCopy() *TransitGatewayVPCAttachmentAccepterParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(StateReason)
+	out := new(TransitGatewayVPCAttachmentAccepterParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *TransitGatewayVPCAttachmentAccepterSpec) DeepCopyInto(out *TransitGatewayVPCAttachmentAccepterSpec) {
 	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentAccepterSpec.
+func (in *TransitGatewayVPCAttachmentAccepterSpec) DeepCopy() *TransitGatewayVPCAttachmentAccepterSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(TransitGatewayVPCAttachmentAccepterSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TagSpecification) DeepCopyInto(out *TagSpecification) {
+func (in *TransitGatewayVPCAttachmentAccepterStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentAccepterStatus) {
 	*out = *in
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TagSpecification.
-func (in *TagSpecification) DeepCopy() *TagSpecification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentAccepterStatus.
+func (in *TransitGatewayVPCAttachmentAccepterStatus) DeepCopy() *TransitGatewayVPCAttachmentAccepterStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(TagSpecification)
+	out := new(TransitGatewayVPCAttachmentAccepterStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2041,6 +5048,13 @@ func (in *VPCCIDRBlockSpec) DeepCopyInto(out *VPCCIDRBlockSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCCIDRBlockSpec.
@@ -2094,3 +5108,178 @@ func (in *VPCCIDRBlockStatus) DeepCopy() *VPCCIDRBlockStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepter) DeepCopyInto(out *VPCPeeringConnectionAccepter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepter.
+func (in *VPCPeeringConnectionAccepter) DeepCopy() *VPCPeeringConnectionAccepter {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionAccepter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterList) DeepCopyInto(out *VPCPeeringConnectionAccepterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCPeeringConnectionAccepter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterList.
+func (in *VPCPeeringConnectionAccepterList) DeepCopy() *VPCPeeringConnectionAccepterList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionAccepterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterObservation) DeepCopyInto(out *VPCPeeringConnectionAccepterObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterObservation.
+func (in *VPCPeeringConnectionAccepterObservation) DeepCopy() *VPCPeeringConnectionAccepterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterOptions) DeepCopyInto(out *VPCPeeringConnectionAccepterOptions) {
+	*out = *in
+	if in.AllowDNSResolutionFromRemoteVPC != nil {
+		in, out := &in.AllowDNSResolutionFromRemoteVPC, &out.AllowDNSResolutionFromRemoteVPC
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterOptions.
+func (in *VPCPeeringConnectionAccepterOptions) DeepCopy() *VPCPeeringConnectionAccepterOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterParameters) DeepCopyInto(out *VPCPeeringConnectionAccepterParameters) {
+	*out = *in
+	if in.VPCPeeringConnectionID != nil {
+		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VPCPeeringConnectionIDRef != nil {
+		in, out := &in.VPCPeeringConnectionIDRef, &out.VPCPeeringConnectionIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPCPeeringConnectionIDSelector != nil {
+		in, out := &in.VPCPeeringConnectionIDSelector, &out.VPCPeeringConnectionIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PeeringOptions != nil {
+		in, out := &in.PeeringOptions, &out.PeeringOptions
+		*out = new(VPCPeeringConnectionAccepterOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterParameters.
+func (in *VPCPeeringConnectionAccepterParameters) DeepCopy() *VPCPeeringConnectionAccepterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterSpec) DeepCopyInto(out *VPCPeeringConnectionAccepterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterSpec.
+func (in *VPCPeeringConnectionAccepterSpec) DeepCopy() *VPCPeeringConnectionAccepterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionAccepterStatus) DeepCopyInto(out *VPCPeeringConnectionAccepterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionAccepterStatus.
+func (in *VPCPeeringConnectionAccepterStatus) DeepCopy() *VPCPeeringConnectionAccepterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionAccepterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
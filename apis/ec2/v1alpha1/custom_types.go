@@ -25,6 +25,21 @@ type CustomLaunchTemplateParameters struct {
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// KeyName is the name of the key pair that is set in the LaunchTemplateData
+	// unless it specifies a key name itself.
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1.KeyPair
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// KeyNameRef is a reference to a KeyPair used to set the KeyName.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair used to set the
+	// KeyName.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
 }
 
 // CustomLaunchTemplateParameters includes the custom status fields of LaunchTemplate.
@@ -98,6 +113,21 @@ type CustomLaunchTemplateVersionParameters struct {
 	// to set the LaunchTemplateName.
 	// +optional
 	LaunchTemplateNameSelector *xpv1.Selector `json:"launchTemplateNameSelector,omitempty"`
+
+	// KeyName is the name of the key pair that is set in the LaunchTemplateData
+	// unless it specifies a key name itself.
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1.KeyPair
+	// +optional
+	KeyName *string `json:"keyName,omitempty"`
+
+	// KeyNameRef is a reference to a KeyPair used to set the KeyName.
+	// +optional
+	KeyNameRef *xpv1.Reference `json:"keyNameRef,omitempty"`
+
+	// KeyNameSelector selects a reference to a KeyPair used to set the
+	// KeyName.
+	// +optional
+	KeyNameSelector *xpv1.Selector `json:"keyNameSelector,omitempty"`
 }
 
 // CustomLaunchTemplateVersionObservation includes the custom status fields of LaunchTemplateVersion.
@@ -172,7 +202,8 @@ type CustomVPCPeeringConnectionParameters struct {
 	PeerVPCIDSelector *xpv1.Selector `json:"peerVPCIDSelector,omitempty"`
 	// Automatically accepts the peering connection. If this is not set, the peering connection
 	// will be created, but will be in pending-acceptance state. This will only lead to an active
-	// connection if both VPCs are in the same tenant.
+	// connection if both VPCs are in the same tenant. Use a VPCPeeringConnectionAccepter with
+	// the ProviderConfig of the peer account to accept peering connections to other accounts.
 	AcceptRequest bool `json:"acceptRequest,omitempty"`
 
 	// Metadata tagging key value pairs
@@ -183,6 +214,9 @@ type CustomVPCPeeringConnectionParameters struct {
 	// +optional
 	RequesterPeeringOptions *VPCPeeringConnectionOptionsDescription `json:"requesterPeeringOptions,omitempty"`
 	// AccepterRequesterPeeringOptions describes the Accepter VPC peering connection options.
+	// They are modified with the credentials of the requester, which is only
+	// possible if both VPCs are in the same account. Otherwise, use the
+	// peeringOptions of a VPCPeeringConnectionAccepter.
 	// +optional
 	AccepterPeeringOptions *VPCPeeringConnectionOptionsDescription `json:"accepterPeeringOptions,omitempty"`
 }
@@ -292,6 +326,21 @@ type CustomRouteParameters struct {
 	// +optional
 	NATGatewayIDSelector *xpv1.Selector `json:"natGatewayIdSelector,omitempty"`
 
+	// The ID of a prefix list used for the destination match.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1.ManagedPrefixList
+	DestinationPrefixListID *string `json:"destinationPrefixListID,omitempty"`
+
+	// DestinationPrefixListIDRef is a reference to an API used to set
+	// the DestinationPrefixListID.
+	// +optional
+	DestinationPrefixListIDRef *xpv1.Reference `json:"destinationPrefixListIDRef,omitempty"`
+
+	// DestinationPrefixListIDSelector selects references to API used
+	// to set the DestinationPrefixListID.
+	// +optional
+	DestinationPrefixListIDSelector *xpv1.Selector `json:"destinationPrefixListIDSelector,omitempty"`
+
 	// The ID of a VPC peering connection.
 	// +crossplane:generate:reference:type=VPCPeeringConnection
 	VPCPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
@@ -92,6 +92,13 @@ type FlowLogParameters struct {
 type FlowLogSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       FlowLogParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // FlowLogObservation defines the observed state of FlowLog
@@ -3136,6 +3136,21 @@ func (in *CustomLaunchTemplateParameters) DeepCopyInto(out *CustomLaunchTemplate
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyNameRef != nil {
+		in, out := &in.KeyNameRef, &out.KeyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyNameSelector != nil {
+		in, out := &in.KeyNameSelector, &out.KeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLaunchTemplateParameters.
@@ -3196,6 +3211,21 @@ func (in *CustomLaunchTemplateVersionParameters) DeepCopyInto(out *CustomLaunchT
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.KeyName != nil {
+		in, out := &in.KeyName, &out.KeyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyNameRef != nil {
+		in, out := &in.KeyNameRef, &out.KeyNameRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyNameSelector != nil {
+		in, out := &in.KeyNameSelector, &out.KeyNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLaunchTemplateVersionParameters.
@@ -3256,6 +3286,21 @@ func (in *CustomRouteParameters) DeepCopyInto(out *CustomRouteParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DestinationPrefixListID != nil {
+		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPrefixListIDRef != nil {
+		in, out := &in.DestinationPrefixListIDRef, &out.DestinationPrefixListIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationPrefixListIDSelector != nil {
+		in, out := &in.DestinationPrefixListIDSelector, &out.DestinationPrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VPCPeeringConnectionID != nil {
 		in, out := &in.VPCPeeringConnectionID, &out.VPCPeeringConnectionID
 		*out = new(string)
@@ -3981,42 +4026,6 @@ func (in *DHCPConfiguration) DeepCopy() *DHCPConfiguration {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DHCPOptions) DeepCopyInto(out *DHCPOptions) {
-	*out = *in
-	if in.DHCPOptionsID != nil {
-		in, out := &in.DHCPOptionsID, &out.DHCPOptionsID
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHCPOptions.
-func (in *DHCPOptions) DeepCopy() *DHCPOptions {
-	if in == nil {
-		return nil
-	}
-	out := new(DHCPOptions)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSEntry) DeepCopyInto(out *DNSEntry) {
 	*out = *in
@@ -6633,6 +6642,13 @@ func (in *FlowLogSpec) DeepCopyInto(out *FlowLogSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogSpec.
@@ -7276,57 +7292,6 @@ func (in *IKEVersionsRequestListValue) DeepCopy() *IKEVersionsRequestListValue {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAM) DeepCopyInto(out *IPAM) {
-	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResourceDiscoveryAssociationCount != nil {
-		in, out := &in.ResourceDiscoveryAssociationCount, &out.ResourceDiscoveryAssociationCount
-		*out = new(int64)
-		**out = **in
-	}
-	if in.ScopeCount != nil {
-		in, out := &in.ScopeCount, &out.ScopeCount
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM.
-func (in *IPAM) DeepCopy() *IPAM {
-	if in == nil {
-		return nil
-	}
-	out := new(IPAM)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMAddressHistoryRecord) DeepCopyInto(out *IPAMAddressHistoryRecord) {
 	*out = *in
@@ -7522,72 +7487,6 @@ func (in *IPAMOperatingRegion) DeepCopy() *IPAMOperatingRegion {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMPool) DeepCopyInto(out *IPAMPool) {
-	*out = *in
-	if in.AutoImport != nil {
-		in, out := &in.AutoImport, &out.AutoImport
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
-		*out = new(string)
-		**out = **in
-	}
-	if in.Locale != nil {
-		in, out := &in.Locale, &out.Locale
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PoolDepth != nil {
-		in, out := &in.PoolDepth, &out.PoolDepth
-		*out = new(int64)
-		**out = **in
-	}
-	if in.PubliclyAdvertisable != nil {
-		in, out := &in.PubliclyAdvertisable, &out.PubliclyAdvertisable
-		*out = new(bool)
-		**out = **in
-	}
-	if in.StateMessage != nil {
-		in, out := &in.StateMessage, &out.StateMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMPool.
-func (in *IPAMPool) DeepCopy() *IPAMPool {
-	if in == nil {
-		return nil
-	}
-	out := new(IPAMPool)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPAMPoolAllocation) DeepCopyInto(out *IPAMPoolAllocation) {
 	*out = *in
@@ -7840,57 +7739,6 @@ func (in *IPAMResourceTag) DeepCopy() *IPAMResourceTag {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMScope) DeepCopyInto(out *IPAMScope) {
-	*out = *in
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.IPAMRegion != nil {
-		in, out := &in.IPAMRegion, &out.IPAMRegion
-		*out = new(string)
-		**out = **in
-	}
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PoolCount != nil {
-		in, out := &in.PoolCount, &out.PoolCount
-		*out = new(int64)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMScope.
-func (in *IPAMScope) DeepCopy() *IPAMScope {
-	if in == nil {
-		return nil
-	}
-	out := new(IPAMScope)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPermission) DeepCopyInto(out *IPPermission) {
 	*out = *in
@@ -8172,117 +8020,6 @@ func (in *IPv6Range) DeepCopy() *IPv6Range {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Image) DeepCopyInto(out *Image) {
-	*out = *in
-	if in.CreationDate != nil {
-		in, out := &in.CreationDate, &out.CreationDate
-		*out = new(string)
-		**out = **in
-	}
-	if in.DeprecationTime != nil {
-		in, out := &in.DeprecationTime, &out.DeprecationTime
-		*out = new(string)
-		**out = **in
-	}
-	if in.Description != nil {
-		in, out := &in.Description, &out.Description
-		*out = new(string)
-		**out = **in
-	}
-	if in.ENASupport != nil {
-		in, out := &in.ENASupport, &out.ENASupport
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ImageID != nil {
-		in, out := &in.ImageID, &out.ImageID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImageLocation != nil {
-		in, out := &in.ImageLocation, &out.ImageLocation
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImageOwnerAlias != nil {
-		in, out := &in.ImageOwnerAlias, &out.ImageOwnerAlias
-		*out = new(string)
-		**out = **in
-	}
-	if in.KernelID != nil {
-		in, out := &in.KernelID, &out.KernelID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Name != nil {
-		in, out := &in.Name, &out.Name
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PlatformDetails != nil {
-		in, out := &in.PlatformDetails, &out.PlatformDetails
-		*out = new(string)
-		**out = **in
-	}
-	if in.Public != nil {
-		in, out := &in.Public, &out.Public
-		*out = new(bool)
-		**out = **in
-	}
-	if in.RAMDiskID != nil {
-		in, out := &in.RAMDiskID, &out.RAMDiskID
-		*out = new(string)
-		**out = **in
-	}
-	if in.RootDeviceName != nil {
-		in, out := &in.RootDeviceName, &out.RootDeviceName
-		*out = new(string)
-		**out = **in
-	}
-	if in.SourceInstanceID != nil {
-		in, out := &in.SourceInstanceID, &out.SourceInstanceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.SRIOVNetSupport != nil {
-		in, out := &in.SRIOVNetSupport, &out.SRIOVNetSupport
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.UsageOperation != nil {
-		in, out := &in.UsageOperation, &out.UsageOperation
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
-func (in *Image) DeepCopy() *Image {
-	if in == nil {
-		return nil
-	}
-	out := new(Image)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageDiskContainer) DeepCopyInto(out *ImageDiskContainer) {
 	*out = *in
@@ -11805,6 +11542,13 @@ func (in *LaunchTemplateSpec) DeepCopyInto(out *LaunchTemplateSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateSpec.
@@ -12130,6 +11874,13 @@ func (in *LaunchTemplateVersionSpec) DeepCopyInto(out *LaunchTemplateVersionSpec
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LaunchTemplateVersionSpec.
@@ -12707,103 +12458,42 @@ func (in *LocalGatewayVirtualInterfaceGroup) DeepCopyInto(out *LocalGatewayVirtu
 			}
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalGatewayVirtualInterfaceGroup.
-func (in *LocalGatewayVirtualInterfaceGroup) DeepCopy() *LocalGatewayVirtualInterfaceGroup {
-	if in == nil {
-		return nil
-	}
-	out := new(LocalGatewayVirtualInterfaceGroup)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MaintenanceDetails) DeepCopyInto(out *MaintenanceDetails) {
-	*out = *in
-	if in.LastMaintenanceApplied != nil {
-		in, out := &in.LastMaintenanceApplied, &out.LastMaintenanceApplied
-		*out = (*in).DeepCopy()
-	}
-	if in.MaintenanceAutoAppliedAfter != nil {
-		in, out := &in.MaintenanceAutoAppliedAfter, &out.MaintenanceAutoAppliedAfter
-		*out = (*in).DeepCopy()
-	}
-	if in.PendingMaintenance != nil {
-		in, out := &in.PendingMaintenance, &out.PendingMaintenance
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceDetails.
-func (in *MaintenanceDetails) DeepCopy() *MaintenanceDetails {
-	if in == nil {
-		return nil
-	}
-	out := new(MaintenanceDetails)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedPrefixList) DeepCopyInto(out *ManagedPrefixList) {
-	*out = *in
-	if in.AddressFamily != nil {
-		in, out := &in.AddressFamily, &out.AddressFamily
-		*out = new(string)
-		**out = **in
-	}
-	if in.MaxEntries != nil {
-		in, out := &in.MaxEntries, &out.MaxEntries
-		*out = new(int64)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrefixListID != nil {
-		in, out := &in.PrefixListID, &out.PrefixListID
-		*out = new(string)
-		**out = **in
-	}
-	if in.PrefixListName != nil {
-		in, out := &in.PrefixListName, &out.PrefixListName
-		*out = new(string)
-		**out = **in
-	}
-	if in.StateMessage != nil {
-		in, out := &in.StateMessage, &out.StateMessage
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.Version != nil {
-		in, out := &in.Version, &out.Version
-		*out = new(int64)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalGatewayVirtualInterfaceGroup.
+func (in *LocalGatewayVirtualInterfaceGroup) DeepCopy() *LocalGatewayVirtualInterfaceGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalGatewayVirtualInterfaceGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceDetails) DeepCopyInto(out *MaintenanceDetails) {
+	*out = *in
+	if in.LastMaintenanceApplied != nil {
+		in, out := &in.LastMaintenanceApplied, &out.LastMaintenanceApplied
+		*out = (*in).DeepCopy()
+	}
+	if in.MaintenanceAutoAppliedAfter != nil {
+		in, out := &in.MaintenanceAutoAppliedAfter, &out.MaintenanceAutoAppliedAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingMaintenance != nil {
+		in, out := &in.PendingMaintenance, &out.PendingMaintenance
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedPrefixList.
-func (in *ManagedPrefixList) DeepCopy() *ManagedPrefixList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceDetails.
+func (in *MaintenanceDetails) DeepCopy() *MaintenanceDetails {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedPrefixList)
+	out := new(MaintenanceDetails)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -13255,52 +12945,6 @@ func (in *NATGatewayAddress) DeepCopy() *NATGatewayAddress {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
-	*out = *in
-	if in.IsDefault != nil {
-		in, out := &in.IsDefault, &out.IsDefault
-		*out = new(bool)
-		**out = **in
-	}
-	if in.NetworkACLID != nil {
-		in, out := &in.NetworkACLID, &out.NetworkACLID
-		*out = new(string)
-		**out = **in
-	}
-	if in.OwnerID != nil {
-		in, out := &in.OwnerID, &out.OwnerID
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.VPCID != nil {
-		in, out := &in.VPCID, &out.VPCID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
-func (in *NetworkACL) DeepCopy() *NetworkACL {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkACL)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
 	*out = *in
@@ -13331,46 +12975,6 @@ func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
-	*out = *in
-	if in.CIDRBlock != nil {
-		in, out := &in.CIDRBlock, &out.CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Egress != nil {
-		in, out := &in.Egress, &out.Egress
-		*out = new(bool)
-		**out = **in
-	}
-	if in.IPv6CIDRBlock != nil {
-		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
-		*out = new(string)
-		**out = **in
-	}
-	if in.Protocol != nil {
-		in, out := &in.Protocol, &out.Protocol
-		*out = new(string)
-		**out = **in
-	}
-	if in.RuleNumber != nil {
-		in, out := &in.RuleNumber, &out.RuleNumber
-		*out = new(int64)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
-func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
-	if in == nil {
-		return nil
-	}
-	out := new(NetworkACLEntry)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkBandwidthGbps) DeepCopyInto(out *NetworkBandwidthGbps) {
 	*out = *in
@@ -16849,11 +16453,6 @@ func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.DestinationPrefixListID != nil {
-		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
-		*out = new(string)
-		**out = **in
-	}
 	if in.EgressOnlyInternetGatewayID != nil {
 		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
 		*out = new(string)
@@ -16892,6 +16491,13 @@ func (in *RouteSpec) DeepCopyInto(out *RouteSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteSpec.
@@ -21175,51 +20781,6 @@ func (in *TransitGatewayParameters) DeepCopy() *TransitGatewayParameters {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TransitGatewayPeeringAttachment) DeepCopyInto(out *TransitGatewayPeeringAttachment) {
-	*out = *in
-	if in.AccepterTransitGatewayAttachmentID != nil {
-		in, out := &in.AccepterTransitGatewayAttachmentID, &out.AccepterTransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
-	}
-	if in.CreationTime != nil {
-		in, out := &in.CreationTime, &out.CreationTime
-		*out = (*in).DeepCopy()
-	}
-	if in.State != nil {
-		in, out := &in.State, &out.State
-		*out = new(string)
-		**out = **in
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-	if in.TransitGatewayAttachmentID != nil {
-		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayPeeringAttachment.
-func (in *TransitGatewayPeeringAttachment) DeepCopy() *TransitGatewayPeeringAttachment {
-	if in == nil {
-		return nil
-	}
-	out := new(TransitGatewayPeeringAttachment)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayPolicyRule) DeepCopyInto(out *TransitGatewayPolicyRule) {
 	*out = *in
@@ -21708,6 +21269,13 @@ func (in *TransitGatewayRouteSpec) DeepCopyInto(out *TransitGatewayRouteSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteSpec.
@@ -21829,36 +21397,6 @@ func (in *TransitGatewayRouteTableAnnouncement) DeepCopy() *TransitGatewayRouteT
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TransitGatewayRouteTableAssociation) DeepCopyInto(out *TransitGatewayRouteTableAssociation) {
-	*out = *in
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitGatewayAttachmentID != nil {
-		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableAssociation.
-func (in *TransitGatewayRouteTableAssociation) DeepCopy() *TransitGatewayRouteTableAssociation {
-	if in == nil {
-		return nil
-	}
-	out := new(TransitGatewayRouteTableAssociation)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayRouteTableList) DeepCopyInto(out *TransitGatewayRouteTableList) {
 	*out = *in
@@ -21974,41 +21512,6 @@ func (in *TransitGatewayRouteTableParameters) DeepCopy() *TransitGatewayRouteTab
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TransitGatewayRouteTablePropagation) DeepCopyInto(out *TransitGatewayRouteTablePropagation) {
-	*out = *in
-	if in.ResourceID != nil {
-		in, out := &in.ResourceID, &out.ResourceID
-		*out = new(string)
-		**out = **in
-	}
-	if in.ResourceType != nil {
-		in, out := &in.ResourceType, &out.ResourceType
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitGatewayAttachmentID != nil {
-		in, out := &in.TransitGatewayAttachmentID, &out.TransitGatewayAttachmentID
-		*out = new(string)
-		**out = **in
-	}
-	if in.TransitGatewayRouteTableAnnouncementID != nil {
-		in, out := &in.TransitGatewayRouteTableAnnouncementID, &out.TransitGatewayRouteTableAnnouncementID
-		*out = new(string)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTablePropagation.
-func (in *TransitGatewayRouteTablePropagation) DeepCopy() *TransitGatewayRouteTablePropagation {
-	if in == nil {
-		return nil
-	}
-	out := new(TransitGatewayRouteTablePropagation)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransitGatewayRouteTableRoute) DeepCopyInto(out *TransitGatewayRouteTableRoute) {
 	*out = *in
@@ -22064,6 +21567,13 @@ func (in *TransitGatewayRouteTableSpec) DeepCopyInto(out *TransitGatewayRouteTab
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayRouteTableSpec.
@@ -22204,6 +21714,13 @@ func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
@@ -22426,6 +21943,13 @@ func (in *TransitGatewayVPCAttachmentSpec) DeepCopyInto(out *TransitGatewayVPCAt
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentSpec.
@@ -23632,6 +23156,13 @@ func (in *VPCEndpointServiceConfigurationSpec) DeepCopyInto(out *VPCEndpointServ
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointServiceConfigurationSpec.
@@ -23666,6 +23197,13 @@ func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
@@ -24057,6 +23595,13 @@ func (in *VPCPeeringConnectionSpec) DeepCopyInto(out *VPCPeeringConnectionSpec)
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionSpec.
@@ -25605,6 +25150,13 @@ func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSpec.
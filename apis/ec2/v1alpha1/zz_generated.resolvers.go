@@ -154,6 +154,32 @@ func (mg *FlowLog) ResolveReferences(ctx context.Context, c client.Reader) error
 	return nil
 }
 
+// ResolveReferences of this LaunchTemplate.
+func (mg *LaunchTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomLaunchTemplateParameters.KeyName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomLaunchTemplateParameters.KeyNameRef,
+		Selector:     mg.Spec.ForProvider.CustomLaunchTemplateParameters.KeyNameSelector,
+		To: reference.To{
+			List:    &manualv1alpha1.KeyPairList{},
+			Managed: &manualv1alpha1.KeyPair{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomLaunchTemplateParameters.KeyName")
+	}
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.KeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.KeyNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this LaunchTemplateVersion.
 func (mg *LaunchTemplateVersion) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -193,6 +219,22 @@ func (mg *LaunchTemplateVersion) ResolveReferences(ctx context.Context, c client
 	mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.LaunchTemplateName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.LaunchTemplateNameRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.KeyName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.KeyNameRef,
+		Selector:     mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.KeyNameSelector,
+		To: reference.To{
+			List:    &manualv1alpha1.KeyPairList{},
+			Managed: &manualv1alpha1.KeyPair{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.KeyName")
+	}
+	mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.KeyName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomLaunchTemplateVersionParameters.KeyNameRef = rsp.ResolvedReference
+
 	return nil
 }
 
@@ -235,6 +277,22 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.CustomRouteParameters.NATGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomRouteParameters.NATGatewayIDRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDRef,
+		Selector:     mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDSelector,
+		To: reference.To{
+			List:    &manualv1alpha1.ManagedPrefixListList{},
+			Managed: &manualv1alpha1.ManagedPrefixList{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID")
+	}
+	mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.VPCPeeringConnectionID),
 		Extract:      reference.ExternalName(),
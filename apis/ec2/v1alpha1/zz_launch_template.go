@@ -52,6 +52,13 @@ type LaunchTemplateParameters struct {
 type LaunchTemplateSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       LaunchTemplateParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // LaunchTemplateObservation defines the observed state of LaunchTemplate
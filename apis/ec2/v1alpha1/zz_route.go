@@ -44,8 +44,6 @@ type RouteParameters struct {
 	// The IPv6 CIDR block used for the destination match. Routing decisions are
 	// based on the most specific match.
 	DestinationIPv6CIDRBlock *string `json:"destinationIPv6CIDRBlock,omitempty"`
-	// The ID of a prefix list used for the destination match.
-	DestinationPrefixListID *string `json:"destinationPrefixListID,omitempty"`
 	// [IPv6 traffic only] The ID of an egress-only internet gateway.
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
 	// The ID of the local gateway.
@@ -61,6 +59,13 @@ type RouteParameters struct {
 type RouteSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RouteParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RouteObservation defines the observed state of Route
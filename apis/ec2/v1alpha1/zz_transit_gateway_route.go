@@ -42,6 +42,13 @@ type TransitGatewayRouteParameters struct {
 type TransitGatewayRouteSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TransitGatewayRouteParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TransitGatewayRouteObservation defines the observed state of TransitGatewayRoute
@@ -40,6 +40,13 @@ type TransitGatewayVPCAttachmentParameters struct {
 type TransitGatewayVPCAttachmentSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TransitGatewayVPCAttachmentParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TransitGatewayVPCAttachmentObservation defines the observed state of TransitGatewayVPCAttachment
@@ -902,15 +902,6 @@ type DHCPConfiguration struct {
 	Key *string `json:"key,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type DHCPOptions struct {
-	DHCPOptionsID *string `json:"dhcpOptionsID,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type DNSEntry struct {
 	DNSName *string `json:"dnsName,omitempty"`
@@ -1882,21 +1873,6 @@ type IKEVersionsRequestListValue struct {
 	Value *string `json:"value,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type IPAM struct {
-	Description *string `json:"description,omitempty"`
-
-	IPAMRegion *string `json:"ipamRegion,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	ResourceDiscoveryAssociationCount *int64 `json:"resourceDiscoveryAssociationCount,omitempty"`
-
-	ScopeCount *int64 `json:"scopeCount,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type IPAMAddressHistoryRecord struct {
 	ResourceCIDR *string `json:"resourceCIDR,omitempty"`
@@ -1959,27 +1935,6 @@ type IPAMOperatingRegion struct {
 	RegionName *string `json:"regionName,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type IPAMPool struct {
-	AutoImport *bool `json:"autoImport,omitempty"`
-
-	Description *string `json:"description,omitempty"`
-
-	IPAMRegion *string `json:"ipamRegion,omitempty"`
-
-	Locale *string `json:"locale,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	PoolDepth *int64 `json:"poolDepth,omitempty"`
-
-	PubliclyAdvertisable *bool `json:"publiclyAdvertisable,omitempty"`
-
-	StateMessage *string `json:"stateMessage,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type IPAMPoolAllocation struct {
 	CIDR *string `json:"cidr,omitempty"`
@@ -2055,21 +2010,6 @@ type IPAMResourceTag struct {
 	Value *string `json:"value,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type IPAMScope struct {
-	Description *string `json:"description,omitempty"`
-
-	IPAMRegion *string `json:"ipamRegion,omitempty"`
-
-	IsDefault *bool `json:"isDefault,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	PoolCount *int64 `json:"poolCount,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type IPPermission struct {
 	FromPort *int64 `json:"fromPort,omitempty"`
@@ -2144,45 +2084,6 @@ type IPv6Range struct {
 	Description *string `json:"description,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type Image struct {
-	CreationDate *string `json:"creationDate,omitempty"`
-
-	DeprecationTime *string `json:"deprecationTime,omitempty"`
-
-	Description *string `json:"description,omitempty"`
-
-	ENASupport *bool `json:"enaSupport,omitempty"`
-
-	ImageID *string `json:"imageID,omitempty"`
-
-	ImageLocation *string `json:"imageLocation,omitempty"`
-
-	ImageOwnerAlias *string `json:"imageOwnerAlias,omitempty"`
-
-	KernelID *string `json:"kernelID,omitempty"`
-
-	Name *string `json:"name,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	PlatformDetails *string `json:"platformDetails,omitempty"`
-
-	Public *bool `json:"public,omitempty"`
-
-	RAMDiskID *string `json:"ramDiskID,omitempty"`
-
-	RootDeviceName *string `json:"rootDeviceName,omitempty"`
-
-	SourceInstanceID *string `json:"sourceInstanceID,omitempty"`
-
-	SRIOVNetSupport *string `json:"sriovNetSupport,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	UsageOperation *string `json:"usageOperation,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type ImageDiskContainer struct {
 	Description *string `json:"description,omitempty"`
@@ -3496,25 +3397,6 @@ type MaintenanceDetails struct {
 	PendingMaintenance *string `json:"pendingMaintenance,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type ManagedPrefixList struct {
-	AddressFamily *string `json:"addressFamily,omitempty"`
-
-	MaxEntries *int64 `json:"maxEntries,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	PrefixListID *string `json:"prefixListID,omitempty"`
-
-	PrefixListName *string `json:"prefixListName,omitempty"`
-
-	StateMessage *string `json:"stateMessage,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	Version *int64 `json:"version,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type MemoryGiBPerVCPU struct {
 	Max *float64 `json:"max,omitempty"`
@@ -3657,19 +3539,6 @@ type NATGatewayAddress struct {
 	PublicIP *string `json:"publicIP,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type NetworkACL struct {
-	IsDefault *bool `json:"isDefault,omitempty"`
-
-	NetworkACLID *string `json:"networkACLID,omitempty"`
-
-	OwnerID *string `json:"ownerID,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	VPCID *string `json:"vpcID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type NetworkACLAssociation struct {
 	NetworkACLAssociationID *string `json:"networkACLAssociationID,omitempty"`
@@ -3679,19 +3548,6 @@ type NetworkACLAssociation struct {
 	SubnetID *string `json:"subnetID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type NetworkACLEntry struct {
-	CIDRBlock *string `json:"cidrBlock,omitempty"`
-
-	Egress *bool `json:"egress,omitempty"`
-
-	IPv6CIDRBlock *string `json:"ipv6CIDRBlock,omitempty"`
-
-	Protocol *string `json:"protocol,omitempty"`
-
-	RuleNumber *int64 `json:"ruleNumber,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type NetworkBandwidthGbps struct {
 	Max *float64 `json:"max,omitempty"`
@@ -6030,19 +5886,6 @@ type TransitGatewayOptions struct {
 	VPNECMPSupport *string `json:"vpnECMPSupport,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type TransitGatewayPeeringAttachment struct {
-	AccepterTransitGatewayAttachmentID *string `json:"accepterTransitGatewayAttachmentID,omitempty"`
-
-	CreationTime *metav1.Time `json:"creationTime,omitempty"`
-
-	State *string `json:"state,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-
-	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type TransitGatewayPolicyRule struct {
 	DestinationCIDRBlock *string `json:"destinationCIDRBlock,omitempty"`
@@ -6170,26 +6013,6 @@ type TransitGatewayRouteTableAnnouncement struct {
 	TransitGatewayRouteTableID *string `json:"transitGatewayRouteTableID,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type TransitGatewayRouteTableAssociation struct {
-	ResourceID *string `json:"resourceID,omitempty"`
-
-	ResourceType *string `json:"resourceType,omitempty"`
-
-	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
-}
-
-// +kubebuilder:skipversion
-type TransitGatewayRouteTablePropagation struct {
-	ResourceID *string `json:"resourceID,omitempty"`
-
-	ResourceType *string `json:"resourceType,omitempty"`
-
-	TransitGatewayAttachmentID *string `json:"transitGatewayAttachmentID,omitempty"`
-
-	TransitGatewayRouteTableAnnouncementID *string `json:"transitGatewayRouteTableAnnouncementID,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type TransitGatewayRouteTableRoute struct {
 	AttachmentID *string `json:"attachmentID,omitempty"`
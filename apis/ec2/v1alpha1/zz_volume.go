@@ -125,6 +125,13 @@ type VolumeParameters struct {
 type VolumeSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       VolumeParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // VolumeObservation defines the observed state of Volume
@@ -70,6 +70,13 @@ type VPCEndpointParameters struct {
 type VPCEndpointSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       VPCEndpointParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // VPCEndpointObservation defines the observed state of VPCEndpoint
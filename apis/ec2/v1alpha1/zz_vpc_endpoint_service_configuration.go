@@ -46,6 +46,13 @@ type VPCEndpointServiceConfigurationParameters struct {
 type VPCEndpointServiceConfigurationSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       VPCEndpointServiceConfigurationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // VPCEndpointServiceConfigurationObservation defines the observed state of VPCEndpointServiceConfiguration
@@ -111,7 +111,18 @@ type PrefixListID struct {
 	Description *string `json:"description,omitempty"`
 
 	// The ID of the prefix.
-	PrefixListID string `json:"prefixListId"`
+	// +optional
+	PrefixListID string `json:"prefixListId,omitempty"`
+
+	// PrefixListIDRef reference a ManagedPrefixList to retrieve its
+	// PrefixListID.
+	// +optional
+	PrefixListIDRef *xpv1.Reference `json:"prefixListIdRef,omitempty"`
+
+	// PrefixListIDSelector selects reference to a ManagedPrefixList to
+	// retrieve its PrefixListID.
+	// +optional
+	PrefixListIDSelector *xpv1.Selector `json:"prefixListIdSelector,omitempty"`
 }
 
 // UserIDGroupPair describes a security group and AWS account ID pair.
@@ -33,8 +33,35 @@ type SubnetParameters struct {
 	Region *string `json:"region,omitempty"`
 
 	// CIDRBlock is the IPv4 network range for the Subnet, in CIDR notation. For example, 10.0.0.0/18.
+	// It is required unless IPv4IPAMPoolID is set, in which case it is
+	// late-initialized with the allocated CIDR block.
+	// +optional
+	// +immutable
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// IPv4IPAMPoolID is the ID of an IPv4 IPAM pool to allocate the CIDR
+	// block from. Use it instead of CIDRBlock to let AWS pick a
+	// non-overlapping range. The pool must be a resource planning pool of the
+	// VPC of the subnet.
+	// +immutable
+	// +optional
+	IPv4IPAMPoolID *string `json:"ipv4IpamPoolId,omitempty"`
+
+	// IPv4IPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	IPv4IPAMPoolIDRef *xpv1.Reference `json:"ipv4IpamPoolIdRef,omitempty"`
+
+	// IPv4IPAMPoolIDSelector selects a reference to an IPAMPool to retrieve
+	// its ID.
+	// +optional
+	IPv4IPAMPoolIDSelector *xpv1.Selector `json:"ipv4IpamPoolIdSelector,omitempty"`
+
+	// IPv4NetmaskLength is the netmask length of the CIDR block to allocate
+	// from the IPv4IPAMPoolID. It defaults to the allocation default netmask
+	// length of the pool.
 	// +immutable
-	CIDRBlock string `json:"cidrBlock"`
+	// +optional
+	IPv4NetmaskLength *int32 `json:"ipv4NetmaskLength,omitempty"`
 
 	// The Availability Zone for the subnet.
 	// Default: AWS selects one for you. If you create more than one subnet in your
@@ -95,6 +122,9 @@ type SubnetObservation struct {
 	// The number of unused private IPv4 addresses in the subnet.
 	AvailableIPAddressCount int32 `json:"availableIpAddressCount,omitempty"`
 
+	// The IPv4 CIDR block of the subnet.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
 	// Indicates whether this is the default subnet for the Availability Zone.
 	DefaultForAZ bool `json:"defaultForAz,omitempty"`
 
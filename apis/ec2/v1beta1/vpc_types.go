@@ -75,10 +75,34 @@ type VPCParameters struct {
 	Region *string `json:"region,omitempty"`
 
 	// CIDRBlock is the IPv4 network range for the VPC, in CIDR notation. For
-	// example, 10.0.0.0/16.
-	// +kubebuilder:validation:Required
+	// example, 10.0.0.0/16. It is required unless IPv4IPAMPoolID is set, in
+	// which case it is late-initialized with the allocated CIDR block.
+	// +optional
+	// +immutable
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// IPv4IPAMPoolID is the ID of an IPv4 IPAM pool to allocate the CIDR
+	// block from. Use it instead of CIDRBlock to let AWS pick a
+	// non-overlapping range.
+	// +immutable
+	// +optional
+	IPv4IPAMPoolID *string `json:"ipv4IpamPoolId,omitempty"`
+
+	// IPv4IPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	IPv4IPAMPoolIDRef *xpv1.Reference `json:"ipv4IpamPoolIdRef,omitempty"`
+
+	// IPv4IPAMPoolIDSelector selects a reference to an IPAMPool to retrieve
+	// its ID.
+	// +optional
+	IPv4IPAMPoolIDSelector *xpv1.Selector `json:"ipv4IpamPoolIdSelector,omitempty"`
+
+	// IPv4NetmaskLength is the netmask length of the CIDR block to allocate
+	// from the IPv4IPAMPoolID. It defaults to the allocation default netmask
+	// length of the pool.
 	// +immutable
-	CIDRBlock string `json:"cidrBlock"`
+	// +optional
+	IPv4NetmaskLength *int32 `json:"ipv4NetmaskLength,omitempty"`
 
 	// The IPv6 CIDR block from the IPv6 address pool. You must also specify Ipv6Pool
 	// in the request. To let Amazon choose the IPv6 CIDR block for you, omit this
@@ -131,6 +155,9 @@ type VPCObservation struct {
 	// Information about the IPv4 CIDR blocks associated with the VPC.
 	CIDRBlockAssociationSet []VPCCIDRBlockAssociation `json:"cidrBlockAssociationSet,omitempty"`
 
+	// The primary IPv4 CIDR block of the VPC.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
 	// The ID of the set of DHCP options you've associated with the VPC.
 	DHCPOptionsID string `json:"dhcpOptionsId,omitempty"`
 
@@ -33,11 +33,35 @@ type VPCCIDRBlockParameters struct {
 	// +optional
 	AmazonProvidedIPv6CIDRBlock *bool `json:"amazonProvidedIpv6CidrBlock,omitempty"`
 
-	// An IPv4 CIDR block to associate with the VPC.
+	// An IPv4 CIDR block to associate with the VPC. When IPv4IPAMPoolID is
+	// set it is late-initialized with the allocated CIDR block.
 	// +immutable
 	// +optional
 	CIDRBlock *string `json:"cidrBlock,omitempty"`
 
+	// IPv4IPAMPoolID is the ID of an IPv4 IPAM pool to allocate the CIDR
+	// block from. Use it instead of CIDRBlock to let AWS pick a
+	// non-overlapping range.
+	// +immutable
+	// +optional
+	IPv4IPAMPoolID *string `json:"ipv4IpamPoolId,omitempty"`
+
+	// IPv4IPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	IPv4IPAMPoolIDRef *xpv1.Reference `json:"ipv4IpamPoolIdRef,omitempty"`
+
+	// IPv4IPAMPoolIDSelector selects a reference to an IPAMPool to retrieve
+	// its ID.
+	// +optional
+	IPv4IPAMPoolIDSelector *xpv1.Selector `json:"ipv4IpamPoolIdSelector,omitempty"`
+
+	// IPv4NetmaskLength is the netmask length of the CIDR block to allocate
+	// from the IPv4IPAMPoolID. It defaults to the allocation default netmask
+	// length of the pool.
+	// +immutable
+	// +optional
+	IPv4NetmaskLength *int32 `json:"ipv4NetmaskLength,omitempty"`
+
 	// An IPv6 CIDR block from the IPv6 address pool. You must also specify Ipv6Pool
 	// in the request.
 	//
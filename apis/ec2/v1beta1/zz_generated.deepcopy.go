@@ -683,6 +683,16 @@ func (in *PrefixListID) DeepCopyInto(out *PrefixListID) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrefixListIDRef != nil {
+		in, out := &in.PrefixListIDRef, &out.PrefixListIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixListIDSelector != nil {
+		in, out := &in.PrefixListIDSelector, &out.PrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListID.
@@ -1332,6 +1342,26 @@ func (in *SubnetParameters) DeepCopyInto(out *SubnetParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolID != nil {
+		in, out := &in.IPv4IPAMPoolID, &out.IPv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4IPAMPoolIDRef != nil {
+		in, out := &in.IPv4IPAMPoolIDRef, &out.IPv4IPAMPoolIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4IPAMPoolIDSelector != nil {
+		in, out := &in.IPv4IPAMPoolIDSelector, &out.IPv4IPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4NetmaskLength != nil {
+		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
 	if in.AvailabilityZone != nil {
 		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
@@ -1635,6 +1665,26 @@ func (in *VPCCIDRBlockParameters) DeepCopyInto(out *VPCCIDRBlockParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolID != nil {
+		in, out := &in.IPv4IPAMPoolID, &out.IPv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4IPAMPoolIDRef != nil {
+		in, out := &in.IPv4IPAMPoolIDRef, &out.IPv4IPAMPoolIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4IPAMPoolIDSelector != nil {
+		in, out := &in.IPv4IPAMPoolIDSelector, &out.IPv4IPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4NetmaskLength != nil {
+		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
 	if in.IPv6CIDRBlock != nil {
 		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
 		*out = new(string)
@@ -1807,6 +1857,26 @@ func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolID != nil {
+		in, out := &in.IPv4IPAMPoolID, &out.IPv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4IPAMPoolIDRef != nil {
+		in, out := &in.IPv4IPAMPoolIDRef, &out.IPv4IPAMPoolIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4IPAMPoolIDSelector != nil {
+		in, out := &in.IPv4IPAMPoolIDSelector, &out.IPv4IPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4NetmaskLength != nil {
+		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Ipv6CIDRBlock != nil {
 		in, out := &in.Ipv6CIDRBlock, &out.Ipv6CIDRBlock
 		*out = new(string)
@@ -231,6 +231,13 @@ type ConditionPair struct {
 type RepositoryPolicySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RepositoryPolicyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RepositoryPolicyObservation keeps the state for the external resource
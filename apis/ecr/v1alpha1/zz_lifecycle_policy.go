@@ -43,6 +43,13 @@ type LifecyclePolicyParameters struct {
 type LifecyclePolicySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       LifecyclePolicyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // LifecyclePolicyObservation defines the observed state of LifecyclePolicy
@@ -266,6 +266,13 @@ type TaskDefinitionFamilyParameters struct {
 type TaskDefinitionFamilySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TaskDefinitionFamilyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TaskDefinitionFamilyObservation defines the observed state of TaskDefinitionFamily
@@ -216,6 +216,13 @@ type ServiceParameters struct {
 type ServiceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ServiceParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ServiceObservation defines the observed state of Service
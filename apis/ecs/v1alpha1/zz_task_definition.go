@@ -278,6 +278,13 @@ type TaskDefinitionParameters struct {
 type TaskDefinitionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TaskDefinitionParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TaskDefinitionObservation defines the observed state of TaskDefinition
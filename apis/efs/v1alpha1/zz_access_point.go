@@ -57,6 +57,13 @@ type AccessPointParameters struct {
 type AccessPointSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       AccessPointParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // AccessPointObservation defines the observed state of AccessPoint
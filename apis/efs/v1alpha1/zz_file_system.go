@@ -109,6 +109,13 @@ type FileSystemParameters struct {
 type FileSystemSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       FileSystemParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // FileSystemObservation defines the observed state of FileSystem
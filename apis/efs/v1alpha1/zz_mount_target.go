@@ -38,6 +38,13 @@ type MountTargetParameters struct {
 type MountTargetSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       MountTargetParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // MountTargetObservation defines the observed state of MountTarget
@@ -0,0 +1,156 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessEntryParameters define the desired state of an AWS Elastic Kubernetes
+// Service AccessEntry.
+type AccessEntryParameters struct {
+	// Region is the region you'd like the AccessEntry to be created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the Amazon EKS cluster to create the access entry in.
+	// +immutable
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used to set the
+	// ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// PrincipalARN is the ARN of the IAM principal for the access entry.
+	//
+	// At least one of principalArn, principalRoleRef, principalRoleSelector,
+	// principalUserRef or principalUserSelector has to be given.
+	// +immutable
+	// +optional
+	PrincipalARN string `json:"principalArn,omitempty"`
+
+	// PrincipalRoleRef is a reference to an IAM Role used to set the
+	// PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalRoleRef *xpv1.Reference `json:"principalRoleRef,omitempty"`
+
+	// PrincipalRoleSelector selects a reference to an IAM Role used to set
+	// the PrincipalARN.
+	// +optional
+	PrincipalRoleSelector *xpv1.Selector `json:"principalRoleSelector,omitempty"`
+
+	// PrincipalUserRef is a reference to an IAM User used to set the
+	// PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalUserRef *xpv1.Reference `json:"principalUserRef,omitempty"`
+
+	// PrincipalUserSelector selects a reference to an IAM User used to set
+	// the PrincipalARN.
+	// +optional
+	PrincipalUserSelector *xpv1.Selector `json:"principalUserSelector,omitempty"`
+
+	// The Kubernetes groups that the principal is a member of. Kubernetes
+	// RBAC bindings to these groups grant the principal access to the cluster
+	// in addition to any associated access policies.
+	// +optional
+	KubernetesGroups []string `json:"kubernetesGroups,omitempty"`
+
+	// The username to authenticate to Kubernetes with. EKS generates a
+	// username if it is not set.
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// The type of the access entry. Defaults to STANDARD.
+	// +immutable
+	// +optional
+	// +kubebuilder:validation:Enum=STANDARD;EC2_LINUX;EC2_WINDOWS;FARGATE_LINUX
+	Type *string `json:"type,omitempty"`
+
+	// The metadata to apply to the access entry to assist with categorization
+	// and organization.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// AccessEntryObservation is the observed state of an AccessEntry.
+type AccessEntryObservation struct {
+	// The ARN of the access entry.
+	AccessEntryARN string `json:"accessEntryArn,omitempty"`
+
+	// The date and time when the access entry was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The date and time when the access entry was last modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// An AccessEntrySpec defines the desired state of an EKS AccessEntry.
+type AccessEntrySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessEntryParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// An AccessEntryStatus represents the observed state of an EKS AccessEntry.
+type AccessEntryStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessEntryObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessEntry is a managed resource that grants an IAM principal access
+// to an AWS Elastic Kubernetes Service cluster whose authentication mode
+// includes the EKS API.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principalArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessEntry struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessEntrySpec   `json:"spec"`
+	Status AccessEntryStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessEntryList contains a list of AccessEntry items
+type AccessEntryList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessEntry `json:"items"`
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessScopeType is the type of scope of an access policy association.
+type AccessScopeType string
+
+// Types of access scopes.
+const (
+	AccessScopeTypeCluster   AccessScopeType = "cluster"
+	AccessScopeTypeNamespace AccessScopeType = "namespace"
+)
+
+// AccessScope is the scope of an access policy association.
+type AccessScope struct {
+	// Type of the scope. A cluster scope applies the permissions of the policy
+	// to the whole cluster, a namespace scope only to the given namespaces.
+	// +kubebuilder:validation:Enum=cluster;namespace
+	Type AccessScopeType `json:"type"`
+
+	// The namespaces the policy is scoped to if the type is namespace. They
+	// may end with the wildcard *.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// AccessPolicyAssociationParameters define the desired state of an AWS
+// Elastic Kubernetes Service AccessPolicyAssociation.
+type AccessPolicyAssociationParameters struct {
+	// Region is the region you'd like the AccessPolicyAssociation to be
+	// created in.
+	// +immutable
+	Region string `json:"region"`
+
+	// The name of the Amazon EKS cluster of the access entry.
+	// +immutable
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef is a reference to a Cluster used to set the ClusterName.
+	// +immutable
+	// +optional
+	ClusterNameRef *xpv1.Reference `json:"clusterNameRef,omitempty"`
+
+	// ClusterNameSelector selects references to a Cluster used to set the
+	// ClusterName.
+	// +optional
+	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// PrincipalARN is the ARN of the IAM principal of the access entry to
+	// associate the policy with.
+	//
+	// At least one of principalArn, principalRoleRef, principalRoleSelector,
+	// principalUserRef or principalUserSelector has to be given.
+	// +immutable
+	// +optional
+	PrincipalARN string `json:"principalArn,omitempty"`
+
+	// PrincipalRoleRef is a reference to an IAM Role used to set the
+	// PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalRoleRef *xpv1.Reference `json:"principalRoleRef,omitempty"`
+
+	// PrincipalRoleSelector selects a reference to an IAM Role used to set
+	// the PrincipalARN.
+	// +optional
+	PrincipalRoleSelector *xpv1.Selector `json:"principalRoleSelector,omitempty"`
+
+	// PrincipalUserRef is a reference to an IAM User used to set the
+	// PrincipalARN.
+	// +immutable
+	// +optional
+	PrincipalUserRef *xpv1.Reference `json:"principalUserRef,omitempty"`
+
+	// PrincipalUserSelector selects a reference to an IAM User used to set
+	// the PrincipalARN.
+	// +optional
+	PrincipalUserSelector *xpv1.Selector `json:"principalUserSelector,omitempty"`
+
+	// The ARN of the access policy to associate, for example
+	// arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy.
+	// +immutable
+	PolicyARN string `json:"policyArn"`
+
+	// The scope of the association.
+	AccessScope AccessScope `json:"accessScope"`
+}
+
+// AccessPolicyAssociationObservation is the observed state of an
+// AccessPolicyAssociation.
+type AccessPolicyAssociationObservation struct {
+	// The date and time when the policy was associated.
+	AssociatedAt *metav1.Time `json:"associatedAt,omitempty"`
+
+	// The date and time when the association was last modified.
+	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
+}
+
+// An AccessPolicyAssociationSpec defines the desired state of an EKS
+// AccessPolicyAssociation.
+type AccessPolicyAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccessPolicyAssociationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// An AccessPolicyAssociationStatus represents the observed state of an EKS
+// AccessPolicyAssociation.
+type AccessPolicyAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessPolicyAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccessPolicyAssociation is a managed resource that associates an EKS
+// access policy with the access entry of an IAM principal.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.forProvider.clusterName"
+// +kubebuilder:printcolumn:name="POLICY",type="string",JSONPath=".spec.forProvider.policyArn"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccessPolicyAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccessPolicyAssociationSpec   `json:"spec"`
+	Status AccessPolicyAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessPolicyAssociationList contains a list of AccessPolicyAssociation items
+type AccessPolicyAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessPolicyAssociation `json:"items"`
+}
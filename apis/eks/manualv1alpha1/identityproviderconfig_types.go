@@ -144,6 +144,13 @@ type IdentityProviderConfigObservation struct {
 type IdentityProviderConfigSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       IdentityProviderConfigParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // An IdentityProviderConfigStatus represents the observed state of an EKS associated identity provider.
@@ -487,6 +487,13 @@ type AutoScalingGroup struct {
 type NodeGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       NodeGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // A NodeGroupStatus represents the observed state of an EKS NodeGroup.
@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	"context"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
+	iamv1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+// ResolveReferences of this AccessEntry
+func (mg *AccessEntry) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.ForProvider
+
+	if err := resolveClusterName(ctx, r, &p.ClusterName, &p.ClusterNameRef, p.ClusterNameSelector); err != nil {
+		return err
+	}
+	return resolvePrincipalARN(ctx, r, &p.PrincipalARN, &p.PrincipalRoleRef, p.PrincipalRoleSelector, &p.PrincipalUserRef, p.PrincipalUserSelector)
+}
+
+// ResolveReferences of this AccessPolicyAssociation
+func (mg *AccessPolicyAssociation) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.ForProvider
+
+	if err := resolveClusterName(ctx, r, &p.ClusterName, &p.ClusterNameRef, p.ClusterNameSelector); err != nil {
+		return err
+	}
+	return resolvePrincipalARN(ctx, r, &p.PrincipalARN, &p.PrincipalRoleRef, p.PrincipalRoleSelector, &p.PrincipalUserRef, p.PrincipalUserSelector)
+}
+
+func resolveClusterName(ctx context.Context, r *reference.APIResolver, name *string, ref **xpv1.Reference, sel *xpv1.Selector) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *name,
+		Reference:    *ref,
+		Selector:     sel,
+		To:           reference.To{Managed: &v1beta1.Cluster{}, List: &v1beta1.ClusterList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.clusterName")
+	}
+	*name = rsp.ResolvedValue
+	*ref = rsp.ResolvedReference
+	return nil
+}
+
+// resolvePrincipalARN resolves the principal ARN from an IAM Role and, if it
+// is still unset, from an IAM User.
+func resolvePrincipalARN(ctx context.Context, r *reference.APIResolver, arn *string, roleRef **xpv1.Reference, roleSel *xpv1.Selector, userRef **xpv1.Reference, userSel *xpv1.Selector) error {
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *arn,
+		Reference:    *roleRef,
+		Selector:     roleSel,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.principalArn")
+	}
+	*arn = rsp.ResolvedValue
+	*roleRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: *arn,
+		Reference:    *userRef,
+		Selector:     userSel,
+		To:           reference.To{Managed: &iamv1beta1.User{}, List: &iamv1beta1.UserList{}},
+		Extract:      iamv1beta1.UserARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.principalArn")
+	}
+	*arn = rsp.ResolvedValue
+	*userRef = rsp.ResolvedReference
+	return nil
+}
@@ -55,8 +55,23 @@ var (
 	IdentityProviderConfigGroupVersionKind = SchemeGroupVersion.WithKind(IdentityProviderConfigKind)
 )
 
+// AccessEntry type metadata.
+var (
+	AccessEntryKind             = reflect.TypeOf(AccessEntry{}).Name()
+	AccessEntryGroupKind        = schema.GroupKind{Group: Group, Kind: AccessEntryKind}.String()
+	AccessEntryKindAPIVersion   = AccessEntryKind + "." + SchemeGroupVersion.String()
+	AccessEntryGroupVersionKind = SchemeGroupVersion.WithKind(AccessEntryKind)
+
+	AccessPolicyAssociationKind             = reflect.TypeOf(AccessPolicyAssociation{}).Name()
+	AccessPolicyAssociationGroupKind        = schema.GroupKind{Group: Group, Kind: AccessPolicyAssociationKind}.String()
+	AccessPolicyAssociationKindAPIVersion   = AccessPolicyAssociationKind + "." + SchemeGroupVersion.String()
+	AccessPolicyAssociationGroupVersionKind = SchemeGroupVersion.WithKind(AccessPolicyAssociationKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
 	SchemeBuilder.Register(&FargateProfile{}, &FargateProfileList{})
 	SchemeBuilder.Register(&IdentityProviderConfig{}, &IdentityProviderConfigList{})
+	SchemeBuilder.Register(&AccessEntry{}, &AccessEntryList{})
+	SchemeBuilder.Register(&AccessPolicyAssociation{}, &AccessPolicyAssociationList{})
 }
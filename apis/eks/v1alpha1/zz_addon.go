@@ -85,6 +85,13 @@ type AddonParameters struct {
 type AddonSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       AddonParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // AddonObservation defines the observed state of Addon
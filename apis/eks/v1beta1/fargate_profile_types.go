@@ -149,6 +149,13 @@ type FargateProfileParameters struct {
 type FargateProfileSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       FargateProfileParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // A FargateProfileStatus represents the observed state of an EKS FargateProfile.
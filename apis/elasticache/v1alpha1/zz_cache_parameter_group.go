@@ -49,6 +49,13 @@ type CacheParameterGroupParameters struct {
 type CacheParameterGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CacheParameterGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // CacheParameterGroupObservation defines the observed state of CacheParameterGroup
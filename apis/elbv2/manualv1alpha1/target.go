@@ -104,6 +104,13 @@ type TargetParameters struct {
 type TargetSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TargetParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TargetHealth describes the health state of a Target.
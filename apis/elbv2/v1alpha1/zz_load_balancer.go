@@ -108,6 +108,13 @@ type LoadBalancerParameters struct {
 type LoadBalancerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       LoadBalancerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // LoadBalancerObservation defines the observed state of LoadBalancer
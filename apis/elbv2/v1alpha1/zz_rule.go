@@ -44,6 +44,13 @@ type RuleParameters struct {
 type RuleSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RuleParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RuleObservation defines the observed state of Rule
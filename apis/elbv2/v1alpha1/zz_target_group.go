@@ -137,6 +137,13 @@ type TargetGroupParameters struct {
 type TargetGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TargetGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TargetGroupObservation defines the observed state of TargetGroup
@@ -52,6 +52,13 @@ type JobRunParameters struct {
 type JobRunSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       JobRunParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // JobRunObservation defines the observed state of JobRun
@@ -85,6 +85,13 @@ type DeliveryStreamParameters struct {
 type DeliveryStreamSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DeliveryStreamParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DeliveryStreamObservation defines the observed state of DeliveryStream
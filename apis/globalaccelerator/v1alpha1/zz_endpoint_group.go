@@ -78,6 +78,13 @@ type EndpointGroupParameters struct {
 type EndpointGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       EndpointGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // EndpointGroupObservation defines the observed state of EndpointGroup
@@ -62,6 +62,13 @@ type ListenerParameters struct {
 type ListenerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ListenerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ListenerObservation defines the observed state of Listener
@@ -36,6 +36,13 @@ type ClassifierParameters struct {
 type ClassifierSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ClassifierParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ClassifierObservation defines the observed state of Classifier
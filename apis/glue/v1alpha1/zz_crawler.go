@@ -63,6 +63,13 @@ type CrawlerParameters struct {
 type CrawlerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       CrawlerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // CrawlerObservation defines the observed state of Crawler
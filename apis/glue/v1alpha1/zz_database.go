@@ -41,6 +41,13 @@ type DatabaseParameters struct {
 type DatabaseSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DatabaseParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DatabaseObservation defines the observed state of Database
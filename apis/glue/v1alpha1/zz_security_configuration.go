@@ -36,6 +36,13 @@ type SecurityConfigurationParameters struct {
 type SecurityConfigurationSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       SecurityConfigurationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // SecurityConfigurationObservation defines the observed state of SecurityConfiguration
@@ -68,6 +68,13 @@ type TriggerParameters struct {
 type TriggerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       TriggerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // TriggerObservation defines the observed state of Trigger
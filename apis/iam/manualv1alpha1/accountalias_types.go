@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountAliasParameters define the desired state of the alias of an AWS
+// account.
+type AccountAliasParameters struct {
+	// AccountAlias is the alias of the account. It is used in the sign-in
+	// URL of the account instead of the account ID.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`
+	AccountAlias string `json:"accountAlias"`
+}
+
+// An AccountAliasSpec defines the desired state of an AccountAlias.
+type AccountAliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountAliasParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// AccountAliasObservation keeps the state for the external resource.
+type AccountAliasObservation struct {
+	// AccountAlias is the current alias of the account.
+	AccountAlias *string `json:"accountAlias,omitempty"`
+
+	// AccountID is the ID of the account the alias belongs to.
+	AccountID *string `json:"accountId,omitempty"`
+}
+
+// An AccountAliasStatus represents the observed state of an AccountAlias.
+type AccountAliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountAliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountAlias is a managed resource that represents the alias of an AWS
+// account. An account has a single alias, so the external name of an
+// AccountAlias is always "account-alias". If several AccountAliases belong to
+// the same account, only the oldest one manages the alias. An alias that was
+// not created by the AccountAlias is never replaced.
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".spec.forProvider.accountAlias"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountAliasSpec   `json:"spec"`
+	Status AccountAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAliasList contains a list of AccountAliases
+type AccountAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountAlias `json:"items"`
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccountPasswordPolicyParameters define the desired state of the password
+// policy of an AWS account.
+type AccountPasswordPolicyParameters struct {
+	// AllowUsersToChangePassword allows all IAM users in the account to
+	// change their own password.
+	// +optional
+	AllowUsersToChangePassword *bool `json:"allowUsersToChangePassword,omitempty"`
+
+	// HardExpiry prevents IAM users whose password expired from setting a
+	// new password. An administrator has to reset it.
+	// +optional
+	HardExpiry *bool `json:"hardExpiry,omitempty"`
+
+	// MaxPasswordAge is the number of days an IAM user password is valid.
+	// If unset, passwords never expire.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1095
+	// +optional
+	MaxPasswordAge *int32 `json:"maxPasswordAge,omitempty"`
+
+	// MinimumPasswordLength is the minimum number of characters allowed in
+	// an IAM user password. Defaults to 8.
+	// +kubebuilder:validation:Minimum=6
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	MinimumPasswordLength *int32 `json:"minimumPasswordLength,omitempty"`
+
+	// PasswordReusePrevention is the number of previous passwords that IAM
+	// users are prevented from reusing. If unset, passwords can be reused.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=24
+	// +optional
+	PasswordReusePrevention *int32 `json:"passwordReusePrevention,omitempty"`
+
+	// RequireLowercaseCharacters requires at least one lowercase letter in
+	// IAM user passwords.
+	// +optional
+	RequireLowercaseCharacters *bool `json:"requireLowercaseCharacters,omitempty"`
+
+	// RequireNumbers requires at least one number in IAM user passwords.
+	// +optional
+	RequireNumbers *bool `json:"requireNumbers,omitempty"`
+
+	// RequireSymbols requires at least one non-alphanumeric character in IAM
+	// user passwords.
+	// +optional
+	RequireSymbols *bool `json:"requireSymbols,omitempty"`
+
+	// RequireUppercaseCharacters requires at least one uppercase letter in
+	// IAM user passwords.
+	// +optional
+	RequireUppercaseCharacters *bool `json:"requireUppercaseCharacters,omitempty"`
+}
+
+// An AccountPasswordPolicySpec defines the desired state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountPasswordPolicyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// AccountPasswordPolicyObservation keeps the state for the external resource.
+type AccountPasswordPolicyObservation struct {
+	// ExpirePasswords indicates whether passwords in the account expire.
+	ExpirePasswords *bool `json:"expirePasswords,omitempty"`
+
+	// AccountID is the ID of the account the password policy belongs to.
+	AccountID *string `json:"accountId,omitempty"`
+}
+
+// An AccountPasswordPolicyStatus represents the observed state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountPasswordPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountPasswordPolicy is a managed resource that represents the password
+// policy of an AWS account. An account has a single password policy, so the
+// external name of an AccountPasswordPolicy is always "password-policy". If
+// several AccountPasswordPolicies belong to the same account, only the oldest
+// one manages the password policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountPasswordPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPasswordPolicySpec   `json:"spec"`
+	Status AccountPasswordPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPasswordPolicyList contains a list of AccountPasswordPolicies
+type AccountPasswordPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPasswordPolicy `json:"items"`
+}
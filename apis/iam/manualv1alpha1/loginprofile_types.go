@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationKeyPasswordHash is the key in the annotations map of a
+// LoginProfile that holds a bcrypt hash of the password that was last set.
+// The password of a login profile cannot be observed, so the referenced
+// password is compared against this hash to detect changes.
+const AnnotationKeyPasswordHash = Group + "/password-hash"
+
+// LoginProfileParameters define the desired state of the console login
+// profile of an AWS IAM User.
+type LoginProfileParameters struct {
+	// Username contains the name of the User.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.User
+	Username string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password of the user. The password is updated whenever the referenced
+	// password differs from the one that was last set. If unset, a random
+	// password is generated when the login profile is created.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// PasswordResetRequired specifies whether the user must set a new
+	// password at their next sign-in. It applies whenever the password is
+	// set, i.e. when the login profile is created and when the referenced
+	// password changes.
+	// +optional
+	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
+}
+
+// A LoginProfileSpec defines the desired state of a LoginProfile.
+type LoginProfileSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LoginProfileParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// LoginProfileObservation keeps the state for the external resource.
+type LoginProfileObservation struct {
+	// CreateDate is the time the login profile was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// PasswordResetRequired indicates whether the user must set a new
+	// password at their next sign-in.
+	PasswordResetRequired bool `json:"passwordResetRequired,omitempty"`
+}
+
+// A LoginProfileStatus represents the observed state of a LoginProfile.
+type LoginProfileStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          LoginProfileObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LoginProfile is a managed resource that represents the password of an
+// AWS IAM User for signing in to the AWS Management Console. The user name
+// and the password are published to the connection secret.
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LoginProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LoginProfileSpec   `json:"spec"`
+	Status LoginProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LoginProfileList contains a list of LoginProfiles
+type LoginProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LoginProfile `json:"items"`
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manualv1alpha1 is the v1alpha1 version of the iam.aws.crossplane.io API.
+// +kubebuilder:object:generate=true
+// +groupName=iam.aws.crossplane.io
+// +versionName=v1alpha1
+package manualv1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "iam.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// AccountPasswordPolicy type metadata.
+var (
+	AccountPasswordPolicyKind             = reflect.TypeOf(AccountPasswordPolicy{}).Name()
+	AccountPasswordPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: AccountPasswordPolicyKind}.String()
+	AccountPasswordPolicyKindAPIVersion   = AccountPasswordPolicyKind + "." + SchemeGroupVersion.String()
+	AccountPasswordPolicyGroupVersionKind = SchemeGroupVersion.WithKind(AccountPasswordPolicyKind)
+)
+
+// AccountAlias type metadata.
+var (
+	AccountAliasKind             = reflect.TypeOf(AccountAlias{}).Name()
+	AccountAliasGroupKind        = schema.GroupKind{Group: Group, Kind: AccountAliasKind}.String()
+	AccountAliasKindAPIVersion   = AccountAliasKind + "." + SchemeGroupVersion.String()
+	AccountAliasGroupVersionKind = SchemeGroupVersion.WithKind(AccountAliasKind)
+)
+
+// SAMLProvider type metadata.
+var (
+	SAMLProviderKind             = reflect.TypeOf(SAMLProvider{}).Name()
+	SAMLProviderGroupKind        = schema.GroupKind{Group: Group, Kind: SAMLProviderKind}.String()
+	SAMLProviderKindAPIVersion   = SAMLProviderKind + "." + SchemeGroupVersion.String()
+	SAMLProviderGroupVersionKind = SchemeGroupVersion.WithKind(SAMLProviderKind)
+)
+
+// ServerCertificate type metadata.
+var (
+	ServerCertificateKind             = reflect.TypeOf(ServerCertificate{}).Name()
+	ServerCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: ServerCertificateKind}.String()
+	ServerCertificateKindAPIVersion   = ServerCertificateKind + "." + SchemeGroupVersion.String()
+	ServerCertificateGroupVersionKind = SchemeGroupVersion.WithKind(ServerCertificateKind)
+)
+
+// LoginProfile type metadata.
+var (
+	LoginProfileKind             = reflect.TypeOf(LoginProfile{}).Name()
+	LoginProfileGroupKind        = schema.GroupKind{Group: Group, Kind: LoginProfileKind}.String()
+	LoginProfileKindAPIVersion   = LoginProfileKind + "." + SchemeGroupVersion.String()
+	LoginProfileGroupVersionKind = SchemeGroupVersion.WithKind(LoginProfileKind)
+)
+
+// VirtualMFADevice type metadata.
+var (
+	VirtualMFADeviceKind             = reflect.TypeOf(VirtualMFADevice{}).Name()
+	VirtualMFADeviceGroupKind        = schema.GroupKind{Group: Group, Kind: VirtualMFADeviceKind}.String()
+	VirtualMFADeviceKindAPIVersion   = VirtualMFADeviceKind + "." + SchemeGroupVersion.String()
+	VirtualMFADeviceGroupVersionKind = SchemeGroupVersion.WithKind(VirtualMFADeviceKind)
+)
+
+// ServiceSpecificCredential type metadata.
+var (
+	ServiceSpecificCredentialKind             = reflect.TypeOf(ServiceSpecificCredential{}).Name()
+	ServiceSpecificCredentialGroupKind        = schema.GroupKind{Group: Group, Kind: ServiceSpecificCredentialKind}.String()
+	ServiceSpecificCredentialKindAPIVersion   = ServiceSpecificCredentialKind + "." + SchemeGroupVersion.String()
+	ServiceSpecificCredentialGroupVersionKind = SchemeGroupVersion.WithKind(ServiceSpecificCredentialKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AccountPasswordPolicy{}, &AccountPasswordPolicyList{})
+	SchemeBuilder.Register(&AccountAlias{}, &AccountAliasList{})
+	SchemeBuilder.Register(&SAMLProvider{}, &SAMLProviderList{})
+	SchemeBuilder.Register(&ServerCertificate{}, &ServerCertificateList{})
+	SchemeBuilder.Register(&LoginProfile{}, &LoginProfileList{})
+	SchemeBuilder.Register(&VirtualMFADevice{}, &VirtualMFADeviceList{})
+	SchemeBuilder.Register(&ServiceSpecificCredential{}, &ServiceSpecificCredentialList{})
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+// ConfigMapKeySelector is a reference to a key of a ConfigMap in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// SAMLProviderParameters define the desired state of an AWS IAM SAML
+// identity provider.
+//
+// Exactly one of samlMetadataDocument or samlMetadataDocumentConfigMapRef
+// should be set. If both are set, samlMetadataDocument takes precedence.
+type SAMLProviderParameters struct {
+	// Name of the SAML provider.
+	// +immutable
+	Name string `json:"name"`
+
+	// SAMLMetadataDocument is the XML metadata document generated by the
+	// identity provider.
+	// +optional
+	SAMLMetadataDocument *string `json:"samlMetadataDocument,omitempty"`
+
+	// SAMLMetadataDocumentConfigMapRef references a key of a ConfigMap that
+	// contains the XML metadata document generated by the identity provider.
+	// +optional
+	SAMLMetadataDocumentConfigMapRef *ConfigMapKeySelector `json:"samlMetadataDocumentConfigMapRef,omitempty"`
+
+	// Tags. For more information about
+	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
+	// in the IAM User Guide.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A SAMLProviderSpec defines the desired state of a SAMLProvider.
+type SAMLProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SAMLProviderParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// SAMLProviderObservation keeps the state for the external resource.
+type SAMLProviderObservation struct {
+	// ARN of the SAML provider.
+	ARN string `json:"arn,omitempty"`
+
+	// CreateDate is the time the SAML provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// ValidUntil is the expiration date and time of the SAML provider.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+}
+
+// A SAMLProviderStatus represents the observed state of a SAMLProvider.
+type SAMLProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SAMLProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A SAMLProvider is a managed resource that represents an AWS IAM SAML
+// identity provider.
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SAMLProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SAMLProviderSpec   `json:"spec"`
+	Status SAMLProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProviderList contains a list of SAMLProviders
+type SAMLProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLProvider `json:"items"`
+}
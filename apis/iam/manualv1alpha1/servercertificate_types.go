@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+// ServerCertificateParameters define the desired state of an AWS IAM server
+// certificate. The name of the server certificate is the external name of
+// the ServerCertificate.
+type ServerCertificateParameters struct {
+	// Path for the server certificate.
+	// Default: /
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// CertificateSecretRef references a Secret of type kubernetes.io/tls.
+	// The certificate is the first certificate in key tls.crt and the
+	// certificate chain are the remaining ones, or key ca.crt if tls.crt
+	// contains a single certificate. The private key is read from key
+	// tls.key. The certificate of a server certificate cannot be changed, so
+	// a changed certificate is reported as an error and requires a new
+	// ServerCertificate.
+	CertificateSecretRef xpv1.SecretReference `json:"certificateSecretRef"`
+
+	// Tags. For more information about
+	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
+	// in the IAM User Guide.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A ServerCertificateSpec defines the desired state of a ServerCertificate.
+type ServerCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServerCertificateParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// ServerCertificateObservation keeps the state for the external resource.
+type ServerCertificateObservation struct {
+	// ARN of the server certificate.
+	ARN string `json:"arn,omitempty"`
+
+	// ServerCertificateID is the stable and unique string identifying the
+	// server certificate.
+	ServerCertificateID string `json:"serverCertificateId,omitempty"`
+
+	// Expiration is the time the server certificate expires.
+	Expiration *metav1.Time `json:"expiration,omitempty"`
+
+	// UploadDate is the time the server certificate was uploaded.
+	UploadDate *metav1.Time `json:"uploadDate,omitempty"`
+}
+
+// A ServerCertificateStatus represents the observed state of a
+// ServerCertificate.
+type ServerCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServerCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServerCertificate is a managed resource that represents an AWS IAM server
+// certificate.
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".status.atProvider.arn"
+// +kubebuilder:printcolumn:name="EXPIRATION",type="string",JSONPath=".status.atProvider.expiration"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ServerCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServerCertificateSpec   `json:"spec"`
+	Status ServerCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServerCertificateList contains a list of ServerCertificates
+type ServerCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServerCertificate `json:"items"`
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceSpecificCredentialParameters define the desired state of an AWS IAM
+// service-specific credential.
+type ServiceSpecificCredentialParameters struct {
+	// Username contains the name of the User.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.User
+	Username string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// ServiceName is the name of the AWS service the credential is for,
+	// i.e. codecommit.amazonaws.com for CodeCommit or cassandra.amazonaws.com
+	// for Amazon Keyspaces.
+	// +immutable
+	// +kubebuilder:validation:Enum=codecommit.amazonaws.com;cassandra.amazonaws.com
+	ServiceName string `json:"serviceName"`
+
+	// Status of the credential. Must be either Active or Inactive. Defaults
+	// to Active.
+	// +optional
+	// +kubebuilder:validation:Enum=Active;Inactive
+	Status *string `json:"status,omitempty"`
+}
+
+// A ServiceSpecificCredentialSpec defines the desired state of a
+// ServiceSpecificCredential.
+type ServiceSpecificCredentialSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceSpecificCredentialParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// ServiceSpecificCredentialObservation keeps the state for the external
+// resource.
+type ServiceSpecificCredentialObservation struct {
+	// ServiceSpecificCredentialID is the ID of the credential.
+	ServiceSpecificCredentialID string `json:"serviceSpecificCredentialId,omitempty"`
+
+	// ServiceUserName is the user name to sign in to the service with.
+	ServiceUserName string `json:"serviceUserName,omitempty"`
+
+	// CreateDate is the time the credential was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+}
+
+// A ServiceSpecificCredentialStatus represents the observed state of a
+// ServiceSpecificCredential.
+type ServiceSpecificCredentialStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceSpecificCredentialObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ServiceSpecificCredential is a managed resource that represents a
+// credential of an AWS IAM User for AWS CodeCommit or Amazon Keyspaces. The
+// service user name and password are published to the connection secret.
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.userName"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ServiceSpecificCredential struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpecificCredentialSpec   `json:"spec"`
+	Status ServiceSpecificCredentialStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceSpecificCredentialList contains a list of
+// ServiceSpecificCredentials
+type ServiceSpecificCredentialList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ServiceSpecificCredential `json:"items"`
+}
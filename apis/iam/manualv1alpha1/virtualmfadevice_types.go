@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+// VirtualMFADeviceParameters define the desired state of an AWS IAM virtual
+// MFA device.
+type VirtualMFADeviceParameters struct {
+	// Path for the virtual MFA device.
+	// +optional
+	// +immutable
+	Path *string `json:"path,omitempty"`
+
+	// Username contains the name of the User the virtual MFA device is
+	// enabled for. The device is enabled right after it was created, using
+	// authentication codes computed from its seed. If unset, the device is
+	// not enabled for any user.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.User
+	Username *string `json:"userName,omitempty"`
+
+	// UsernameRef references to an User to retrieve its userName
+	// +optional
+	UsernameRef *xpv1.Reference `json:"userNameRef,omitempty"`
+
+	// UsernameSelector selects a reference to an User to retrieve its userName
+	// +optional
+	UsernameSelector *xpv1.Selector `json:"userNameSelector,omitempty"`
+
+	// Tags. For more information about
+	// tagging, see Tagging IAM Identities (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags.html)
+	// in the IAM User Guide.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+}
+
+// A VirtualMFADeviceSpec defines the desired state of a VirtualMFADevice.
+type VirtualMFADeviceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VirtualMFADeviceParameters `json:"forProvider,omitempty"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// VirtualMFADeviceObservation keeps the state for the external resource.
+type VirtualMFADeviceObservation struct {
+	// SerialNumber is the serial number, i.e. the ARN, of the virtual MFA
+	// device.
+	SerialNumber string `json:"serialNumber,omitempty"`
+
+	// EnableDate is the time the virtual MFA device was enabled.
+	EnableDate *metav1.Time `json:"enableDate,omitempty"`
+
+	// Username is the name of the User the virtual MFA device is enabled
+	// for.
+	Username *string `json:"userName,omitempty"`
+}
+
+// A VirtualMFADeviceStatus represents the observed state of a
+// VirtualMFADevice.
+type VirtualMFADeviceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VirtualMFADeviceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VirtualMFADevice is a managed resource that represents an AWS IAM
+// virtual MFA device. The name of the device is the name of the managed
+// resource. The Base32 encoded seed (seed), the QR code as PNG image
+// (qrCodePNG) and the serial number (serialNumber) of the device are
+// published to the connection secret when it is created. IAM never returns
+// the seed again, so a device whose connection secret is lost has to be
+// recreated.
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".status.atProvider.userName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VirtualMFADevice struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VirtualMFADeviceSpec   `json:"spec"`
+	Status VirtualMFADeviceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VirtualMFADeviceList contains a list of VirtualMFADevices
+type VirtualMFADeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMFADevice `json:"items"`
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by angryjet. DO NOT EDIT.
+
+package manualv1alpha1
+
+import (
+	"context"
+	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	errors "github.com/pkg/errors"
+	client "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResolveReferences of this LoginProfile.
+func (mg *LoginProfile) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Username,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UsernameRef,
+		Selector:     mg.Spec.ForProvider.UsernameSelector,
+		To: reference.To{
+			List:    &v1beta1.UserList{},
+			Managed: &v1beta1.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Username")
+	}
+	mg.Spec.ForProvider.Username = rsp.ResolvedValue
+	mg.Spec.ForProvider.UsernameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ServiceSpecificCredential.
+func (mg *ServiceSpecificCredential) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Username,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UsernameRef,
+		Selector:     mg.Spec.ForProvider.UsernameSelector,
+		To: reference.To{
+			List:    &v1beta1.UserList{},
+			Managed: &v1beta1.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Username")
+	}
+	mg.Spec.ForProvider.Username = rsp.ResolvedValue
+	mg.Spec.ForProvider.UsernameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this VirtualMFADevice.
+func (mg *VirtualMFADevice) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Username),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UsernameRef,
+		Selector:     mg.Spec.ForProvider.UsernameSelector,
+		To: reference.To{
+			List:    &v1beta1.UserList{},
+			Managed: &v1beta1.User{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Username")
+	}
+	mg.Spec.ForProvider.Username = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.UsernameRef = rsp.ResolvedReference
+
+	return nil
+}
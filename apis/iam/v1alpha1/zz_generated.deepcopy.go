@@ -530,6 +530,13 @@ func (in *InstanceProfileSpec) DeepCopyInto(out *InstanceProfileSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceProfileSpec.
@@ -620,30 +627,6 @@ func (in *InstanceProfile_SDK) DeepCopy() *InstanceProfile_SDK {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoginProfile) DeepCopyInto(out *LoginProfile) {
-	*out = *in
-	if in.CreateDate != nil {
-		in, out := &in.CreateDate, &out.CreateDate
-		*out = (*in).DeepCopy()
-	}
-	if in.PasswordResetRequired != nil {
-		in, out := &in.PasswordResetRequired, &out.PasswordResetRequired
-		*out = new(bool)
-		**out = **in
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoginProfile.
-func (in *LoginProfile) DeepCopy() *LoginProfile {
-	if in == nil {
-		return nil
-	}
-	out := new(LoginProfile)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MFADevice) DeepCopyInto(out *MFADevice) {
 	*out = *in
@@ -1235,32 +1218,6 @@ func (in *SSHPublicKeyMetadata) DeepCopy() *SSHPublicKeyMetadata {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServerCertificate) DeepCopyInto(out *ServerCertificate) {
-	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerCertificate.
-func (in *ServerCertificate) DeepCopy() *ServerCertificate {
-	if in == nil {
-		return nil
-	}
-	out := new(ServerCertificate)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerCertificateMetadata) DeepCopyInto(out *ServerCertificateMetadata) {
 	*out = *in
@@ -1494,6 +1451,13 @@ func (in *ServiceLinkedRoleSpec) DeepCopyInto(out *ServiceLinkedRoleSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceLinkedRoleSpec.
@@ -1523,25 +1487,6 @@ func (in *ServiceLinkedRoleStatus) DeepCopy() *ServiceLinkedRoleStatus {
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ServiceSpecificCredential) DeepCopyInto(out *ServiceSpecificCredential) {
-	*out = *in
-	if in.CreateDate != nil {
-		in, out := &in.CreateDate, &out.CreateDate
-		*out = (*in).DeepCopy()
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpecificCredential.
-func (in *ServiceSpecificCredential) DeepCopy() *ServiceSpecificCredential {
-	if in == nil {
-		return nil
-	}
-	out := new(ServiceSpecificCredential)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceSpecificCredentialMetadata) DeepCopyInto(out *ServiceSpecificCredentialMetadata) {
 	*out = *in
@@ -1742,33 +1687,3 @@ func (in *UserDetail) DeepCopy() *UserDetail {
 	in.DeepCopyInto(out)
 	return out
 }
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VirtualMFADevice) DeepCopyInto(out *VirtualMFADevice) {
-	*out = *in
-	if in.EnableDate != nil {
-		in, out := &in.EnableDate, &out.EnableDate
-		*out = (*in).DeepCopy()
-	}
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]*Tag, len(*in))
-		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(Tag)
-				(*in).DeepCopyInto(*out)
-			}
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMFADevice.
-func (in *VirtualMFADevice) DeepCopy() *VirtualMFADevice {
-	if in == nil {
-		return nil
-	}
-	out := new(VirtualMFADevice)
-	in.DeepCopyInto(out)
-	return out
-}
@@ -55,6 +55,13 @@ type InstanceProfileParameters struct {
 type InstanceProfileSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       InstanceProfileParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // InstanceProfileObservation defines the observed state of InstanceProfile
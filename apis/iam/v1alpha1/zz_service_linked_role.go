@@ -57,6 +57,13 @@ type ServiceLinkedRoleParameters struct {
 type ServiceLinkedRoleSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ServiceLinkedRoleParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ServiceLinkedRoleObservation defines the observed state of ServiceLinkedRole
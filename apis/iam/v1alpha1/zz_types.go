@@ -161,13 +161,6 @@ type InstanceProfile_SDK struct {
 	Tags []*Tag `json:"tags,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type LoginProfile struct {
-	CreateDate *metav1.Time `json:"createDate,omitempty"`
-
-	PasswordResetRequired *bool `json:"passwordResetRequired,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type MFADevice struct {
 	EnableDate *metav1.Time `json:"enableDate,omitempty"`
@@ -410,11 +403,6 @@ type SSHPublicKeyMetadata struct {
 	UploadDate *metav1.Time `json:"uploadDate,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type ServerCertificate struct {
-	Tags []*Tag `json:"tags,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type ServerCertificateMetadata struct {
 	// The Amazon Resource Name (ARN). ARNs are unique identifiers for Amazon Web
@@ -446,11 +434,6 @@ type ServiceLastAccessed struct {
 	LastAuthenticatedRegion *string `json:"lastAuthenticatedRegion,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type ServiceSpecificCredential struct {
-	CreateDate *metav1.Time `json:"createDate,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type ServiceSpecificCredentialMetadata struct {
 	CreateDate *metav1.Time `json:"createDate,omitempty"`
@@ -538,10 +521,3 @@ type UserDetail struct {
 
 	UserID *string `json:"userID,omitempty"`
 }
-
-// +kubebuilder:skipversion
-type VirtualMFADevice struct {
-	EnableDate *metav1.Time `json:"enableDate,omitempty"`
-
-	Tags []*Tag `json:"tags,omitempty"`
-}
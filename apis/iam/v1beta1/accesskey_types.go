@@ -21,6 +21,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// AnnotationKeyPreviousAccessKeyID is the key in the annotations map of an
+// AccessKey that holds the ID of the access key that was replaced by the
+// current one until it has been deleted. It is stored together with the
+// external name of the new access key, so that the previous one is not lost
+// if the status can't be updated.
+const AnnotationKeyPreviousAccessKeyID = CRDGroup + "/previous-access-key-id"
+
 // AccessKeyParameters define the desired state of an AWS IAM Access Key.
 type AccessKeyParameters struct {
 	// Username contains the name of the User.
@@ -41,17 +48,63 @@ type AccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// Rotation configures the automatic rotation of the access key. If
+	// unset, the access key is never rotated.
+	// +optional
+	Rotation *AccessKeyRotation `json:"rotation,omitempty"`
+}
+
+// AccessKeyRotation configures the automatic rotation of an access key.
+// Once the current key is older than MaxAge, a new key is created and
+// published to the connection secret. The previous key stays active for
+// OverlapPeriod, then it is deactivated and deleted. The previous key is only
+// deactivated once the connection secret referenced by
+// writeConnectionSecretToRef holds the new key, so rotation requires it. Since a user can only
+// have two access keys, the rotation is blocked while the user has an
+// access key that is not managed by this resource.
+type AccessKeyRotation struct {
+	// MaxAge is the maximum age of an access key before it is rotated,
+	// e.g. 2160h for 90 days.
+	MaxAge metav1.Duration `json:"maxAge"`
+
+	// OverlapPeriod is how long the previous access key stays active after
+	// a new one was created, so that consumers can pick up the new key.
+	// Defaults to 24h.
+	// +optional
+	OverlapPeriod *metav1.Duration `json:"overlapPeriod,omitempty"`
 }
 
 // An AccessKeySpec defines the desired state of an IAM Access Key.
 type AccessKeySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       AccessKeyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// AccessKeyObservation keeps the state for the external resource.
+type AccessKeyObservation struct {
+	// AccessKeyID is the ID of the current access key.
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+
+	// CreateDate is the time the current access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// PreviousAccessKeyID is the ID of the access key that was replaced by
+	// the current one and has not been deleted yet.
+	PreviousAccessKeyID *string `json:"previousAccessKeyId,omitempty"`
 }
 
 // AccessKeyStatus represents the observed state of an IAM Access Key.
 type AccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -43,6 +43,21 @@ type PolicyParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// The maximum number of policy versions to retain, including the default
+	// version. When a document change requires a new version and the limit is
+	// reached, the oldest version that is neither the default nor protected is
+	// deleted. Defaults to 5, the maximum allowed by IAM.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=5
+	// +optional
+	VersionsToKeep *int32 `json:"versionsToKeep,omitempty"`
+
+	// The IDs of policy versions (e.g. v3) that must never be pruned, for
+	// example to keep a known-good version to roll back to. Protected versions
+	// count towards versionsToKeep.
+	// +optional
+	ProtectedVersionIDs []string `json:"protectedVersionIds,omitempty"`
 }
 
 // A PolicySpec defines the desired state of a Policy.
@@ -51,6 +66,18 @@ type PolicySpec struct {
 	ForProvider       PolicyParameters `json:"forProvider"`
 }
 
+// PolicyVersion describes a single version of a managed policy.
+type PolicyVersion struct {
+	// The identifier of the policy version, e.g. v1.
+	VersionID string `json:"versionId"`
+
+	// The date and time when the policy version was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// Specifies whether the policy version is the default version.
+	IsDefaultVersion bool `json:"isDefaultVersion,omitempty"`
+}
+
 // PolicyObservation keeps the state for the external resource
 type PolicyObservation struct {
 	// The Amazon PolicyObservation Name (ARN) of the policy
@@ -72,6 +99,9 @@ type PolicyObservation struct {
 
 	// The stable and unique string identifying the policy.
 	PolicyID string `json:"policyId,omitempty"`
+
+	// The versions of the policy, ordered from newest to oldest.
+	Versions []PolicyVersion `json:"versions,omitempty"`
 }
 
 // A PolicyStatus represents the observed state of a Policy.
@@ -18,6 +18,7 @@ package v1beta1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -67,13 +68,71 @@ type RoleLastUsed struct {
 	Region *string `json:"region,omitempty"`
 }
 
+// ServiceAccountTrust describes the Kubernetes service accounts that may
+// assume a role with a web identity token of an OpenID Connect provider.
+type ServiceAccountTrust struct {
+	// OIDCProviderARN is the ARN of the IAM OpenID Connect provider that
+	// issues the service account tokens.
+	// +optional
+	// +crossplane:generate:reference:type=OpenIDConnectProvider
+	OIDCProviderARN *string `json:"oidcProviderArn,omitempty"`
+
+	// OIDCProviderARNRef references an OpenIDConnectProvider to retrieve its
+	// ARN.
+	// +optional
+	OIDCProviderARNRef *xpv1.Reference `json:"oidcProviderArnRef,omitempty"`
+
+	// OIDCProviderARNSelector selects a reference to an
+	// OpenIDConnectProvider to retrieve its ARN.
+	// +optional
+	OIDCProviderARNSelector *xpv1.Selector `json:"oidcProviderArnSelector,omitempty"`
+
+	// ClusterRef references an EKS Cluster whose OpenID Connect issuer is
+	// trusted. The ARN of the IAM OpenID Connect provider is derived from the
+	// ARN and the issuer URL of the cluster and stored in oidcProviderArn.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to an EKS Cluster whose OpenID
+	// Connect issuer is trusted.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// ServiceAccounts that may assume the role.
+	// +kubebuilder:validation:MinItems=1
+	ServiceAccounts []ServiceAccount `json:"serviceAccounts"`
+
+	// Audience is the expected audience of the service account tokens.
+	// Default: sts.amazonaws.com
+	// +optional
+	Audience *string `json:"audience,omitempty"`
+}
+
+// ServiceAccount identifies a Kubernetes service account.
+type ServiceAccount struct {
+	// Namespace of the service account. It may contain the wildcards * and ?.
+	Namespace string `json:"namespace"`
+
+	// Name of the service account. It may contain the wildcards * and ?.
+	Name string `json:"name"`
+}
+
 // RoleParameters define the desired state of an AWS IAM Role.
 type RoleParameters struct {
 
 	// AssumeRolePolicyDocument is the the trust relationship policy document
-	// that grants an entity permission to assume the role.
+	// that grants an entity permission to assume the role. It may be omitted
+	// if serviceAccountTrust is set.
 	// +immutable
-	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument"`
+	// +optional
+	AssumeRolePolicyDocument string `json:"assumeRolePolicyDocument,omitempty"`
+
+	// ServiceAccountTrust allows Kubernetes service accounts to assume the
+	// role through an OpenID Connect provider (IAM roles for service
+	// accounts). It is rendered into statements that are merged with the
+	// ones in assumeRolePolicyDocument.
+	// +optional
+	ServiceAccountTrust *ServiceAccountTrust `json:"serviceAccountTrust,omitempty"`
 
 	// Description is a description of the role.
 	// +optional
@@ -102,6 +161,38 @@ type RoleParameters struct {
 	// +immutable
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// ExclusivePolicyManagement makes ManagedPolicyARNs and InlinePolicies
+	// the exclusive sets of policies of the role. If true, the policies in
+	// these sets are attached and put, and any other policy of the role is
+	// detached or deleted, so empty sets remove all policies of the role. If
+	// unset or false, the policies of the role are not managed by this
+	// resource and both sets are ignored.
+	// +optional
+	ExclusivePolicyManagement *bool `json:"exclusivePolicyManagement,omitempty"`
+
+	// ManagedPolicyARNs is the set of managed policies attached to the role
+	// if ExclusivePolicyManagement is true.
+	// +optional
+	// +crossplane:generate:reference:type=Policy
+	// +crossplane:generate:reference:extractor=PolicyARN()
+	// +crossplane:generate:reference:refFieldName=ManagedPolicyARNRefs
+	// +crossplane:generate:reference:selectorFieldName=ManagedPolicyARNSelector
+	ManagedPolicyARNs []string `json:"managedPolicyArns,omitempty"`
+
+	// ManagedPolicyARNRefs references Policies to retrieve their ARNs.
+	// +optional
+	ManagedPolicyARNRefs []xpv1.Reference `json:"managedPolicyArnRefs,omitempty"`
+
+	// ManagedPolicyARNSelector selects references to Policies to retrieve
+	// their ARNs.
+	// +optional
+	ManagedPolicyARNSelector *xpv1.Selector `json:"managedPolicyArnSelector,omitempty"`
+
+	// InlinePolicies is the set of inline policies of the role, keyed by
+	// policy name, if ExclusivePolicyManagement is true.
+	// +optional
+	InlinePolicies map[string]extv1.JSON `json:"inlinePolicies,omitempty"`
 }
 
 // A RoleSpec defines the desired state of a Role.
@@ -135,6 +226,14 @@ type RoleExternalStatus struct {
 	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies_access-advisor.html#access-advisor_tracking-period)
 	// in the IAM User Guide.
 	RoleLastUsed *RoleLastUsed `json:"roleLastUsed,omitempty"`
+
+	// DetachedPolicyARNs are the managed policies that were last detached
+	// from the role because they are not in managedPolicyArns.
+	DetachedPolicyARNs []string `json:"detachedPolicyArns,omitempty"`
+
+	// DeletedInlinePolicyNames are the inline policies that were last deleted
+	// from the role because they are not in inlinePolicies.
+	DeletedInlinePolicyNames []string `json:"deletedInlinePolicyNames,omitempty"`
 }
 
 // A RoleStatus represents the observed state of a Role.
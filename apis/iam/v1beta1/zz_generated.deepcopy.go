@@ -22,6 +22,8 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -84,6 +86,30 @@ func (in *AccessKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyObservation) DeepCopyInto(out *AccessKeyObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.PreviousAccessKeyID != nil {
+		in, out := &in.PreviousAccessKeyID, &out.PreviousAccessKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyObservation.
+func (in *AccessKeyObservation) DeepCopy() *AccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 	*out = *in
@@ -97,6 +123,11 @@ func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AccessKeyRotation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyParameters.
@@ -109,11 +140,39 @@ func (in *AccessKeyParameters) DeepCopy() *AccessKeyParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyRotation) DeepCopyInto(out *AccessKeyRotation) {
+	*out = *in
+	out.MaxAge = in.MaxAge
+	if in.OverlapPeriod != nil {
+		in, out := &in.OverlapPeriod, &out.OverlapPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyRotation.
+func (in *AccessKeyRotation) DeepCopy() *AccessKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeySpec) DeepCopyInto(out *AccessKeySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeySpec.
@@ -130,6 +189,7 @@ func (in *AccessKeySpec) DeepCopy() *AccessKeySpec {
 func (in *AccessKeyStatus) DeepCopyInto(out *AccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyStatus.
@@ -669,6 +729,13 @@ func (in *OpenIDConnectProviderSpec) DeepCopyInto(out *OpenIDConnectProviderSpec
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderSpec.
@@ -760,6 +827,13 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
 	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]PolicyVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
@@ -790,6 +864,16 @@ func (in *PolicyParameters) DeepCopyInto(out *PolicyParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.VersionsToKeep != nil {
+		in, out := &in.VersionsToKeep, &out.VersionsToKeep
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ProtectedVersionIDs != nil {
+		in, out := &in.ProtectedVersionIDs, &out.ProtectedVersionIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyParameters.
@@ -823,7 +907,7 @@ func (in *PolicySpec) DeepCopy() *PolicySpec {
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
@@ -836,6 +920,25 @@ func (in *PolicyStatus) DeepCopy() *PolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyVersion) DeepCopyInto(out *PolicyVersion) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyVersion.
+func (in *PolicyVersion) DeepCopy() *PolicyVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Role) DeepCopyInto(out *Role) {
 	*out = *in
@@ -875,6 +978,16 @@ func (in *RoleExternalStatus) DeepCopyInto(out *RoleExternalStatus) {
 		*out = new(RoleLastUsed)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DetachedPolicyARNs != nil {
+		in, out := &in.DetachedPolicyARNs, &out.DetachedPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeletedInlinePolicyNames != nil {
+		in, out := &in.DeletedInlinePolicyNames, &out.DeletedInlinePolicyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleExternalStatus.
@@ -946,6 +1059,11 @@ func (in *RoleList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 	*out = *in
+	if in.ServiceAccountTrust != nil {
+		in, out := &in.ServiceAccountTrust, &out.ServiceAccountTrust
+		*out = new(ServiceAccountTrust)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Description != nil {
 		in, out := &in.Description, &out.Description
 		*out = new(string)
@@ -971,6 +1089,35 @@ func (in *RoleParameters) DeepCopyInto(out *RoleParameters) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExclusivePolicyManagement != nil {
+		in, out := &in.ExclusivePolicyManagement, &out.ExclusivePolicyManagement
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ManagedPolicyARNs != nil {
+		in, out := &in.ManagedPolicyARNs, &out.ManagedPolicyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ManagedPolicyARNRefs != nil {
+		in, out := &in.ManagedPolicyARNRefs, &out.ManagedPolicyARNRefs
+		*out = make([]v1.Reference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ManagedPolicyARNSelector != nil {
+		in, out := &in.ManagedPolicyARNSelector, &out.ManagedPolicyARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InlinePolicies != nil {
+		in, out := &in.InlinePolicies, &out.InlinePolicies
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleParameters.
@@ -1294,6 +1441,71 @@ func (in *RoleStatus) DeepCopy() *RoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccount) DeepCopyInto(out *ServiceAccount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccount.
+func (in *ServiceAccount) DeepCopy() *ServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountTrust) DeepCopyInto(out *ServiceAccountTrust) {
+	*out = *in
+	if in.OIDCProviderARN != nil {
+		in, out := &in.OIDCProviderARN, &out.OIDCProviderARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.OIDCProviderARNRef != nil {
+		in, out := &in.OIDCProviderARNRef, &out.OIDCProviderARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDCProviderARNSelector != nil {
+		in, out := &in.OIDCProviderARNSelector, &out.OIDCProviderARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]ServiceAccount, len(*in))
+		copy(*out, *in)
+	}
+	if in.Audience != nil {
+		in, out := &in.Audience, &out.Audience
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountTrust.
+func (in *ServiceAccountTrust) DeepCopy() *ServiceAccountTrust {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountTrust)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -135,6 +135,51 @@ func (mg *GroupUserMembership) ResolveReferences(ctx context.Context, c client.R
 	return nil
 }
 
+// ResolveReferences of this Role.
+func (mg *Role) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	if mg.Spec.ForProvider.ServiceAccountTrust != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.ServiceAccountTrust.OIDCProviderARN),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.ServiceAccountTrust.OIDCProviderARNRef,
+			Selector:     mg.Spec.ForProvider.ServiceAccountTrust.OIDCProviderARNSelector,
+			To: reference.To{
+				List:    &OpenIDConnectProviderList{},
+				Managed: &OpenIDConnectProvider{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.ServiceAccountTrust.OIDCProviderARN")
+		}
+		mg.Spec.ForProvider.ServiceAccountTrust.OIDCProviderARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.ServiceAccountTrust.OIDCProviderARNRef = rsp.ResolvedReference
+
+	}
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ManagedPolicyARNs,
+		Extract:       PolicyARN(),
+		References:    mg.Spec.ForProvider.ManagedPolicyARNRefs,
+		Selector:      mg.Spec.ForProvider.ManagedPolicyARNSelector,
+		To: reference.To{
+			List:    &PolicyList{},
+			Managed: &Policy{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.ManagedPolicyARNs")
+	}
+	mg.Spec.ForProvider.ManagedPolicyARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ManagedPolicyARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
 // ResolveReferences of this RolePolicy.
 func (mg *RolePolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
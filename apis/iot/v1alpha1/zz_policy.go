@@ -48,6 +48,13 @@ type PolicyParameters struct {
 type PolicySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       PolicyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // PolicyObservation defines the observed state of Policy
@@ -45,6 +45,13 @@ type ThingParameters struct {
 type ThingSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ThingParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ThingObservation defines the observed state of Thing
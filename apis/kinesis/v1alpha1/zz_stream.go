@@ -44,6 +44,13 @@ type StreamParameters struct {
 type StreamSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       StreamParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // StreamObservation defines the observed state of Stream
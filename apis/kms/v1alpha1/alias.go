@@ -65,6 +65,13 @@ type AliasParameters struct {
 type AliasSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       AliasParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // AliasObservation defines the observed state of Alias
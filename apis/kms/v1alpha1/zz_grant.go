@@ -124,6 +124,13 @@ type GrantParameters struct {
 type GrantSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       GrantParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // GrantObservation defines the observed state of Grant
@@ -256,6 +256,13 @@ type KeyParameters struct {
 type KeySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       KeyParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // KeyObservation defines the observed state of Key
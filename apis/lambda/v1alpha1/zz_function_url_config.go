@@ -58,6 +58,13 @@ type FunctionURLConfigParameters struct {
 type FunctionURLConfigSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       FunctionURLConfigParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // FunctionURLConfigObservation defines the observed state of FunctionURLConfig
@@ -109,6 +109,13 @@ type FunctionParameters struct {
 type FunctionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       FunctionParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // FunctionObservation defines the observed state of Function
@@ -76,6 +76,13 @@ type BrokerParameters struct {
 type BrokerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       BrokerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // BrokerObservation defines the observed state of Broker
@@ -49,6 +49,13 @@ type ConfigurationParameters struct {
 type ConfigurationSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ConfigurationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ConfigurationObservation defines the observed state of Configuration
@@ -122,6 +122,13 @@ type EnvironmentParameters struct {
 type EnvironmentSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       EnvironmentParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // EnvironmentObservation defines the observed state of Environment
@@ -184,6 +184,13 @@ type DBClusterParameters struct {
 type DBClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       DBClusterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // DBClusterObservation defines the observed state of DBCluster
@@ -44,6 +44,13 @@ type RuleGroupsNamespaceParameters struct {
 type RuleGroupsNamespaceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       RuleGroupsNamespaceParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // RuleGroupsNamespaceObservation defines the observed state of RuleGroupsNamespace
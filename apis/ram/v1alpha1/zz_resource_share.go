@@ -94,6 +94,13 @@ type ResourceShareParameters struct {
 type ResourceShareSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ResourceShareParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ResourceShareObservation defines the observed state of ResourceShare
@@ -88,6 +88,13 @@ type GlobalClusterParameters struct {
 type GlobalClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       GlobalClusterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // GlobalClusterObservation defines the observed state of GlobalCluster
@@ -73,6 +73,13 @@ type OptionGroupParameters struct {
 type OptionGroupSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       OptionGroupParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // OptionGroupObservation defines the observed state of OptionGroup
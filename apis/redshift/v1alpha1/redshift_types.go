@@ -641,6 +641,13 @@ type Tag struct {
 type ClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ClusterParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ClusterStatus represents the observed state of an AWS Redshift Cluster.
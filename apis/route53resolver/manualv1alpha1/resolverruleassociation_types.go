@@ -50,6 +50,13 @@ type ResolverRuleAssociation struct {
 type ResolverRuleAssociationSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ResolverRuleAssociationParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ResolverRuleAssociationStatus represents the observed state of a ResolverRuleAssociation.
@@ -72,6 +72,13 @@ type ResolverRuleParameters struct {
 type ResolverRuleSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ResolverRuleParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ResolverRuleObservation defines the observed state of ResolverRule
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+)
+
+// ConfigMapKeySelector is a reference to a key of a ConfigMap in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// ObjectParameters define the desired state of an AWS S3 Object.
+//
+// Exactly one of content, contentBase64, contentConfigMapRef or
+// contentSecretRef should be set. If several are set, they take precedence in
+// that order.
+type ObjectParameters struct {
+	// Region is where the Bucket of this Object resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// Bucket is the name of the bucket that contains the object.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket to retrieve its name.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket to retrieve its name.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Key is the key of the object in the bucket.
+	// +immutable
+	Key string `json:"key"`
+
+	// Content is the literal UTF-8 content of the object.
+	// +optional
+	Content *string `json:"content,omitempty"`
+
+	// ContentBase64 is the base64 encoded binary content of the object.
+	// +optional
+	ContentBase64 *string `json:"contentBase64,omitempty"`
+
+	// ContentConfigMapRef references a key of a ConfigMap whose value is
+	// used as the content of the object. Keys of binaryData are supported.
+	// +optional
+	ContentConfigMapRef *ConfigMapKeySelector `json:"contentConfigMapRef,omitempty"`
+
+	// ContentSecretRef references a key of a Secret whose value is used as
+	// the content of the object.
+	// +optional
+	ContentSecretRef *xpv1.SecretKeySelector `json:"contentSecretRef,omitempty"`
+
+	// ContentType is a standard MIME type describing the format of the
+	// object data.
+	// +optional
+	ContentType *string `json:"contentType,omitempty"`
+
+	// CacheControl specifies caching behavior along the request/reply chain.
+	// +optional
+	CacheControl *string `json:"cacheControl,omitempty"`
+
+	// ServerSideEncryption is the server-side encryption algorithm used when
+	// storing this object in Amazon S3.
+	// +kubebuilder:validation:Enum=AES256;"aws:kms";"aws:kms:dsse"
+	// +optional
+	ServerSideEncryption *string `json:"serverSideEncryption,omitempty"`
+
+	// SSEKMSKeyID is the ARN of the AWS KMS key used to encrypt the object.
+	// It is only used if serverSideEncryption is aws:kms or aws:kms:dsse.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+
+	// SSEKMSKeyIDRef references a KMS Key to retrieve its ARN.
+	// +optional
+	SSEKMSKeyIDRef *xpv1.Reference `json:"sseKmsKeyIdRef,omitempty"`
+
+	// SSEKMSKeyIDSelector selects a reference to a KMS Key to retrieve its
+	// ARN.
+	// +optional
+	SSEKMSKeyIDSelector *xpv1.Selector `json:"sseKmsKeyIdSelector,omitempty"`
+
+	// Metadata is a map of user-defined metadata to store with the object.
+	// Keys are stored in lower case by Amazon S3.
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// Tags is the set of tags of the object.
+	// +optional
+	Tags []v1beta1.Tag `json:"tags,omitempty"`
+
+	// IgnoreContentDrift disables the comparison of the content checksum of
+	// the object with the desired content. Changes of the desired content are
+	// still written to the object whenever another field needs an update.
+	// +optional
+	IgnoreContentDrift *bool `json:"ignoreContentDrift,omitempty"`
+}
+
+// ObjectObservation keeps the state for the external resource.
+type ObjectObservation struct {
+	// ETag is the entity tag of the object.
+	ETag *string `json:"etag,omitempty"`
+
+	// ChecksumSHA256 is the base64 encoded SHA-256 checksum of the object.
+	ChecksumSHA256 *string `json:"checksumSHA256,omitempty"`
+
+	// VersionID is the version of the object.
+	VersionID *string `json:"versionId,omitempty"`
+
+	// ContentLength is the size of the object in bytes.
+	ContentLength *int64 `json:"contentLength,omitempty"`
+}
+
+// ObjectSpec defines the desired state of an Object.
+type ObjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectParameters `json:"forProvider"`
+}
+
+// ObjectStatus represents the observed state of an Object.
+type ObjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Object is a managed resource that represents an AWS S3 Object.
+// +kubebuilder:printcolumn:name="BUCKET",type="string",JSONPath=".spec.forProvider.bucket"
+// +kubebuilder:printcolumn:name="KEY",type="string",JSONPath=".spec.forProvider.key"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Object struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectSpec   `json:"spec"`
+	Status ObjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectList contains a list of Objects
+type ObjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Object `json:"items"`
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// AnalyticsConfiguration specifies the configuration and any analyses for the
+// storage class analysis of an Amazon S3 bucket. For more information, see
+// Amazon S3 Analytics – Storage Class Analysis
+// (https://docs.aws.amazon.com/AmazonS3/latest/dev/analytics-storage-class.html)
+// in the Amazon Simple Storage Service Developer Guide.
+type AnalyticsConfiguration struct {
+	// The ID that identifies the analytics configuration.
+	ID string `json:"id"`
+
+	// The filter used to describe a set of objects for analyses. If no filter
+	// is provided, all objects will be considered in any analysis.
+	// +optional
+	Filter *AnalyticsFilter `json:"filter,omitempty"`
+
+	// Contains data related to access patterns to be collected and made
+	// available to analyze the tradeoffs between different storage classes.
+	StorageClassAnalysis StorageClassAnalysis `json:"storageClassAnalysis"`
+}
+
+// AnalyticsFilter describes a set of objects for analyses. A filter must have
+// exactly one of Prefix, Tag, or And specified.
+type AnalyticsFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating
+	// an analytics filter.
+	// +optional
+	And *AnalyticsAndOperator `json:"and,omitempty"`
+
+	// The prefix to use when evaluating an analytics filter.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The tag to use when evaluating an analytics filter.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// AnalyticsAndOperator is a conjunction (logical AND) of predicates, which is
+// used in evaluating an analytics filter.
+type AnalyticsAndOperator struct {
+	// The prefix to use when evaluating an AND predicate.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The list of tags to use when evaluating an AND predicate.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// StorageClassAnalysis specifies data related to access patterns to be
+// collected and made available to analyze the tradeoffs between different
+// storage classes for an Amazon S3 bucket.
+type StorageClassAnalysis struct {
+	// Specifies how data related to the storage class analysis for an Amazon
+	// S3 bucket should be exported. If unset, the analysis is only available
+	// in the console.
+	// +optional
+	DataExport *StorageClassAnalysisDataExport `json:"dataExport,omitempty"`
+}
+
+// StorageClassAnalysisDataExport specifies how data related to the storage
+// class analysis for an Amazon S3 bucket should be exported.
+type StorageClassAnalysisDataExport struct {
+	// The ARN of the bucket to which data is exported.
+	// At least one of bucketArn, bucketArnRef or bucketArnSelector is
+	// required.
+	// +optional
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketARN()
+	BucketARN *string `json:"bucketArn,omitempty"`
+
+	// BucketARNRef references a Bucket to retrieve its ARN.
+	// +optional
+	BucketARNRef *xpv1.Reference `json:"bucketArnRef,omitempty"`
+
+	// BucketARNSelector selects a reference to a Bucket to retrieve its ARN.
+	// +optional
+	BucketARNSelector *xpv1.Selector `json:"bucketArnSelector,omitempty"`
+
+	// The account ID that owns the destination S3 bucket. If no account ID is
+	// provided, the owner is not validated before exporting data.
+	// +optional
+	BucketAccountID *string `json:"bucketAccountId,omitempty"`
+
+	// The prefix to use when exporting data. The prefix is prepended to all
+	// results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+}
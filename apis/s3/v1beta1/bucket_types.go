@@ -178,6 +178,45 @@ type BucketParameters struct {
 
 	// PolicyUpdatePolicy specifies the update behaviour of `policy`.
 	PolicyUpdatePolicy *BucketPolicyUpdatePolicy `json:"policyUpdatePolicy,omitempty"`
+
+	// Specifies the S3 Intelligent-Tiering configurations of the bucket,
+	// identified by their ID. If unset, the configurations of the bucket are
+	// not managed. An empty list removes all configurations.
+	// +optional
+	IntelligentTieringConfigurations []IntelligentTieringConfiguration `json:"intelligentTieringConfigurations"`
+
+	// Specifies the inventory configurations of the bucket, identified by
+	// their ID. If unset, the configurations of the bucket are not managed.
+	// An empty list removes all configurations.
+	// +optional
+	InventoryConfigurations []InventoryConfiguration `json:"inventoryConfigurations"`
+
+	// Specifies the CloudWatch request metrics configurations of the bucket,
+	// identified by their ID. If unset, the configurations of the bucket are
+	// not managed. An empty list removes all configurations.
+	// +optional
+	MetricsConfigurations []MetricsConfiguration `json:"metricsConfigurations"`
+
+	// Specifies the storage class analytics configurations of the bucket,
+	// identified by their ID. If unset, the configurations of the bucket are
+	// not managed. An empty list removes all configurations.
+	// +optional
+	AnalyticsConfigurations []AnalyticsConfiguration `json:"analyticsConfigurations"`
+
+	// ForceDestroy indicates that all objects, object versions and delete
+	// markers should be deleted from the bucket before the bucket itself is
+	// deleted. Large buckets are emptied incrementally over several
+	// reconciles. Objects protected by an object lock in compliance mode or
+	// a legal hold are never deleted and block the deletion of the bucket.
+	// +optional
+	ForceDestroy *bool `json:"forceDestroy,omitempty"`
+
+	// BypassGovernanceRetention indicates whether objects protected by an
+	// object lock in governance mode should be deleted when the bucket is
+	// emptied because of forceDestroy. This requires the
+	// s3:BypassGovernanceRetention permission.
+	// +optional
+	BypassGovernanceRetention *bool `json:"bypassGovernanceRetention,omitempty"`
 }
 
 // BucketPolicyUpdatePolicy specifies the update behaviour of a bucket policy.
@@ -216,6 +255,13 @@ const (
 type BucketSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       BucketParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // BucketExternalStatus keeps the state for the external resource
@@ -224,6 +270,12 @@ type BucketExternalStatus struct {
 	// about ARNs and how to use them, see S3 Resources (https://docs.aws.amazon.com/AmazonS3/latest/dev/s3-arn-format.html)
 	// in the Amazon Simple Storage Service guide.
 	ARN string `json:"arn"`
+
+	// DeletedObjects is the number of object versions and delete markers that
+	// have been deleted so far while emptying the bucket because of
+	// forceDestroy.
+	// +optional
+	DeletedObjects *int64 `json:"deletedObjects,omitempty"`
 }
 
 // BucketStatus represents the observed state of the Bucket.
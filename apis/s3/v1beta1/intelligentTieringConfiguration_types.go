@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// IntelligentTieringConfiguration specifies the S3 Intelligent-Tiering
+// configuration for an Amazon S3 bucket. For more information, see Storage
+// class for automatically optimizing frequently and infrequently accessed
+// objects (https://docs.aws.amazon.com/AmazonS3/latest/dev/storage-class-intro.html#sc-dynamic-data-access).
+type IntelligentTieringConfiguration struct {
+	// The ID used to identify the S3 Intelligent-Tiering configuration.
+	ID string `json:"id"`
+
+	// Specifies a bucket filter. The configuration only includes objects that
+	// meet the filter's criteria.
+	// +optional
+	Filter *IntelligentTieringFilter `json:"filter,omitempty"`
+
+	// Specifies the status of the configuration.
+	// +kubebuilder:validation:Enum=Enabled;Disabled
+	Status string `json:"status"`
+
+	// Specifies the S3 Intelligent-Tiering storage class tier of the
+	// configuration.
+	Tierings []Tiering `json:"tierings"`
+}
+
+// IntelligentTieringFilter specifies the objects an S3 Intelligent-Tiering
+// configuration applies to. A filter must have at most one of Prefix, Tag, or
+// And specified.
+type IntelligentTieringFilter struct {
+	// A conjunction (logical AND) of predicates, which is used in evaluating
+	// an S3 Intelligent-Tiering configuration.
+	// +optional
+	And *IntelligentTieringAndOperator `json:"and,omitempty"`
+
+	// An object key name prefix that identifies the subset of objects to
+	// which the configuration applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// A tag that objects must have for the configuration to apply.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// IntelligentTieringAndOperator is a conjunction (logical AND) of predicates,
+// which is used in evaluating an S3 Intelligent-Tiering configuration.
+type IntelligentTieringAndOperator struct {
+	// An object key name prefix that identifies the subset of objects to
+	// which the configuration applies.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// All of these tags must exist in the object's tag set in order for the
+	// configuration to apply.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// Tiering defines the number of days after which objects are moved to an
+// archive access tier.
+type Tiering struct {
+	// S3 Intelligent-Tiering access tier.
+	// +kubebuilder:validation:Enum=ARCHIVE_ACCESS;DEEP_ARCHIVE_ACCESS
+	AccessTier string `json:"accessTier"`
+
+	// The number of consecutive days of no access after which an object will
+	// be eligible to be transitioned to the corresponding tier.
+	Days int32 `json:"days"`
+}
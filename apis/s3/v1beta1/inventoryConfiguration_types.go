@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+// InventoryConfiguration specifies the inventory configuration for an Amazon
+// S3 bucket. For more information, see GET Bucket inventory
+// (https://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketGETInventoryConfig.html)
+// in the Amazon Simple Storage Service API Reference.
+type InventoryConfiguration struct {
+	// The ID used to identify the inventory configuration.
+	ID string `json:"id"`
+
+	// Contains information about where to publish the inventory results.
+	Destination InventoryDestination `json:"destination"`
+
+	// Specifies an inventory filter. The inventory only includes objects that
+	// meet the filter's criteria.
+	// +optional
+	Filter *InventoryFilter `json:"filter,omitempty"`
+
+	// Object versions to include in the inventory list. If set to All, the
+	// list includes all the object versions, which adds the version-related
+	// fields VersionId, IsLatest, and DeleteMarker to the list. If set to
+	// Current, the list does not contain these version-related fields.
+	// +kubebuilder:validation:Enum=All;Current
+	IncludedObjectVersions string `json:"includedObjectVersions"`
+
+	// Specifies whether the inventory is enabled or disabled.
+	IsEnabled bool `json:"isEnabled"`
+
+	// Contains the optional fields that are included in the inventory
+	// results.
+	// +optional
+	OptionalFields []string `json:"optionalFields,omitempty"`
+
+	// Specifies how frequently inventory results are produced.
+	// +kubebuilder:validation:Enum=Daily;Weekly
+	Frequency string `json:"frequency"`
+}
+
+// InventoryDestination specifies the inventory configuration for an Amazon S3
+// bucket.
+type InventoryDestination struct {
+	// The ARN of the bucket where inventory results will be published.
+	// At least one of bucketArn, bucketArnRef or bucketArnSelector is
+	// required.
+	// +optional
+	// +crossplane:generate:reference:type=Bucket
+	// +crossplane:generate:reference:extractor=BucketARN()
+	BucketARN *string `json:"bucketArn,omitempty"`
+
+	// BucketARNRef references a Bucket to retrieve its ARN.
+	// +optional
+	BucketARNRef *xpv1.Reference `json:"bucketArnRef,omitempty"`
+
+	// BucketARNSelector selects a reference to a Bucket to retrieve its ARN.
+	// +optional
+	BucketARNSelector *xpv1.Selector `json:"bucketArnSelector,omitempty"`
+
+	// The account ID that owns the destination S3 bucket. If no account ID is
+	// provided, the owner is not validated before exporting data.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Specifies the output format of the inventory results.
+	// +kubebuilder:validation:Enum=CSV;ORC;Parquet
+	Format string `json:"format"`
+
+	// The prefix that is prepended to all inventory results.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Contains the type of server-side encryption used to encrypt the
+	// inventory results.
+	// +optional
+	Encryption *InventoryEncryption `json:"encryption,omitempty"`
+}
+
+// InventoryEncryption contains the type of server-side encryption used to
+// encrypt the inventory results. At most one of sseS3 or sseKmsKeyId should
+// be set.
+type InventoryEncryption struct {
+	// Specifies the use of SSE-S3 to encrypt delivered inventory reports.
+	// +optional
+	SSES3 bool `json:"sseS3,omitempty"`
+
+	// Specifies the ID of the AWS Key Management Service (AWS KMS) symmetric
+	// encryption customer managed key to use for encrypting inventory
+	// reports.
+	// +optional
+	SSEKMSKeyID *string `json:"sseKmsKeyId,omitempty"`
+}
+
+// InventoryFilter specifies an inventory filter. The inventory only includes
+// objects that meet the filter's criteria.
+type InventoryFilter struct {
+	// The prefix that an object must have to be included in the inventory
+	// results.
+	Prefix string `json:"prefix"`
+}
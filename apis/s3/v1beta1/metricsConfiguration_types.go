@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// MetricsConfiguration specifies a metrics configuration for the CloudWatch
+// request metrics of an Amazon S3 bucket. For more information, see PUT
+// Bucket metrics (https://docs.aws.amazon.com/AmazonS3/latest/API/RESTBucketPUTMetricConfiguration.html)
+// in the Amazon Simple Storage Service API Reference.
+type MetricsConfiguration struct {
+	// The ID used to identify the metrics configuration.
+	ID string `json:"id"`
+
+	// Specifies a metrics configuration filter. The metrics configuration
+	// will only include objects that meet the filter's criteria.
+	// +optional
+	Filter *MetricsFilter `json:"filter,omitempty"`
+}
+
+// MetricsFilter specifies the objects a metrics configuration applies to. A
+// filter must have exactly one of Prefix, Tag, AccessPointARN or And
+// specified.
+type MetricsFilter struct {
+	// The access point ARN used when evaluating a metrics filter.
+	// +optional
+	AccessPointARN *string `json:"accessPointArn,omitempty"`
+
+	// A conjunction (logical AND) of predicates, which is used in evaluating
+	// a metrics filter.
+	// +optional
+	And *MetricsAndOperator `json:"and,omitempty"`
+
+	// The prefix used when evaluating a metrics filter.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The tag used when evaluating a metrics filter.
+	// +optional
+	Tag *Tag `json:"tag,omitempty"`
+}
+
+// MetricsAndOperator is a conjunction (logical AND) of predicates, which is
+// used in evaluating a metrics filter.
+type MetricsAndOperator struct {
+	// The access point ARN used when evaluating an AND predicate.
+	// +optional
+	AccessPointARN *string `json:"accessPointArn,omitempty"`
+
+	// The prefix used when evaluating an AND predicate.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// The list of tags used when evaluating an AND predicate.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
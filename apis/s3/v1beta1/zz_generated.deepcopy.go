@@ -71,6 +71,82 @@ func (in *AccessControlTranslation) DeepCopy() *AccessControlTranslation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsAndOperator) DeepCopyInto(out *AnalyticsAndOperator) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsAndOperator.
+func (in *AnalyticsAndOperator) DeepCopy() *AnalyticsAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsConfiguration) DeepCopyInto(out *AnalyticsConfiguration) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(AnalyticsFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	in.StorageClassAnalysis.DeepCopyInto(&out.StorageClassAnalysis)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsConfiguration.
+func (in *AnalyticsConfiguration) DeepCopy() *AnalyticsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnalyticsFilter) DeepCopyInto(out *AnalyticsFilter) {
+	*out = *in
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(AnalyticsAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnalyticsFilter.
+func (in *AnalyticsFilter) DeepCopy() *AnalyticsFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(AnalyticsFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Bucket) DeepCopyInto(out *Bucket) {
 	*out = *in
@@ -101,6 +177,11 @@ func (in *Bucket) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketExternalStatus) DeepCopyInto(out *BucketExternalStatus) {
 	*out = *in
+	if in.DeletedObjects != nil {
+		in, out := &in.DeletedObjects, &out.DeletedObjects
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketExternalStatus.
@@ -285,6 +366,44 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(BucketPolicyUpdatePolicy)
 		**out = **in
 	}
+	if in.IntelligentTieringConfigurations != nil {
+		in, out := &in.IntelligentTieringConfigurations, &out.IntelligentTieringConfigurations
+		*out = make([]IntelligentTieringConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InventoryConfigurations != nil {
+		in, out := &in.InventoryConfigurations, &out.InventoryConfigurations
+		*out = make([]InventoryConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MetricsConfigurations != nil {
+		in, out := &in.MetricsConfigurations, &out.MetricsConfigurations
+		*out = make([]MetricsConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AnalyticsConfigurations != nil {
+		in, out := &in.AnalyticsConfigurations, &out.AnalyticsConfigurations
+		*out = make([]AnalyticsConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ForceDestroy != nil {
+		in, out := &in.ForceDestroy, &out.ForceDestroy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BypassGovernanceRetention != nil {
+		in, out := &in.BypassGovernanceRetention, &out.BypassGovernanceRetention
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -317,6 +436,13 @@ func (in *BucketSpec) DeepCopyInto(out *BucketSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.ConnectionDetailsTemplate != nil {
+		in, out := &in.ConnectionDetailsTemplate, &out.ConnectionDetailsTemplate
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketSpec.
@@ -333,7 +459,7 @@ func (in *BucketSpec) DeepCopy() *BucketSpec {
 func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
@@ -623,6 +749,192 @@ func (in *IndexDocument) DeepCopy() *IndexDocument {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelligentTieringAndOperator) DeepCopyInto(out *IntelligentTieringAndOperator) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelligentTieringAndOperator.
+func (in *IntelligentTieringAndOperator) DeepCopy() *IntelligentTieringAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelligentTieringAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelligentTieringConfiguration) DeepCopyInto(out *IntelligentTieringConfiguration) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(IntelligentTieringFilter)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tierings != nil {
+		in, out := &in.Tierings, &out.Tierings
+		*out = make([]Tiering, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelligentTieringConfiguration.
+func (in *IntelligentTieringConfiguration) DeepCopy() *IntelligentTieringConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelligentTieringConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntelligentTieringFilter) DeepCopyInto(out *IntelligentTieringFilter) {
+	*out = *in
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(IntelligentTieringAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntelligentTieringFilter.
+func (in *IntelligentTieringFilter) DeepCopy() *IntelligentTieringFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(IntelligentTieringFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryConfiguration) DeepCopyInto(out *InventoryConfiguration) {
+	*out = *in
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(InventoryFilter)
+		**out = **in
+	}
+	if in.OptionalFields != nil {
+		in, out := &in.OptionalFields, &out.OptionalFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryConfiguration.
+func (in *InventoryConfiguration) DeepCopy() *InventoryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryDestination) DeepCopyInto(out *InventoryDestination) {
+	*out = *in
+	if in.BucketARN != nil {
+		in, out := &in.BucketARN, &out.BucketARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketARNRef != nil {
+		in, out := &in.BucketARNRef, &out.BucketARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketARNSelector != nil {
+		in, out := &in.BucketARNSelector, &out.BucketARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccountID != nil {
+		in, out := &in.AccountID, &out.AccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(InventoryEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryDestination.
+func (in *InventoryDestination) DeepCopy() *InventoryDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryEncryption) DeepCopyInto(out *InventoryEncryption) {
+	*out = *in
+	if in.SSEKMSKeyID != nil {
+		in, out := &in.SSEKMSKeyID, &out.SSEKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryEncryption.
+func (in *InventoryEncryption) DeepCopy() *InventoryEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryFilter) DeepCopyInto(out *InventoryFilter) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryFilter.
+func (in *InventoryFilter) DeepCopy() *InventoryFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaFunctionConfiguration) DeepCopyInto(out *LambdaFunctionConfiguration) {
 	*out = *in
@@ -836,6 +1148,91 @@ func (in *Metrics) DeepCopy() *Metrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsAndOperator) DeepCopyInto(out *MetricsAndOperator) {
+	*out = *in
+	if in.AccessPointARN != nil {
+		in, out := &in.AccessPointARN, &out.AccessPointARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsAndOperator.
+func (in *MetricsAndOperator) DeepCopy() *MetricsAndOperator {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsAndOperator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsConfiguration) DeepCopyInto(out *MetricsConfiguration) {
+	*out = *in
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(MetricsFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsConfiguration.
+func (in *MetricsConfiguration) DeepCopy() *MetricsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetricsFilter) DeepCopyInto(out *MetricsFilter) {
+	*out = *in
+	if in.AccessPointARN != nil {
+		in, out := &in.AccessPointARN, &out.AccessPointARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.And != nil {
+		in, out := &in.And, &out.And
+		*out = new(MetricsAndOperator)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(Tag)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetricsFilter.
+func (in *MetricsFilter) DeepCopy() *MetricsFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(MetricsFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NoncurrentVersionExpiration) DeepCopyInto(out *NoncurrentVersionExpiration) {
 	*out = *in
@@ -1393,6 +1790,66 @@ func (in *SseKmsEncryptedObjects) DeepCopy() *SseKmsEncryptedObjects {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassAnalysis) DeepCopyInto(out *StorageClassAnalysis) {
+	*out = *in
+	if in.DataExport != nil {
+		in, out := &in.DataExport, &out.DataExport
+		*out = new(StorageClassAnalysisDataExport)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassAnalysis.
+func (in *StorageClassAnalysis) DeepCopy() *StorageClassAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClassAnalysisDataExport) DeepCopyInto(out *StorageClassAnalysisDataExport) {
+	*out = *in
+	if in.BucketARN != nil {
+		in, out := &in.BucketARN, &out.BucketARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketARNRef != nil {
+		in, out := &in.BucketARNRef, &out.BucketARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketARNSelector != nil {
+		in, out := &in.BucketARNSelector, &out.BucketARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BucketAccountID != nil {
+		in, out := &in.BucketAccountID, &out.BucketAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClassAnalysisDataExport.
+func (in *StorageClassAnalysisDataExport) DeepCopy() *StorageClassAnalysisDataExport {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClassAnalysisDataExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -1479,6 +1936,21 @@ func (in *TargetGrantee) DeepCopy() *TargetGrantee {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tiering) DeepCopyInto(out *Tiering) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tiering.
+func (in *Tiering) DeepCopy() *Tiering {
+	if in == nil {
+		return nil
+	}
+	out := new(Tiering)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopicConfiguration) DeepCopyInto(out *TopicConfiguration) {
 	*out = *in
@@ -174,6 +174,44 @@ func (mg *Bucket) ResolveReferences(ctx context.Context, c client.Reader) error
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.InventoryConfigurations); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.BucketARN),
+			Extract:      BucketARN(),
+			Reference:    mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.BucketARNRef,
+			Selector:     mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.BucketARNSelector,
+			To: reference.To{
+				List:    &BucketList{},
+				Managed: &Bucket{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.BucketARN")
+		}
+		mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.BucketARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.InventoryConfigurations[i3].Destination.BucketARNRef = rsp.ResolvedReference
+
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.AnalyticsConfigurations); i3++ {
+		if mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport != nil {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.BucketARN),
+				Extract:      BucketARN(),
+				Reference:    mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.BucketARNRef,
+				Selector:     mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.BucketARNSelector,
+				To: reference.To{
+					List:    &BucketList{},
+					Managed: &Bucket{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.BucketARN")
+			}
+			mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.BucketARN = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.AnalyticsConfigurations[i3].StorageClassAnalysis.DataExport.BucketARNRef = rsp.ResolvedReference
+
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,208 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+)
+
+// AnnotationKeyCreateRequestTokenARN is the key in the annotations map of a
+// MultiRegionAccessPoint that holds the request token of its create operation
+// until the operation has finished. The status can't hold it because it is
+// not persisted after the creation.
+const AnnotationKeyCreateRequestTokenARN = Group + "/create-request-token-arn"
+
+// MultiRegionAccessPointRegion is a bucket that is associated with a
+// Multi-Region Access Point.
+type MultiRegionAccessPointRegion struct {
+	// The name of the associated bucket for the Region.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef is a reference to a Bucket used to set Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket used to set Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// The Amazon Web Services account ID that owns the Amazon S3 bucket that's
+	// associated with this Multi-Region Access Point.
+	// +optional
+	BucketAccountID *string `json:"bucketAccountID,omitempty"`
+}
+
+// MultiRegionAccessPointRoute is the routing configuration of a bucket of a
+// Multi-Region Access Point.
+type MultiRegionAccessPointRoute struct {
+	// The name of the Amazon S3 bucket of the route. Either the Bucket or the
+	// Region value must be provided. If both are provided, the bucket must be
+	// in the specified Region.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1.Bucket
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef is a reference to a Bucket used to set Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket used to set Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// The Amazon Web Services Region of the route.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// The traffic state for the bucket or Region. A value of 0 indicates a
+	// passive state, which means that no new traffic will be routed to the
+	// Region. A value of 100 indicates an active state, which means that
+	// traffic will be routed to the Region.
+	// +kubebuilder:validation:Enum=0;100
+	TrafficDialPercentage int64 `json:"trafficDialPercentage"`
+}
+
+// PublicAccessBlockConfiguration specifies the public access block settings
+// of an access point.
+type PublicAccessBlockConfiguration struct {
+	// Specifies whether Amazon S3 should block public access control lists
+	// (ACLs) for buckets in this account.
+	// +optional
+	BlockPublicACLs *bool `json:"blockPublicACLs,omitempty"`
+
+	// Specifies whether Amazon S3 should block public bucket policies for
+	// buckets in this account.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// Specifies whether Amazon S3 should ignore public ACLs for buckets in this
+	// account.
+	// +optional
+	IgnorePublicACLs *bool `json:"ignorePublicACLs,omitempty"`
+
+	// Specifies whether Amazon S3 should restrict public bucket policies for
+	// buckets in this account.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// MultiRegionAccessPointParameters define the desired state of an AWS S3
+// Multi-Region Access Point. The Multi-Region Access Point is managed through
+// the us-west-2 control plane of Amazon S3.
+type MultiRegionAccessPointParameters struct {
+	// The Amazon Web Services account ID for the owner of the Multi-Region
+	// Access Point. The owner must also own the underlying buckets. Defaults to
+	// the account of the provider credentials.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountID,omitempty"`
+
+	// The buckets in different Regions that are associated with the
+	// Multi-Region Access Point.
+	// +kubebuilder:validation:MinItems=1
+	// +immutable
+	Regions []MultiRegionAccessPointRegion `json:"regions"`
+
+	// The PublicAccessBlock configuration of the Multi-Region Access Point.
+	// +optional
+	// +immutable
+	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// The policy of the Multi-Region Access Point. Amazon S3 does not support
+	// removing the policy of a Multi-Region Access Point, so unsetting it
+	// leaves the current policy in place.
+	// +optional
+	Policy *common.BucketPolicyBody `json:"policy,omitempty"`
+
+	// The routing configuration of the Multi-Region Access Point, used to fail
+	// over traffic between its buckets. If unset, the routes are not managed.
+	// +optional
+	Routes []MultiRegionAccessPointRoute `json:"routes,omitempty"`
+}
+
+// A MultiRegionAccessPointSpec defines the desired state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MultiRegionAccessPointParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// MultiRegionAccessPointObservation keeps the state for the external resource.
+type MultiRegionAccessPointObservation struct {
+	// The alias of the Multi-Region Access Point.
+	Alias *string `json:"alias,omitempty"`
+
+	// The ARN of the Multi-Region Access Point.
+	ARN *string `json:"arn,omitempty"`
+
+	// The current status of the Multi-Region Access Point.
+	Status *string `json:"status,omitempty"`
+
+	// When the Multi-Region Access Point create request was received.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// The request token of the asynchronous delete or put policy operation
+	// that is in progress, if any.
+	RequestTokenARN *string `json:"requestTokenARN,omitempty"`
+}
+
+// A MultiRegionAccessPointStatus represents the observed state of a
+// MultiRegionAccessPoint.
+type MultiRegionAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MultiRegionAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MultiRegionAccessPoint is a managed resource that represents an AWS S3
+// Multi-Region Access Point.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".status.atProvider.alias"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MultiRegionAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MultiRegionAccessPointSpec   `json:"spec"`
+	Status MultiRegionAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MultiRegionAccessPointList contains a list of MultiRegionAccessPoints
+type MultiRegionAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MultiRegionAccessPoint `json:"items"`
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manualv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/common"
+)
+
+// AWSLambdaTransformation is a Lambda function that transforms the objects
+// served by an Object Lambda Access Point.
+type AWSLambdaTransformation struct {
+	// The Amazon Resource Name (ARN) of the Lambda function.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	FunctionARN *string `json:"functionARN,omitempty"`
+
+	// FunctionARNRef is a reference to a Function used to set FunctionARN.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionARNRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a Function used to set
+	// FunctionARN.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionARNSelector,omitempty"`
+
+	// Additional JSON that provides supplemental data to the Lambda function
+	// used to transform objects.
+	// +optional
+	FunctionPayload *string `json:"functionPayload,omitempty"`
+}
+
+// ObjectLambdaContentTransformation is the content transformation of an
+// Object Lambda Access Point configuration.
+type ObjectLambdaContentTransformation struct {
+	// The Lambda function that transforms the content.
+	AWSLambda AWSLambdaTransformation `json:"awsLambda"`
+}
+
+// ObjectLambdaTransformationConfiguration is a transformation configuration
+// of an Object Lambda Access Point.
+type ObjectLambdaTransformationConfiguration struct {
+	// The actions that are transformed. Valid inputs are GetObject,
+	// ListObjects, HeadObject, and ListObjectsV2.
+	// +kubebuilder:validation:MinItems=1
+	Actions []string `json:"actions"`
+
+	// The content transformation of the actions.
+	ContentTransformation ObjectLambdaContentTransformation `json:"contentTransformation"`
+}
+
+// ObjectLambdaConfiguration is the configuration of an Object Lambda Access
+// Point.
+type ObjectLambdaConfiguration struct {
+	// The features that are allowed. Valid inputs are GetObject-Range,
+	// GetObject-PartNumber, HeadObject-Range, and HeadObject-PartNumber.
+	// +optional
+	AllowedFeatures []string `json:"allowedFeatures,omitempty"`
+
+	// Whether the CloudWatch metrics configuration is enabled.
+	// +optional
+	CloudWatchMetricsEnabled *bool `json:"cloudWatchMetricsEnabled,omitempty"`
+
+	// The ARN of the standard access point that is associated with the Object
+	// Lambda Access Point.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1.AccessPoint
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/s3control/v1alpha1.AccessPointARN()
+	SupportingAccessPoint *string `json:"supportingAccessPoint,omitempty"`
+
+	// SupportingAccessPointRef is a reference to an AccessPoint used to set
+	// SupportingAccessPoint.
+	// +optional
+	SupportingAccessPointRef *xpv1.Reference `json:"supportingAccessPointRef,omitempty"`
+
+	// SupportingAccessPointSelector selects a reference to an AccessPoint used
+	// to set SupportingAccessPoint.
+	// +optional
+	SupportingAccessPointSelector *xpv1.Selector `json:"supportingAccessPointSelector,omitempty"`
+
+	// The transformation configurations of the Object Lambda Access Point.
+	// +kubebuilder:validation:MinItems=1
+	TransformationConfigurations []ObjectLambdaTransformationConfiguration `json:"transformationConfigurations"`
+}
+
+// ObjectLambdaAccessPointParameters define the desired state of an AWS S3
+// Object Lambda Access Point.
+type ObjectLambdaAccessPointParameters struct {
+	// Region is which region the ObjectLambdaAccessPoint will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The Amazon Web Services account ID for the owner of the Object Lambda
+	// Access Point. Defaults to the account of the provider credentials.
+	// +optional
+	// +immutable
+	AccountID *string `json:"accountID,omitempty"`
+
+	// The configuration of the Object Lambda Access Point.
+	Configuration ObjectLambdaConfiguration `json:"configuration"`
+
+	// The resource policy of the Object Lambda Access Point.
+	// +optional
+	Policy *common.BucketPolicyBody `json:"policy,omitempty"`
+}
+
+// An ObjectLambdaAccessPointSpec defines the desired state of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ObjectLambdaAccessPointParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
+}
+
+// ObjectLambdaAccessPointObservation keeps the state for the external
+// resource.
+type ObjectLambdaAccessPointObservation struct {
+	// The alias of the Object Lambda Access Point.
+	Alias *string `json:"alias,omitempty"`
+
+	// The status of the alias of the Object Lambda Access Point.
+	AliasStatus *string `json:"aliasStatus,omitempty"`
+
+	// The ARN of the Object Lambda Access Point.
+	ARN *string `json:"arn,omitempty"`
+
+	// When the Object Lambda Access Point was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// An ObjectLambdaAccessPointStatus represents the observed state of an
+// ObjectLambdaAccessPoint.
+type ObjectLambdaAccessPointStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ObjectLambdaAccessPointObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ObjectLambdaAccessPoint is a managed resource that represents an AWS S3
+// Object Lambda Access Point.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ObjectLambdaAccessPoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ObjectLambdaAccessPointSpec   `json:"spec"`
+	Status ObjectLambdaAccessPointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ObjectLambdaAccessPointList contains a list of ObjectLambdaAccessPoints
+type ObjectLambdaAccessPointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObjectLambdaAccessPoint `json:"items"`
+}
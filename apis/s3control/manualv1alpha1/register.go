@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package manualv1alpha1 is the v1alpha1 version of the s3control.aws.crossplane.io API.
+// +kubebuilder:object:generate=true
+// +groupName=s3control.aws.crossplane.io
+// +versionName=v1alpha1
+package manualv1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "s3control.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// MultiRegionAccessPoint type metadata.
+var (
+	MultiRegionAccessPointKind             = reflect.TypeOf(MultiRegionAccessPoint{}).Name()
+	MultiRegionAccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: MultiRegionAccessPointKind}.String()
+	MultiRegionAccessPointKindAPIVersion   = MultiRegionAccessPointKind + "." + SchemeGroupVersion.String()
+	MultiRegionAccessPointGroupVersionKind = SchemeGroupVersion.WithKind(MultiRegionAccessPointKind)
+)
+
+// ObjectLambdaAccessPoint type metadata.
+var (
+	ObjectLambdaAccessPointKind             = reflect.TypeOf(ObjectLambdaAccessPoint{}).Name()
+	ObjectLambdaAccessPointGroupKind        = schema.GroupKind{Group: Group, Kind: ObjectLambdaAccessPointKind}.String()
+	ObjectLambdaAccessPointKindAPIVersion   = ObjectLambdaAccessPointKind + "." + SchemeGroupVersion.String()
+	ObjectLambdaAccessPointGroupVersionKind = SchemeGroupVersion.WithKind(ObjectLambdaAccessPointKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MultiRegionAccessPoint{}, &MultiRegionAccessPointList{})
+	SchemeBuilder.Register(&ObjectLambdaAccessPoint{}, &ObjectLambdaAccessPointList{})
+}
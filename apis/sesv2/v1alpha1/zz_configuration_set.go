@@ -56,6 +56,13 @@ type ConfigurationSetParameters struct {
 type ConfigurationSetSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ConfigurationSetParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ConfigurationSetObservation defines the observed state of ConfigurationSet
@@ -49,6 +49,13 @@ type EmailIdentityParameters struct {
 type EmailIdentitySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       EmailIdentityParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // EmailIdentityObservation defines the observed state of EmailIdentity
@@ -40,6 +40,13 @@ type EmailTemplateParameters struct {
 type EmailTemplateSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       EmailTemplateParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // EmailTemplateObservation defines the observed state of EmailTemplate
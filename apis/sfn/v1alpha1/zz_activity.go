@@ -67,6 +67,13 @@ type ActivityParameters struct {
 type ActivitySpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ActivityParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ActivityObservation defines the observed state of Activity
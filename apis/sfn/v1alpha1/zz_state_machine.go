@@ -83,6 +83,13 @@ type StateMachineParameters struct {
 type StateMachineSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       StateMachineParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // StateMachineObservation defines the observed state of StateMachine
@@ -188,6 +188,13 @@ type QueueParameters struct {
 type QueueSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       QueueParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // QueueObservation is the representation of the current state that is observed
@@ -212,6 +212,13 @@ type ServerParameters struct {
 type ServerSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ServerParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // ServerObservation defines the observed state of Server
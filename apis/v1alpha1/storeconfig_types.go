@@ -24,6 +24,60 @@ import (
 // A StoreConfigSpec defines the desired state of a ProviderConfig.
 type StoreConfigSpec struct {
 	xpv1.SecretStoreConfig `json:",inline"`
+
+	// AWS configures a secret store backed by AWS Secrets Manager or AWS
+	// Systems Manager Parameter Store. If set, it takes precedence over the
+	// store selected with type.
+	// +optional
+	AWS *AWSSecretStoreConfig `json:"aws,omitempty"`
+}
+
+// AWSSecretStoreType is the AWS service used to store connection details.
+// +kubebuilder:validation:Enum=SecretsManager;ParameterStore
+type AWSSecretStoreType string
+
+// AWS secret store types.
+const (
+	// AWSSecretStoreSecretsManager stores connection details as a JSON
+	// document in an AWS Secrets Manager secret.
+	AWSSecretStoreSecretsManager AWSSecretStoreType = "SecretsManager"
+
+	// AWSSecretStoreParameterStore stores connection details as a JSON
+	// document in an AWS Systems Manager SecureString parameter.
+	AWSSecretStoreParameterStore AWSSecretStoreType = "ParameterStore"
+)
+
+// AWSSecretStoreConfig configures an AWS secret store.
+type AWSSecretStoreConfig struct {
+	// Type of the AWS secret store.
+	// +optional
+	// +kubebuilder:default=SecretsManager
+	Type AWSSecretStoreType `json:"type,omitempty"`
+
+	// ProviderConfigReference specifies the ProviderConfig whose
+	// credentials are used to access the secret store.
+	// +optional
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// Region of the secret store.
+	Region string `json:"region"`
+
+	// Prefix is prepended to the names of the secrets written to the store.
+	// Secrets are named <prefix><scope>/<name>, where scope is the namespace
+	// of the connection secret owner or the defaultScope for cluster scoped
+	// resources.
+	// +optional
+	Prefix *string `json:"prefix,omitempty"`
+
+	// KMSKeyID is the ARN, key ID or alias of the KMS key used to encrypt
+	// the secrets. The AWS managed key of the service is used if omitted.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// Tags added to every secret written to the store.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // A StoreConfigStatus represents the status of a StoreConfig.
@@ -60,7 +114,24 @@ type StoreConfigList struct {
 
 // GetStoreConfig returns SecretStoreConfig
 func (in *StoreConfig) GetStoreConfig() xpv1.SecretStoreConfig {
-	return in.Spec.SecretStoreConfig
+	if in.Spec.AWS == nil {
+		return in.Spec.SecretStoreConfig
+	}
+	// crossplane-runtime only knows about its in-tree stores. AWS stores are
+	// served in-process, so we present them as a plugin store whose config
+	// reference points back to this StoreConfig and let our store builder
+	// pick them up from there.
+	sc := *in.Spec.SecretStoreConfig.DeepCopy()
+	t := xpv1.SecretStorePlugin
+	sc.Type = &t
+	sc.Plugin = &xpv1.PluginStoreConfig{
+		ConfigRef: xpv1.Config{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       StoreConfigKind,
+			Name:       in.GetName(),
+		},
+	}
+	return sc
 }
 
 // GetCondition of this StoreConfig.
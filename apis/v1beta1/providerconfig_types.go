@@ -51,12 +51,39 @@ type ProviderConfigSpec struct {
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;PodIdentity
 	Source xpv1.CredentialsSource `json:"source"`
 
+	// PodIdentity configures the EKS Pod Identity agent the credentials are
+	// retrieved from. It is only used if the source is PodIdentity.
+	// +optional
+	PodIdentity *PodIdentityOptions `json:"podIdentity,omitempty"`
+
 	xpv1.CommonCredentialSelectors `json:",inline"`
 }
 
+// CredentialsSourcePodIdentity indicates that the provider should retrieve
+// its credentials from the container credentials endpoint of the EKS Pod
+// Identity agent.
+const CredentialsSourcePodIdentity xpv1.CredentialsSource = "PodIdentity"
+
+// PodIdentityOptions define where the credentials of the EKS Pod Identity
+// agent are retrieved from.
+type PodIdentityOptions struct {
+	// Endpoint is the full URI of the container credentials endpoint.
+	// Defaults to the value of the AWS_CONTAINER_CREDENTIALS_FULL_URI
+	// environment variable injected by EKS.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+
+	// TokenFile is the path of the file containing the authorization token
+	// sent to the endpoint. Defaults to the value of the
+	// AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE environment variable injected by
+	// EKS.
+	// +optional
+	TokenFile *string `json:"tokenFile,omitempty"`
+}
+
 // Tag is session tag that can be used to assume an IAM Role
 type Tag struct {
 	// Name of the tag.
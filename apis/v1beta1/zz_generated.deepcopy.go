@@ -142,6 +142,31 @@ func (in *EndpointConfig) DeepCopy() *EndpointConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodIdentityOptions) DeepCopyInto(out *PodIdentityOptions) {
+	*out = *in
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.TokenFile != nil {
+		in, out := &in.TokenFile, &out.TokenFile
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodIdentityOptions.
+func (in *PodIdentityOptions) DeepCopy() *PodIdentityOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PodIdentityOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfig) DeepCopyInto(out *ProviderConfig) {
 	*out = *in
@@ -319,6 +344,11 @@ func (in *ProviderConfigUsageList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderCredentials) DeepCopyInto(out *ProviderCredentials) {
 	*out = *in
+	if in.PodIdentity != nil {
+		in, out := &in.PodIdentity, &out.PodIdentity
+		*out = new(PodIdentityOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.CommonCredentialSelectors.DeepCopyInto(&out.CommonCredentialSelectors)
 }
 
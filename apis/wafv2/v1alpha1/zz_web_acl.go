@@ -110,6 +110,13 @@ type WebACLParameters struct {
 type WebACLSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       WebACLParameters `json:"forProvider"`
+
+	// ConnectionDetailsTemplate maps connection detail keys to Go templates
+	// that are rendered over the connection details of this resource
+	// (.Details) and its observed state (.AtProvider). Rendered keys are
+	// published along with the connection details on every reconcile.
+	// +optional
+	ConnectionDetailsTemplate map[string]string `json:"connectionDetailsTemplate,omitempty"`
 }
 
 // WebACLObservation defines the observed state of WebACL
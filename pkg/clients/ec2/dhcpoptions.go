@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// DHCPOptionsIDNotFound is the code that is returned by ec2 when the given
+	// DhcpOptionsId is not valid
+	DHCPOptionsIDNotFound = "InvalidDhcpOptionID.NotFound"
+
+	// DefaultDHCPOptionsID is the ID to associate a VPC with the default DHCP
+	// options set, i.e. AmazonProvidedDNS.
+	DefaultDHCPOptionsID = "default"
+)
+
+// DHCP configuration keys of ec2.
+const (
+	dhcpDomainName         = "domain-name"
+	dhcpDomainNameServers  = "domain-name-servers"
+	dhcpNTPServers         = "ntp-servers"
+	dhcpNetBIOSNameServers = "netbios-name-servers"
+	dhcpNetBIOSNodeType    = "netbios-node-type"
+)
+
+// DHCPOptionsClient is the external client used for DHCPOptions Custom Resource
+type DHCPOptionsClient interface {
+	CreateDhcpOptions(ctx context.Context, input *ec2.CreateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.CreateDhcpOptionsOutput, error)
+	DescribeDhcpOptions(ctx context.Context, input *ec2.DescribeDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeDhcpOptionsOutput, error)
+	DeleteDhcpOptions(ctx context.Context, input *ec2.DeleteDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.DeleteDhcpOptionsOutput, error)
+	DescribeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	AssociateDhcpOptions(ctx context.Context, input *ec2.AssociateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.AssociateDhcpOptionsOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewDHCPOptionsClient returns a new client using AWS credentials as JSON encoded data.
+func NewDHCPOptionsClient(cfg aws.Config) DHCPOptionsClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// DHCPOptionsAssociationClient is the external client used for
+// DHCPOptionsAssociation Custom Resource
+type DHCPOptionsAssociationClient interface {
+	DescribeVpcs(ctx context.Context, input *ec2.DescribeVpcsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcsOutput, error)
+	AssociateDhcpOptions(ctx context.Context, input *ec2.AssociateDhcpOptionsInput, opts ...func(*ec2.Options)) (*ec2.AssociateDhcpOptionsOutput, error)
+}
+
+// NewDHCPOptionsAssociationClient returns a new client using AWS credentials as JSON encoded data.
+func NewDHCPOptionsAssociationClient(cfg aws.Config) DHCPOptionsAssociationClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsDHCPOptionsNotFoundErr returns true if the error is because the DHCP
+// options set doesn't exist
+func IsDHCPOptionsNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == DHCPOptionsIDNotFound
+}
+
+// GetVPCsByDHCPOptions returns the IDs of all VPCs that are associated with
+// the given DHCP options set.
+func GetVPCsByDHCPOptions(ctx context.Context, client DHCPOptionsClient, id string) ([]string, error) {
+	var ids []string
+	p := ec2.NewDescribeVpcsPaginator(client, &ec2.DescribeVpcsInput{
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("dhcp-options-id"),
+			Values: []string{id},
+		}},
+	})
+	for p.HasMorePages() {
+		res, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range res.Vpcs {
+			ids = append(ids, aws.ToString(v.VpcId))
+		}
+	}
+	return ids, nil
+}
+
+// GenerateCreateDHCPOptionsInput returns the input to create a DHCP options
+// set with the supplied parameters.
+func GenerateCreateDHCPOptionsInput(p manualv1alpha1.DHCPOptionsParameters) *ec2.CreateDhcpOptionsInput {
+	in := &ec2.CreateDhcpOptionsInput{}
+	add := func(key string, values ...string) {
+		if len(values) == 0 {
+			return
+		}
+		in.DhcpConfigurations = append(in.DhcpConfigurations, ec2types.NewDhcpConfiguration{
+			Key:    aws.String(key),
+			Values: values,
+		})
+	}
+	if p.DomainName != nil {
+		add(dhcpDomainName, *p.DomainName)
+	}
+	add(dhcpDomainNameServers, p.DomainNameServers...)
+	add(dhcpNTPServers, p.NTPServers...)
+	add(dhcpNetBIOSNameServers, p.NetBIOSNameServers...)
+	if p.NetBIOSNodeType != nil {
+		add(dhcpNetBIOSNodeType, *p.NetBIOSNodeType)
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeDhcpOptions,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateDHCPOptionsObservation is used to produce
+// manualv1alpha1.DHCPOptionsObservation from ec2types.DhcpOptions.
+func GenerateDHCPOptionsObservation(o ec2types.DhcpOptions) manualv1alpha1.DHCPOptionsObservation {
+	return manualv1alpha1.DHCPOptionsObservation{
+		DHCPOptionsID: aws.ToString(o.DhcpOptionsId),
+		OwnerID:       aws.ToString(o.OwnerId),
+	}
+}
+
+// IsDHCPOptionsUpToDate returns true if there is no update-able difference
+// between desired and observed state of the DHCP options set. Only its tags
+// can be updated.
+func IsDHCPOptionsUpToDate(p manualv1alpha1.DHCPOptionsParameters, o ec2types.DhcpOptions) bool {
+	add, remove := DiffEC2Tags(GenerateEC2TagsManualV1alpha1(p.Tags), o.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
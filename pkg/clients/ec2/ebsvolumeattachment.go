@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// VolumeIDNotFound is the code that is returned by ec2 when the given
+	// VolumeId is not valid
+	VolumeIDNotFound = "InvalidVolume.NotFound"
+)
+
+// EBSVolumeAttachmentClient is the external client used for EBSVolumeAttachment Custom Resource
+type EBSVolumeAttachmentClient interface {
+	DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	AttachVolume(ctx context.Context, input *ec2.AttachVolumeInput, opts ...func(*ec2.Options)) (*ec2.AttachVolumeOutput, error)
+	DetachVolume(ctx context.Context, input *ec2.DetachVolumeInput, opts ...func(*ec2.Options)) (*ec2.DetachVolumeOutput, error)
+}
+
+// NewEBSVolumeAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewEBSVolumeAttachmentClient(cfg aws.Config) EBSVolumeAttachmentClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVolumeNotFoundErr returns true if the error is because the volume doesn't
+// exist
+func IsVolumeNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VolumeIDNotFound
+}
+
+// FindVolumeAttachment returns the attachment of the volume to the given
+// instance, or nil if the volume is not attached to it.
+func FindVolumeAttachment(v ec2types.Volume, instanceID string) *ec2types.VolumeAttachment {
+	for i := range v.Attachments {
+		if aws.ToString(v.Attachments[i].InstanceId) == instanceID {
+			return &v.Attachments[i]
+		}
+	}
+	return nil
+}
+
+// GenerateEBSVolumeAttachmentObservation is used to produce
+// manualv1alpha1.EBSVolumeAttachmentObservation from ec2types.VolumeAttachment.
+func GenerateEBSVolumeAttachmentObservation(a ec2types.VolumeAttachment) manualv1alpha1.EBSVolumeAttachmentObservation {
+	o := manualv1alpha1.EBSVolumeAttachmentObservation{
+		State:               string(a.State),
+		DeleteOnTermination: aws.ToBool(a.DeleteOnTermination),
+	}
+	if a.AttachTime != nil {
+		t := metav1.NewTime(*a.AttachTime)
+		o.AttachTime = &t
+	}
+	return o
+}
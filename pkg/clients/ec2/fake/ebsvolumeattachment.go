@@ -0,0 +1,50 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.EBSVolumeAttachmentClient = (*MockEBSVolumeAttachmentClient)(nil)
+
+// MockEBSVolumeAttachmentClient is a type that implements all the methods for EBSVolumeAttachmentClient interface
+type MockEBSVolumeAttachmentClient struct {
+	MockDescribe func(ctx context.Context, input *ec2.DescribeVolumesInput, opts []func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+	MockAttach   func(ctx context.Context, input *ec2.AttachVolumeInput, opts []func(*ec2.Options)) (*ec2.AttachVolumeOutput, error)
+	MockDetach   func(ctx context.Context, input *ec2.DetachVolumeInput, opts []func(*ec2.Options)) (*ec2.DetachVolumeOutput, error)
+}
+
+// DescribeVolumes mocks DescribeVolumes method
+func (m *MockEBSVolumeAttachmentClient) DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// AttachVolume mocks AttachVolume method
+func (m *MockEBSVolumeAttachmentClient) AttachVolume(ctx context.Context, input *ec2.AttachVolumeInput, opts ...func(*ec2.Options)) (*ec2.AttachVolumeOutput, error) {
+	return m.MockAttach(ctx, input, opts)
+}
+
+// DetachVolume mocks DetachVolume method
+func (m *MockEBSVolumeAttachmentClient) DetachVolume(ctx context.Context, input *ec2.DetachVolumeInput, opts ...func(*ec2.Options)) (*ec2.DetachVolumeOutput, error) {
+	return m.MockDetach(ctx, input, opts)
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ImageClient = (*MockImageClient)(nil)
+
+// MockImageClient is a type that implements all the methods for ImageClient interface
+type MockImageClient struct {
+	MockCreate            func(ctx context.Context, input *ec2.CreateImageInput, opts []func(*ec2.Options)) (*ec2.CreateImageOutput, error)
+	MockRegister          func(ctx context.Context, input *ec2.RegisterImageInput, opts []func(*ec2.Options)) (*ec2.RegisterImageOutput, error)
+	MockDescribe          func(ctx context.Context, input *ec2.DescribeImagesInput, opts []func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	MockDescribeAttribute func(ctx context.Context, input *ec2.DescribeImageAttributeInput, opts []func(*ec2.Options)) (*ec2.DescribeImageAttributeOutput, error)
+	MockModifyAttribute   func(ctx context.Context, input *ec2.ModifyImageAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error)
+	MockDeregister        func(ctx context.Context, input *ec2.DeregisterImageInput, opts []func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
+	MockCreateTags        func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags        func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateImage mocks CreateImage method
+func (m *MockImageClient) CreateImage(ctx context.Context, input *ec2.CreateImageInput, opts ...func(*ec2.Options)) (*ec2.CreateImageOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// RegisterImage mocks RegisterImage method
+func (m *MockImageClient) RegisterImage(ctx context.Context, input *ec2.RegisterImageInput, opts ...func(*ec2.Options)) (*ec2.RegisterImageOutput, error) {
+	return m.MockRegister(ctx, input, opts)
+}
+
+// DescribeImages mocks DescribeImages method
+func (m *MockImageClient) DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DescribeImageAttribute mocks DescribeImageAttribute method
+func (m *MockImageClient) DescribeImageAttribute(ctx context.Context, input *ec2.DescribeImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.DescribeImageAttributeOutput, error) {
+	return m.MockDescribeAttribute(ctx, input, opts)
+}
+
+// ModifyImageAttribute mocks ModifyImageAttribute method
+func (m *MockImageClient) ModifyImageAttribute(ctx context.Context, input *ec2.ModifyImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error) {
+	return m.MockModifyAttribute(ctx, input, opts)
+}
+
+// DeregisterImage mocks DeregisterImage method
+func (m *MockImageClient) DeregisterImage(ctx context.Context, input *ec2.DeregisterImageInput, opts ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error) {
+	return m.MockDeregister(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockImageClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockImageClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interfaces
+var (
+	_ clientset.IPAMClient      = (*MockIPAMClient)(nil)
+	_ clientset.IPAMScopeClient = (*MockIPAMClient)(nil)
+	_ clientset.IPAMPoolClient  = (*MockIPAMClient)(nil)
+)
+
+// MockIPAMClient is a type that implements all the methods for the client
+// interfaces of the IPAM, IPAM scope and IPAM pool resources
+type MockIPAMClient struct {
+	MockDescribeIpams           func(ctx context.Context, input *ec2.DescribeIpamsInput, opts []func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error)
+	MockCreateIpam              func(ctx context.Context, input *ec2.CreateIpamInput, opts []func(*ec2.Options)) (*ec2.CreateIpamOutput, error)
+	MockModifyIpam              func(ctx context.Context, input *ec2.ModifyIpamInput, opts []func(*ec2.Options)) (*ec2.ModifyIpamOutput, error)
+	MockDeleteIpam              func(ctx context.Context, input *ec2.DeleteIpamInput, opts []func(*ec2.Options)) (*ec2.DeleteIpamOutput, error)
+	MockDescribeIpamScopes      func(ctx context.Context, input *ec2.DescribeIpamScopesInput, opts []func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error)
+	MockCreateIpamScope         func(ctx context.Context, input *ec2.CreateIpamScopeInput, opts []func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error)
+	MockModifyIpamScope         func(ctx context.Context, input *ec2.ModifyIpamScopeInput, opts []func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error)
+	MockDeleteIpamScope         func(ctx context.Context, input *ec2.DeleteIpamScopeInput, opts []func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error)
+	MockDescribeIpamPools       func(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts []func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error)
+	MockCreateIpamPool          func(ctx context.Context, input *ec2.CreateIpamPoolInput, opts []func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error)
+	MockModifyIpamPool          func(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts []func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error)
+	MockDeleteIpamPool          func(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts []func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error)
+	MockGetIpamPoolCidrs        func(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts []func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error)
+	MockProvisionIpamPoolCidr   func(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts []func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error)
+	MockDeprovisionIpamPoolCidr func(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts []func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error)
+	MockCreateTags              func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags              func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// DescribeIpams mocks DescribeIpams method
+func (m *MockIPAMClient) DescribeIpams(ctx context.Context, input *ec2.DescribeIpamsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error) {
+	return m.MockDescribeIpams(ctx, input, opts)
+}
+
+// CreateIpam mocks CreateIpam method
+func (m *MockIPAMClient) CreateIpam(ctx context.Context, input *ec2.CreateIpamInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamOutput, error) {
+	return m.MockCreateIpam(ctx, input, opts)
+}
+
+// ModifyIpam mocks ModifyIpam method
+func (m *MockIPAMClient) ModifyIpam(ctx context.Context, input *ec2.ModifyIpamInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error) {
+	return m.MockModifyIpam(ctx, input, opts)
+}
+
+// DeleteIpam mocks DeleteIpam method
+func (m *MockIPAMClient) DeleteIpam(ctx context.Context, input *ec2.DeleteIpamInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamOutput, error) {
+	return m.MockDeleteIpam(ctx, input, opts)
+}
+
+// DescribeIpamScopes mocks DescribeIpamScopes method
+func (m *MockIPAMClient) DescribeIpamScopes(ctx context.Context, input *ec2.DescribeIpamScopesInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error) {
+	return m.MockDescribeIpamScopes(ctx, input, opts)
+}
+
+// CreateIpamScope mocks CreateIpamScope method
+func (m *MockIPAMClient) CreateIpamScope(ctx context.Context, input *ec2.CreateIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error) {
+	return m.MockCreateIpamScope(ctx, input, opts)
+}
+
+// ModifyIpamScope mocks ModifyIpamScope method
+func (m *MockIPAMClient) ModifyIpamScope(ctx context.Context, input *ec2.ModifyIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error) {
+	return m.MockModifyIpamScope(ctx, input, opts)
+}
+
+// DeleteIpamScope mocks DeleteIpamScope method
+func (m *MockIPAMClient) DeleteIpamScope(ctx context.Context, input *ec2.DeleteIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error) {
+	return m.MockDeleteIpamScope(ctx, input, opts)
+}
+
+// DescribeIpamPools mocks DescribeIpamPools method
+func (m *MockIPAMClient) DescribeIpamPools(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error) {
+	return m.MockDescribeIpamPools(ctx, input, opts)
+}
+
+// CreateIpamPool mocks CreateIpamPool method
+func (m *MockIPAMClient) CreateIpamPool(ctx context.Context, input *ec2.CreateIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error) {
+	return m.MockCreateIpamPool(ctx, input, opts)
+}
+
+// ModifyIpamPool mocks ModifyIpamPool method
+func (m *MockIPAMClient) ModifyIpamPool(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error) {
+	return m.MockModifyIpamPool(ctx, input, opts)
+}
+
+// DeleteIpamPool mocks DeleteIpamPool method
+func (m *MockIPAMClient) DeleteIpamPool(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error) {
+	return m.MockDeleteIpamPool(ctx, input, opts)
+}
+
+// GetIpamPoolCidrs mocks GetIpamPoolCidrs method
+func (m *MockIPAMClient) GetIpamPoolCidrs(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error) {
+	return m.MockGetIpamPoolCidrs(ctx, input, opts)
+}
+
+// ProvisionIpamPoolCidr mocks ProvisionIpamPoolCidr method
+func (m *MockIPAMClient) ProvisionIpamPoolCidr(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error) {
+	return m.MockProvisionIpamPoolCidr(ctx, input, opts)
+}
+
+// DeprovisionIpamPoolCidr mocks DeprovisionIpamPoolCidr method
+func (m *MockIPAMClient) DeprovisionIpamPoolCidr(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error) {
+	return m.MockDeprovisionIpamPoolCidr(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockIPAMClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockIPAMClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ManagedPrefixListClient = (*MockManagedPrefixListClient)(nil)
+
+// MockManagedPrefixListClient is a type that implements all the methods for ManagedPrefixListClient interface
+type MockManagedPrefixListClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateManagedPrefixListInput, opts []func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput, opts []func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error)
+	MockGetEntries func(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput, opts []func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyManagedPrefixListInput, opts []func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteManagedPrefixListInput, opts []func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateManagedPrefixList mocks CreateManagedPrefixList method
+func (m *MockManagedPrefixListClient) CreateManagedPrefixList(ctx context.Context, input *ec2.CreateManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeManagedPrefixLists mocks DescribeManagedPrefixLists method
+func (m *MockManagedPrefixListClient) DescribeManagedPrefixLists(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput, opts ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// GetManagedPrefixListEntries mocks GetManagedPrefixListEntries method
+func (m *MockManagedPrefixListClient) GetManagedPrefixListEntries(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput, opts ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error) {
+	return m.MockGetEntries(ctx, input, opts)
+}
+
+// ModifyManagedPrefixList mocks ModifyManagedPrefixList method
+func (m *MockManagedPrefixListClient) ModifyManagedPrefixList(ctx context.Context, input *ec2.ModifyManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteManagedPrefixList mocks DeleteManagedPrefixList method
+func (m *MockManagedPrefixListClient) DeleteManagedPrefixList(ctx context.Context, input *ec2.DeleteManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockManagedPrefixListClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockManagedPrefixListClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
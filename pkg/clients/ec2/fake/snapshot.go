@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SnapshotClient = (*MockSnapshotClient)(nil)
+
+// MockSnapshotClient is a type that implements all the methods for SnapshotClient interface
+type MockSnapshotClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateSnapshotInput, opts []func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error)
+	MockCopy       func(ctx context.Context, input *ec2.CopySnapshotInput, opts []func(*ec2.Options)) (*ec2.CopySnapshotOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeSnapshotsInput, opts []func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteSnapshotInput, opts []func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateSnapshot mocks CreateSnapshot method
+func (m *MockSnapshotClient) CreateSnapshot(ctx context.Context, input *ec2.CreateSnapshotInput, opts ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// CopySnapshot mocks CopySnapshot method
+func (m *MockSnapshotClient) CopySnapshot(ctx context.Context, input *ec2.CopySnapshotInput, opts ...func(*ec2.Options)) (*ec2.CopySnapshotOutput, error) {
+	return m.MockCopy(ctx, input, opts)
+}
+
+// DescribeSnapshots mocks DescribeSnapshots method
+func (m *MockSnapshotClient) DescribeSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// DeleteSnapshot mocks DeleteSnapshot method
+func (m *MockSnapshotClient) DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockSnapshotClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockSnapshotClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
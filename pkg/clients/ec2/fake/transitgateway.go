@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interfaces
+var (
+	_ clientset.TransitGatewayPeeringAttachmentClient         = (*MockTransitGatewayClient)(nil)
+	_ clientset.TransitGatewayPeeringAttachmentAccepterClient = (*MockTransitGatewayClient)(nil)
+	_ clientset.TransitGatewayVPCAttachmentAccepterClient     = (*MockTransitGatewayClient)(nil)
+	_ clientset.TransitGatewayRouteTableAssociationClient     = (*MockTransitGatewayClient)(nil)
+	_ clientset.TransitGatewayRouteTablePropagationClient     = (*MockTransitGatewayClient)(nil)
+)
+
+// MockTransitGatewayClient is a type that implements all the methods for the
+// client interfaces of the transit gateway attachment, accepter, route table
+// association and route table propagation resources
+type MockTransitGatewayClient struct {
+	MockCreatePeeringAttachment    func(ctx context.Context, input *ec2.CreateTransitGatewayPeeringAttachmentInput, opts []func(*ec2.Options)) (*ec2.CreateTransitGatewayPeeringAttachmentOutput, error)
+	MockDescribePeeringAttachments func(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error)
+	MockAcceptPeeringAttachment    func(ctx context.Context, input *ec2.AcceptTransitGatewayPeeringAttachmentInput, opts []func(*ec2.Options)) (*ec2.AcceptTransitGatewayPeeringAttachmentOutput, error)
+	MockDeletePeeringAttachment    func(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	MockDescribeVpcAttachments     func(ctx context.Context, input *ec2.DescribeTransitGatewayVpcAttachmentsInput, opts []func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error)
+	MockAcceptVpcAttachment        func(ctx context.Context, input *ec2.AcceptTransitGatewayVpcAttachmentInput, opts []func(*ec2.Options)) (*ec2.AcceptTransitGatewayVpcAttachmentOutput, error)
+	MockDeleteVpcAttachment        func(ctx context.Context, input *ec2.DeleteTransitGatewayVpcAttachmentInput, opts []func(*ec2.Options)) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error)
+	MockDescribeAttachments        func(ctx context.Context, input *ec2.DescribeTransitGatewayAttachmentsInput, opts []func(*ec2.Options)) (*ec2.DescribeTransitGatewayAttachmentsOutput, error)
+	MockGetAssociations            func(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput, opts []func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error)
+	MockAssociate                  func(ctx context.Context, input *ec2.AssociateTransitGatewayRouteTableInput, opts []func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error)
+	MockDisassociate               func(ctx context.Context, input *ec2.DisassociateTransitGatewayRouteTableInput, opts []func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error)
+	MockGetPropagations            func(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput, opts []func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error)
+	MockEnablePropagation          func(ctx context.Context, input *ec2.EnableTransitGatewayRouteTablePropagationInput, opts []func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error)
+	MockDisablePropagation         func(ctx context.Context, input *ec2.DisableTransitGatewayRouteTablePropagationInput, opts []func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error)
+	MockCreateTags                 func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags                 func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateTransitGatewayPeeringAttachment mocks CreateTransitGatewayPeeringAttachment method
+func (m *MockTransitGatewayClient) CreateTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.CreateTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.CreateTransitGatewayPeeringAttachmentOutput, error) {
+	return m.MockCreatePeeringAttachment(ctx, input, opts)
+}
+
+// DescribeTransitGatewayPeeringAttachments mocks DescribeTransitGatewayPeeringAttachments method
+func (m *MockTransitGatewayClient) DescribeTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return m.MockDescribePeeringAttachments(ctx, input, opts)
+}
+
+// AcceptTransitGatewayPeeringAttachment mocks AcceptTransitGatewayPeeringAttachment method
+func (m *MockTransitGatewayClient) AcceptTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.AcceptTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+	return m.MockAcceptPeeringAttachment(ctx, input, opts)
+}
+
+// DeleteTransitGatewayPeeringAttachment mocks DeleteTransitGatewayPeeringAttachment method
+func (m *MockTransitGatewayClient) DeleteTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+	return m.MockDeletePeeringAttachment(ctx, input, opts)
+}
+
+// DescribeTransitGatewayVpcAttachments mocks DescribeTransitGatewayVpcAttachments method
+func (m *MockTransitGatewayClient) DescribeTransitGatewayVpcAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayVpcAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	return m.MockDescribeVpcAttachments(ctx, input, opts)
+}
+
+// AcceptTransitGatewayVpcAttachment mocks AcceptTransitGatewayVpcAttachment method
+func (m *MockTransitGatewayClient) AcceptTransitGatewayVpcAttachment(ctx context.Context, input *ec2.AcceptTransitGatewayVpcAttachmentInput, opts ...func(*ec2.Options)) (*ec2.AcceptTransitGatewayVpcAttachmentOutput, error) {
+	return m.MockAcceptVpcAttachment(ctx, input, opts)
+}
+
+// DeleteTransitGatewayVpcAttachment mocks DeleteTransitGatewayVpcAttachment method
+func (m *MockTransitGatewayClient) DeleteTransitGatewayVpcAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayVpcAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+	return m.MockDeleteVpcAttachment(ctx, input, opts)
+}
+
+// DescribeTransitGatewayAttachments mocks DescribeTransitGatewayAttachments method
+func (m *MockTransitGatewayClient) DescribeTransitGatewayAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayAttachmentsOutput, error) {
+	return m.MockDescribeAttachments(ctx, input, opts)
+}
+
+// GetTransitGatewayRouteTableAssociations mocks GetTransitGatewayRouteTableAssociations method
+func (m *MockTransitGatewayClient) GetTransitGatewayRouteTableAssociations(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	return m.MockGetAssociations(ctx, input, opts)
+}
+
+// AssociateTransitGatewayRouteTable mocks AssociateTransitGatewayRouteTable method
+func (m *MockTransitGatewayClient) AssociateTransitGatewayRouteTable(ctx context.Context, input *ec2.AssociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error) {
+	return m.MockAssociate(ctx, input, opts)
+}
+
+// DisassociateTransitGatewayRouteTable mocks DisassociateTransitGatewayRouteTable method
+func (m *MockTransitGatewayClient) DisassociateTransitGatewayRouteTable(ctx context.Context, input *ec2.DisassociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error) {
+	return m.MockDisassociate(ctx, input, opts)
+}
+
+// GetTransitGatewayRouteTablePropagations mocks GetTransitGatewayRouteTablePropagations method
+func (m *MockTransitGatewayClient) GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	return m.MockGetPropagations(ctx, input, opts)
+}
+
+// EnableTransitGatewayRouteTablePropagation mocks EnableTransitGatewayRouteTablePropagation method
+func (m *MockTransitGatewayClient) EnableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.EnableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
+	return m.MockEnablePropagation(ctx, input, opts)
+}
+
+// DisableTransitGatewayRouteTablePropagation mocks DisableTransitGatewayRouteTablePropagation method
+func (m *MockTransitGatewayClient) DisableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.DisableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+	return m.MockDisablePropagation(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockTransitGatewayClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockTransitGatewayClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
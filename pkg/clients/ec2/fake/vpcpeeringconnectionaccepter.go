@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.VPCPeeringConnectionAccepterClient = (*MockVPCPeeringConnectionAccepterClient)(nil)
+
+// MockVPCPeeringConnectionAccepterClient is a type that implements all the
+// methods for VPCPeeringConnectionAccepterClient interface
+type MockVPCPeeringConnectionAccepterClient struct {
+	MockDescribe      func(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput, opts []func(*ec2.Options)) (*ec2.DescribeVpcPeeringConnectionsOutput, error)
+	MockAccept        func(ctx context.Context, input *ec2.AcceptVpcPeeringConnectionInput, opts []func(*ec2.Options)) (*ec2.AcceptVpcPeeringConnectionOutput, error)
+	MockModifyOptions func(ctx context.Context, input *ec2.ModifyVpcPeeringConnectionOptionsInput, opts []func(*ec2.Options)) (*ec2.ModifyVpcPeeringConnectionOptionsOutput, error)
+	MockDelete        func(ctx context.Context, input *ec2.DeleteVpcPeeringConnectionInput, opts []func(*ec2.Options)) (*ec2.DeleteVpcPeeringConnectionOutput, error)
+	MockCreateTags    func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags    func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// DescribeVpcPeeringConnections mocks DescribeVpcPeeringConnections method
+func (m *MockVPCPeeringConnectionAccepterClient) DescribeVpcPeeringConnections(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// AcceptVpcPeeringConnection mocks AcceptVpcPeeringConnection method
+func (m *MockVPCPeeringConnectionAccepterClient) AcceptVpcPeeringConnection(ctx context.Context, input *ec2.AcceptVpcPeeringConnectionInput, opts ...func(*ec2.Options)) (*ec2.AcceptVpcPeeringConnectionOutput, error) {
+	return m.MockAccept(ctx, input, opts)
+}
+
+// ModifyVpcPeeringConnectionOptions mocks ModifyVpcPeeringConnectionOptions method
+func (m *MockVPCPeeringConnectionAccepterClient) ModifyVpcPeeringConnectionOptions(ctx context.Context, input *ec2.ModifyVpcPeeringConnectionOptionsInput, opts ...func(*ec2.Options)) (*ec2.ModifyVpcPeeringConnectionOptionsOutput, error) {
+	return m.MockModifyOptions(ctx, input, opts)
+}
+
+// DeleteVpcPeeringConnection mocks DeleteVpcPeeringConnection method
+func (m *MockVPCPeeringConnectionAccepterClient) DeleteVpcPeeringConnection(ctx context.Context, input *ec2.DeleteVpcPeeringConnectionInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpcPeeringConnectionOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockVPCPeeringConnectionAccepterClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockVPCPeeringConnectionAccepterClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
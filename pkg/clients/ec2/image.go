@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// ImageIDNotFound is the code that is returned by ec2 when the given
+	// ImageId is not valid
+	ImageIDNotFound = "InvalidAMIID.NotFound"
+)
+
+// ImageClient is the external client used for Image Custom Resource
+type ImageClient interface {
+	CreateImage(ctx context.Context, input *ec2.CreateImageInput, opts ...func(*ec2.Options)) (*ec2.CreateImageOutput, error)
+	RegisterImage(ctx context.Context, input *ec2.RegisterImageInput, opts ...func(*ec2.Options)) (*ec2.RegisterImageOutput, error)
+	DescribeImages(ctx context.Context, input *ec2.DescribeImagesInput, opts ...func(*ec2.Options)) (*ec2.DescribeImagesOutput, error)
+	DescribeImageAttribute(ctx context.Context, input *ec2.DescribeImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.DescribeImageAttributeOutput, error)
+	ModifyImageAttribute(ctx context.Context, input *ec2.ModifyImageAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifyImageAttributeOutput, error)
+	DeregisterImage(ctx context.Context, input *ec2.DeregisterImageInput, opts ...func(*ec2.Options)) (*ec2.DeregisterImageOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewImageClient returns a new client using AWS credentials as JSON encoded data.
+func NewImageClient(cfg aws.Config) ImageClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsImageNotFoundErr returns true if the error is because the AMI doesn't
+// exist
+func IsImageNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == ImageIDNotFound
+}
+
+// GenerateImageObservation is used to produce manualv1alpha1.ImageObservation from
+// ec2types.Image.
+func GenerateImageObservation(i ec2types.Image) manualv1alpha1.ImageObservation {
+	o := manualv1alpha1.ImageObservation{
+		ImageID:      aws.ToString(i.ImageId),
+		OwnerID:      aws.ToString(i.OwnerId),
+		State:        string(i.State),
+		CreationDate: aws.ToString(i.CreationDate),
+	}
+	if i.StateReason != nil {
+		o.StateMessage = aws.ToString(i.StateReason.Message)
+	}
+	for _, m := range i.BlockDeviceMappings {
+		if m.Ebs != nil && m.Ebs.SnapshotId != nil {
+			o.SnapshotIDs = append(o.SnapshotIDs, *m.Ebs.SnapshotId)
+		}
+	}
+	return o
+}
+
+func imageTagSpecifications(tags []manualv1alpha1.Tag) []ec2types.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []ec2types.TagSpecification{{
+		ResourceType: ec2types.ResourceTypeImage,
+		Tags:         GenerateEC2TagsManualV1alpha1(tags),
+	}}
+}
+
+// GenerateCreateImageInput returns the input to create an AMI from an
+// instance with the supplied parameters.
+func GenerateCreateImageInput(p manualv1alpha1.ImageParameters) *ec2.CreateImageInput {
+	return &ec2.CreateImageInput{
+		InstanceId:        p.InstanceID,
+		Name:              aws.String(p.Name),
+		Description:       p.Description,
+		NoReboot:          p.NoReboot,
+		TagSpecifications: imageTagSpecifications(p.Tags),
+	}
+}
+
+// GenerateRegisterImageInput returns the input to register an AMI from the
+// snapshot of a root volume with the supplied parameters.
+func GenerateRegisterImageInput(p manualv1alpha1.ImageParameters) *ec2.RegisterImageInput {
+	in := &ec2.RegisterImageInput{
+		Name:               aws.String(p.Name),
+		Description:        p.Description,
+		RootDeviceName:     p.RootDeviceName,
+		VirtualizationType: p.VirtualizationType,
+		EnaSupport:         p.ENASupport,
+		BlockDeviceMappings: []ec2types.BlockDeviceMapping{{
+			DeviceName: p.RootDeviceName,
+			Ebs: &ec2types.EbsBlockDevice{
+				SnapshotId:          p.SnapshotID,
+				DeleteOnTermination: aws.Bool(true),
+			},
+		}},
+		TagSpecifications: imageTagSpecifications(p.Tags),
+	}
+	if p.Architecture != nil {
+		in.Architecture = ec2types.ArchitectureValues(*p.Architecture)
+	}
+	if p.BootMode != nil {
+		in.BootMode = ec2types.BootModeValues(*p.BootMode)
+	}
+	return in
+}
+
+// GetLaunchPermissionAccountIDs returns the sorted IDs of the accounts in
+// the supplied launch permissions.
+func GetLaunchPermissionAccountIDs(perms []ec2types.LaunchPermission) []string {
+	var ids []string
+	for _, p := range perms {
+		if p.UserId != nil {
+			ids = append(ids, *p.UserId)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// DiffLaunchPermissions returns the launch permission modifications to share
+// the AMI with exactly the desired accounts, or nil if none are needed.
+func DiffLaunchPermissions(desired, observed []string) *ec2types.LaunchPermissionModifications {
+	want := make(map[string]bool, len(desired))
+	for _, id := range desired {
+		want[id] = true
+	}
+	have := make(map[string]bool, len(observed))
+	for _, id := range observed {
+		have[id] = true
+	}
+	mod := &ec2types.LaunchPermissionModifications{}
+	for _, id := range desired {
+		if !have[id] {
+			mod.Add = append(mod.Add, ec2types.LaunchPermission{UserId: aws.String(id)})
+			have[id] = true
+		}
+	}
+	for _, id := range observed {
+		if !want[id] {
+			mod.Remove = append(mod.Remove, ec2types.LaunchPermission{UserId: aws.String(id)})
+		}
+	}
+	if len(mod.Add) == 0 && len(mod.Remove) == 0 {
+		return nil
+	}
+	return mod
+}
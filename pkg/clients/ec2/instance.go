@@ -15,6 +15,7 @@ package ec2
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"sort"
@@ -25,6 +26,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/smithy-go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
@@ -33,6 +35,8 @@ import (
 const (
 	// InstanceNotFound is the code that is returned by ec2 when the given InstanceID is not valid
 	InstanceNotFound = "InvalidInstanceID.NotFound"
+
+	errDecodeUserData = "cannot decode base64 encoded user data"
 )
 
 // InstanceClient is the external client used for Instance Custom Resource
@@ -43,6 +47,8 @@ type InstanceClient interface {
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
 }
 
 // NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
@@ -75,6 +81,14 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if pointer.StringValue(spec.RAMDiskID) != pointer.StringValue(instance.RamdiskId) {
 		return false
 	}
+	// InstanceType
+	if spec.InstanceType != "" && spec.InstanceType != string(instance.InstanceType) {
+		return false
+	}
+	// EBSOptimized
+	if spec.EBSOptimized != nil && *spec.EBSOptimized != pointer.BoolValue(instance.EbsOptimized) {
+		return false
+	}
 	// UserData
 	if pointer.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
 		return false
@@ -99,6 +113,94 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	return CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// GenerateModifyInstanceAttributeInputs returns an input per attribute of the
+// instance that can be modified while it is running and differs from the
+// supplied observation.
+func GenerateModifyInstanceAttributeInputs(id string, spec manualv1alpha1.InstanceParameters, o manualv1alpha1.InstanceObservation) []*ec2.ModifyInstanceAttributeInput {
+	var in []*ec2.ModifyInstanceAttributeInput
+	if !ptr.Equal(spec.DisableAPITermination, o.DisableAPITermination) {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:            aws.String(id),
+			DisableApiTermination: &types.AttributeBooleanValue{Value: spec.DisableAPITermination},
+		})
+	}
+	if spec.InstanceInitiatedShutdownBehavior != pointer.StringValue(o.InstanceInitiatedShutdownBehavior) {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:                        aws.String(id),
+			InstanceInitiatedShutdownBehavior: &types.AttributeValue{Value: aws.String(spec.InstanceInitiatedShutdownBehavior)},
+		})
+	}
+	if len(spec.SecurityGroupIDs) > 0 && !sameGroupIDs(spec.SecurityGroupIDs, o.SecurityGroups) {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Groups:     spec.SecurityGroupIDs,
+		})
+	}
+	return in
+}
+
+// GenerateStoppedModifyInstanceAttributeInputs returns an input per attribute
+// of the instance that can only be modified while it is stopped and differs
+// from the supplied observation.
+func GenerateStoppedModifyInstanceAttributeInputs(id string, spec manualv1alpha1.InstanceParameters, o manualv1alpha1.InstanceObservation) ([]*ec2.ModifyInstanceAttributeInput, error) {
+	var in []*ec2.ModifyInstanceAttributeInput
+	if spec.InstanceType != "" && spec.InstanceType != o.InstanceType {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   aws.String(id),
+			InstanceType: &types.AttributeValue{Value: aws.String(spec.InstanceType)},
+		})
+	}
+	if spec.EBSOptimized != nil && *spec.EBSOptimized != pointer.BoolValue(o.EBSOptimized) {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId:   aws.String(id),
+			EbsOptimized: &types.AttributeBooleanValue{Value: spec.EBSOptimized},
+		})
+	}
+	if spec.KernelID != nil && !ptr.Equal(spec.KernelID, o.KernelID) {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Kernel:     &types.AttributeValue{Value: spec.KernelID},
+		})
+	}
+	if spec.RAMDiskID != nil && !ptr.Equal(spec.RAMDiskID, o.RAMDiskID) {
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			Ramdisk:    &types.AttributeValue{Value: spec.RAMDiskID},
+		})
+	}
+	if spec.UserData != nil && !ptr.Equal(spec.UserData, o.UserData) {
+		// The SDK base64 encodes blob attributes itself, whereas the user
+		// data in the spec is already encoded.
+		userData, err := base64.StdEncoding.DecodeString(*spec.UserData)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", errDecodeUserData, err)
+		}
+		in = append(in, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			UserData:   &types.BlobAttributeValue{Value: userData},
+		})
+	}
+	return in, nil
+}
+
+// sameGroupIDs returns true if the supplied IDs and groups refer to the same
+// security groups, regardless of their order.
+func sameGroupIDs(ids []string, groups []manualv1alpha1.GroupIdentifier) bool {
+	if len(ids) != len(groups) {
+		return false
+	}
+	want := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		want[id] = struct{}{}
+	}
+	for _, g := range groups {
+		if _, ok := want[g.GroupID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance, attributes *ec2.DescribeInstanceAttributeOutput) manualv1alpha1.InstanceObservation {
@@ -193,6 +295,31 @@ func GenerateInstanceCondition(o manualv1alpha1.InstanceObservation) Condition {
 	}
 }
 
+// IsInstanceStopDesired returns true if the instance should be kept stopped
+// or hibernated.
+func IsInstanceStopDesired(spec manualv1alpha1.InstanceParameters) bool {
+	switch pointer.StringValue(spec.DesiredState) {
+	case manualv1alpha1.InstanceDesiredStateStopped, manualv1alpha1.InstanceDesiredStateHibernated:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInstanceInDesiredState returns true if the observed instance state is, or
+// is transitioning to, the desired power state of the instance. Any state is
+// accepted if no desired power state is set.
+func IsInstanceInDesiredState(spec manualv1alpha1.InstanceParameters, state string) bool {
+	switch types.InstanceStateName(state) {
+	case types.InstanceStateNameRunning, types.InstanceStateNamePending:
+		return spec.DesiredState == nil || pointer.StringValue(spec.DesiredState) == manualv1alpha1.InstanceDesiredStateRunning
+	case types.InstanceStateNameStopped, types.InstanceStateNameStopping:
+		return spec.DesiredState == nil || IsInstanceStopDesired(spec)
+	default:
+		return true
+	}
+}
+
 // Condition denotes the current state across instances
 type Condition string
 
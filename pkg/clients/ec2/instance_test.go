@@ -148,6 +148,114 @@ func TestGenerateInstanceConditions(t *testing.T) {
 	}
 }
 
+func TestIsInstanceInDesiredState(t *testing.T) {
+	type args struct {
+		spec  manualv1alpha1.InstanceParameters
+		state string
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoDesiredState": {
+			args: args{state: "stopped"},
+			want: true,
+		},
+		"Running": {
+			args: args{
+				spec:  manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)},
+				state: "pending",
+			},
+			want: true,
+		},
+		"RunningButStopped": {
+			args: args{
+				spec:  manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)},
+				state: "stopped",
+			},
+		},
+		"Hibernating": {
+			args: args{
+				spec:  manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated)},
+				state: "stopping",
+			},
+			want: true,
+		},
+		"StoppedButRunning": {
+			args: args{
+				spec:  manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped)},
+				state: "running",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstanceInDesiredState(tc.args.spec, tc.args.state)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateStoppedModifyInstanceAttributeInputs(t *testing.T) {
+	type args struct {
+		spec     manualv1alpha1.InstanceParameters
+		observed manualv1alpha1.InstanceObservation
+	}
+	type want struct {
+		in  []*ec2.ModifyInstanceAttributeInput
+		err bool
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				spec:     manualv1alpha1.InstanceParameters{InstanceType: "t3.micro", EBSOptimized: aws.Bool(true)},
+				observed: manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", EBSOptimized: aws.Bool(true)},
+			},
+		},
+		"ChangedAttributes": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{
+					InstanceType: "m5.large",
+					EBSOptimized: aws.Bool(true),
+					UserData:     aws.String("ZWNobyBoaQ=="),
+				},
+				observed: manualv1alpha1.InstanceObservation{InstanceType: "t3.micro"},
+			},
+			want: want{
+				in: []*ec2.ModifyInstanceAttributeInput{
+					{InstanceId: aws.String(instanceID), InstanceType: &types.AttributeValue{Value: aws.String("m5.large")}},
+					{InstanceId: aws.String(instanceID), EbsOptimized: &types.AttributeBooleanValue{Value: aws.Bool(true)}},
+					{InstanceId: aws.String(instanceID), UserData: &types.BlobAttributeValue{Value: []byte("echo hi")}},
+				},
+			},
+		},
+		"InvalidUserData": {
+			args: args{
+				spec: manualv1alpha1.InstanceParameters{UserData: aws.String("not base64")},
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			in, err := GenerateStoppedModifyInstanceAttributeInputs(instanceID, tc.args.spec, tc.args.observed)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("GenerateStoppedModifyInstanceAttributeInputs(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.in, in, cmpopts.IgnoreUnexported(ec2.ModifyInstanceAttributeInput{}, types.AttributeValue{}, types.AttributeBooleanValue{}, types.BlobAttributeValue{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDescribeInstancesByExternalTags(t *testing.T) {
 	type args struct {
 		extTags map[string]string
@@ -0,0 +1,431 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// IPAMIDNotFound is the code that is returned by ec2 when the given
+	// IpamId is not valid
+	IPAMIDNotFound = "InvalidIpamId.NotFound"
+
+	// IPAMScopeIDNotFound is the code that is returned by ec2 when the given
+	// IpamScopeId is not valid
+	IPAMScopeIDNotFound = "InvalidIpamScopeId.NotFound"
+
+	// IPAMPoolIDNotFound is the code that is returned by ec2 when the given
+	// IpamPoolId is not valid
+	IPAMPoolIDNotFound = "InvalidIpamPoolId.NotFound"
+)
+
+// IPAMClient is the external client used for IPAM Custom Resource
+type IPAMClient interface {
+	DescribeIpams(ctx context.Context, input *ec2.DescribeIpamsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error)
+	CreateIpam(ctx context.Context, input *ec2.CreateIpamInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamOutput, error)
+	ModifyIpam(ctx context.Context, input *ec2.ModifyIpamInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error)
+	DeleteIpam(ctx context.Context, input *ec2.DeleteIpamInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMClient(cfg aws.Config) IPAMClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IPAMScopeClient is the external client used for IPAMScope Custom Resource
+type IPAMScopeClient interface {
+	DescribeIpamScopes(ctx context.Context, input *ec2.DescribeIpamScopesInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error)
+	CreateIpamScope(ctx context.Context, input *ec2.CreateIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error)
+	ModifyIpamScope(ctx context.Context, input *ec2.ModifyIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error)
+	DeleteIpamScope(ctx context.Context, input *ec2.DeleteIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMScopeClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMScopeClient(cfg aws.Config) IPAMScopeClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IPAMPoolClient is the external client used for IPAMPool Custom Resource
+type IPAMPoolClient interface {
+	DescribeIpamPools(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error)
+	CreateIpamPool(ctx context.Context, input *ec2.CreateIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error)
+	ModifyIpamPool(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error)
+	DeleteIpamPool(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error)
+	GetIpamPoolCidrs(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error)
+	ProvisionIpamPoolCidr(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error)
+	DeprovisionIpamPoolCidr(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMPoolClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMPoolClient(cfg aws.Config) IPAMPoolClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsIPAMNotFoundErr returns true if the error is because the IPAM doesn't
+// exist
+func IsIPAMNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == IPAMIDNotFound
+}
+
+// IsIPAMScopeNotFoundErr returns true if the error is because the IPAM scope
+// doesn't exist
+func IsIPAMScopeNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == IPAMScopeIDNotFound
+}
+
+// IsIPAMPoolNotFoundErr returns true if the error is because the IPAM pool
+// doesn't exist
+func IsIPAMPoolNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == IPAMPoolIDNotFound
+}
+
+// IsIPAMStateGone returns true if the IPAM, IPAM scope or IPAM pool with the
+// given state is deleted or being deleted. All of them share the same state
+// values.
+func IsIPAMStateGone(state string) bool {
+	return state == string(ec2types.IpamStateDeleteInProgress) ||
+		state == string(ec2types.IpamStateDeleteComplete)
+}
+
+// GenerateCreateIpamInput returns the input to create an IPAM with the
+// supplied parameters.
+func GenerateCreateIpamInput(p manualv1alpha1.IPAMParameters) *ec2.CreateIpamInput {
+	in := &ec2.CreateIpamInput{
+		Description: p.Description,
+		Tier:        ec2types.IpamTier(aws.ToString(p.Tier)),
+	}
+	for _, r := range p.OperatingRegions {
+		in.OperatingRegions = append(in.OperatingRegions, ec2types.AddIpamOperatingRegion{RegionName: aws.String(r)})
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpam,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateIPAMObservation is used to produce manualv1alpha1.IPAMObservation from
+// ec2types.Ipam.
+func GenerateIPAMObservation(i ec2types.Ipam) manualv1alpha1.IPAMObservation {
+	return manualv1alpha1.IPAMObservation{
+		IPAMID:                aws.ToString(i.IpamId),
+		IPAMArn:               aws.ToString(i.IpamArn),
+		OwnerID:               aws.ToString(i.OwnerId),
+		PrivateDefaultScopeID: aws.ToString(i.PrivateDefaultScopeId),
+		PublicDefaultScopeID:  aws.ToString(i.PublicDefaultScopeId),
+		ScopeCount:            aws.ToInt32(i.ScopeCount),
+		State:                 string(i.State),
+	}
+}
+
+// LateInitializeIPAM fills the empty fields in *manualv1alpha1.IPAMParameters with
+// the values seen in ec2types.Ipam.
+func LateInitializeIPAM(in *manualv1alpha1.IPAMParameters, i *ec2types.Ipam) {
+	if i == nil {
+		return
+	}
+	in.Description = pointer.LateInitialize(in.Description, i.Description)
+	in.Tier = pointer.LateInitialize(in.Tier, pointer.ToOrNilIfZeroValue(string(i.Tier)))
+	if len(in.OperatingRegions) == 0 {
+		for _, r := range i.OperatingRegions {
+			in.OperatingRegions = append(in.OperatingRegions, aws.ToString(r.RegionName))
+		}
+	}
+}
+
+// DiffIPAMOperatingRegions returns the operating regions to add to and to
+// remove from the observed ones to match the desired ones.
+func DiffIPAMOperatingRegions(desired []string, observed []ec2types.IpamOperatingRegion) (add []ec2types.AddIpamOperatingRegion, remove []ec2types.RemoveIpamOperatingRegion) {
+	current := make(map[string]bool, len(observed))
+	for _, r := range observed {
+		current[aws.ToString(r.RegionName)] = true
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, r := range desired {
+		wanted[r] = true
+		if !current[r] {
+			add = append(add, ec2types.AddIpamOperatingRegion{RegionName: aws.String(r)})
+		}
+	}
+	for _, r := range observed {
+		if !wanted[aws.ToString(r.RegionName)] {
+			remove = append(remove, ec2types.RemoveIpamOperatingRegion{RegionName: r.RegionName})
+		}
+	}
+	return add, remove
+}
+
+// IsIPAMUpToDate returns true if the supplied IPAM matches the parameters,
+// ignoring tags.
+func IsIPAMUpToDate(p manualv1alpha1.IPAMParameters, i ec2types.Ipam) bool {
+	if aws.ToString(p.Description) != aws.ToString(i.Description) {
+		return false
+	}
+	if p.Tier != nil && *p.Tier != string(i.Tier) {
+		return false
+	}
+	add, remove := DiffIPAMOperatingRegions(p.OperatingRegions, i.OperatingRegions)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// GenerateCreateIpamScopeInput returns the input to create an IPAM scope with
+// the supplied parameters.
+func GenerateCreateIpamScopeInput(p manualv1alpha1.IPAMScopeParameters) *ec2.CreateIpamScopeInput {
+	in := &ec2.CreateIpamScopeInput{
+		IpamId:      p.IPAMID,
+		Description: p.Description,
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpamScope,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateIPAMScopeObservation is used to produce manualv1alpha1.IPAMScopeObservation
+// from ec2types.IpamScope.
+func GenerateIPAMScopeObservation(s ec2types.IpamScope) manualv1alpha1.IPAMScopeObservation {
+	return manualv1alpha1.IPAMScopeObservation{
+		IPAMScopeID:   aws.ToString(s.IpamScopeId),
+		IPAMScopeArn:  aws.ToString(s.IpamScopeArn),
+		IPAMArn:       aws.ToString(s.IpamArn),
+		IPAMScopeType: string(s.IpamScopeType),
+		IsDefault:     aws.ToBool(s.IsDefault),
+		PoolCount:     aws.ToInt32(s.PoolCount),
+		State:         string(s.State),
+	}
+}
+
+// LateInitializeIPAMScope fills the empty fields in
+// *manualv1alpha1.IPAMScopeParameters with the values seen in ec2types.IpamScope.
+func LateInitializeIPAMScope(in *manualv1alpha1.IPAMScopeParameters, s *ec2types.IpamScope) {
+	if s == nil {
+		return
+	}
+	in.Description = pointer.LateInitialize(in.Description, s.Description)
+}
+
+// IsIPAMScopeUpToDate returns true if the supplied IPAM scope matches the
+// parameters, ignoring tags.
+func IsIPAMScopeUpToDate(p manualv1alpha1.IPAMScopeParameters, s ec2types.IpamScope) bool {
+	return aws.ToString(p.Description) == aws.ToString(s.Description)
+}
+
+// GenerateCreateIpamPoolInput returns the input to create an IPAM pool with
+// the supplied parameters.
+func GenerateCreateIpamPoolInput(p manualv1alpha1.IPAMPoolParameters) *ec2.CreateIpamPoolInput {
+	in := &ec2.CreateIpamPoolInput{
+		IpamScopeId:                    p.IPAMScopeID,
+		AddressFamily:                  ec2types.AddressFamily(p.AddressFamily),
+		Locale:                         p.Locale,
+		SourceIpamPoolId:               p.SourceIPAMPoolID,
+		Description:                    p.Description,
+		AutoImport:                     p.AutoImport,
+		PubliclyAdvertisable:           p.PubliclyAdvertisable,
+		AllocationDefaultNetmaskLength: p.AllocationDefaultNetmaskLength,
+		AllocationMaxNetmaskLength:     p.AllocationMaxNetmaskLength,
+		AllocationMinNetmaskLength:     p.AllocationMinNetmaskLength,
+		AllocationResourceTags:         generateRequestIpamResourceTags(p.AllocationResourceTags),
+	}
+	if p.SourceResource != nil {
+		in.SourceResource = &ec2types.IpamPoolSourceResourceRequest{
+			ResourceId:     p.SourceResource.ResourceID,
+			ResourceOwner:  p.SourceResource.ResourceOwner,
+			ResourceRegion: p.SourceResource.ResourceRegion,
+			ResourceType:   ec2types.IpamPoolSourceResourceType(p.SourceResource.ResourceType),
+		}
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpamPool,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+func generateRequestIpamResourceTags(tags []manualv1alpha1.Tag) []ec2types.RequestIpamResourceTag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]ec2types.RequestIpamResourceTag, len(tags))
+	for i, t := range tags {
+		res[i] = ec2types.RequestIpamResourceTag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// GenerateIPAMPoolObservation is used to produce manualv1alpha1.IPAMPoolObservation
+// from ec2types.IpamPool and the CIDRs of the pool.
+func GenerateIPAMPoolObservation(p ec2types.IpamPool, cidrs []ec2types.IpamPoolCidr) manualv1alpha1.IPAMPoolObservation {
+	o := manualv1alpha1.IPAMPoolObservation{
+		IPAMPoolID:    aws.ToString(p.IpamPoolId),
+		IPAMPoolArn:   aws.ToString(p.IpamPoolArn),
+		IPAMArn:       aws.ToString(p.IpamArn),
+		IPAMScopeArn:  aws.ToString(p.IpamScopeArn),
+		IPAMScopeType: string(p.IpamScopeType),
+		PoolDepth:     aws.ToInt32(p.PoolDepth),
+		State:         string(p.State),
+		StateMessage:  aws.ToString(p.StateMessage),
+	}
+	for _, c := range cidrs {
+		if c.State == ec2types.IpamPoolCidrStateProvisioned {
+			o.ProvisionedCIDRs = append(o.ProvisionedCIDRs, aws.ToString(c.Cidr))
+		}
+	}
+	return o
+}
+
+// LateInitializeIPAMPool fills the empty fields in
+// *manualv1alpha1.IPAMPoolParameters with the values seen in ec2types.IpamPool.
+func LateInitializeIPAMPool(in *manualv1alpha1.IPAMPoolParameters, p *ec2types.IpamPool) {
+	if p == nil {
+		return
+	}
+	in.Description = pointer.LateInitialize(in.Description, p.Description)
+	in.AutoImport = pointer.LateInitialize(in.AutoImport, p.AutoImport)
+	in.Locale = pointer.LateInitialize(in.Locale, p.Locale)
+	in.PubliclyAdvertisable = pointer.LateInitialize(in.PubliclyAdvertisable, p.PubliclyAdvertisable)
+	in.AllocationDefaultNetmaskLength = pointer.LateInitialize(in.AllocationDefaultNetmaskLength, p.AllocationDefaultNetmaskLength)
+	in.AllocationMaxNetmaskLength = pointer.LateInitialize(in.AllocationMaxNetmaskLength, p.AllocationMaxNetmaskLength)
+	in.AllocationMinNetmaskLength = pointer.LateInitialize(in.AllocationMinNetmaskLength, p.AllocationMinNetmaskLength)
+}
+
+// GetIPAMPoolCIDRs returns all the CIDRs of the given IPAM pool.
+func GetIPAMPoolCIDRs(ctx context.Context, client IPAMPoolClient, id string) ([]ec2types.IpamPoolCidr, error) {
+	var cidrs []ec2types.IpamPoolCidr
+	in := &ec2.GetIpamPoolCidrsInput{IpamPoolId: aws.String(id)}
+	for {
+		res, err := client.GetIpamPoolCidrs(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, res.IpamPoolCidrs...)
+		if aws.ToString(res.NextToken) == "" {
+			return cidrs, nil
+		}
+		in.NextToken = res.NextToken
+	}
+}
+
+// DiffIPAMPoolCIDRs returns the CIDRs to provision into and to deprovision
+// from the pool to match the desired ones. CIDRs that are being provisioned
+// count as provisioned.
+func DiffIPAMPoolCIDRs(desired []string, observed []ec2types.IpamPoolCidr) (provision, deprovision []string) {
+	current := map[string]bool{}
+	for _, c := range observed {
+		if c.State == ec2types.IpamPoolCidrStateProvisioned || c.State == ec2types.IpamPoolCidrStatePendingProvision {
+			current[aws.ToString(c.Cidr)] = true
+		}
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, c := range desired {
+		wanted[c] = true
+		if !current[c] {
+			provision = append(provision, c)
+		}
+	}
+	for _, c := range observed {
+		if c.State == ec2types.IpamPoolCidrStateProvisioned && !wanted[aws.ToString(c.Cidr)] {
+			deprovision = append(deprovision, aws.ToString(c.Cidr))
+		}
+	}
+	return provision, deprovision
+}
+
+// GenerateModifyIpamPoolInput returns the input to modify the observed IPAM
+// pool to match the parameters, or nil if the pool is up to date.
+func GenerateModifyIpamPoolInput(id string, p manualv1alpha1.IPAMPoolParameters, o ec2types.IpamPool) *ec2.ModifyIpamPoolInput {
+	in := &ec2.ModifyIpamPoolInput{IpamPoolId: aws.String(id)}
+	changed := false
+	if p.Description != nil && aws.ToString(p.Description) != aws.ToString(o.Description) {
+		in.Description = p.Description
+		changed = true
+	}
+	if p.AutoImport != nil && aws.ToBool(p.AutoImport) != aws.ToBool(o.AutoImport) {
+		in.AutoImport = p.AutoImport
+		changed = true
+	}
+	if p.AllocationDefaultNetmaskLength != nil && aws.ToInt32(p.AllocationDefaultNetmaskLength) != aws.ToInt32(o.AllocationDefaultNetmaskLength) {
+		in.AllocationDefaultNetmaskLength = p.AllocationDefaultNetmaskLength
+		changed = true
+	}
+	if p.AllocationMaxNetmaskLength != nil && aws.ToInt32(p.AllocationMaxNetmaskLength) != aws.ToInt32(o.AllocationMaxNetmaskLength) {
+		in.AllocationMaxNetmaskLength = p.AllocationMaxNetmaskLength
+		changed = true
+	}
+	if p.AllocationMinNetmaskLength != nil && aws.ToInt32(p.AllocationMinNetmaskLength) != aws.ToInt32(o.AllocationMinNetmaskLength) {
+		in.AllocationMinNetmaskLength = p.AllocationMinNetmaskLength
+		changed = true
+	}
+
+	in.AddAllocationResourceTags, in.RemoveAllocationResourceTags = diffIPAMResourceTags(p.AllocationResourceTags, o.AllocationResourceTags)
+	if len(in.AddAllocationResourceTags) > 0 || len(in.RemoveAllocationResourceTags) > 0 {
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// diffIPAMResourceTags returns the allocation resource tags to add and to
+// remove. Allocation resource tags are matched as key-value pairs, so a tag
+// whose value changed is removed and added again.
+func diffIPAMResourceTags(desired []manualv1alpha1.Tag, observed []ec2types.IpamResourceTag) (add, remove []ec2types.RequestIpamResourceTag) {
+	current := make(map[manualv1alpha1.Tag]bool, len(observed))
+	for _, t := range observed {
+		current[manualv1alpha1.Tag{Key: aws.ToString(t.Key), Value: aws.ToString(t.Value)}] = true
+	}
+	wanted := make(map[manualv1alpha1.Tag]bool, len(desired))
+	for _, t := range desired {
+		wanted[t] = true
+		if !current[t] {
+			add = append(add, ec2types.RequestIpamResourceTag{Key: aws.String(t.Key), Value: aws.String(t.Value)})
+		}
+	}
+	for _, t := range observed {
+		if !wanted[manualv1alpha1.Tag{Key: aws.ToString(t.Key), Value: aws.ToString(t.Value)}] {
+			remove = append(remove, ec2types.RequestIpamResourceTag{Key: t.Key, Value: t.Value})
+		}
+	}
+	return add, remove
+}
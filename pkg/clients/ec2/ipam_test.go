@@ -0,0 +1,160 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestDiffIPAMOperatingRegions(t *testing.T) {
+	type want struct {
+		add    []ec2types.AddIpamOperatingRegion
+		remove []ec2types.RemoveIpamOperatingRegion
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []ec2types.IpamOperatingRegion
+		want     want
+	}{
+		"UpToDate": {
+			desired:  []string{"us-east-1", "eu-west-1"},
+			observed: []ec2types.IpamOperatingRegion{{RegionName: aws.String("eu-west-1")}, {RegionName: aws.String("us-east-1")}},
+		},
+		"AddAndRemove": {
+			desired:  []string{"us-east-1", "eu-west-1"},
+			observed: []ec2types.IpamOperatingRegion{{RegionName: aws.String("us-east-1")}, {RegionName: aws.String("us-west-2")}},
+			want: want{
+				add:    []ec2types.AddIpamOperatingRegion{{RegionName: aws.String("eu-west-1")}},
+				remove: []ec2types.RemoveIpamOperatingRegion{{RegionName: aws.String("us-west-2")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffIPAMOperatingRegions(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(ec2types.AddIpamOperatingRegion{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(ec2types.RemoveIpamOperatingRegion{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffIPAMPoolCIDRs(t *testing.T) {
+	type want struct {
+		provision   []string
+		deprovision []string
+	}
+	cases := map[string]struct {
+		desired  []string
+		observed []ec2types.IpamPoolCidr
+		want     want
+	}{
+		"UpToDate": {
+			desired: []string{"10.0.0.0/8", "172.16.0.0/12"},
+			observed: []ec2types.IpamPoolCidr{
+				{Cidr: aws.String("10.0.0.0/8"), State: ec2types.IpamPoolCidrStateProvisioned},
+				{Cidr: aws.String("172.16.0.0/12"), State: ec2types.IpamPoolCidrStatePendingProvision},
+			},
+		},
+		"ProvisionAndDeprovision": {
+			desired: []string{"10.0.0.0/8", "192.168.0.0/16"},
+			observed: []ec2types.IpamPoolCidr{
+				{Cidr: aws.String("10.0.0.0/8"), State: ec2types.IpamPoolCidrStateProvisioned},
+				{Cidr: aws.String("172.16.0.0/12"), State: ec2types.IpamPoolCidrStateProvisioned},
+				{Cidr: aws.String("100.64.0.0/10"), State: ec2types.IpamPoolCidrStateDeprovisioned},
+			},
+			want: want{
+				provision:   []string{"192.168.0.0/16"},
+				deprovision: []string{"172.16.0.0/12"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			provision, deprovision := DiffIPAMPoolCIDRs(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.provision, provision); diff != "" {
+				t.Errorf("provision: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deprovision, deprovision); diff != "" {
+				t.Errorf("deprovision: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyIpamPoolInput(t *testing.T) {
+	poolID := "ipam-pool-123"
+	cases := map[string]struct {
+		p    manualv1alpha1.IPAMPoolParameters
+		o    ec2types.IpamPool
+		want *ec2.ModifyIpamPoolInput
+	}{
+		"UpToDate": {
+			p: manualv1alpha1.IPAMPoolParameters{
+				Description:                    aws.String("pool"),
+				AllocationDefaultNetmaskLength: aws.Int32(24),
+				AllocationResourceTags:         []manualv1alpha1.Tag{{Key: "env", Value: "prod"}},
+			},
+			o: ec2types.IpamPool{
+				Description:                    aws.String("pool"),
+				AllocationDefaultNetmaskLength: aws.Int32(24),
+				AllocationResourceTags:         []ec2types.IpamResourceTag{{Key: aws.String("env"), Value: aws.String("prod")}},
+			},
+		},
+		"Changed": {
+			p: manualv1alpha1.IPAMPoolParameters{
+				Description:                    aws.String("pool"),
+				AllocationDefaultNetmaskLength: aws.Int32(24),
+				AllocationResourceTags:         []manualv1alpha1.Tag{{Key: "env", Value: "prod"}},
+			},
+			o: ec2types.IpamPool{
+				Description:                    aws.String("old"),
+				AllocationDefaultNetmaskLength: aws.Int32(20),
+				AllocationResourceTags:         []ec2types.IpamResourceTag{{Key: aws.String("env"), Value: aws.String("dev")}},
+			},
+			want: &ec2.ModifyIpamPoolInput{
+				IpamPoolId:                     aws.String(poolID),
+				Description:                    aws.String("pool"),
+				AllocationDefaultNetmaskLength: aws.Int32(24),
+				AddAllocationResourceTags:      []ec2types.RequestIpamResourceTag{{Key: aws.String("env"), Value: aws.String("prod")}},
+				RemoveAllocationResourceTags:   []ec2types.RequestIpamResourceTag{{Key: aws.String("env"), Value: aws.String("dev")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyIpamPoolInput(poolID, tc.p, tc.o)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.ModifyIpamPoolInput{}, ec2types.RequestIpamResourceTag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
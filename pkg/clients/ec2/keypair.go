@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// KeyPairNotFound is the code that is returned by ec2 when the given
+	// key pair name is not valid
+	KeyPairNotFound = "InvalidKeyPair.NotFound"
+
+	// KeyPairPrivateKey is the connection secret key of the private key
+	// material of a key pair generated by AWS.
+	KeyPairPrivateKey = "privateKey"
+
+	// KeyPairName is the connection secret key of the key pair name.
+	KeyPairName = "keyName"
+
+	// KeyPairFingerprint is the connection secret key of the key pair
+	// fingerprint.
+	KeyPairFingerprint = "keyFingerprint"
+)
+
+// KeyPairClient is the external client used for KeyPair Custom Resource
+type KeyPairClient interface {
+	CreateKeyPair(ctx context.Context, input *ec2.CreateKeyPairInput, opts ...func(*ec2.Options)) (*ec2.CreateKeyPairOutput, error)
+	ImportKeyPair(ctx context.Context, input *ec2.ImportKeyPairInput, opts ...func(*ec2.Options)) (*ec2.ImportKeyPairOutput, error)
+	DescribeKeyPairs(ctx context.Context, input *ec2.DescribeKeyPairsInput, opts ...func(*ec2.Options)) (*ec2.DescribeKeyPairsOutput, error)
+	DeleteKeyPair(ctx context.Context, input *ec2.DeleteKeyPairInput, opts ...func(*ec2.Options)) (*ec2.DeleteKeyPairOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewKeyPairClient returns a new client using AWS credentials as JSON encoded data.
+func NewKeyPairClient(cfg aws.Config) KeyPairClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsKeyPairNotFoundErr returns true if the error is because the key pair
+// doesn't exist
+func IsKeyPairNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == KeyPairNotFound
+}
+
+// GenerateKeyPairObservation is used to produce manualv1alpha1.KeyPairObservation
+// from ec2types.KeyPairInfo.
+func GenerateKeyPairObservation(k ec2types.KeyPairInfo) manualv1alpha1.KeyPairObservation {
+	return manualv1alpha1.KeyPairObservation{
+		KeyPairID:      aws.ToString(k.KeyPairId),
+		KeyFingerprint: aws.ToString(k.KeyFingerprint),
+		KeyType:        string(k.KeyType),
+	}
+}
+
+// GenerateCreateKeyPairInput returns the input to let AWS generate the key
+// pair with the supplied name.
+func GenerateCreateKeyPairInput(name string, p manualv1alpha1.KeyPairParameters) *ec2.CreateKeyPairInput {
+	in := &ec2.CreateKeyPairInput{
+		KeyName:   aws.String(name),
+		KeyType:   ec2types.KeyType(aws.ToString(p.KeyType)),
+		KeyFormat: ec2types.KeyFormat(aws.ToString(p.KeyFormat)),
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeKeyPair,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateImportKeyPairInput returns the input to import the supplied public
+// key as key pair with the supplied name.
+func GenerateImportKeyPairInput(name string, p manualv1alpha1.KeyPairParameters, publicKey string) *ec2.ImportKeyPairInput {
+	in := &ec2.ImportKeyPairInput{
+		KeyName:           aws.String(name),
+		PublicKeyMaterial: []byte(publicKey),
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeKeyPair,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// IsKeyPairUpToDate returns true if the tags of the observed key pair match
+// the desired ones. All other parameters of a key pair are immutable.
+func IsKeyPairUpToDate(p manualv1alpha1.KeyPairParameters, k ec2types.KeyPairInfo) bool {
+	add, remove := DiffEC2Tags(GenerateEC2TagsManualV1alpha1(p.Tags), k.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
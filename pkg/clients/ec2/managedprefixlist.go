@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// PrefixListIDNotFound is the code that is returned by ec2 when the given
+	// PrefixListId is not valid
+	PrefixListIDNotFound = "InvalidPrefixListID.NotFound"
+)
+
+// ManagedPrefixListClient is the external client used for ManagedPrefixList Custom Resource
+type ManagedPrefixListClient interface {
+	CreateManagedPrefixList(ctx context.Context, input *ec2.CreateManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error)
+	DescribeManagedPrefixLists(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput, opts ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error)
+	GetManagedPrefixListEntries(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput, opts ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error)
+	ModifyManagedPrefixList(ctx context.Context, input *ec2.ModifyManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error)
+	DeleteManagedPrefixList(ctx context.Context, input *ec2.DeleteManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewManagedPrefixListClient returns a new client using AWS credentials as JSON encoded data.
+func NewManagedPrefixListClient(cfg aws.Config) ManagedPrefixListClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsManagedPrefixListNotFoundErr returns true if the error is because the
+// prefix list doesn't exist
+func IsManagedPrefixListNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == PrefixListIDNotFound
+}
+
+// IsManagedPrefixListPending returns true if a change of the prefix list is
+// in progress. A prefix list cannot be modified in that state.
+func IsManagedPrefixListPending(state ec2types.PrefixListState) bool {
+	switch state { //nolint:exhaustive
+	case ec2types.PrefixListStateCreateInProgress,
+		ec2types.PrefixListStateModifyInProgress,
+		ec2types.PrefixListStateRestoreInProgress,
+		ec2types.PrefixListStateDeleteInProgress:
+		return true
+	}
+	return false
+}
+
+// GetManagedPrefixListEntries returns all entries of the current version of
+// the prefix list.
+func GetManagedPrefixListEntries(ctx context.Context, client ManagedPrefixListClient, id string) ([]ec2types.PrefixListEntry, error) {
+	var entries []ec2types.PrefixListEntry
+	p := ec2.NewGetManagedPrefixListEntriesPaginator(client, &ec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	})
+	for p.HasMorePages() {
+		res, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res.Entries...)
+	}
+	return entries, nil
+}
+
+// GenerateManagedPrefixListObservation is used to produce
+// manualv1alpha1.ManagedPrefixListObservation from ec2types.ManagedPrefixList.
+func GenerateManagedPrefixListObservation(pl ec2types.ManagedPrefixList) manualv1alpha1.ManagedPrefixListObservation {
+	return manualv1alpha1.ManagedPrefixListObservation{
+		PrefixListID:  aws.ToString(pl.PrefixListId),
+		PrefixListARN: aws.ToString(pl.PrefixListArn),
+		OwnerID:       aws.ToString(pl.OwnerId),
+		State:         string(pl.State),
+		StateMessage:  aws.ToString(pl.StateMessage),
+		Version:       aws.ToInt64(pl.Version),
+	}
+}
+
+// GenerateCreateManagedPrefixListInput returns the input to create a prefix
+// list with the supplied parameters.
+func GenerateCreateManagedPrefixListInput(p manualv1alpha1.ManagedPrefixListParameters) *ec2.CreateManagedPrefixListInput {
+	in := &ec2.CreateManagedPrefixListInput{
+		PrefixListName: aws.String(p.PrefixListName),
+		AddressFamily:  aws.String(p.AddressFamily),
+		MaxEntries:     aws.Int32(p.MaxEntries),
+	}
+	for _, e := range p.Entries {
+		in.Entries = append(in.Entries, ec2types.AddPrefixListEntry{
+			Cidr:        aws.String(e.CIDR),
+			Description: e.Description,
+		})
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypePrefixList,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// normalizeCIDR returns the canonical form of the supplied CIDR, e.g.
+// 10.0.0.1/16 becomes 10.0.0.0/16, as ec2 stores it.
+func normalizeCIDR(cidr string) string {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return cidr
+	}
+	return n.String()
+}
+
+// DiffPrefixListEntries returns the entries that have to be added to and
+// removed from the observed entries to match the desired ones. ec2 rejects
+// requests that add and remove the same CIDR, so an entry whose description
+// changed is only removed. It is added again by the next modification.
+func DiffPrefixListEntries(desired []manualv1alpha1.PrefixListEntry, observed []ec2types.PrefixListEntry) (add []ec2types.AddPrefixListEntry, remove []ec2types.RemovePrefixListEntry) {
+	have := make(map[string]ec2types.PrefixListEntry, len(observed))
+	for _, e := range observed {
+		have[normalizeCIDR(aws.ToString(e.Cidr))] = e
+	}
+	want := make(map[string]bool, len(desired))
+	for _, e := range desired {
+		cidr := normalizeCIDR(e.CIDR)
+		want[cidr] = true
+		o, ok := have[cidr]
+		switch {
+		case !ok:
+			add = append(add, ec2types.AddPrefixListEntry{Cidr: aws.String(cidr), Description: e.Description})
+		case aws.ToString(o.Description) != aws.ToString(e.Description):
+			remove = append(remove, ec2types.RemovePrefixListEntry{Cidr: o.Cidr})
+		}
+	}
+	for cidr, o := range have {
+		if !want[cidr] {
+			remove = append(remove, ec2types.RemovePrefixListEntry{Cidr: o.Cidr})
+		}
+	}
+	sort.Slice(add, func(i, j int) bool { return aws.ToString(add[i].Cidr) < aws.ToString(add[j].Cidr) })
+	sort.Slice(remove, func(i, j int) bool { return aws.ToString(remove[i].Cidr) < aws.ToString(remove[j].Cidr) })
+	return add, remove
+}
+
+// IsManagedPrefixListUpToDate returns true if there is no update-able
+// difference between desired and observed state of the prefix list.
+func IsManagedPrefixListUpToDate(p manualv1alpha1.ManagedPrefixListParameters, pl ec2types.ManagedPrefixList, entries []ec2types.PrefixListEntry) bool {
+	if p.PrefixListName != aws.ToString(pl.PrefixListName) || p.MaxEntries != aws.ToInt32(pl.MaxEntries) {
+		return false
+	}
+	add, remove := DiffPrefixListEntries(p.Entries, entries)
+	if len(add) > 0 || len(remove) > 0 {
+		return false
+	}
+	addTags, removeTags := DiffEC2Tags(GenerateEC2TagsManualV1alpha1(p.Tags), pl.Tags)
+	return len(addTags) == 0 && len(removeTags) == 0
+}
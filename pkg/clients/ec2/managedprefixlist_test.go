@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func TestDiffPrefixListEntries(t *testing.T) {
+	type args struct {
+		desired  []manualv1alpha1.PrefixListEntry
+		observed []ec2types.PrefixListEntry
+	}
+	type want struct {
+		add    []ec2types.AddPrefixListEntry
+		remove []ec2types.RemovePrefixListEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				desired: []manualv1alpha1.PrefixListEntry{
+					{CIDR: "10.0.0.1/16", Description: aws.String("vpc")},
+					{CIDR: "192.168.0.0/24"},
+				},
+				observed: []ec2types.PrefixListEntry{
+					{Cidr: aws.String("192.168.0.0/24")},
+					{Cidr: aws.String("10.0.0.0/16"), Description: aws.String("vpc")},
+				},
+			},
+		},
+		"AddAndRemove": {
+			args: args{
+				desired: []manualv1alpha1.PrefixListEntry{
+					{CIDR: "10.0.0.0/16"},
+					{CIDR: "10.1.0.0/16"},
+				},
+				observed: []ec2types.PrefixListEntry{
+					{Cidr: aws.String("10.0.0.0/16")},
+					{Cidr: aws.String("10.2.0.0/16")},
+				},
+			},
+			want: want{
+				add:    []ec2types.AddPrefixListEntry{{Cidr: aws.String("10.1.0.0/16")}},
+				remove: []ec2types.RemovePrefixListEntry{{Cidr: aws.String("10.2.0.0/16")}},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				desired:  []manualv1alpha1.PrefixListEntry{{CIDR: "10.0.0.0/16", Description: aws.String("new")}},
+				observed: []ec2types.PrefixListEntry{{Cidr: aws.String("10.0.0.0/16"), Description: aws.String("old")}},
+			},
+			want: want{
+				remove: []ec2types.RemovePrefixListEntry{{Cidr: aws.String("10.0.0.0/16")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffPrefixListEntries(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(ec2types.AddPrefixListEntry{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(ec2types.RemovePrefixListEntry{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given
+	// NetworkAclId is not valid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned by ec2 when the
+	// given network ACL entry does not exist
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+
+	// DefaultNetworkACLRuleNumber is the rule number of the entries that deny
+	// all traffic not matched by any other entry. They cannot be modified.
+	DefaultNetworkACLRuleNumber = 32767
+
+	// DefaultIPv6NetworkACLRuleNumber is the rule number of the entries that
+	// deny all IPv6 traffic not matched by any other entry. They are added to
+	// network ACLs in VPCs with an IPv6 CIDR block and cannot be modified.
+	DefaultIPv6NetworkACLRuleNumber = 32768
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAcl(ctx context.Context, input *ec2.CreateNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclOutput, error)
+	DeleteNetworkAcl(ctx context.Context, input *ec2.DeleteNetworkAclInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclOutput, error)
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+	ReplaceNetworkAclAssociation(ctx context.Context, input *ec2.ReplaceNetworkAclAssociationInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclAssociationOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg aws.Config) NetworkACLClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// NetworkACLEntryClient is the external client used for NetworkACLEntry Custom Resource
+type NetworkACLEntryClient interface {
+	DescribeNetworkAcls(ctx context.Context, input *ec2.DescribeNetworkAclsInput, opts ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkAclEntry(ctx context.Context, input *ec2.CreateNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.CreateNetworkAclEntryOutput, error)
+	ReplaceNetworkAclEntry(ctx context.Context, input *ec2.ReplaceNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.ReplaceNetworkAclEntryOutput, error)
+	DeleteNetworkAclEntry(ctx context.Context, input *ec2.DeleteNetworkAclEntryInput, opts ...func(*ec2.Options)) (*ec2.DeleteNetworkAclEntryOutput, error)
+}
+
+// NewNetworkACLEntryClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLEntryClient(cfg aws.Config) NetworkACLEntryClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network
+// ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLIDNotFound
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the
+// network ACL entry doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == NetworkACLEntryNotFound
+}
+
+// protocolNumbers maps the protocol names accepted in network ACL entries to
+// the protocol numbers returned by ec2.
+var protocolNumbers = map[string]string{
+	"all":    "-1",
+	"tcp":    "6",
+	"udp":    "17",
+	"icmp":   "1",
+	"icmpv6": "58",
+}
+
+// NormalizeNetworkACLProtocol returns the protocol number of the supplied
+// protocol name or number.
+func NormalizeNetworkACLProtocol(protocol string) string {
+	p := strings.ToLower(protocol)
+	if n, ok := protocolNumbers[p]; ok {
+		return n
+	}
+	return p
+}
+
+// GenerateNetworkACLEntry returns the network ACL entry described by the
+// supplied rule.
+func GenerateNetworkACLEntry(r manualv1alpha1.NetworkACLRule, egress bool) ec2types.NetworkAclEntry {
+	e := ec2types.NetworkAclEntry{
+		RuleNumber:    aws.Int32(r.RuleNumber),
+		Egress:        aws.Bool(egress),
+		Protocol:      aws.String(NormalizeNetworkACLProtocol(r.Protocol)),
+		RuleAction:    ec2types.RuleAction(r.RuleAction),
+		CidrBlock:     r.CIDRBlock,
+		Ipv6CidrBlock: r.IPv6CIDRBlock,
+	}
+	if r.FromPort != nil || r.ToPort != nil {
+		e.PortRange = &ec2types.PortRange{From: r.FromPort, To: r.ToPort}
+	}
+	if r.ICMPType != nil || r.ICMPCode != nil {
+		e.IcmpTypeCode = &ec2types.IcmpTypeCode{Type: r.ICMPType, Code: r.ICMPCode}
+	}
+	return e
+}
+
+// GenerateNetworkACLEntries returns the network ACL entries described by the
+// supplied rules.
+func GenerateNetworkACLEntries(rules []manualv1alpha1.NetworkACLRule, egress bool) []ec2types.NetworkAclEntry {
+	entries := make([]ec2types.NetworkAclEntry, len(rules))
+	for i := range rules {
+		entries[i] = GenerateNetworkACLEntry(rules[i], egress)
+	}
+	return entries
+}
+
+// GenerateNetworkACLObservation is used to produce
+// manualv1alpha1.NetworkACLObservation from ec2types.NetworkAcl.
+func GenerateNetworkACLObservation(acl ec2types.NetworkAcl) manualv1alpha1.NetworkACLObservation {
+	o := manualv1alpha1.NetworkACLObservation{
+		NetworkACLID: aws.ToString(acl.NetworkAclId),
+		IsDefault:    aws.ToBool(acl.IsDefault),
+		OwnerID:      aws.ToString(acl.OwnerId),
+	}
+	if len(acl.Associations) > 0 {
+		o.Associations = make([]manualv1alpha1.NetworkACLAssociation, len(acl.Associations))
+		for i, a := range acl.Associations {
+			o.Associations[i] = manualv1alpha1.NetworkACLAssociation{
+				AssociationID: aws.ToString(a.NetworkAclAssociationId),
+				SubnetID:      aws.ToString(a.SubnetId),
+			}
+		}
+	}
+	return o
+}
+
+// LateInitializeNetworkACL fills the empty fields in
+// *manualv1alpha1.NetworkACLParameters with the values seen in
+// ec2types.NetworkAcl.
+func LateInitializeNetworkACL(in *manualv1alpha1.NetworkACLParameters, acl *ec2types.NetworkAcl) {
+	if acl == nil {
+		return
+	}
+	in.VPCID = pointer.LateInitialize(in.VPCID, acl.VpcId)
+}
+
+// IsNetworkACLUpToDate returns true if the entries, subnet associations and
+// tags of the network ACL match the desired state.
+func IsNetworkACLUpToDate(p manualv1alpha1.NetworkACLParameters, acl ec2types.NetworkAcl) bool {
+	add, remove := DiffEC2Tags(GenerateEC2TagsManualV1alpha1(p.Tags), acl.Tags)
+	if len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+	associate, disassociate := DiffNetworkACLAssociations(p.SubnetIDs, acl.Associations)
+	if len(associate) != 0 || len(disassociate) != 0 {
+		return false
+	}
+	create, replace, del := DiffNetworkACLEntries(DesiredNetworkACLEntries(p), ManagedNetworkACLEntries(p, acl.Entries))
+	return len(create) == 0 && len(replace) == 0 && len(del) == 0
+}
+
+// DesiredNetworkACLEntries returns the ingress and egress entries that are
+// managed by the supplied parameters.
+func DesiredNetworkACLEntries(p manualv1alpha1.NetworkACLParameters) []ec2types.NetworkAclEntry {
+	var entries []ec2types.NetworkAclEntry
+	if !pointer.BoolValue(p.IgnoreIngress) {
+		entries = append(entries, GenerateNetworkACLEntries(p.Ingress, false)...)
+	}
+	if !pointer.BoolValue(p.IgnoreEgress) {
+		entries = append(entries, GenerateNetworkACLEntries(p.Egress, true)...)
+	}
+	return entries
+}
+
+// ManagedNetworkACLEntries filters the supplied observed entries down to the
+// ones that are managed by the supplied parameters, i.e. it drops the entries
+// in ignored directions and the default entries.
+func ManagedNetworkACLEntries(p manualv1alpha1.NetworkACLParameters, observed []ec2types.NetworkAclEntry) []ec2types.NetworkAclEntry {
+	entries := make([]ec2types.NetworkAclEntry, 0, len(observed))
+	for _, e := range observed {
+		switch {
+		case aws.ToInt32(e.RuleNumber) == DefaultNetworkACLRuleNumber,
+			aws.ToInt32(e.RuleNumber) == DefaultIPv6NetworkACLRuleNumber:
+		case aws.ToBool(e.Egress) && pointer.BoolValue(p.IgnoreEgress):
+		case !aws.ToBool(e.Egress) && pointer.BoolValue(p.IgnoreIngress):
+		default:
+			entries = append(entries, e)
+		}
+	}
+	return entries
+}
+
+// DiffNetworkACLAssociations returns the subnets that should be associated
+// with the network ACL and the associations of subnets that should be
+// associated with the default network ACL of the VPC again.
+func DiffNetworkACLAssociations(subnetIDs []string, observed []ec2types.NetworkAclAssociation) (associate []string, disassociate []ec2types.NetworkAclAssociation) {
+	want := make(map[string]struct{}, len(subnetIDs))
+	for _, id := range subnetIDs {
+		want[id] = struct{}{}
+	}
+	have := make(map[string]struct{}, len(observed))
+	for _, a := range observed {
+		id := aws.ToString(a.SubnetId)
+		have[id] = struct{}{}
+		if _, ok := want[id]; !ok {
+			disassociate = append(disassociate, a)
+		}
+	}
+	for id := range want {
+		if _, ok := have[id]; !ok {
+			associate = append(associate, id)
+		}
+	}
+	sort.Strings(associate)
+	return associate, disassociate
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input to create the supplied
+// entry in the network ACL with the supplied ID.
+func GenerateCreateNetworkACLEntryInput(id string, e ec2types.NetworkAclEntry) *ec2.CreateNetworkAclEntryInput {
+	return &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    e.RuleNumber,
+		Egress:        e.Egress,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		PortRange:     e.PortRange,
+		IcmpTypeCode:  e.IcmpTypeCode,
+	}
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input to replace the entry
+// with the same direction and rule number as the supplied one in the network
+// ACL with the supplied ID.
+func GenerateReplaceNetworkACLEntryInput(id string, e ec2types.NetworkAclEntry) *ec2.ReplaceNetworkAclEntryInput {
+	return &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId:  aws.String(id),
+		RuleNumber:    e.RuleNumber,
+		Egress:        e.Egress,
+		Protocol:      e.Protocol,
+		RuleAction:    e.RuleAction,
+		CidrBlock:     e.CidrBlock,
+		Ipv6CidrBlock: e.Ipv6CidrBlock,
+		PortRange:     e.PortRange,
+		IcmpTypeCode:  e.IcmpTypeCode,
+	}
+}
+
+// GenerateDeleteNetworkACLEntryInput returns the input to delete the supplied
+// entry from the network ACL with the supplied ID.
+func GenerateDeleteNetworkACLEntryInput(id string, e ec2types.NetworkAclEntry) *ec2.DeleteNetworkAclEntryInput {
+	return &ec2.DeleteNetworkAclEntryInput{
+		NetworkAclId: aws.String(id),
+		RuleNumber:   e.RuleNumber,
+		Egress:       e.Egress,
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// entryKey represents the unique tuple (direction, rule number) of a network
+// ACL entry in a format supported as a map key
+type entryKey struct {
+	egress     bool
+	ruleNumber int32
+}
+
+func getEntryKey(e ec2types.NetworkAclEntry) entryKey {
+	return entryKey{
+		egress:     aws.ToBool(e.Egress),
+		ruleNumber: aws.ToInt32(e.RuleNumber),
+	}
+}
+
+func convertEntriesToMap(entries []ec2types.NetworkAclEntry) map[entryKey]ec2types.NetworkAclEntry {
+	ret := make(map[entryKey]ec2types.NetworkAclEntry, len(entries))
+	for _, e := range entries {
+		ret[getEntryKey(e)] = e
+	}
+	return ret
+}
+
+// IsNetworkACLEntryUpToDate returns true if the observed entry matches the
+// desired one. Port ranges and ICMP types are only compared for the protocols
+// they apply to, since ec2 ignores them otherwise.
+func IsNetworkACLEntryUpToDate(want, have ec2types.NetworkAclEntry) bool {
+	protocol := NormalizeNetworkACLProtocol(aws.ToString(want.Protocol))
+	if protocol != NormalizeNetworkACLProtocol(aws.ToString(have.Protocol)) ||
+		want.RuleAction != have.RuleAction ||
+		aws.ToString(want.CidrBlock) != aws.ToString(have.CidrBlock) ||
+		aws.ToString(want.Ipv6CidrBlock) != aws.ToString(have.Ipv6CidrBlock) {
+		return false
+	}
+
+	switch protocol {
+	case protocolNumbers["tcp"], protocolNumbers["udp"]:
+		var w, h ec2types.PortRange
+		if want.PortRange != nil {
+			w = *want.PortRange
+		}
+		if have.PortRange != nil {
+			h = *have.PortRange
+		}
+		return aws.ToInt32(w.From) == aws.ToInt32(h.From) && aws.ToInt32(w.To) == aws.ToInt32(h.To)
+	case protocolNumbers["icmp"], protocolNumbers["icmpv6"]:
+		var w, h ec2types.IcmpTypeCode
+		if want.IcmpTypeCode != nil {
+			w = *want.IcmpTypeCode
+		}
+		if have.IcmpTypeCode != nil {
+			h = *have.IcmpTypeCode
+		}
+		return aws.ToInt32(w.Type) == aws.ToInt32(h.Type) && aws.ToInt32(w.Code) == aws.ToInt32(h.Code)
+	}
+	return true
+}
+
+// DiffNetworkACLEntries compares two sets of network ACL entries, and returns
+// the entries to create, replace and delete to make them identical. Entries
+// are identified by their direction and rule number, so an entry that
+// changed in place is replaced rather than deleted and created again.
+func DiffNetworkACLEntries(want, have []ec2types.NetworkAclEntry) (create, replace, remove []ec2types.NetworkAclEntry) {
+	wantMap := convertEntriesToMap(want)
+	haveMap := convertEntriesToMap(have)
+
+	for key, w := range wantMap {
+		h, ok := haveMap[key]
+		switch {
+		case !ok:
+			create = append(create, w)
+		case !IsNetworkACLEntryUpToDate(w, h):
+			replace = append(replace, w)
+		}
+	}
+
+	for key, h := range haveMap {
+		if _, ok := wantMap[key]; !ok {
+			remove = append(remove, h)
+		}
+	}
+
+	// Sort the entries so that they are applied in a stable order.
+	for _, entries := range [][]ec2types.NetworkAclEntry{create, replace, remove} {
+		sort.Slice(entries, func(i, j int) bool {
+			ki, kj := getEntryKey(entries[i]), getEntryKey(entries[j])
+			if ki.egress != kj.egress {
+				return !ki.egress
+			}
+			return ki.ruleNumber < kj.ruleNumber
+		})
+	}
+	return create, replace, remove
+}
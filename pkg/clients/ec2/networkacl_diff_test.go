@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+func aclEntry(n int32, egress bool, protocol string, action ec2types.RuleAction, cidr string, port int32) ec2types.NetworkAclEntry {
+	e := ec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(n),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String(protocol),
+		RuleAction: action,
+		CidrBlock:  aws.String(cidr),
+	}
+	if port != 0 {
+		e.PortRange = &ec2types.PortRange{From: aws.Int32(port), To: aws.Int32(port)}
+	}
+	return e
+}
+
+func TestDiffNetworkACLEntries(t *testing.T) {
+	type args struct {
+		want []ec2types.NetworkAclEntry
+		have []ec2types.NetworkAclEntry
+	}
+	type want struct {
+		create  []ec2types.NetworkAclEntry
+		replace []ec2types.NetworkAclEntry
+		remove  []ec2types.NetworkAclEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"UpToDate": {
+			args: args{
+				want: GenerateNetworkACLEntries([]manualv1alpha1.NetworkACLRule{
+					{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: aws.String("10.0.0.0/16"), FromPort: aws.Int32(443), ToPort: aws.Int32(443)},
+					{RuleNumber: 200, Protocol: "-1", RuleAction: "deny", CIDRBlock: aws.String("0.0.0.0/0"), FromPort: aws.Int32(0), ToPort: aws.Int32(0)},
+				}, false),
+				have: []ec2types.NetworkAclEntry{
+					aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+					// ec2 does not return the ports of entries for all protocols.
+					aclEntry(200, false, "-1", ec2types.RuleActionDeny, "0.0.0.0/0", 0),
+				},
+			},
+		},
+		"Create": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{
+					aclEntry(100, true, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+					aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+				},
+			},
+			want: want{
+				create: []ec2types.NetworkAclEntry{
+					aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+					aclEntry(100, true, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+				},
+			},
+		},
+		"Replace": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{aclEntry(100, false, "6", ec2types.RuleActionDeny, "10.0.0.0/16", 443)},
+				have: []ec2types.NetworkAclEntry{aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443)},
+			},
+			want: want{
+				replace: []ec2types.NetworkAclEntry{aclEntry(100, false, "6", ec2types.RuleActionDeny, "10.0.0.0/16", 443)},
+			},
+		},
+		"RemoveUnmanaged": {
+			args: args{
+				want: []ec2types.NetworkAclEntry{aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443)},
+				have: []ec2types.NetworkAclEntry{
+					aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+					aclEntry(100, true, "17", ec2types.RuleActionAllow, "0.0.0.0/0", 53),
+				},
+			},
+			want: want{
+				remove: []ec2types.NetworkAclEntry{aclEntry(100, true, "17", ec2types.RuleActionAllow, "0.0.0.0/0", 53)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.args.want, tc.args.have)
+			if diff := cmp.Diff(tc.want.create, create, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(ec2types.NetworkAclEntry{}, ec2types.PortRange{})); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.replace, replace, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(ec2types.NetworkAclEntry{}, ec2types.PortRange{})); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(ec2types.NetworkAclEntry{}, ec2types.PortRange{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func ipv6DefaultEntry(egress bool) ec2types.NetworkAclEntry {
+	return ec2types.NetworkAclEntry{
+		RuleNumber:    aws.Int32(DefaultIPv6NetworkACLRuleNumber),
+		Egress:        aws.Bool(egress),
+		Protocol:      aws.String("-1"),
+		RuleAction:    ec2types.RuleActionDeny,
+		Ipv6CidrBlock: aws.String("::/0"),
+	}
+}
+
+func TestManagedNetworkACLEntries(t *testing.T) {
+	managed := aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443)
+
+	cases := map[string]struct {
+		p        manualv1alpha1.NetworkACLParameters
+		observed []ec2types.NetworkAclEntry
+		want     []ec2types.NetworkAclEntry
+	}{
+		"SkipDefaultEntries": {
+			observed: []ec2types.NetworkAclEntry{
+				managed,
+				aclEntry(DefaultNetworkACLRuleNumber, false, "-1", ec2types.RuleActionDeny, "0.0.0.0/0", 0),
+				ipv6DefaultEntry(false),
+			},
+			want: []ec2types.NetworkAclEntry{managed},
+		},
+		"SkipIgnoredDirection": {
+			p: manualv1alpha1.NetworkACLParameters{IgnoreEgress: aws.Bool(true)},
+			observed: []ec2types.NetworkAclEntry{
+				managed,
+				aclEntry(100, true, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+				ipv6DefaultEntry(true),
+			},
+			want: []ec2types.NetworkAclEntry{managed},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ManagedNetworkACLEntries(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2types.NetworkAclEntry{}, ec2types.PortRange{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNetworkACLUpToDate(t *testing.T) {
+	observed := ec2types.NetworkAcl{
+		Associations: []ec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-1"), NetworkAclAssociationId: aws.String("aclassoc-1")}},
+		Entries: []ec2types.NetworkAclEntry{
+			aclEntry(100, false, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+			aclEntry(100, true, "6", ec2types.RuleActionAllow, "10.0.0.0/16", 443),
+			aclEntry(DefaultNetworkACLRuleNumber, false, "-1", ec2types.RuleActionDeny, "0.0.0.0/0", 0),
+			ipv6DefaultEntry(false),
+			aclEntry(DefaultNetworkACLRuleNumber, true, "-1", ec2types.RuleActionDeny, "0.0.0.0/0", 0),
+			ipv6DefaultEntry(true),
+		},
+	}
+	rule := manualv1alpha1.NetworkACLRule{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: aws.String("10.0.0.0/16"), FromPort: aws.Int32(443), ToPort: aws.Int32(443)}
+
+	cases := map[string]struct {
+		p    manualv1alpha1.NetworkACLParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{"subnet-1"},
+				Ingress:   []manualv1alpha1.NetworkACLRule{rule},
+				Egress:    []manualv1alpha1.NetworkACLRule{rule},
+			},
+			want: true,
+		},
+		"IgnoreEgress": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs:    []string{"subnet-1"},
+				Ingress:      []manualv1alpha1.NetworkACLRule{rule},
+				IgnoreEgress: aws.Bool(true),
+			},
+			want: true,
+		},
+		"UnmanagedEgress": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{"subnet-1"},
+				Ingress:   []manualv1alpha1.NetworkACLRule{rule},
+			},
+			want: false,
+		},
+		"NewSubnet": {
+			p: manualv1alpha1.NetworkACLParameters{
+				SubnetIDs: []string{"subnet-1", "subnet-2"},
+				Ingress:   []manualv1alpha1.NetworkACLRule{rule},
+				Egress:    []manualv1alpha1.NetworkACLRule{rule},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNetworkACLUpToDate(tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
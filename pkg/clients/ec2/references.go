@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+)
+
+// Kinds cannot declare generated references to kinds of API packages that
+// import their own API package, e.g. kinds of v1beta1 to kinds of
+// manualv1alpha1 and v1alpha1. The requests below are resolved by the
+// controllers of the referencing kinds instead.
+
+// InstanceIDReference returns the request to resolve a reference to a
+// manualv1alpha1.Instance.
+func InstanceIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &manualv1alpha1.InstanceList{},
+			Managed: &manualv1alpha1.Instance{},
+		},
+	}
+}
+
+// VolumeIDReference returns the request to resolve a reference to a
+// v1alpha1.Volume.
+func VolumeIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &v1alpha1.VolumeList{},
+			Managed: &v1alpha1.Volume{},
+		},
+	}
+}
+
+// TransitGatewayIDReference returns the request to resolve a reference to a
+// v1alpha1.TransitGateway.
+func TransitGatewayIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &v1alpha1.TransitGatewayList{},
+			Managed: &v1alpha1.TransitGateway{},
+		},
+	}
+}
+
+// TransitGatewayRouteTableIDReference returns the request to resolve a reference to a
+// v1alpha1.TransitGatewayRouteTable.
+func TransitGatewayRouteTableIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &v1alpha1.TransitGatewayRouteTableList{},
+			Managed: &v1alpha1.TransitGatewayRouteTable{},
+		},
+	}
+}
+
+// TransitGatewayVPCAttachmentIDReference returns the request to resolve a reference to a
+// v1alpha1.TransitGatewayVPCAttachment.
+func TransitGatewayVPCAttachmentIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &v1alpha1.TransitGatewayVPCAttachmentList{},
+			Managed: &v1alpha1.TransitGatewayVPCAttachment{},
+		},
+	}
+}
+
+// VPCPeeringConnectionIDReference returns the request to resolve a reference to a
+// v1alpha1.VPCPeeringConnection.
+func VPCPeeringConnectionIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &v1alpha1.VPCPeeringConnectionList{},
+			Managed: &v1alpha1.VPCPeeringConnection{},
+		},
+	}
+}
+
+// ManagedPrefixListIDReference returns the request to resolve a reference to a
+// manualv1alpha1.ManagedPrefixList.
+func ManagedPrefixListIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &manualv1alpha1.ManagedPrefixListList{},
+			Managed: &manualv1alpha1.ManagedPrefixList{},
+		},
+	}
+}
+
+// IPAMPoolIDReference returns the request to resolve a reference to a
+// manualv1alpha1.IPAMPool.
+func IPAMPoolIDReference(id *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(id),
+		Extract:      reference.ExternalName(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &manualv1alpha1.IPAMPoolList{},
+			Managed: &manualv1alpha1.IPAMPool{},
+		},
+	}
+}
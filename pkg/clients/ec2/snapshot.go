@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// SnapshotIDNotFound is the code that is returned by ec2 when the given
+	// SnapshotId is not valid
+	SnapshotIDNotFound = "InvalidSnapshot.NotFound"
+)
+
+// SnapshotClient is the external client used for Snapshot Custom Resource
+type SnapshotClient interface {
+	CreateSnapshot(ctx context.Context, input *ec2.CreateSnapshotInput, opts ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error)
+	CopySnapshot(ctx context.Context, input *ec2.CopySnapshotInput, opts ...func(*ec2.Options)) (*ec2.CopySnapshotOutput, error)
+	DescribeSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error)
+	DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewSnapshotClient returns a new client using AWS credentials as JSON encoded data.
+func NewSnapshotClient(cfg aws.Config) SnapshotClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsSnapshotNotFoundErr returns true if the error is because the snapshot
+// doesn't exist
+func IsSnapshotNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == SnapshotIDNotFound
+}
+
+// GenerateSnapshotObservation is used to produce manualv1alpha1.SnapshotObservation
+// from ec2types.Snapshot.
+func GenerateSnapshotObservation(s ec2types.Snapshot) manualv1alpha1.SnapshotObservation {
+	return manualv1alpha1.SnapshotObservation{
+		SnapshotID:   aws.ToString(s.SnapshotId),
+		OwnerID:      aws.ToString(s.OwnerId),
+		State:        string(s.State),
+		StateMessage: aws.ToString(s.StateMessage),
+		Progress:     aws.ToString(s.Progress),
+		VolumeSize:   aws.ToInt32(s.VolumeSize),
+		Encrypted:    aws.ToBool(s.Encrypted),
+	}
+}
+
+func snapshotTagSpecifications(tags []manualv1alpha1.Tag) []ec2types.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []ec2types.TagSpecification{{
+		ResourceType: ec2types.ResourceTypeSnapshot,
+		Tags:         GenerateEC2TagsManualV1alpha1(tags),
+	}}
+}
+
+// GenerateCreateSnapshotInput returns the input to take a snapshot of a
+// volume with the supplied parameters.
+func GenerateCreateSnapshotInput(p manualv1alpha1.SnapshotParameters) *ec2.CreateSnapshotInput {
+	return &ec2.CreateSnapshotInput{
+		VolumeId:          p.VolumeID,
+		Description:       p.Description,
+		TagSpecifications: snapshotTagSpecifications(p.Tags),
+	}
+}
+
+// GenerateCopySnapshotInput returns the input to copy a snapshot with the
+// supplied parameters. The source region defaults to the region of the copy.
+func GenerateCopySnapshotInput(p manualv1alpha1.SnapshotParameters) *ec2.CopySnapshotInput {
+	in := &ec2.CopySnapshotInput{
+		SourceSnapshotId:  p.SourceSnapshotID,
+		SourceRegion:      p.SourceRegion,
+		Description:       p.Description,
+		Encrypted:         p.Encrypted,
+		KmsKeyId:          p.KMSKeyID,
+		TagSpecifications: snapshotTagSpecifications(p.Tags),
+	}
+	if in.SourceRegion == nil {
+		in.SourceRegion = aws.String(p.Region)
+	}
+	return in
+}
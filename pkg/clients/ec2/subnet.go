@@ -44,6 +44,7 @@ func IsSubnetNotFoundErr(err error) bool {
 func GenerateSubnetObservation(subnet ec2types.Subnet) v1beta1.SubnetObservation {
 	o := v1beta1.SubnetObservation{
 		AvailableIPAddressCount: aws.ToInt32(subnet.AvailableIpAddressCount),
+		CIDRBlock:               aws.ToString(subnet.CidrBlock),
 		DefaultForAZ:            aws.ToBool(subnet.DefaultForAz),
 		SubnetID:                aws.ToString(subnet.SubnetId),
 		SubnetState:             string(subnet.State),
@@ -82,12 +82,14 @@ func TestGenerateSubnetObservation(t *testing.T) {
 		"AllFilled": {
 			in: ec2types.Subnet{
 				AvailableIpAddressCount: aws.Int32(int32(availableIPCount)),
+				CidrBlock:               aws.String("10.0.0.0/24"),
 				DefaultForAz:            aws.Bool(true),
 				SubnetId:                aws.String(subnetID),
 				State:                   ec2types.SubnetStateAvailable,
 			},
 			out: v1beta1.SubnetObservation{
 				AvailableIPAddressCount: int32(availableIPCount),
+				CIDRBlock:               "10.0.0.0/24",
 				DefaultForAZ:            true,
 				SubnetID:                subnetID,
 				SubnetState:             state,
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// TransitGatewayAttachmentIDNotFound is the code that is returned by ec2
+	// when the given TransitGatewayAttachmentId is not valid
+	TransitGatewayAttachmentIDNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+
+	// TransitGatewayRouteTableIDNotFound is the code that is returned by ec2
+	// when the given TransitGatewayRouteTableId is not valid
+	TransitGatewayRouteTableIDNotFound = "InvalidRouteTableID.NotFound"
+)
+
+// TransitGatewayPeeringAttachmentClient is the external client used for
+// TransitGatewayPeeringAttachment Custom Resource
+type TransitGatewayPeeringAttachmentClient interface {
+	CreateTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.CreateTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.CreateTransitGatewayPeeringAttachmentOutput, error)
+	DescribeTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error)
+	DeleteTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTransitGatewayPeeringAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayPeeringAttachmentClient(cfg aws.Config) TransitGatewayPeeringAttachmentClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// TransitGatewayPeeringAttachmentAccepterClient is the external client used
+// for TransitGatewayPeeringAttachmentAccepter Custom Resource
+type TransitGatewayPeeringAttachmentAccepterClient interface {
+	DescribeTransitGatewayPeeringAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayPeeringAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayPeeringAttachmentsOutput, error)
+	AcceptTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.AcceptTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.AcceptTransitGatewayPeeringAttachmentOutput, error)
+	DeleteTransitGatewayPeeringAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayPeeringAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayPeeringAttachmentOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTransitGatewayPeeringAttachmentAccepterClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayPeeringAttachmentAccepterClient(cfg aws.Config) TransitGatewayPeeringAttachmentAccepterClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// TransitGatewayVPCAttachmentAccepterClient is the external client used for
+// TransitGatewayVPCAttachmentAccepter Custom Resource
+type TransitGatewayVPCAttachmentAccepterClient interface {
+	DescribeTransitGatewayVpcAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayVpcAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayVpcAttachmentsOutput, error)
+	AcceptTransitGatewayVpcAttachment(ctx context.Context, input *ec2.AcceptTransitGatewayVpcAttachmentInput, opts ...func(*ec2.Options)) (*ec2.AcceptTransitGatewayVpcAttachmentOutput, error)
+	DeleteTransitGatewayVpcAttachment(ctx context.Context, input *ec2.DeleteTransitGatewayVpcAttachmentInput, opts ...func(*ec2.Options)) (*ec2.DeleteTransitGatewayVpcAttachmentOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewTransitGatewayVPCAttachmentAccepterClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayVPCAttachmentAccepterClient(cfg aws.Config) TransitGatewayVPCAttachmentAccepterClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// TransitGatewayRouteTableAssociationClient is the external client used for
+// TransitGatewayRouteTableAssociation Custom Resource
+type TransitGatewayRouteTableAssociationClient interface {
+	GetTransitGatewayRouteTableAssociations(ctx context.Context, input *ec2.GetTransitGatewayRouteTableAssociationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTableAssociationsOutput, error)
+	DescribeTransitGatewayAttachments(ctx context.Context, input *ec2.DescribeTransitGatewayAttachmentsInput, opts ...func(*ec2.Options)) (*ec2.DescribeTransitGatewayAttachmentsOutput, error)
+	AssociateTransitGatewayRouteTable(ctx context.Context, input *ec2.AssociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.AssociateTransitGatewayRouteTableOutput, error)
+	DisassociateTransitGatewayRouteTable(ctx context.Context, input *ec2.DisassociateTransitGatewayRouteTableInput, opts ...func(*ec2.Options)) (*ec2.DisassociateTransitGatewayRouteTableOutput, error)
+}
+
+// NewTransitGatewayRouteTableAssociationClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTableAssociationClient(cfg aws.Config) TransitGatewayRouteTableAssociationClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// TransitGatewayRouteTablePropagationClient is the external client used for
+// TransitGatewayRouteTablePropagation Custom Resource
+type TransitGatewayRouteTablePropagationClient interface {
+	GetTransitGatewayRouteTablePropagations(ctx context.Context, input *ec2.GetTransitGatewayRouteTablePropagationsInput, opts ...func(*ec2.Options)) (*ec2.GetTransitGatewayRouteTablePropagationsOutput, error)
+	EnableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.EnableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.EnableTransitGatewayRouteTablePropagationOutput, error)
+	DisableTransitGatewayRouteTablePropagation(ctx context.Context, input *ec2.DisableTransitGatewayRouteTablePropagationInput, opts ...func(*ec2.Options)) (*ec2.DisableTransitGatewayRouteTablePropagationOutput, error)
+}
+
+// NewTransitGatewayRouteTablePropagationClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayRouteTablePropagationClient(cfg aws.Config) TransitGatewayRouteTablePropagationClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because
+// the transit gateway attachment doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TransitGatewayAttachmentIDNotFound
+}
+
+// IsTransitGatewayRouteTableNotFoundErr returns true if the error is because
+// the transit gateway route table doesn't exist
+func IsTransitGatewayRouteTableNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == TransitGatewayRouteTableIDNotFound
+}
+
+// IsTransitGatewayAttachmentGone returns true if the transit gateway
+// attachment is deleted or being deleted. Deleted attachments are still
+// returned by ec2 for a while.
+func IsTransitGatewayAttachmentGone(s ec2types.TransitGatewayAttachmentState) bool {
+	return s == ec2types.TransitGatewayAttachmentStateDeleted ||
+		s == ec2types.TransitGatewayAttachmentStateDeleting
+}
+
+// GenerateCreateTransitGatewayPeeringAttachmentInput returns the input to
+// create a transit gateway peering attachment with the supplied parameters.
+func GenerateCreateTransitGatewayPeeringAttachmentInput(p manualv1alpha1.TransitGatewayPeeringAttachmentParameters) *ec2.CreateTransitGatewayPeeringAttachmentInput {
+	in := &ec2.CreateTransitGatewayPeeringAttachmentInput{
+		TransitGatewayId:     p.TransitGatewayID,
+		PeerTransitGatewayId: p.PeerTransitGatewayID,
+		PeerAccountId:        aws.String(p.PeerAccountID),
+		PeerRegion:           aws.String(p.PeerRegion),
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeTransitGatewayAttachment,
+			Tags:         GenerateEC2TagsManualV1alpha1(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateTransitGatewayPeeringAttachmentObservation is used to produce
+// manualv1alpha1.TransitGatewayPeeringAttachmentObservation from
+// ec2types.TransitGatewayPeeringAttachment.
+func GenerateTransitGatewayPeeringAttachmentObservation(a ec2types.TransitGatewayPeeringAttachment) manualv1alpha1.TransitGatewayPeeringAttachmentObservation {
+	o := manualv1alpha1.TransitGatewayPeeringAttachmentObservation{
+		TransitGatewayAttachmentID: aws.ToString(a.TransitGatewayAttachmentId),
+		State:                      string(a.State),
+	}
+	if a.Status != nil {
+		o.StatusMessage = aws.ToString(a.Status.Message)
+	}
+	if a.CreationTime != nil {
+		t := metav1.NewTime(*a.CreationTime)
+		o.CreationTime = &t
+	}
+	return o
+}
+
+// GenerateTransitGatewayPeeringAttachmentAccepterObservation is used to
+// produce manualv1alpha1.TransitGatewayPeeringAttachmentAccepterObservation from
+// ec2types.TransitGatewayPeeringAttachment.
+func GenerateTransitGatewayPeeringAttachmentAccepterObservation(a ec2types.TransitGatewayPeeringAttachment) manualv1alpha1.TransitGatewayPeeringAttachmentAccepterObservation {
+	o := manualv1alpha1.TransitGatewayPeeringAttachmentAccepterObservation{
+		State: string(a.State),
+	}
+	if a.AccepterTgwInfo != nil {
+		o.TransitGatewayID = aws.ToString(a.AccepterTgwInfo.TransitGatewayId)
+	}
+	if a.RequesterTgwInfo != nil {
+		o.PeerTransitGatewayID = aws.ToString(a.RequesterTgwInfo.TransitGatewayId)
+		o.PeerAccountID = aws.ToString(a.RequesterTgwInfo.OwnerId)
+		o.PeerRegion = aws.ToString(a.RequesterTgwInfo.Region)
+	}
+	return o
+}
+
+// GenerateTransitGatewayVPCAttachmentAccepterObservation is used to produce
+// manualv1alpha1.TransitGatewayVPCAttachmentAccepterObservation from
+// ec2types.TransitGatewayVpcAttachment.
+func GenerateTransitGatewayVPCAttachmentAccepterObservation(a ec2types.TransitGatewayVpcAttachment) manualv1alpha1.TransitGatewayVPCAttachmentAccepterObservation {
+	return manualv1alpha1.TransitGatewayVPCAttachmentAccepterObservation{
+		State:            string(a.State),
+		TransitGatewayID: aws.ToString(a.TransitGatewayId),
+		VPCID:            aws.ToString(a.VpcId),
+		VPCOwnerID:       aws.ToString(a.VpcOwnerId),
+	}
+}
+
+// GetTransitGatewayRouteTableAssociation returns the association of the given
+// transit gateway route table and attachment, or nil if there is none.
+func GetTransitGatewayRouteTableAssociation(ctx context.Context, client TransitGatewayRouteTableAssociationClient, routeTableID, attachmentID string) (*ec2types.TransitGatewayRouteTableAssociation, error) {
+	res, err := client.GetTransitGatewayRouteTableAssociations(ctx, &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("transit-gateway-attachment-id"),
+			Values: []string{attachmentID},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range res.Associations {
+		if aws.ToString(res.Associations[i].TransitGatewayAttachmentId) == attachmentID {
+			return &res.Associations[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// GetTransitGatewayRouteTablePropagation returns the propagation of the given
+// transit gateway attachment to the route table, or nil if there is none.
+func GetTransitGatewayRouteTablePropagation(ctx context.Context, client TransitGatewayRouteTablePropagationClient, routeTableID, attachmentID string) (*ec2types.TransitGatewayRouteTablePropagation, error) {
+	res, err := client.GetTransitGatewayRouteTablePropagations(ctx, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(routeTableID),
+		Filters: []ec2types.Filter{{
+			Name:   aws.String("transit-gateway-attachment-id"),
+			Values: []string{attachmentID},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range res.TransitGatewayRouteTablePropagations {
+		if aws.ToString(res.TransitGatewayRouteTablePropagations[i].TransitGatewayAttachmentId) == attachmentID {
+			return &res.TransitGatewayRouteTablePropagations[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -61,6 +61,7 @@ func IsVpcUpToDate(spec v1beta1.VPCParameters, vpc ec2types.Vpc, attributes ec2.
 // ec2types.Vpc.
 func GenerateVpcObservation(vpc ec2types.Vpc) v1beta1.VPCObservation {
 	o := v1beta1.VPCObservation{
+		CIDRBlock:     aws.ToString(vpc.CidrBlock),
 		IsDefault:     aws.ToBool(vpc.IsDefault),
 		DHCPOptionsID: aws.ToString(vpc.DhcpOptionsId),
 		OwnerID:       aws.ToString(vpc.OwnerId),
@@ -14,6 +14,7 @@ var (
 	boolFalse         = false
 	vpcOwner          = "some owner"
 	vpcStateAvailable = "available"
+	vpcCIDRBlock      = "10.0.0.0/16"
 )
 
 func TestGenerateVPCObservation(t *testing.T) {
@@ -23,12 +24,14 @@ func TestGenerateVPCObservation(t *testing.T) {
 	}{
 		"AllFilled": {
 			in: ec2types.Vpc{
+				CidrBlock: pointer.ToOrNilIfZeroValue(vpcCIDRBlock),
 				IsDefault: &boolFalse,
 				OwnerId:   pointer.ToOrNilIfZeroValue(vpcOwner),
 				VpcId:     pointer.ToOrNilIfZeroValue(vpcID),
 				State:     ec2types.VpcStateAvailable,
 			},
 			out: v1beta1.VPCObservation{
+				CIDRBlock: vpcCIDRBlock,
 				IsDefault: boolFalse,
 				OwnerID:   vpcOwner,
 				VPCState:  vpcStateAvailable,
@@ -91,6 +91,19 @@ func GenerateVpcCIDRBlockObservation(associationID string, vpc ec2types.Vpc) v1b
 	return o
 }
 
+// LateInitializeVPCCIDRBlock fills the empty fields in
+// *v1beta1.VPCCIDRBlockParameters with the values seen in
+// v1beta1.VPCCIDRBlockObservation.
+func LateInitializeVPCCIDRBlock(in *v1beta1.VPCCIDRBlockParameters, o v1beta1.VPCCIDRBlockObservation) {
+	if in == nil {
+		return
+	}
+	// Only IPAM allocated IPv4 CIDR blocks are unknown before creation.
+	if in.IPv4IPAMPoolID != nil {
+		in.CIDRBlock = pointer.LateInitialize(in.CIDRBlock, pointer.ToOrNilIfZeroValue(o.CIDRBlock))
+	}
+}
+
 // FindVPCCIDRBlockStatus is used to grab ec2.VpcCidrBlockStateCode from
 // ec2types.Vpc.
 func FindVPCCIDRBlockStatus(associationID string, vpc ec2types.Vpc) (ec2types.VpcCidrBlockStateCode, error) {
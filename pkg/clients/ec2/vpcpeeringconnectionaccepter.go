@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	// VPCPeeringConnectionIDNotFound is the code that is returned by ec2 when
+	// the given VpcPeeringConnectionId is not valid
+	VPCPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionAccepterClient is the external client used for
+// VPCPeeringConnectionAccepter Custom Resource
+type VPCPeeringConnectionAccepterClient interface {
+	DescribeVpcPeeringConnections(ctx context.Context, input *ec2.DescribeVpcPeeringConnectionsInput, opts ...func(*ec2.Options)) (*ec2.DescribeVpcPeeringConnectionsOutput, error)
+	AcceptVpcPeeringConnection(ctx context.Context, input *ec2.AcceptVpcPeeringConnectionInput, opts ...func(*ec2.Options)) (*ec2.AcceptVpcPeeringConnectionOutput, error)
+	ModifyVpcPeeringConnectionOptions(ctx context.Context, input *ec2.ModifyVpcPeeringConnectionOptionsInput, opts ...func(*ec2.Options)) (*ec2.ModifyVpcPeeringConnectionOptionsOutput, error)
+	DeleteVpcPeeringConnection(ctx context.Context, input *ec2.DeleteVpcPeeringConnectionInput, opts ...func(*ec2.Options)) (*ec2.DeleteVpcPeeringConnectionOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewVPCPeeringConnectionAccepterClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCPeeringConnectionAccepterClient(cfg aws.Config) VPCPeeringConnectionAccepterClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the
+// VPC peering connection doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VPCPeeringConnectionIDNotFound
+}
+
+// GenerateVPCPeeringConnectionAccepterObservation is used to produce
+// manualv1alpha1.VPCPeeringConnectionAccepterObservation from
+// ec2types.VpcPeeringConnection.
+func GenerateVPCPeeringConnectionAccepterObservation(c ec2types.VpcPeeringConnection) manualv1alpha1.VPCPeeringConnectionAccepterObservation {
+	o := manualv1alpha1.VPCPeeringConnectionAccepterObservation{}
+	if c.Status != nil {
+		o.Status = string(c.Status.Code)
+		o.StatusMessage = aws.ToString(c.Status.Message)
+	}
+	if c.AccepterVpcInfo != nil {
+		o.VPCID = aws.ToString(c.AccepterVpcInfo.VpcId)
+	}
+	if c.RequesterVpcInfo != nil {
+		o.PeerVPCID = aws.ToString(c.RequesterVpcInfo.VpcId)
+		o.PeerOwnerID = aws.ToString(c.RequesterVpcInfo.OwnerId)
+		o.PeerRegion = aws.ToString(c.RequesterVpcInfo.Region)
+	}
+	return o
+}
+
+// IsVPCPeeringConnectionAccepterOptionsUpToDate returns true if the desired
+// accepter peering options are applied to the VPC peering connection.
+func IsVPCPeeringConnectionAccepterOptionsUpToDate(p *manualv1alpha1.VPCPeeringConnectionAccepterOptions, c ec2types.VpcPeeringConnection) bool {
+	if p == nil || p.AllowDNSResolutionFromRemoteVPC == nil {
+		return true
+	}
+	var observed bool
+	if c.AccepterVpcInfo != nil && c.AccepterVpcInfo.PeeringOptions != nil {
+		observed = aws.ToBool(c.AccepterVpcInfo.PeeringOptions.AllowDnsResolutionFromRemoteVpc)
+	}
+	return observed == aws.ToBool(p.AllowDNSResolutionFromRemoteVPC)
+}
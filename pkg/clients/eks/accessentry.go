@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// GenerateCreateAccessEntryInput from AccessEntryParameters.
+func GenerateCreateAccessEntryInput(p manualv1alpha1.AccessEntryParameters) *eks.CreateAccessEntryInput {
+	return &eks.CreateAccessEntryInput{
+		ClusterName:      &p.ClusterName,
+		PrincipalArn:     &p.PrincipalARN,
+		KubernetesGroups: p.KubernetesGroups,
+		Username:         p.Username,
+		Type:             p.Type,
+		Tags:             p.Tags,
+	}
+}
+
+// GenerateUpdateAccessEntryInput from AccessEntryParameters.
+func GenerateUpdateAccessEntryInput(p manualv1alpha1.AccessEntryParameters) *eks.UpdateAccessEntryInput {
+	groups := p.KubernetesGroups
+	if groups == nil {
+		// An omitted list leaves the groups of the access entry unchanged.
+		groups = []string{}
+	}
+	return &eks.UpdateAccessEntryInput{
+		ClusterName:      &p.ClusterName,
+		PrincipalArn:     &p.PrincipalARN,
+		KubernetesGroups: groups,
+		Username:         p.Username,
+	}
+}
+
+// GenerateAccessEntryObservation is used to produce AccessEntryObservation
+// from ekstypes.AccessEntry.
+func GenerateAccessEntryObservation(ae *ekstypes.AccessEntry) manualv1alpha1.AccessEntryObservation {
+	if ae == nil {
+		return manualv1alpha1.AccessEntryObservation{}
+	}
+	return manualv1alpha1.AccessEntryObservation{
+		AccessEntryARN: pointer.StringValue(ae.AccessEntryArn),
+		CreatedAt:      pointer.TimeToMetaTime(ae.CreatedAt),
+		ModifiedAt:     pointer.TimeToMetaTime(ae.ModifiedAt),
+	}
+}
+
+// LateInitializeAccessEntry fills the empty fields in *AccessEntryParameters
+// with the values seen in ekstypes.AccessEntry.
+func LateInitializeAccessEntry(in *manualv1alpha1.AccessEntryParameters, ae *ekstypes.AccessEntry) {
+	if ae == nil {
+		return
+	}
+	in.Username = pointer.LateInitialize(in.Username, ae.Username)
+	in.Type = pointer.LateInitialize(in.Type, ae.Type)
+	if len(in.Tags) == 0 {
+		in.Tags = ae.Tags
+	}
+}
+
+// IsAccessEntryUpToDate checks whether there is a change in the Kubernetes
+// groups, the username or the tags of the access entry.
+func IsAccessEntryUpToDate(p manualv1alpha1.AccessEntryParameters, ae *ekstypes.AccessEntry) bool {
+	return IsAccessEntryConfigUpToDate(p, ae) && cmp.Equal(p.Tags, ae.Tags, cmpopts.EquateEmpty())
+}
+
+// IsAccessEntryConfigUpToDate checks whether there is a change in the
+// Kubernetes groups or the username of the access entry.
+func IsAccessEntryConfigUpToDate(p manualv1alpha1.AccessEntryParameters, ae *ekstypes.AccessEntry) bool {
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return cmp.Equal(p.KubernetesGroups, ae.KubernetesGroups, cmpopts.EquateEmpty(), sortStrings) &&
+		(p.Username == nil || pointer.StringValue(p.Username) == pointer.StringValue(ae.Username))
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+var (
+	principalARN = "arn:aws:iam::123456789:role/my-cool-role"
+	username     = "my-cool-user"
+)
+
+func TestGenerateUpdateAccessEntryInput(t *testing.T) {
+	cases := map[string]struct {
+		p    manualv1alpha1.AccessEntryParameters
+		want *eks.UpdateAccessEntryInput
+	}{
+		"AllFields": {
+			p: manualv1alpha1.AccessEntryParameters{
+				ClusterName:      clusterName,
+				PrincipalARN:     principalARN,
+				KubernetesGroups: []string{"viewers"},
+				Username:         &username,
+			},
+			want: &eks.UpdateAccessEntryInput{
+				ClusterName:      &clusterName,
+				PrincipalArn:     &principalARN,
+				KubernetesGroups: []string{"viewers"},
+				Username:         &username,
+			},
+		},
+		"NoGroups": {
+			p: manualv1alpha1.AccessEntryParameters{
+				ClusterName:  clusterName,
+				PrincipalARN: principalARN,
+			},
+			want: &eks.UpdateAccessEntryInput{
+				ClusterName:      &clusterName,
+				PrincipalArn:     &principalARN,
+				KubernetesGroups: []string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateAccessEntryInput(tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAccessEntry(t *testing.T) {
+	type args struct {
+		p  *manualv1alpha1.AccessEntryParameters
+		ae *ekstypes.AccessEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want *manualv1alpha1.AccessEntryParameters
+	}{
+		"AllFieldsEmpty": {
+			args: args{
+				p: &manualv1alpha1.AccessEntryParameters{},
+				ae: &ekstypes.AccessEntry{
+					Username: &username,
+					Type:     pointer.ToOrNilIfZeroValue("STANDARD"),
+					Tags:     map[string]string{"cool": "tag"},
+				},
+			},
+			want: &manualv1alpha1.AccessEntryParameters{
+				Username: &username,
+				Type:     pointer.ToOrNilIfZeroValue("STANDARD"),
+				Tags:     map[string]string{"cool": "tag"},
+			},
+		},
+		"FieldsAlreadySet": {
+			args: args{
+				p: &manualv1alpha1.AccessEntryParameters{
+					Username: pointer.ToOrNilIfZeroValue("other-user"),
+					Tags:     map[string]string{"cool": "tag"},
+				},
+				ae: &ekstypes.AccessEntry{
+					Username: &username,
+					Tags:     map[string]string{"other": "tag"},
+				},
+			},
+			want: &manualv1alpha1.AccessEntryParameters{
+				Username: pointer.ToOrNilIfZeroValue("other-user"),
+				Tags:     map[string]string{"cool": "tag"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAccessEntry(tc.args.p, tc.args.ae)
+			if diff := cmp.Diff(tc.want, tc.args.p); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessEntryUpToDate(t *testing.T) {
+	type args struct {
+		p  manualv1alpha1.AccessEntryParameters
+		ae *ekstypes.AccessEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDateIgnoringGroupOrder": {
+			args: args{
+				p: manualv1alpha1.AccessEntryParameters{
+					KubernetesGroups: []string{"b", "a"},
+					Tags:             map[string]string{"cool": "tag"},
+				},
+				ae: &ekstypes.AccessEntry{
+					KubernetesGroups: []string{"a", "b"},
+					Username:         &username,
+					Tags:             map[string]string{"cool": "tag"},
+				},
+			},
+			want: true,
+		},
+		"GroupsChanged": {
+			args: args{
+				p: manualv1alpha1.AccessEntryParameters{
+					KubernetesGroups: []string{"a"},
+				},
+				ae: &ekstypes.AccessEntry{
+					KubernetesGroups: []string{"a", "b"},
+				},
+			},
+			want: false,
+		},
+		"UsernameChanged": {
+			args: args{
+				p: manualv1alpha1.AccessEntryParameters{
+					Username: pointer.ToOrNilIfZeroValue("other-user"),
+				},
+				ae: &ekstypes.AccessEntry{
+					Username: &username,
+				},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p: manualv1alpha1.AccessEntryParameters{
+					Tags: map[string]string{"cool": "tag"},
+				},
+				ae: &ekstypes.AccessEntry{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessEntryUpToDate(tc.args.p, tc.args.ae)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
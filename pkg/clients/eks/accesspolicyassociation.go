@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// GenerateAssociateAccessPolicyInput from AccessPolicyAssociationParameters.
+func GenerateAssociateAccessPolicyInput(p manualv1alpha1.AccessPolicyAssociationParameters) *eks.AssociateAccessPolicyInput {
+	return &eks.AssociateAccessPolicyInput{
+		ClusterName:  &p.ClusterName,
+		PrincipalArn: &p.PrincipalARN,
+		PolicyArn:    &p.PolicyARN,
+		AccessScope: &ekstypes.AccessScope{
+			Type:       ekstypes.AccessScopeType(p.AccessScope.Type),
+			Namespaces: p.AccessScope.Namespaces,
+		},
+	}
+}
+
+// FindAssociatedAccessPolicy returns the association of the policy with the
+// access entry of the principal, or nil if the policy is not associated.
+func FindAssociatedAccessPolicy(ctx context.Context, client Client, clusterName, principalARN, policyARN string) (*ekstypes.AssociatedAccessPolicy, error) {
+	paginator := eks.NewListAssociatedAccessPoliciesPaginator(client, &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalARN),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.AssociatedAccessPolicies {
+			if pointer.StringValue(page.AssociatedAccessPolicies[i].PolicyArn) == policyARN {
+				return &page.AssociatedAccessPolicies[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// GenerateAccessPolicyAssociationObservation is used to produce
+// AccessPolicyAssociationObservation from ekstypes.AssociatedAccessPolicy.
+func GenerateAccessPolicyAssociationObservation(ap *ekstypes.AssociatedAccessPolicy) manualv1alpha1.AccessPolicyAssociationObservation {
+	if ap == nil {
+		return manualv1alpha1.AccessPolicyAssociationObservation{}
+	}
+	return manualv1alpha1.AccessPolicyAssociationObservation{
+		AssociatedAt: pointer.TimeToMetaTime(ap.AssociatedAt),
+		ModifiedAt:   pointer.TimeToMetaTime(ap.ModifiedAt),
+	}
+}
+
+// IsAccessPolicyAssociationUpToDate checks whether there is a change in the
+// access scope of the association.
+func IsAccessPolicyAssociationUpToDate(p manualv1alpha1.AccessPolicyAssociationParameters, ap *ekstypes.AssociatedAccessPolicy) bool {
+	if ap.AccessScope == nil {
+		return false
+	}
+	return string(p.AccessScope.Type) == string(ap.AccessScope.Type) &&
+		cmp.Equal(p.AccessScope.Namespaces, ap.AccessScope.Namespaces, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
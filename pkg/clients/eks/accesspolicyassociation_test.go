@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
+)
+
+var (
+	viewPolicyARN  = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+	adminPolicyARN = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSAdminPolicy"
+)
+
+func TestFindAssociatedAccessPolicy(t *testing.T) {
+	errBoom := errors.New("boom")
+	pages := map[string]*eks.ListAssociatedAccessPoliciesOutput{
+		"": {
+			AssociatedAccessPolicies: []ekstypes.AssociatedAccessPolicy{{PolicyArn: &viewPolicyARN}},
+			NextToken:                &principalARN,
+		},
+		principalARN: {
+			AssociatedAccessPolicies: []ekstypes.AssociatedAccessPolicy{{PolicyArn: &adminPolicyARN}},
+		},
+	}
+	list := func(_ context.Context, input *eks.ListAssociatedAccessPoliciesInput, _ []func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+		if input.NextToken == nil {
+			return pages[""], nil
+		}
+		return pages[*input.NextToken], nil
+	}
+
+	type want struct {
+		ap  *ekstypes.AssociatedAccessPolicy
+		err error
+	}
+
+	cases := map[string]struct {
+		client    Client
+		policyARN string
+		want      want
+	}{
+		"FoundOnLaterPage": {
+			client:    &fake.MockClient{MockListAssociatedAccessPolicies: list},
+			policyARN: adminPolicyARN,
+			want: want{
+				ap: &ekstypes.AssociatedAccessPolicy{PolicyArn: &adminPolicyARN},
+			},
+		},
+		"NotAssociated": {
+			client:    &fake.MockClient{MockListAssociatedAccessPolicies: list},
+			policyARN: "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy",
+		},
+		"ListFailed": {
+			client: &fake.MockClient{
+				MockListAssociatedAccessPolicies: func(_ context.Context, _ *eks.ListAssociatedAccessPoliciesInput, _ []func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+					return nil, errBoom
+				},
+			},
+			policyARN: viewPolicyARN,
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindAssociatedAccessPolicy(context.Background(), tc.client, clusterName, principalARN, tc.policyARN)
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.ap, got, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccessPolicyAssociationUpToDate(t *testing.T) {
+	type args struct {
+		p  manualv1alpha1.AccessPolicyAssociationParameters
+		ap *ekstypes.AssociatedAccessPolicy
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDateIgnoringNamespaceOrder": {
+			args: args{
+				p: manualv1alpha1.AccessPolicyAssociationParameters{
+					AccessScope: manualv1alpha1.AccessScope{
+						Type:       manualv1alpha1.AccessScopeTypeNamespace,
+						Namespaces: []string{"b", "a"},
+					},
+				},
+				ap: &ekstypes.AssociatedAccessPolicy{
+					AccessScope: &ekstypes.AccessScope{
+						Type:       ekstypes.AccessScopeTypeNamespace,
+						Namespaces: []string{"a", "b"},
+					},
+				},
+			},
+			want: true,
+		},
+		"ScopeTypeChanged": {
+			args: args{
+				p: manualv1alpha1.AccessPolicyAssociationParameters{
+					AccessScope: manualv1alpha1.AccessScope{
+						Type: manualv1alpha1.AccessScopeTypeCluster,
+					},
+				},
+				ap: &ekstypes.AssociatedAccessPolicy{
+					AccessScope: &ekstypes.AccessScope{
+						Type:       ekstypes.AccessScopeTypeNamespace,
+						Namespaces: []string{"a"},
+					},
+				},
+			},
+			want: false,
+		},
+		"NoScope": {
+			args: args{
+				p: manualv1alpha1.AccessPolicyAssociationParameters{
+					AccessScope: manualv1alpha1.AccessScope{
+						Type: manualv1alpha1.AccessScopeTypeCluster,
+					},
+				},
+				ap: &ekstypes.AssociatedAccessPolicy{},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessPolicyAssociationUpToDate(tc.args.p, tc.args.ap)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -48,6 +48,15 @@ type MockClient struct {
 	MockDescribeIdentityProviderConfig     func(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	MockAssociateIdentityProviderConfig    func(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
 	MockDisassociateIdentityProviderConfig func(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error)
+
+	MockDescribeAccessEntry func(ctx context.Context, input *eks.DescribeAccessEntryInput, opts []func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	MockCreateAccessEntry   func(ctx context.Context, input *eks.CreateAccessEntryInput, opts []func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	MockUpdateAccessEntry   func(ctx context.Context, input *eks.UpdateAccessEntryInput, opts []func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error)
+	MockDeleteAccessEntry   func(ctx context.Context, input *eks.DeleteAccessEntryInput, opts []func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+
+	MockListAssociatedAccessPolicies func(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts []func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error)
+	MockAssociateAccessPolicy        func(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts []func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	MockDisassociateAccessPolicy     func(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts []func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error)
 }
 
 // MockSTSClient mock sts client
@@ -169,3 +178,45 @@ func (c *MockClient) AssociateIdentityProviderConfig(ctx context.Context, input
 func (c *MockClient) DisassociateIdentityProviderConfig(ctx context.Context, input *eks.DisassociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DisassociateIdentityProviderConfigOutput, error) {
 	return c.MockDisassociateIdentityProviderConfig(ctx, input, opts)
 }
+
+// DescribeAccessEntry calls the underlying MockDescribeAccessEntry
+// method.
+func (c *MockClient) DescribeAccessEntry(ctx context.Context, input *eks.DescribeAccessEntryInput, opts ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error) {
+	return c.MockDescribeAccessEntry(ctx, input, opts)
+}
+
+// CreateAccessEntry calls the underlying MockCreateAccessEntry
+// method.
+func (c *MockClient) CreateAccessEntry(ctx context.Context, input *eks.CreateAccessEntryInput, opts ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error) {
+	return c.MockCreateAccessEntry(ctx, input, opts)
+}
+
+// UpdateAccessEntry calls the underlying MockUpdateAccessEntry
+// method.
+func (c *MockClient) UpdateAccessEntry(ctx context.Context, input *eks.UpdateAccessEntryInput, opts ...func(*eks.Options)) (*eks.UpdateAccessEntryOutput, error) {
+	return c.MockUpdateAccessEntry(ctx, input, opts)
+}
+
+// DeleteAccessEntry calls the underlying MockDeleteAccessEntry
+// method.
+func (c *MockClient) DeleteAccessEntry(ctx context.Context, input *eks.DeleteAccessEntryInput, opts ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error) {
+	return c.MockDeleteAccessEntry(ctx, input, opts)
+}
+
+// ListAssociatedAccessPolicies calls the underlying MockListAssociatedAccessPolicies
+// method.
+func (c *MockClient) ListAssociatedAccessPolicies(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, opts ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+	return c.MockListAssociatedAccessPolicies(ctx, input, opts)
+}
+
+// AssociateAccessPolicy calls the underlying MockAssociateAccessPolicy
+// method.
+func (c *MockClient) AssociateAccessPolicy(ctx context.Context, input *eks.AssociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error) {
+	return c.MockAssociateAccessPolicy(ctx, input, opts)
+}
+
+// DisassociateAccessPolicy calls the underlying MockDisassociateAccessPolicy
+// method.
+func (c *MockClient) DisassociateAccessPolicy(ctx context.Context, input *eks.DisassociateAccessPolicyInput, opts ...func(*eks.Options)) (*eks.DisassociateAccessPolicyOutput, error) {
+	return c.MockDisassociateAccessPolicy(ctx, input, opts)
+}
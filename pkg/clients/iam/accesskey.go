@@ -18,9 +18,28 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+// DefaultAccessKeyOverlapPeriod is how long the previous access key stays
+// active after a rotation if no overlap period is configured.
+const DefaultAccessKeyOverlapPeriod = 24 * time.Hour
+
+// AccessKeyRotationAction is the next step of the rotation of an access key.
+type AccessKeyRotationAction string
+
+// Steps of the rotation of an access key.
+const (
+	AccessKeyRotationNone       AccessKeyRotationAction = ""
+	AccessKeyRotationCreate     AccessKeyRotationAction = "Create"
+	AccessKeyRotationDeactivate AccessKeyRotationAction = "Deactivate"
+	AccessKeyRotationDelete     AccessKeyRotationAction = "Delete"
 )
 
 // AccessClient is the external client used for AccessKey Custom Resource
@@ -35,3 +54,31 @@ type AccessClient interface {
 func NewAccessClient(conf aws.Config) AccessClient {
 	return iam.NewFromConfig(conf)
 }
+
+// NextAccessKeyRotationAction returns the next step of the rotation of the
+// current access key, given the previous access key if it still exists. A
+// new key is created once the current one is older than the maximum age.
+// The previous key is deactivated once the overlap period has passed since
+// the current key was created, and deleted once it is inactive.
+func NextAccessKeyRotationAction(r *v1beta1.AccessKeyRotation, current iamtypes.AccessKeyMetadata, previous *iamtypes.AccessKeyMetadata, now time.Time) AccessKeyRotationAction {
+	if r == nil || current.CreateDate == nil {
+		return AccessKeyRotationNone
+	}
+	if previous != nil {
+		if previous.Status == iamtypes.StatusTypeInactive {
+			return AccessKeyRotationDelete
+		}
+		overlap := DefaultAccessKeyOverlapPeriod
+		if r.OverlapPeriod != nil {
+			overlap = r.OverlapPeriod.Duration
+		}
+		if !now.Before(current.CreateDate.Add(overlap)) {
+			return AccessKeyRotationDeactivate
+		}
+		return AccessKeyRotationNone
+	}
+	if !now.Before(current.CreateDate.Add(r.MaxAge.Duration)) {
+		return AccessKeyRotationCreate
+	}
+	return AccessKeyRotationNone
+}
@@ -0,0 +1,97 @@
+package iam
+
+import (
+	"testing"
+	"time"
+
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+func TestNextAccessKeyRotationAction(t *testing.T) {
+	now := time.Now()
+	age := func(d time.Duration) *time.Time {
+		t := now.Add(-d)
+		return &t
+	}
+	rotation := &v1beta1.AccessKeyRotation{
+		MaxAge:        metav1.Duration{Duration: 90 * 24 * time.Hour},
+		OverlapPeriod: &metav1.Duration{Duration: time.Hour},
+	}
+
+	type args struct {
+		rotation *v1beta1.AccessKeyRotation
+		current  iamtypes.AccessKeyMetadata
+		previous *iamtypes.AccessKeyMetadata
+	}
+
+	cases := map[string]struct {
+		args args
+		want AccessKeyRotationAction
+	}{
+		"NoRotation": {
+			args: args{
+				current: iamtypes.AccessKeyMetadata{CreateDate: age(365 * 24 * time.Hour)},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"NotExpired": {
+			args: args{
+				rotation: rotation,
+				current:  iamtypes.AccessKeyMetadata{CreateDate: age(89 * 24 * time.Hour)},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"Expired": {
+			args: args{
+				rotation: rotation,
+				current:  iamtypes.AccessKeyMetadata{CreateDate: age(90 * 24 * time.Hour)},
+			},
+			want: AccessKeyRotationCreate,
+		},
+		"WithinOverlap": {
+			args: args{
+				rotation: rotation,
+				current:  iamtypes.AccessKeyMetadata{CreateDate: age(time.Minute)},
+				previous: &iamtypes.AccessKeyMetadata{Status: iamtypes.StatusTypeActive},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"OverlapPassed": {
+			args: args{
+				rotation: rotation,
+				current:  iamtypes.AccessKeyMetadata{CreateDate: age(2 * time.Hour)},
+				previous: &iamtypes.AccessKeyMetadata{Status: iamtypes.StatusTypeActive},
+			},
+			want: AccessKeyRotationDeactivate,
+		},
+		"DefaultOverlap": {
+			args: args{
+				rotation: &v1beta1.AccessKeyRotation{MaxAge: rotation.MaxAge},
+				current:  iamtypes.AccessKeyMetadata{CreateDate: age(2 * time.Hour)},
+				previous: &iamtypes.AccessKeyMetadata{Status: iamtypes.StatusTypeActive},
+			},
+			want: AccessKeyRotationNone,
+		},
+		"PreviousInactive": {
+			args: args{
+				rotation: rotation,
+				current:  iamtypes.AccessKeyMetadata{CreateDate: age(2 * time.Hour)},
+				previous: &iamtypes.AccessKeyMetadata{Status: iamtypes.StatusTypeInactive},
+			},
+			want: AccessKeyRotationDelete,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NextAccessKeyRotationAction(tc.args.rotation, tc.args.current, tc.args.previous, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// AccountPasswordPolicyExternalName is the external name of every
+	// AccountPasswordPolicy.
+	AccountPasswordPolicyExternalName = "password-policy"
+
+	// AccountAliasExternalName is the external name of every AccountAlias.
+	AccountAliasExternalName = "account-alias"
+
+	// defaultMinimumPasswordLength is the minimum password length AWS uses
+	// if none is configured.
+	defaultMinimumPasswordLength = 8
+)
+
+// AccountPasswordPolicyClient is the external client used for
+// AccountPasswordPolicy Custom Resource
+type AccountPasswordPolicyClient interface {
+	GetAccountPasswordPolicy(ctx context.Context, input *iam.GetAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error)
+	UpdateAccountPasswordPolicy(ctx context.Context, input *iam.UpdateAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error)
+	DeleteAccountPasswordPolicy(ctx context.Context, input *iam.DeleteAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteAccountPasswordPolicyOutput, error)
+}
+
+// NewAccountPasswordPolicyClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewAccountPasswordPolicyClient(cfg aws.Config) AccountPasswordPolicyClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// AccountAliasClient is the external client used for AccountAlias Custom
+// Resource
+type AccountAliasClient interface {
+	ListAccountAliases(ctx context.Context, input *iam.ListAccountAliasesInput, opts ...func(*iam.Options)) (*iam.ListAccountAliasesOutput, error)
+	CreateAccountAlias(ctx context.Context, input *iam.CreateAccountAliasInput, opts ...func(*iam.Options)) (*iam.CreateAccountAliasOutput, error)
+	DeleteAccountAlias(ctx context.Context, input *iam.DeleteAccountAliasInput, opts ...func(*iam.Options)) (*iam.DeleteAccountAliasOutput, error)
+}
+
+// NewAccountAliasClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewAccountAliasClient(cfg aws.Config) AccountAliasClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GetAccountID returns the ID of the account the supplied STS client
+// authenticates to.
+func GetAccountID(ctx context.Context, client STSClient) (string, error) {
+	out, err := client.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(out.Account), nil
+}
+
+// GenerateUpdateAccountPasswordPolicyInput returns the input to set the
+// password policy of an account to the desired one.
+func GenerateUpdateAccountPasswordPolicyInput(p manualv1alpha1.AccountPasswordPolicyParameters) *iam.UpdateAccountPasswordPolicyInput {
+	return &iam.UpdateAccountPasswordPolicyInput{
+		AllowUsersToChangePassword: pointer.BoolValue(p.AllowUsersToChangePassword),
+		HardExpiry:                 p.HardExpiry,
+		MaxPasswordAge:             p.MaxPasswordAge,
+		MinimumPasswordLength:      p.MinimumPasswordLength,
+		PasswordReusePrevention:    p.PasswordReusePrevention,
+		RequireLowercaseCharacters: pointer.BoolValue(p.RequireLowercaseCharacters),
+		RequireNumbers:             pointer.BoolValue(p.RequireNumbers),
+		RequireSymbols:             pointer.BoolValue(p.RequireSymbols),
+		RequireUppercaseCharacters: pointer.BoolValue(p.RequireUppercaseCharacters),
+	}
+}
+
+// IsAccountPasswordPolicyUpToDate returns whether the observed password
+// policy of an account matches the desired one. Unset parameters are
+// compared to the values AWS uses if they are not configured.
+func IsAccountPasswordPolicyUpToDate(p manualv1alpha1.AccountPasswordPolicyParameters, observed iamtypes.PasswordPolicy) bool {
+	minLength := int32(defaultMinimumPasswordLength)
+	if p.MinimumPasswordLength != nil {
+		minLength = *p.MinimumPasswordLength
+	}
+	observedMinLength := int32(defaultMinimumPasswordLength)
+	if observed.MinimumPasswordLength != nil {
+		observedMinLength = *observed.MinimumPasswordLength
+	}
+	return pointer.BoolValue(p.AllowUsersToChangePassword) == observed.AllowUsersToChangePassword &&
+		pointer.BoolValue(p.HardExpiry) == pointer.BoolValue(observed.HardExpiry) &&
+		pointer.Int32Value(p.MaxPasswordAge) == pointer.Int32Value(observed.MaxPasswordAge) &&
+		minLength == observedMinLength &&
+		pointer.Int32Value(p.PasswordReusePrevention) == pointer.Int32Value(observed.PasswordReusePrevention) &&
+		pointer.BoolValue(p.RequireLowercaseCharacters) == observed.RequireLowercaseCharacters &&
+		pointer.BoolValue(p.RequireNumbers) == observed.RequireNumbers &&
+		pointer.BoolValue(p.RequireSymbols) == observed.RequireSymbols &&
+		pointer.BoolValue(p.RequireUppercaseCharacters) == observed.RequireUppercaseCharacters
+}
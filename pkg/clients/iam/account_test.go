@@ -0,0 +1,83 @@
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+)
+
+func TestIsAccountPasswordPolicyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        manualv1alpha1.AccountPasswordPolicyParameters
+		observed iamtypes.PasswordPolicy
+		want     bool
+	}{
+		"DefaultMinimumPasswordLength": {
+			p:        manualv1alpha1.AccountPasswordPolicyParameters{},
+			observed: iamtypes.PasswordPolicy{MinimumPasswordLength: aws.Int32(8)},
+			want:     true,
+		},
+		"MinimumPasswordLengthChanged": {
+			p:        manualv1alpha1.AccountPasswordPolicyParameters{MinimumPasswordLength: aws.Int32(14)},
+			observed: iamtypes.PasswordPolicy{MinimumPasswordLength: aws.Int32(8)},
+		},
+		"RequirementsMatch": {
+			p: manualv1alpha1.AccountPasswordPolicyParameters{
+				MaxPasswordAge: aws.Int32(90),
+				RequireSymbols: aws.Bool(true),
+			},
+			observed: iamtypes.PasswordPolicy{
+				ExpirePasswords: true,
+				MaxPasswordAge:  aws.Int32(90),
+				RequireSymbols:  true,
+			},
+			want: true,
+		},
+		"RequirementRemoved": {
+			p:        manualv1alpha1.AccountPasswordPolicyParameters{},
+			observed: iamtypes.PasswordPolicy{RequireNumbers: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsAccountPasswordPolicyUpToDate(tc.p, tc.observed); got != tc.want {
+				t.Errorf("IsAccountPasswordPolicyUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestFindSAMLProviderARN(t *testing.T) {
+	out := &awsiam.ListSAMLProvidersOutput{
+		SAMLProviderList: []iamtypes.SAMLProviderListEntry{
+			{Arn: aws.String("arn:aws:iam::123456789012:saml-provider/my-idp-2")},
+			{Arn: aws.String("arn:aws:iam::123456789012:saml-provider/my-idp")},
+		},
+	}
+
+	cases := map[string]struct {
+		name string
+		want string
+	}{
+		"Found": {
+			name: "my-idp",
+			want: "arn:aws:iam::123456789012:saml-provider/my-idp",
+		},
+		"NotFound": {
+			name: "idp",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := FindSAMLProviderARN(out, tc.name); got != tc.want {
+				t.Errorf("FindSAMLProviderARN(...): want %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
@@ -38,6 +38,13 @@ type MockRoleClient struct {
 	MockUpdateAssumeRolePolicy        func(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts []func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	MockTagRole                       func(ctx context.Context, input *iam.TagRoleInput, opts []func(*iam.Options)) (*iam.TagRoleOutput, error)
 	MockUntagRole                     func(ctx context.Context, input *iam.UntagRoleInput, opts []func(*iam.Options)) (*iam.UntagRoleOutput, error)
+	MockListAttachedRolePolicies      func(ctx context.Context, input *iam.ListAttachedRolePoliciesInput, opts []func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+	MockAttachRolePolicy              func(ctx context.Context, input *iam.AttachRolePolicyInput, opts []func(*iam.Options)) (*iam.AttachRolePolicyOutput, error)
+	MockDetachRolePolicy              func(ctx context.Context, input *iam.DetachRolePolicyInput, opts []func(*iam.Options)) (*iam.DetachRolePolicyOutput, error)
+	MockListRolePolicies              func(ctx context.Context, input *iam.ListRolePoliciesInput, opts []func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	MockGetRolePolicy                 func(ctx context.Context, input *iam.GetRolePolicyInput, opts []func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	MockPutRolePolicy                 func(ctx context.Context, input *iam.PutRolePolicyInput, opts []func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	MockDeleteRolePolicy              func(ctx context.Context, input *iam.DeleteRolePolicyInput, opts []func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
 }
 
 // GetRole mocks GetRole method
@@ -84,3 +91,38 @@ func (m *MockRoleClient) TagRole(ctx context.Context, input *iam.TagRoleInput, o
 func (m *MockRoleClient) UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error) {
 	return m.MockUntagRole(ctx, input, opts)
 }
+
+// ListAttachedRolePolicies mocks ListAttachedRolePolicies method
+func (m *MockRoleClient) ListAttachedRolePolicies(ctx context.Context, input *iam.ListAttachedRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+	return m.MockListAttachedRolePolicies(ctx, input, opts)
+}
+
+// AttachRolePolicy mocks AttachRolePolicy method
+func (m *MockRoleClient) AttachRolePolicy(ctx context.Context, input *iam.AttachRolePolicyInput, opts ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error) {
+	return m.MockAttachRolePolicy(ctx, input, opts)
+}
+
+// DetachRolePolicy mocks DetachRolePolicy method
+func (m *MockRoleClient) DetachRolePolicy(ctx context.Context, input *iam.DetachRolePolicyInput, opts ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error) {
+	return m.MockDetachRolePolicy(ctx, input, opts)
+}
+
+// ListRolePolicies mocks ListRolePolicies method
+func (m *MockRoleClient) ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+	return m.MockListRolePolicies(ctx, input, opts)
+}
+
+// GetRolePolicy mocks GetRolePolicy method
+func (m *MockRoleClient) GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error) {
+	return m.MockGetRolePolicy(ctx, input, opts)
+}
+
+// PutRolePolicy mocks PutRolePolicy method
+func (m *MockRoleClient) PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error) {
+	return m.MockPutRolePolicy(ctx, input, opts)
+}
+
+// DeleteRolePolicy mocks DeleteRolePolicy method
+func (m *MockRoleClient) DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error) {
+	return m.MockDeleteRolePolicy(ctx, input, opts)
+}
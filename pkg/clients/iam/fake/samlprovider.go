@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.SAMLProviderClient = (*MockSAMLProviderClient)(nil)
+
+// MockSAMLProviderClient is a type that implements all the methods for SAMLProviderClient interface
+type MockSAMLProviderClient struct {
+	MockListSAMLProviders  func(ctx context.Context, input *iam.ListSAMLProvidersInput, opts []func(*iam.Options)) (*iam.ListSAMLProvidersOutput, error)
+	MockGetSAMLProvider    func(ctx context.Context, input *iam.GetSAMLProviderInput, opts []func(*iam.Options)) (*iam.GetSAMLProviderOutput, error)
+	MockCreateSAMLProvider func(ctx context.Context, input *iam.CreateSAMLProviderInput, opts []func(*iam.Options)) (*iam.CreateSAMLProviderOutput, error)
+	MockUpdateSAMLProvider func(ctx context.Context, input *iam.UpdateSAMLProviderInput, opts []func(*iam.Options)) (*iam.UpdateSAMLProviderOutput, error)
+	MockDeleteSAMLProvider func(ctx context.Context, input *iam.DeleteSAMLProviderInput, opts []func(*iam.Options)) (*iam.DeleteSAMLProviderOutput, error)
+	MockTagSAMLProvider    func(ctx context.Context, input *iam.TagSAMLProviderInput, opts []func(*iam.Options)) (*iam.TagSAMLProviderOutput, error)
+	MockUntagSAMLProvider  func(ctx context.Context, input *iam.UntagSAMLProviderInput, opts []func(*iam.Options)) (*iam.UntagSAMLProviderOutput, error)
+}
+
+// ListSAMLProviders mocks ListSAMLProviders method
+func (m *MockSAMLProviderClient) ListSAMLProviders(ctx context.Context, input *iam.ListSAMLProvidersInput, opts ...func(*iam.Options)) (*iam.ListSAMLProvidersOutput, error) {
+	return m.MockListSAMLProviders(ctx, input, opts)
+}
+
+// GetSAMLProvider mocks GetSAMLProvider method
+func (m *MockSAMLProviderClient) GetSAMLProvider(ctx context.Context, input *iam.GetSAMLProviderInput, opts ...func(*iam.Options)) (*iam.GetSAMLProviderOutput, error) {
+	return m.MockGetSAMLProvider(ctx, input, opts)
+}
+
+// CreateSAMLProvider mocks CreateSAMLProvider method
+func (m *MockSAMLProviderClient) CreateSAMLProvider(ctx context.Context, input *iam.CreateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.CreateSAMLProviderOutput, error) {
+	return m.MockCreateSAMLProvider(ctx, input, opts)
+}
+
+// UpdateSAMLProvider mocks UpdateSAMLProvider method
+func (m *MockSAMLProviderClient) UpdateSAMLProvider(ctx context.Context, input *iam.UpdateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UpdateSAMLProviderOutput, error) {
+	return m.MockUpdateSAMLProvider(ctx, input, opts)
+}
+
+// DeleteSAMLProvider mocks DeleteSAMLProvider method
+func (m *MockSAMLProviderClient) DeleteSAMLProvider(ctx context.Context, input *iam.DeleteSAMLProviderInput, opts ...func(*iam.Options)) (*iam.DeleteSAMLProviderOutput, error) {
+	return m.MockDeleteSAMLProvider(ctx, input, opts)
+}
+
+// TagSAMLProvider mocks TagSAMLProvider method
+func (m *MockSAMLProviderClient) TagSAMLProvider(ctx context.Context, input *iam.TagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.TagSAMLProviderOutput, error) {
+	return m.MockTagSAMLProvider(ctx, input, opts)
+}
+
+// UntagSAMLProvider mocks UntagSAMLProvider method
+func (m *MockSAMLProviderClient) UntagSAMLProvider(ctx context.Context, input *iam.UntagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UntagSAMLProviderOutput, error) {
+	return m.MockUntagSAMLProvider(ctx, input, opts)
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.ServerCertificateClient = (*MockServerCertificateClient)(nil)
+
+// MockServerCertificateClient is a type that implements all the methods for ServerCertificateClient interface
+type MockServerCertificateClient struct {
+	MockGetServerCertificate    func(ctx context.Context, input *iam.GetServerCertificateInput, opts []func(*iam.Options)) (*iam.GetServerCertificateOutput, error)
+	MockUploadServerCertificate func(ctx context.Context, input *iam.UploadServerCertificateInput, opts []func(*iam.Options)) (*iam.UploadServerCertificateOutput, error)
+	MockUpdateServerCertificate func(ctx context.Context, input *iam.UpdateServerCertificateInput, opts []func(*iam.Options)) (*iam.UpdateServerCertificateOutput, error)
+	MockDeleteServerCertificate func(ctx context.Context, input *iam.DeleteServerCertificateInput, opts []func(*iam.Options)) (*iam.DeleteServerCertificateOutput, error)
+	MockTagServerCertificate    func(ctx context.Context, input *iam.TagServerCertificateInput, opts []func(*iam.Options)) (*iam.TagServerCertificateOutput, error)
+	MockUntagServerCertificate  func(ctx context.Context, input *iam.UntagServerCertificateInput, opts []func(*iam.Options)) (*iam.UntagServerCertificateOutput, error)
+}
+
+// GetServerCertificate mocks GetServerCertificate method
+func (m *MockServerCertificateClient) GetServerCertificate(ctx context.Context, input *iam.GetServerCertificateInput, opts ...func(*iam.Options)) (*iam.GetServerCertificateOutput, error) {
+	return m.MockGetServerCertificate(ctx, input, opts)
+}
+
+// UploadServerCertificate mocks UploadServerCertificate method
+func (m *MockServerCertificateClient) UploadServerCertificate(ctx context.Context, input *iam.UploadServerCertificateInput, opts ...func(*iam.Options)) (*iam.UploadServerCertificateOutput, error) {
+	return m.MockUploadServerCertificate(ctx, input, opts)
+}
+
+// UpdateServerCertificate mocks UpdateServerCertificate method
+func (m *MockServerCertificateClient) UpdateServerCertificate(ctx context.Context, input *iam.UpdateServerCertificateInput, opts ...func(*iam.Options)) (*iam.UpdateServerCertificateOutput, error) {
+	return m.MockUpdateServerCertificate(ctx, input, opts)
+}
+
+// DeleteServerCertificate mocks DeleteServerCertificate method
+func (m *MockServerCertificateClient) DeleteServerCertificate(ctx context.Context, input *iam.DeleteServerCertificateInput, opts ...func(*iam.Options)) (*iam.DeleteServerCertificateOutput, error) {
+	return m.MockDeleteServerCertificate(ctx, input, opts)
+}
+
+// TagServerCertificate mocks TagServerCertificate method
+func (m *MockServerCertificateClient) TagServerCertificate(ctx context.Context, input *iam.TagServerCertificateInput, opts ...func(*iam.Options)) (*iam.TagServerCertificateOutput, error) {
+	return m.MockTagServerCertificate(ctx, input, opts)
+}
+
+// UntagServerCertificate mocks UntagServerCertificate method
+func (m *MockServerCertificateClient) UntagServerCertificate(ctx context.Context, input *iam.UntagServerCertificateInput, opts ...func(*iam.Options)) (*iam.UntagServerCertificateOutput, error) {
+	return m.MockUntagServerCertificate(ctx, input, opts)
+}
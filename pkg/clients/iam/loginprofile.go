@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+)
+
+const (
+	errGetPasswordSecret = "cannot get the referenced password Secret"
+
+	// loginProfilePasswordSymbols are the symbols IAM allows in passwords.
+	loginProfilePasswordSymbols = "!@#$%^&*()_+-=[]{}|'"
+)
+
+// loginProfilePasswordSettings are the settings of generated login profile
+// passwords.
+var loginProfilePasswordSettings = password.Settings{
+	CharacterSet: "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789" + loginProfilePasswordSymbols,
+	Length:       32,
+}
+
+// LoginProfileClient is the external client used for LoginProfile Custom
+// Resource
+type LoginProfileClient interface {
+	GetLoginProfile(ctx context.Context, input *iam.GetLoginProfileInput, opts ...func(*iam.Options)) (*iam.GetLoginProfileOutput, error)
+	CreateLoginProfile(ctx context.Context, input *iam.CreateLoginProfileInput, opts ...func(*iam.Options)) (*iam.CreateLoginProfileOutput, error)
+	UpdateLoginProfile(ctx context.Context, input *iam.UpdateLoginProfileInput, opts ...func(*iam.Options)) (*iam.UpdateLoginProfileOutput, error)
+	DeleteLoginProfile(ctx context.Context, input *iam.DeleteLoginProfileInput, opts ...func(*iam.Options)) (*iam.DeleteLoginProfileOutput, error)
+}
+
+// NewLoginProfileClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewLoginProfileClient(cfg aws.Config) LoginProfileClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GenerateLoginProfilePassword returns a random password that contains
+// lowercase and uppercase letters, numbers and symbols, so that it satisfies
+// the character requirements of any account password policy.
+func GenerateLoginProfilePassword() (string, error) {
+	for {
+		pw, err := loginProfilePasswordSettings.Generate()
+		if err != nil {
+			return "", err
+		}
+		if strings.IndexFunc(pw, unicode.IsLower) >= 0 &&
+			strings.IndexFunc(pw, unicode.IsUpper) >= 0 &&
+			strings.IndexFunc(pw, unicode.IsDigit) >= 0 &&
+			strings.ContainsAny(pw, loginProfilePasswordSymbols) {
+			return pw, nil
+		}
+	}
+}
+
+// GetLoginProfilePassword returns the password referenced by a LoginProfile.
+// The password is empty if the LoginProfile does not reference one.
+func GetLoginProfilePassword(ctx context.Context, kube client.Reader, p manualv1alpha1.LoginProfileParameters) (string, error) {
+	if p.PasswordSecretRef == nil {
+		return "", nil
+	}
+	ref := p.PasswordSecretRef
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return "", errors.Wrap(err, errGetPasswordSecret)
+	}
+	v, ok := s.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errSecretKeyFmt, ref.Key)
+	}
+	return string(v), nil
+}
+
+// HashLoginProfilePassword returns a bcrypt hash of the supplied password.
+// The password is digested first, since bcrypt only supports passwords of
+// up to 72 bytes while IAM allows up to 128 characters.
+func HashLoginProfilePassword(pw string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword(digestPassword(pw), bcrypt.DefaultCost)
+	return string(hash), err
+}
+
+// IsLoginProfilePasswordUpToDate returns whether the supplied password is the
+// one whose hash was recorded when it was last set. An empty password is
+// always up to date, since it means no password is referenced.
+func IsLoginProfilePasswordUpToDate(pw, hash string) bool {
+	if pw == "" {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), digestPassword(pw)) == nil
+}
+
+func digestPassword(pw string) []byte {
+	d := sha256.Sum256([]byte(pw))
+	return []byte(hex.EncodeToString(d[:]))
+}
@@ -0,0 +1,126 @@
+package iam
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+)
+
+func TestGenerateLoginProfilePassword(t *testing.T) {
+	for range 100 {
+		pw, err := GenerateLoginProfilePassword()
+		if err != nil {
+			t.Fatalf("GenerateLoginProfilePassword(): unexpected error %v", err)
+		}
+		if strings.IndexFunc(pw, unicode.IsLower) < 0 ||
+			strings.IndexFunc(pw, unicode.IsUpper) < 0 ||
+			strings.IndexFunc(pw, unicode.IsDigit) < 0 ||
+			!strings.ContainsAny(pw, loginProfilePasswordSymbols) {
+			t.Errorf("GenerateLoginProfilePassword(): %q does not contain all character classes", pw)
+		}
+	}
+}
+
+func TestGetLoginProfilePassword(t *testing.T) {
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+		Key:             "password",
+	}
+
+	// secret returns a kube client that returns the given password in the
+	// referenced Secret.
+	secret := func(pw string) client.Reader {
+		return &test.MockClient{
+			MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*corev1.Secret).Data = map[string][]byte{ref.Key: []byte(pw)}
+				return nil
+			}),
+		}
+	}
+
+	type want struct {
+		pw  string
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		p    manualv1alpha1.LoginProfileParameters
+		want want
+	}{
+		"NoReference": {
+			p: manualv1alpha1.LoginProfileParameters{},
+		},
+		"Referenced": {
+			kube: secret("secret"),
+			p:    manualv1alpha1.LoginProfileParameters{PasswordSecretRef: ref},
+			want: want{pw: "secret"},
+		},
+		"MissingKey": {
+			kube: secret("secret"),
+			p: manualv1alpha1.LoginProfileParameters{PasswordSecretRef: &xpv1.SecretKeySelector{
+				SecretReference: ref.SecretReference,
+				Key:             "pw",
+			}},
+			want: want{err: errors.Errorf(errSecretKeyFmt, "pw")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pw, err := GetLoginProfilePassword(context.Background(), tc.kube, tc.p)
+			if diff := cmp.Diff(tc.want, want{pw: pw, err: err}, cmp.AllowUnexported(want{}), test.EquateErrors()); diff != "" {
+				t.Errorf("GetLoginProfilePassword(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLoginProfilePasswordUpToDate(t *testing.T) {
+	// A password longer than the 72 bytes bcrypt supports.
+	long := strings.Repeat("a", 100)
+	hash, err := HashLoginProfilePassword(long)
+	if err != nil {
+		t.Fatalf("HashLoginProfilePassword(...): unexpected error %v", err)
+	}
+
+	cases := map[string]struct {
+		pw   string
+		hash string
+		want bool
+	}{
+		"NoPassword": {
+			want: true,
+		},
+		"NoHash": {
+			pw: long,
+		},
+		"Unchanged": {
+			pw:   long,
+			hash: hash,
+			want: true,
+		},
+		"Changed": {
+			pw:   long + "b",
+			hash: hash,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsLoginProfilePasswordUpToDate(tc.pw, tc.hash); got != tc.want {
+				t.Errorf("IsLoginProfilePasswordUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -3,17 +3,23 @@ package iam
 import (
 	"context"
 	"net/url"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
+// MaxPolicyVersions is the maximum number of versions IAM retains for a
+// managed policy.
+const MaxPolicyVersions = 5
+
 // PolicyClient is the external client used for Policy Custom Resource
 type PolicyClient interface {
 	GetPolicy(ctx context.Context, input *iam.GetPolicyInput, opts ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
@@ -23,6 +29,7 @@ type PolicyClient interface {
 	CreatePolicyVersion(ctx context.Context, input *iam.CreatePolicyVersionInput, opts ...func(*iam.Options)) (*iam.CreatePolicyVersionOutput, error)
 	ListPolicyVersions(ctx context.Context, input *iam.ListPolicyVersionsInput, opts ...func(*iam.Options)) (*iam.ListPolicyVersionsOutput, error)
 	DeletePolicyVersion(ctx context.Context, input *iam.DeletePolicyVersionInput, opts ...func(*iam.Options)) (*iam.DeletePolicyVersionOutput, error)
+	SetDefaultPolicyVersion(ctx context.Context, input *iam.SetDefaultPolicyVersionInput, opts ...func(*iam.Options)) (*iam.SetDefaultPolicyVersionOutput, error)
 	TagPolicy(ctx context.Context, input *iam.TagPolicyInput, opts ...func(*iam.Options)) (*iam.TagPolicyOutput, error)
 	UntagPolicy(ctx context.Context, input *iam.UntagPolicyInput, opts ...func(*iam.Options)) (*iam.UntagPolicyOutput, error)
 }
@@ -77,3 +84,60 @@ func ValidatePolicyObject(policy string) error {
 	_, err := policyutils.ParsePolicyString(policy)
 	return err
 }
+
+// GeneratePolicyVersions returns the observed representation of the supplied
+// policy versions, ordered from newest to oldest.
+func GeneratePolicyVersions(versions []iamtypes.PolicyVersion) []v1beta1.PolicyVersion {
+	if len(versions) == 0 {
+		return nil
+	}
+	sorted := sortPolicyVersions(versions)
+	out := make([]v1beta1.PolicyVersion, len(sorted))
+	for i, v := range sorted {
+		o := v1beta1.PolicyVersion{
+			VersionID:        aws.ToString(v.VersionId),
+			IsDefaultVersion: v.IsDefaultVersion,
+		}
+		if v.CreateDate != nil {
+			o.CreateDate = &metav1.Time{Time: *v.CreateDate}
+		}
+		out[len(out)-1-i] = o
+	}
+	return out
+}
+
+// PolicyVersionsToPrune returns the versions that have to be deleted so that
+// at most keep versions remain. Only the oldest versions that are neither the
+// default version nor listed in protected are returned, so fewer versions may
+// be returned than needed to reach keep.
+func PolicyVersionsToPrune(versions []iamtypes.PolicyVersion, keep int, protected []string) []iamtypes.PolicyVersion {
+	excess := len(versions) - keep
+	if excess <= 0 {
+		return nil
+	}
+	isProtected := make(map[string]bool, len(protected))
+	for _, id := range protected {
+		isProtected[id] = true
+	}
+	var prune []iamtypes.PolicyVersion
+	for _, v := range sortPolicyVersions(versions) {
+		if len(prune) == excess {
+			break
+		}
+		if v.IsDefaultVersion || isProtected[aws.ToString(v.VersionId)] {
+			continue
+		}
+		prune = append(prune, v)
+	}
+	return prune
+}
+
+// sortPolicyVersions returns a copy of versions ordered from oldest to newest.
+func sortPolicyVersions(versions []iamtypes.PolicyVersion) []iamtypes.PolicyVersion {
+	sorted := make([]iamtypes.PolicyVersion, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return aws.ToTime(sorted[i].CreateDate).Before(aws.ToTime(sorted[j].CreateDate))
+	})
+	return sorted
+}
@@ -2,10 +2,14 @@ package iam
 
 import (
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 )
@@ -131,3 +135,107 @@ func TestIsPolicyUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func policyVersion(id string, created int64, isDefault bool) iamtypes.PolicyVersion {
+	return iamtypes.PolicyVersion{
+		VersionId:        aws.String(id),
+		CreateDate:       aws.Time(time.Unix(created, 0)),
+		IsDefaultVersion: isDefault,
+	}
+}
+
+func TestPolicyVersionsToPrune(t *testing.T) {
+	type args struct {
+		versions  []iamtypes.PolicyVersion
+		keep      int
+		protected []string
+	}
+
+	versions := []iamtypes.PolicyVersion{
+		policyVersion("v3", 300, false),
+		policyVersion("v1", 100, false),
+		policyVersion("v4", 400, true),
+		policyVersion("v2", 200, false),
+	}
+
+	cases := map[string]struct {
+		args args
+		want []iamtypes.PolicyVersion
+	}{
+		"BelowLimit": {
+			args: args{
+				versions: versions,
+				keep:     4,
+			},
+		},
+		"OldestFirst": {
+			args: args{
+				versions: versions,
+				keep:     2,
+			},
+			want: []iamtypes.PolicyVersion{
+				policyVersion("v1", 100, false),
+				policyVersion("v2", 200, false),
+			},
+		},
+		"SkipProtected": {
+			args: args{
+				versions:  versions,
+				keep:      3,
+				protected: []string{"v1"},
+			},
+			want: []iamtypes.PolicyVersion{
+				policyVersion("v2", 200, false),
+			},
+		},
+		"SkipDefault": {
+			args: args{
+				versions:  versions,
+				keep:      0,
+				protected: []string{"v1", "v2"},
+			},
+			want: []iamtypes.PolicyVersion{
+				policyVersion("v3", 300, false),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := PolicyVersionsToPrune(tc.args.versions, tc.args.keep, tc.args.protected)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(iamtypes.PolicyVersion{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePolicyVersions(t *testing.T) {
+	cases := map[string]struct {
+		versions []iamtypes.PolicyVersion
+		want     []v1beta1.PolicyVersion
+	}{
+		"Empty": {},
+		"NewestFirst": {
+			versions: []iamtypes.PolicyVersion{
+				policyVersion("v1", 100, false),
+				policyVersion("v3", 300, true),
+				policyVersion("v2", 200, false),
+			},
+			want: []v1beta1.PolicyVersion{
+				{VersionID: "v3", CreateDate: &metav1.Time{Time: time.Unix(300, 0)}, IsDefaultVersion: true},
+				{VersionID: "v2", CreateDate: &metav1.Time{Time: time.Unix(200, 0)}},
+				{VersionID: "v1", CreateDate: &metav1.Time{Time: time.Unix(100, 0)}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePolicyVersions(tc.versions)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,16 +4,25 @@ import (
 	"context"
 	"encoding/json"
 	"net/url"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/smithy-go/document"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
+	eksv1beta1 "github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/convert"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/jsonpatch"
@@ -21,6 +30,21 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
+const (
+	// DefaultServiceAccountAudience is the audience of the service account
+	// tokens that are exchanged for role credentials.
+	DefaultServiceAccountAudience = "sts.amazonaws.com"
+
+	errNoOIDCProvider  = "serviceAccountTrust requires either oidcProviderArn, clusterRef or clusterSelector"
+	errOIDCProviderARN = "cannot parse OpenID Connect provider ARN"
+	errClusterARN      = "cannot parse cluster ARN"
+	errNoOIDCIssuer    = "cluster has no OpenID Connect issuer"
+	errParseTrust      = "cannot parse assume role policy document"
+	errRenderTrust     = "cannot render assume role policy document"
+
+	oidcProviderResourcePrefix = "oidc-provider/"
+)
+
 // RoleClient is the external client used for Role Custom Resource
 type RoleClient interface {
 	GetRole(ctx context.Context, input *iam.GetRoleInput, opts ...func(*iam.Options)) (*iam.GetRoleOutput, error)
@@ -32,6 +56,13 @@ type RoleClient interface {
 	UpdateAssumeRolePolicy(ctx context.Context, input *iam.UpdateAssumeRolePolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAssumeRolePolicyOutput, error)
 	TagRole(ctx context.Context, input *iam.TagRoleInput, opts ...func(*iam.Options)) (*iam.TagRoleOutput, error)
 	UntagRole(ctx context.Context, input *iam.UntagRoleInput, opts ...func(*iam.Options)) (*iam.UntagRoleOutput, error)
+	ListAttachedRolePolicies(ctx context.Context, input *iam.ListAttachedRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+	AttachRolePolicy(ctx context.Context, input *iam.AttachRolePolicyInput, opts ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error)
+	DetachRolePolicy(ctx context.Context, input *iam.DetachRolePolicyInput, opts ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error)
+	ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, opts ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, opts ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	PutRolePolicy(ctx context.Context, input *iam.PutRolePolicyInput, opts ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	DeleteRolePolicy(ctx context.Context, input *iam.DeleteRolePolicyInput, opts ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
 }
 
 // NewRoleClient returns a new client using AWS credentials as JSON encoded data.
@@ -122,7 +153,11 @@ func LateInitializeRole(in *v1beta1.RoleParameters, role *iamtypes.Role) {
 	if role == nil {
 		return
 	}
-	in.AssumeRolePolicyDocument = pointer.LateInitializeValueFromPtr(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	// The observed document of a role with service account trust contains the
+	// rendered statements, which must not end up in the spec.
+	if in.ServiceAccountTrust == nil {
+		in.AssumeRolePolicyDocument = pointer.LateInitializeValueFromPtr(in.AssumeRolePolicyDocument, role.AssumeRolePolicyDocument)
+	}
 	in.Description = pointer.LateInitialize(in.Description, role.Description)
 	in.MaxSessionDuration = pointer.LateInitialize(in.MaxSessionDuration, role.MaxSessionDuration)
 	in.Path = pointer.LateInitialize(in.Path, role.Path)
@@ -184,3 +219,203 @@ func lessTag(a, b iamtypes.Tag) bool {
 	}
 	return *a.Key <= *b.Key
 }
+
+// ListAttachedRolePolicyARNs returns the ARNs of all managed policies that
+// are attached to the given role.
+func ListAttachedRolePolicyARNs(ctx context.Context, client RoleClient, roleName string) ([]string, error) {
+	var arns []string
+	input := &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)}
+	for {
+		out, err := client.ListAttachedRolePolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range out.AttachedPolicies {
+			arns = append(arns, aws.ToString(p.PolicyArn))
+		}
+		if !out.IsTruncated {
+			return arns, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// ListRolePolicyNames returns the names of all inline policies of the given
+// role.
+func ListRolePolicyNames(ctx context.Context, client RoleClient, roleName string) ([]string, error) {
+	var names []string
+	input := &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)}
+	for {
+		out, err := client.ListRolePolicies(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		names = append(names, out.PolicyNames...)
+		if !out.IsTruncated {
+			return names, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// DiffManagedPolicyARNs returns the managed policies that need to be attached
+// to and detached from a role to match the desired set exactly.
+func DiffManagedPolicyARNs(desired, observed []string) (attach, detach []string) {
+	want := sets.New(desired...)
+	have := sets.New(observed...)
+	return sets.List(want.Difference(have)), sets.List(have.Difference(want))
+}
+
+// DiffInlinePolicyNames returns the inline policies of a role that are
+// missing or not desired, given the names of its current inline policies.
+func DiffInlinePolicyNames(desired map[string]extv1.JSON, observed []string) (missing, extra []string) {
+	want := sets.KeySet(desired)
+	have := sets.New(observed...)
+	return sets.List(want.Difference(have)), sets.List(have.Difference(want))
+}
+
+// OIDCProviderARNFromCluster returns the ARN of the IAM OpenID Connect
+// provider for the issuer of an EKS cluster in the account of the cluster.
+func OIDCProviderARNFromCluster(clusterARN, issuer string) (string, error) {
+	if issuer == "" {
+		return "", errors.New(errNoOIDCIssuer)
+	}
+	parsed, err := arn.Parse(clusterARN)
+	if err != nil {
+		return "", errors.Wrap(err, errClusterARN)
+	}
+	return arn.ARN{
+		Partition: parsed.Partition,
+		Service:   "iam",
+		AccountID: parsed.AccountID,
+		Resource:  oidcProviderResourcePrefix + strings.TrimPrefix(issuer, "https://"),
+	}.String(), nil
+}
+
+// ClusterOIDCProviderARNReference returns the request to resolve a reference
+// to an EKS Cluster into the ARN of the IAM OpenID Connect provider of its
+// issuer.
+func ClusterOIDCProviderARNReference(providerARN *string, ref *xpv1.Reference, sel *xpv1.Selector) reference.ResolutionRequest {
+	return reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(providerARN),
+		Extract:      ClusterOIDCProviderARN(),
+		Reference:    ref,
+		Selector:     sel,
+		To: reference.To{
+			List:    &eksv1beta1.ClusterList{},
+			Managed: &eksv1beta1.Cluster{},
+		},
+	}
+}
+
+// ClusterOIDCProviderARN extracts the ARN of the IAM OpenID Connect provider
+// of the issuer of an EKS Cluster. It returns an empty string until the
+// cluster has an ARN and an issuer.
+func ClusterOIDCProviderARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		cr, ok := mg.(*eksv1beta1.Cluster)
+		if !ok {
+			return ""
+		}
+		providerARN, err := OIDCProviderARNFromCluster(cr.Status.AtProvider.Arn, cr.Status.AtProvider.Identity.OIDC.Issuer)
+		if err != nil {
+			return ""
+		}
+		return providerARN
+	}
+}
+
+// RenderAssumeRolePolicyDocument merges the statements that allow the service
+// accounts of trust to assume the role through the supplied OpenID Connect
+// provider into document. Statements that are already present in document are
+// not added again.
+func RenderAssumeRolePolicyDocument(document, providerARN string, trust v1beta1.ServiceAccountTrust) (string, error) {
+	if providerARN == "" {
+		return "", errors.New(errNoOIDCProvider)
+	}
+	parsed, err := arn.Parse(providerARN)
+	if err != nil || !strings.HasPrefix(parsed.Resource, oidcProviderResourcePrefix) {
+		return "", errors.Errorf("%s: %s", errOIDCProviderARN, providerARN)
+	}
+	issuer := strings.TrimPrefix(parsed.Resource, oidcProviderResourcePrefix)
+
+	p := policy.Policy{Version: "2012-10-17"}
+	if document != "" {
+		if p, err = policy.ParsePolicyString(document); err != nil {
+			return "", errors.Wrap(err, errParseTrust)
+		}
+	}
+
+	for _, st := range serviceAccountTrustStatements(providerARN, issuer, trust) {
+		if !containsStatement(p.Statements, st) {
+			p.Statements = append(p.Statements, st)
+		}
+	}
+
+	raw, err := json.Marshal(&p)
+	if err != nil {
+		return "", errors.Wrap(err, errRenderTrust)
+	}
+	return string(raw), nil
+}
+
+// serviceAccountTrustStatements returns a statement for the service accounts
+// with exact names and one for those with wildcards, since their subjects have
+// to be matched with different condition operators.
+func serviceAccountTrustStatements(providerARN, issuer string, trust v1beta1.ServiceAccountTrust) []policy.Statement {
+	audience := DefaultServiceAccountAudience
+	if trust.Audience != nil {
+		audience = *trust.Audience
+	}
+
+	var exact, wildcard []string
+	for _, sa := range trust.ServiceAccounts {
+		sub := "system:serviceaccount:" + sa.Namespace + ":" + sa.Name
+		if strings.ContainsAny(sub, "*?") {
+			wildcard = append(wildcard, sub)
+			continue
+		}
+		exact = append(exact, sub)
+	}
+
+	var statements []policy.Statement
+	for _, subs := range []struct {
+		operator string
+		values   []string
+	}{{"StringEquals", exact}, {"StringLike", wildcard}} {
+		if len(subs.values) == 0 {
+			continue
+		}
+		sort.Strings(subs.values)
+		sub := make(policy.ConditionSettingsValue, len(subs.values))
+		for i, v := range subs.values {
+			sub[i] = v
+		}
+		conditions := policy.ConditionMap{
+			"StringEquals": policy.ConditionSettings{
+				issuer + ":aud": policy.ConditionSettingsValue{audience},
+			},
+		}
+		if conditions[subs.operator] == nil {
+			conditions[subs.operator] = policy.ConditionSettings{}
+		}
+		conditions[subs.operator][issuer+":sub"] = sub
+
+		statements = append(statements, policy.Statement{
+			Effect:    policy.StatementEffectAllow,
+			Principal: &policy.Principal{Federated: aws.String(providerARN)},
+			Action:    policy.StringOrArray{"sts:AssumeRoleWithWebIdentity"},
+			Condition: conditions,
+		})
+	}
+	return statements
+}
+
+func containsStatement(statements []policy.Statement, st policy.Statement) bool {
+	for _, s := range statements {
+		if cmp.Equal(s, st) {
+			return true
+		}
+	}
+	return false
+}
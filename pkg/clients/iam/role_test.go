@@ -10,12 +10,15 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/smithy-go/document"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	"k8s.io/utils/ptr"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 var (
@@ -429,3 +432,200 @@ func TestIsRoleUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffManagedPolicyARNs(t *testing.T) {
+	type want struct {
+		attach []string
+		detach []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Equal": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "a"},
+			want:     want{attach: []string{}, detach: []string{}},
+		},
+		"AttachAndDetach": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "c"},
+			want:     want{attach: []string{"a"}, detach: []string{"c"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffManagedPolicyARNs(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, want{attach: attach, detach: detach}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderAssumeRolePolicyDocument(t *testing.T) {
+	providerARN := "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ABCDEF"
+	irsaStatement := `{
+		"Effect": "Allow",
+		"Principal": {"Federated": "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-east-1.amazonaws.com/id/ABCDEF"},
+		"Action": "sts:AssumeRoleWithWebIdentity",
+		"Condition": {
+			"StringEquals": {
+				"oidc.eks.us-east-1.amazonaws.com/id/ABCDEF:aud": "sts.amazonaws.com",
+				"oidc.eks.us-east-1.amazonaws.com/id/ABCDEF:sub": ["system:serviceaccount:apps:api", "system:serviceaccount:default:web"]
+			}
+		}
+	}`
+	trust := v1beta1.ServiceAccountTrust{
+		ServiceAccounts: []v1beta1.ServiceAccount{
+			{Namespace: "default", Name: "web"},
+			{Namespace: "apps", Name: "api"},
+		},
+	}
+
+	type args struct {
+		document    string
+		providerARN string
+		trust       v1beta1.ServiceAccountTrust
+	}
+	type want struct {
+		document string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"OnlyServiceAccounts": {
+			args: args{
+				providerARN: providerARN,
+				trust:       trust,
+			},
+			want: want{
+				document: `{"Version": "2012-10-17", "Statement": [` + irsaStatement + `]}`,
+			},
+		},
+		"MergedWithExplicitStatements": {
+			args: args{
+				document:    assumeRolePolicyDocument,
+				providerARN: providerARN,
+				trust:       trust,
+			},
+			want: want{
+				document: `{"Version": "2012-10-17", "Statement": [
+					{"Effect": "Allow", "Principal": {"Service": "eks.amazonaws.com"}, "Action": "sts:AssumeRole"},
+					` + irsaStatement + `]}`,
+			},
+		},
+		"AlreadyRendered": {
+			args: args{
+				document:    `{"Version": "2012-10-17", "Statement": [` + irsaStatement + `]}`,
+				providerARN: providerARN,
+				trust:       trust,
+			},
+			want: want{
+				document: `{"Version": "2012-10-17", "Statement": [` + irsaStatement + `]}`,
+			},
+		},
+		"WildcardAndAudience": {
+			args: args{
+				providerARN: providerARN,
+				trust: v1beta1.ServiceAccountTrust{
+					ServiceAccounts: []v1beta1.ServiceAccount{{Namespace: "jobs", Name: "*"}},
+					Audience:        ptr.To("custom"),
+				},
+			},
+			want: want{
+				document: `{"Version": "2012-10-17", "Statement": [{
+					"Effect": "Allow",
+					"Principal": {"Federated": "` + providerARN + `"},
+					"Action": "sts:AssumeRoleWithWebIdentity",
+					"Condition": {
+						"StringEquals": {"oidc.eks.us-east-1.amazonaws.com/id/ABCDEF:aud": "custom"},
+						"StringLike": {"oidc.eks.us-east-1.amazonaws.com/id/ABCDEF:sub": "system:serviceaccount:jobs:*"}
+					}
+				}]}`,
+			},
+		},
+		"NoProvider": {
+			args: args{
+				trust: trust,
+			},
+			want: want{
+				err: errors.New(errNoOIDCProvider),
+			},
+		},
+		"InvalidProvider": {
+			args: args{
+				providerARN: "arn:aws:iam::123456789012:role/not-a-provider",
+				trust:       trust,
+			},
+			want: want{
+				err: errors.Errorf("%s: %s", errOIDCProviderARN, "arn:aws:iam::123456789012:role/not-a-provider"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RenderAssumeRolePolicyDocument(tc.args.document, tc.args.providerARN, tc.args.trust)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if tc.want.err != nil {
+				return
+			}
+			wantPolicy, _ := policy.ParsePolicyString(tc.want.document)
+			gotPolicy, err := policy.ParsePolicyString(got)
+			if err != nil {
+				t.Fatalf("cannot parse rendered document: %v", err)
+			}
+			if diff := cmp.Diff(wantPolicy, gotPolicy); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOIDCProviderARNFromCluster(t *testing.T) {
+	type want struct {
+		arn string
+		err error
+	}
+
+	cases := map[string]struct {
+		clusterARN string
+		issuer     string
+		want       want
+	}{
+		"Successful": {
+			clusterARN: "arn:aws-cn:eks:cn-north-1:123456789012:cluster/demo",
+			issuer:     "https://oidc.eks.cn-north-1.amazonaws.com.cn/id/ABCDEF",
+			want: want{
+				arn: "arn:aws-cn:iam::123456789012:oidc-provider/oidc.eks.cn-north-1.amazonaws.com.cn/id/ABCDEF",
+			},
+		},
+		"NoIssuer": {
+			clusterARN: "arn:aws:eks:us-east-1:123456789012:cluster/demo",
+			want: want{
+				err: errors.New(errNoOIDCIssuer),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := OIDCProviderARNFromCluster(tc.clusterARN, tc.issuer)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
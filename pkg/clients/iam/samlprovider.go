@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	errNoSAMLMetadataDocument = "one of samlMetadataDocument or samlMetadataDocumentConfigMapRef is required"
+	errGetConfigMap           = "cannot get the referenced ConfigMap"
+	errConfigMapKeyFmt        = "the referenced ConfigMap does not contain key %q"
+)
+
+// SAMLProviderClient is the external client used for SAMLProvider Custom
+// Resource
+type SAMLProviderClient interface {
+	ListSAMLProviders(ctx context.Context, input *iam.ListSAMLProvidersInput, opts ...func(*iam.Options)) (*iam.ListSAMLProvidersOutput, error)
+	GetSAMLProvider(ctx context.Context, input *iam.GetSAMLProviderInput, opts ...func(*iam.Options)) (*iam.GetSAMLProviderOutput, error)
+	CreateSAMLProvider(ctx context.Context, input *iam.CreateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.CreateSAMLProviderOutput, error)
+	UpdateSAMLProvider(ctx context.Context, input *iam.UpdateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UpdateSAMLProviderOutput, error)
+	DeleteSAMLProvider(ctx context.Context, input *iam.DeleteSAMLProviderInput, opts ...func(*iam.Options)) (*iam.DeleteSAMLProviderOutput, error)
+	TagSAMLProvider(ctx context.Context, input *iam.TagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.TagSAMLProviderOutput, error)
+	UntagSAMLProvider(ctx context.Context, input *iam.UntagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UntagSAMLProviderOutput, error)
+}
+
+// NewSAMLProviderClient returns a new client using AWS credentials as JSON
+// encoded data.
+func NewSAMLProviderClient(cfg aws.Config) SAMLProviderClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GetSAMLMetadataDocument returns the desired metadata document of a
+// SAMLProvider from its inline document or the referenced ConfigMap.
+func GetSAMLMetadataDocument(ctx context.Context, kube client.Reader, p manualv1alpha1.SAMLProviderParameters) (string, error) {
+	switch {
+	case p.SAMLMetadataDocument != nil:
+		return *p.SAMLMetadataDocument, nil
+	case p.SAMLMetadataDocumentConfigMapRef != nil:
+		ref := p.SAMLMetadataDocumentConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return v, nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return string(v), nil
+		}
+		return "", errors.Errorf(errConfigMapKeyFmt, ref.Key)
+	}
+	return "", errors.New(errNoSAMLMetadataDocument)
+}
+
+// FindSAMLProviderARN returns the ARN of the SAML provider with the given
+// name among the given ones, or an empty string if there is none.
+func FindSAMLProviderARN(providers *iam.ListSAMLProvidersOutput, name string) string {
+	for _, p := range providers.SAMLProviderList {
+		if arn := pointer.StringValue(p.Arn); strings.HasSuffix(arn, ":saml-provider/"+name) {
+			return arn
+		}
+	}
+	return ""
+}
+
+// GenerateSAMLProviderObservation returns the observation of the given SAML
+// provider.
+func GenerateSAMLProviderObservation(arn string, observed *iam.GetSAMLProviderOutput) manualv1alpha1.SAMLProviderObservation {
+	return manualv1alpha1.SAMLProviderObservation{
+		ARN:        arn,
+		CreateDate: pointer.TimeToMetaTime(observed.CreateDate),
+		ValidUntil: pointer.TimeToMetaTime(observed.ValidUntil),
+	}
+}
+
+// IsSAMLMetadataDocumentUpToDate returns whether the observed metadata
+// document of a SAML provider matches the desired one.
+func IsSAMLMetadataDocumentUpToDate(document string, observed *iam.GetSAMLProviderOutput) bool {
+	return strings.TrimSpace(document) == strings.TrimSpace(pointer.StringValue(observed.SAMLMetadataDocument))
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/pem"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	errGetSecret    = "cannot get the referenced Secret"
+	errSecretKeyFmt = "the referenced Secret does not contain key %q"
+
+	defaultServerCertificatePath = "/"
+
+	// tlsCertificateChainKey is the key of the certificate chain in a Secret
+	// of type kubernetes.io/tls, as used by cert-manager. It is only used if
+	// the certificate itself does not contain the chain.
+	tlsCertificateChainKey = "ca.crt"
+)
+
+// ServerCertificateClient is the external client used for ServerCertificate
+// Custom Resource
+type ServerCertificateClient interface {
+	GetServerCertificate(ctx context.Context, input *iam.GetServerCertificateInput, opts ...func(*iam.Options)) (*iam.GetServerCertificateOutput, error)
+	UploadServerCertificate(ctx context.Context, input *iam.UploadServerCertificateInput, opts ...func(*iam.Options)) (*iam.UploadServerCertificateOutput, error)
+	UpdateServerCertificate(ctx context.Context, input *iam.UpdateServerCertificateInput, opts ...func(*iam.Options)) (*iam.UpdateServerCertificateOutput, error)
+	DeleteServerCertificate(ctx context.Context, input *iam.DeleteServerCertificateInput, opts ...func(*iam.Options)) (*iam.DeleteServerCertificateOutput, error)
+	TagServerCertificate(ctx context.Context, input *iam.TagServerCertificateInput, opts ...func(*iam.Options)) (*iam.TagServerCertificateOutput, error)
+	UntagServerCertificate(ctx context.Context, input *iam.UntagServerCertificateInput, opts ...func(*iam.Options)) (*iam.UntagServerCertificateOutput, error)
+}
+
+// NewServerCertificateClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewServerCertificateClient(cfg aws.Config) ServerCertificateClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// ServerCertificateContent is the content of a server certificate read from
+// a Secret of type kubernetes.io/tls.
+type ServerCertificateContent struct {
+	CertificateBody  string
+	PrivateKey       string
+	CertificateChain *string
+}
+
+// GetServerCertificateContent returns the certificate, private key and
+// certificate chain of a ServerCertificate from the referenced Secret. The
+// first certificate in tls.crt is the certificate body and the remaining ones
+// are the certificate chain, as issued by cert-manager. ca.crt is used as the
+// certificate chain if tls.crt contains a single certificate.
+func GetServerCertificateContent(ctx context.Context, kube client.Reader, p manualv1alpha1.ServerCertificateParameters) (ServerCertificateContent, error) {
+	ref := p.CertificateSecretRef
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return ServerCertificateContent{}, errors.Wrap(err, errGetSecret)
+	}
+	c := ServerCertificateContent{}
+	for key, v := range map[string]*string{corev1.TLSCertKey: &c.CertificateBody, corev1.TLSPrivateKeyKey: &c.PrivateKey} {
+		b, ok := s.Data[key]
+		if !ok {
+			return ServerCertificateContent{}, errors.Errorf(errSecretKeyFmt, key)
+		}
+		*v = string(b)
+	}
+	body, chain := splitCertificates(c.CertificateBody)
+	c.CertificateBody = body
+	if chain != "" {
+		c.CertificateChain = aws.String(chain)
+	} else if b, ok := s.Data[tlsCertificateChainKey]; ok && len(b) != 0 {
+		c.CertificateChain = aws.String(string(b))
+	}
+	return c, nil
+}
+
+// splitCertificates returns the first PEM encoded certificate of the given
+// data and the remaining ones. The data is returned as is if it contains no
+// PEM blocks.
+func splitCertificates(data string) (first, rest string) {
+	blocks := pemBlocks(data)
+	if len(blocks) == 0 {
+		return data, ""
+	}
+	return blocks[0], strings.Join(blocks[1:], "")
+}
+
+// pemBlocks returns the re-encoded PEM blocks of the given data.
+func pemBlocks(data string) []string {
+	var blocks []string
+	rest := []byte(data)
+	for {
+		var b *pem.Block
+		b, rest = pem.Decode(rest)
+		if b == nil {
+			return blocks
+		}
+		blocks = append(blocks, string(pem.EncodeToMemory(b)))
+	}
+}
+
+// normalizeCertificates returns the PEM blocks of the given data in a
+// canonical encoding, or the trimmed data if it contains no PEM blocks.
+func normalizeCertificates(data string) string {
+	blocks := pemBlocks(data)
+	if len(blocks) == 0 {
+		return strings.TrimSpace(data)
+	}
+	return strings.Join(blocks, "")
+}
+
+// GenerateUploadServerCertificateInput returns the input to upload the given
+// server certificate.
+func GenerateUploadServerCertificateInput(name string, p manualv1alpha1.ServerCertificateParameters, c ServerCertificateContent) *iam.UploadServerCertificateInput {
+	return &iam.UploadServerCertificateInput{
+		CertificateBody:       aws.String(c.CertificateBody),
+		CertificateChain:      c.CertificateChain,
+		Path:                  p.Path,
+		PrivateKey:            aws.String(c.PrivateKey),
+		ServerCertificateName: aws.String(name),
+		Tags:                  BuildIAMTags(p.Tags),
+	}
+}
+
+// GenerateServerCertificateObservation returns the observation of the given
+// server certificate.
+func GenerateServerCertificateObservation(observed iamtypes.ServerCertificate) manualv1alpha1.ServerCertificateObservation {
+	if observed.ServerCertificateMetadata == nil {
+		return manualv1alpha1.ServerCertificateObservation{}
+	}
+	m := observed.ServerCertificateMetadata
+	return manualv1alpha1.ServerCertificateObservation{
+		ARN:                 pointer.StringValue(m.Arn),
+		ServerCertificateID: pointer.StringValue(m.ServerCertificateId),
+		Expiration:          pointer.TimeToMetaTime(m.Expiration),
+		UploadDate:          pointer.TimeToMetaTime(m.UploadDate),
+	}
+}
+
+// IsServerCertificateContentUpToDate returns whether the observed certificate
+// and certificate chain of a server certificate match the desired ones. The
+// private key of a server certificate cannot be observed.
+func IsServerCertificateContentUpToDate(c ServerCertificateContent, observed iamtypes.ServerCertificate) bool {
+	return normalizeCertificates(c.CertificateBody) == normalizeCertificates(pointer.StringValue(observed.CertificateBody)) &&
+		normalizeCertificates(pointer.StringValue(c.CertificateChain)) == normalizeCertificates(pointer.StringValue(observed.CertificateChain))
+}
+
+// IsServerCertificatePathUpToDate returns whether the observed path of a
+// server certificate matches the desired one.
+func IsServerCertificatePathUpToDate(p manualv1alpha1.ServerCertificateParameters, observed iamtypes.ServerCertificate) bool {
+	path := defaultServerCertificatePath
+	if p.Path != nil {
+		path = *p.Path
+	}
+	return observed.ServerCertificateMetadata != nil && path == pointer.StringValue(observed.ServerCertificateMetadata.Path)
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// ServiceSpecificCredentialClient is the external client used for
+// ServiceSpecificCredential Custom Resource
+type ServiceSpecificCredentialClient interface {
+	CreateServiceSpecificCredential(ctx context.Context, input *iam.CreateServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.CreateServiceSpecificCredentialOutput, error)
+	ListServiceSpecificCredentials(ctx context.Context, input *iam.ListServiceSpecificCredentialsInput, opts ...func(*iam.Options)) (*iam.ListServiceSpecificCredentialsOutput, error)
+	UpdateServiceSpecificCredential(ctx context.Context, input *iam.UpdateServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.UpdateServiceSpecificCredentialOutput, error)
+	DeleteServiceSpecificCredential(ctx context.Context, input *iam.DeleteServiceSpecificCredentialInput, opts ...func(*iam.Options)) (*iam.DeleteServiceSpecificCredentialOutput, error)
+}
+
+// NewServiceSpecificCredentialClient returns a new client using AWS
+// credentials as JSON encoded data.
+func NewServiceSpecificCredentialClient(cfg aws.Config) ServiceSpecificCredentialClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// FindServiceSpecificCredential returns the credential with the given ID
+// among the given ones, or nil if there is none.
+func FindServiceSpecificCredential(out *iam.ListServiceSpecificCredentialsOutput, id string) *iamtypes.ServiceSpecificCredentialMetadata {
+	for i := range out.ServiceSpecificCredentials {
+		if pointer.StringValue(out.ServiceSpecificCredentials[i].ServiceSpecificCredentialId) == id {
+			return &out.ServiceSpecificCredentials[i]
+		}
+	}
+	return nil
+}
+
+// GenerateServiceSpecificCredentialObservation returns the observation of
+// the given credential.
+func GenerateServiceSpecificCredentialObservation(m iamtypes.ServiceSpecificCredentialMetadata) manualv1alpha1.ServiceSpecificCredentialObservation {
+	return manualv1alpha1.ServiceSpecificCredentialObservation{
+		ServiceSpecificCredentialID: pointer.StringValue(m.ServiceSpecificCredentialId),
+		ServiceUserName:             pointer.StringValue(m.ServiceUserName),
+		CreateDate:                  pointer.TimeToMetaTime(m.CreateDate),
+	}
+}
+
+// IsServiceSpecificCredentialUpToDate returns whether the observed status of
+// a credential matches the desired one.
+func IsServiceSpecificCredentialUpToDate(p manualv1alpha1.ServiceSpecificCredentialParameters, m iamtypes.ServiceSpecificCredentialMetadata) bool {
+	return p.Status == nil || *p.Status == string(m.Status)
+}
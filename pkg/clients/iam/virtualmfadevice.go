@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // TOTP codes of virtual MFA devices use HMAC-SHA1
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	errDecodeSeed = "cannot decode the seed of the virtual MFA device"
+
+	// totpPeriod and totpDigits are the parameters of the time-based one-time
+	// passwords (RFC 6238) of virtual MFA devices.
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+)
+
+// VirtualMFADeviceClient is the external client used for VirtualMFADevice
+// Custom Resource
+type VirtualMFADeviceClient interface {
+	CreateVirtualMFADevice(ctx context.Context, input *iam.CreateVirtualMFADeviceInput, opts ...func(*iam.Options)) (*iam.CreateVirtualMFADeviceOutput, error)
+	ListVirtualMFADevices(ctx context.Context, input *iam.ListVirtualMFADevicesInput, opts ...func(*iam.Options)) (*iam.ListVirtualMFADevicesOutput, error)
+	DeleteVirtualMFADevice(ctx context.Context, input *iam.DeleteVirtualMFADeviceInput, opts ...func(*iam.Options)) (*iam.DeleteVirtualMFADeviceOutput, error)
+	EnableMFADevice(ctx context.Context, input *iam.EnableMFADeviceInput, opts ...func(*iam.Options)) (*iam.EnableMFADeviceOutput, error)
+	DeactivateMFADevice(ctx context.Context, input *iam.DeactivateMFADeviceInput, opts ...func(*iam.Options)) (*iam.DeactivateMFADeviceOutput, error)
+	ListMFADeviceTags(ctx context.Context, input *iam.ListMFADeviceTagsInput, opts ...func(*iam.Options)) (*iam.ListMFADeviceTagsOutput, error)
+	TagMFADevice(ctx context.Context, input *iam.TagMFADeviceInput, opts ...func(*iam.Options)) (*iam.TagMFADeviceOutput, error)
+	UntagMFADevice(ctx context.Context, input *iam.UntagMFADeviceInput, opts ...func(*iam.Options)) (*iam.UntagMFADeviceOutput, error)
+}
+
+// NewVirtualMFADeviceClient returns a new client using AWS credentials as
+// JSON encoded data.
+func NewVirtualMFADeviceClient(cfg aws.Config) VirtualMFADeviceClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// FindVirtualMFADevice returns the virtual MFA device with the given serial
+// number, or nil if there is none.
+func FindVirtualMFADevice(ctx context.Context, c VirtualMFADeviceClient, serialNumber string) (*iamtypes.VirtualMFADevice, error) {
+	p := iam.NewListVirtualMFADevicesPaginator(c, &iam.ListVirtualMFADevicesInput{
+		AssignmentStatus: iamtypes.AssignmentStatusTypeAny,
+	})
+	for p.HasMorePages() {
+		out, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range out.VirtualMFADevices {
+			if pointer.StringValue(out.VirtualMFADevices[i].SerialNumber) == serialNumber {
+				return &out.VirtualMFADevices[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// GenerateVirtualMFADeviceObservation returns the observation of the given
+// virtual MFA device.
+func GenerateVirtualMFADeviceObservation(d iamtypes.VirtualMFADevice) manualv1alpha1.VirtualMFADeviceObservation {
+	o := manualv1alpha1.VirtualMFADeviceObservation{
+		SerialNumber: pointer.StringValue(d.SerialNumber),
+		EnableDate:   pointer.TimeToMetaTime(d.EnableDate),
+	}
+	if d.User != nil {
+		o.Username = d.User.UserName
+	}
+	return o
+}
+
+// GenerateMFAAuthenticationCodes returns the authentication codes of the
+// virtual MFA device with the given Base32 encoded seed for the time step
+// before the given time and the time step of the given time. These are the
+// two consecutive codes required to enable the device.
+func GenerateMFAAuthenticationCodes(seed []byte, t time.Time) (string, string, error) {
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(string(seed), "="))
+	if err != nil {
+		return "", "", errors.Wrap(err, errDecodeSeed)
+	}
+	step := uint64(t.Unix()) / uint64(totpPeriod/time.Second)
+	return totp(key, step-1), totp(key, step), nil
+}
+
+// totp returns the time-based one-time password of the given key and time
+// step as defined by RFC 6238.
+func totp(key []byte, step uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, step)
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, code%1000000)
+}
@@ -0,0 +1,59 @@
+package iam
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGenerateMFAAuthenticationCodes(t *testing.T) {
+	// The SHA-1 test vectors of RFC 6238, truncated to six digits.
+	seed := []byte(base32.StdEncoding.EncodeToString([]byte("12345678901234567890")))
+
+	type want struct {
+		code1 string
+		code2 string
+	}
+
+	cases := map[string]struct {
+		seed []byte
+		t    time.Time
+		want want
+		err  bool
+	}{
+		"Step1": {
+			seed: seed,
+			t:    time.Unix(59, 0),
+			want: want{code1: "755224", code2: "287082"},
+		},
+		"Step37037037": {
+			seed: seed,
+			t:    time.Unix(1111111111, 0),
+			want: want{code1: "081804", code2: "050471"},
+		},
+		"Unpadded": {
+			seed: []byte("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"),
+			t:    time.Unix(59, 0),
+			want: want{code1: "755224", code2: "287082"},
+		},
+		"InvalidSeed": {
+			seed: []byte("not base32!"),
+			t:    time.Unix(59, 0),
+			err:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			code1, code2, err := GenerateMFAAuthenticationCodes(tc.seed, tc.t)
+			if (err != nil) != tc.err {
+				t.Fatalf("GenerateMFAAuthenticationCodes(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want, want{code1: code1, code2: code2}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("GenerateMFAAuthenticationCodes(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
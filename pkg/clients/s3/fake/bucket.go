@@ -65,8 +65,22 @@ type MockBucketClient struct {
 	MockGetBucketTagging    func(ctx context.Context, input *s3.GetBucketTaggingInput, opts []func(*s3.Options)) (*s3.GetBucketTaggingOutput, error)
 	MockDeleteBucketTagging func(ctx context.Context, input *s3.DeleteBucketTaggingInput, opts []func(*s3.Options)) (*s3.DeleteBucketTaggingOutput, error)
 
-	MockPutBucketAnalyticsConfiguration func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
-	MockGetBucketAnalyticsConfiguration func(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	MockPutBucketAnalyticsConfiguration    func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error)
+	MockGetBucketAnalyticsConfiguration    func(ctx context.Context, input *s3.GetBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketAnalyticsConfigurationOutput, error)
+	MockListBucketAnalyticsConfigurations  func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error)
+	MockDeleteBucketAnalyticsConfiguration func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error)
+
+	MockPutBucketIntelligentTieringConfiguration    func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error)
+	MockListBucketIntelligentTieringConfigurations  func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error)
+	MockDeleteBucketIntelligentTieringConfiguration func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error)
+
+	MockPutBucketInventoryConfiguration    func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error)
+	MockListBucketInventoryConfigurations  func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error)
+	MockDeleteBucketInventoryConfiguration func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error)
+
+	MockPutBucketMetricsConfiguration    func(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error)
+	MockListBucketMetricsConfigurations  func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error)
+	MockDeleteBucketMetricsConfiguration func(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error)
 
 	MockPutBucketLifecycleConfiguration func(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error)
 	MockGetBucketLifecycleConfiguration func(ctx context.Context, input *s3.GetBucketLifecycleConfigurationInput, opts []func(*s3.Options)) (*s3.GetBucketLifecycleConfigurationOutput, error)
@@ -89,6 +103,9 @@ type MockBucketClient struct {
 	MockGetObjectLockConfiguration func(ctx context.Context, input *s3.GetObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.GetObjectLockConfigurationOutput, error)
 	MockPutObjectLockConfiguration func(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts []func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error)
 
+	MockListObjectVersions func(ctx context.Context, input *s3.ListObjectVersionsInput, opts []func(*s3.Options)) (*s3.ListObjectVersionsOutput, error)
+	MockDeleteObjects      func(ctx context.Context, input *s3.DeleteObjectsInput, opts []func(*s3.Options)) (*s3.DeleteObjectsOutput, error)
+
 	MockBucketPolicyClient
 }
 
@@ -232,6 +249,61 @@ func (m MockBucketClient) GetBucketAnalyticsConfiguration(ctx context.Context, i
 	return m.MockGetBucketAnalyticsConfiguration(ctx, input, opts)
 }
 
+// ListBucketAnalyticsConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketAnalyticsConfigurations(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return m.MockListBucketAnalyticsConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketAnalyticsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketAnalyticsConfiguration(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+	return m.MockDeleteBucketAnalyticsConfiguration(ctx, input, opts)
+}
+
+// PutBucketIntelligentTieringConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockPutBucketIntelligentTieringConfiguration(ctx, input, opts)
+}
+
+// ListBucketIntelligentTieringConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketIntelligentTieringConfigurations(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return m.MockListBucketIntelligentTieringConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketIntelligentTieringConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketIntelligentTieringConfiguration(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+	return m.MockDeleteBucketIntelligentTieringConfiguration(ctx, input, opts)
+}
+
+// PutBucketInventoryConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketInventoryConfiguration(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+	return m.MockPutBucketInventoryConfiguration(ctx, input, opts)
+}
+
+// ListBucketInventoryConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketInventoryConfigurations(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return m.MockListBucketInventoryConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketInventoryConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketInventoryConfiguration(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+	return m.MockDeleteBucketInventoryConfiguration(ctx, input, opts)
+}
+
+// PutBucketMetricsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) PutBucketMetricsConfiguration(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+	return m.MockPutBucketMetricsConfiguration(ctx, input, opts)
+}
+
+// ListBucketMetricsConfigurations is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListBucketMetricsConfigurations(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts ...func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return m.MockListBucketMetricsConfigurations(ctx, input, opts)
+}
+
+// DeleteBucketMetricsConfiguration is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteBucketMetricsConfiguration(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts ...func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+	return m.MockDeleteBucketMetricsConfiguration(ctx, input, opts)
+}
+
 // PutBucketLifecycleConfiguration is the fake method call to invoke the internal mock method
 func (m MockBucketClient) PutBucketLifecycleConfiguration(ctx context.Context, input *s3.PutBucketLifecycleConfigurationInput, opts ...func(*s3.Options)) (*s3.PutBucketLifecycleConfigurationOutput, error) {
 	return m.MockPutBucketLifecycleConfiguration(ctx, input, opts)
@@ -306,3 +378,13 @@ func (m MockBucketClient) GetObjectLockConfiguration(ctx context.Context, input
 func (m MockBucketClient) PutObjectLockConfiguration(ctx context.Context, input *s3.PutObjectLockConfigurationInput, opts ...func(*s3.Options)) (*s3.PutObjectLockConfigurationOutput, error) {
 	return m.MockPutObjectLockConfiguration(ctx, input, opts)
 }
+
+// ListObjectVersions is the fake method call to invoke the internal mock method
+func (m MockBucketClient) ListObjectVersions(ctx context.Context, input *s3.ListObjectVersionsInput, opts ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	return m.MockListObjectVersions(ctx, input, opts)
+}
+
+// DeleteObjects is the fake method call to invoke the internal mock method
+func (m MockBucketClient) DeleteObjects(ctx context.Context, input *s3.DeleteObjectsInput, opts ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	return m.MockDeleteObjects(ctx, input, opts)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec // S3 ETags of single part uploads are MD5 sums
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+)
+
+const (
+	errNoContent       = "one of content, contentBase64, contentConfigMapRef or contentSecretRef is required"
+	errDecodeContent   = "cannot decode contentBase64"
+	errGetConfigMap    = "cannot get the referenced ConfigMap"
+	errGetSecret       = "cannot get the referenced Secret"
+	errConfigMapKeyFmt = "the referenced ConfigMap does not contain key %q"
+	errSecretKeyFmt    = "the referenced Secret does not contain key %q"
+)
+
+// ObjectClient is the interface for Client for making S3 Object requests.
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+	PutObjectTagging(ctx context.Context, input *s3.PutObjectTaggingInput, opts ...func(*s3.Options)) (*s3.PutObjectTaggingOutput, error)
+	DeleteObjectTagging(ctx context.Context, input *s3.DeleteObjectTaggingInput, opts ...func(*s3.Options)) (*s3.DeleteObjectTaggingOutput, error)
+}
+
+// NewObjectClient returns a new client using AWS credentials as JSON encoded
+// data.
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// GetObjectContent returns the desired content of an Object from its inline
+// content or the referenced ConfigMap or Secret.
+func GetObjectContent(ctx context.Context, kube client.Reader, p manualv1alpha1.ObjectParameters) ([]byte, error) {
+	switch {
+	case p.Content != nil:
+		return []byte(*p.Content), nil
+	case p.ContentBase64 != nil:
+		b, err := base64.StdEncoding.DecodeString(*p.ContentBase64)
+		if err != nil {
+			return nil, errors.Wrap(err, errDecodeContent)
+		}
+		return b, nil
+	case p.ContentConfigMapRef != nil:
+		ref := p.ContentConfigMapRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+			return nil, errors.Wrap(err, errGetConfigMap)
+		}
+		if v, ok := cm.Data[ref.Key]; ok {
+			return []byte(v), nil
+		}
+		if v, ok := cm.BinaryData[ref.Key]; ok {
+			return v, nil
+		}
+		return nil, errors.Errorf(errConfigMapKeyFmt, ref.Key)
+	case p.ContentSecretRef != nil:
+		ref := p.ContentSecretRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+			return nil, errors.Wrap(err, errGetSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return nil, errors.Errorf(errSecretKeyFmt, ref.Key)
+		}
+		return v, nil
+	}
+	return nil, errors.New(errNoContent)
+}
+
+// ObjectContentSHA256 returns the base64 encoded SHA-256 checksum of the
+// supplied content, as reported by S3.
+func ObjectContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// GeneratePutObjectInput returns the input for a PutObject request.
+func GeneratePutObjectInput(bucket string, p manualv1alpha1.ObjectParameters, content []byte) *s3.PutObjectInput {
+	return &s3.PutObjectInput{
+		Bucket:               aws.String(bucket),
+		Key:                  aws.String(p.Key),
+		Body:                 bytes.NewReader(content),
+		ContentLength:        aws.Int64(int64(len(content))),
+		ContentType:          p.ContentType,
+		CacheControl:         p.CacheControl,
+		ChecksumAlgorithm:    s3types.ChecksumAlgorithmSha256,
+		ServerSideEncryption: s3types.ServerSideEncryption(aws.ToString(p.ServerSideEncryption)),
+		SSEKMSKeyId:          p.SSEKMSKeyID,
+		Metadata:             p.Metadata,
+		Tagging:              generateObjectTagging(p.Tags),
+	}
+}
+
+func generateObjectTagging(tags []v1beta1.Tag) *string {
+	if len(tags) == 0 {
+		return nil
+	}
+	var sb strings.Builder
+	for i, t := range tags {
+		if i > 0 {
+			sb.WriteString("&")
+		}
+		sb.WriteString(url.QueryEscape(t.Key))
+		sb.WriteString("=")
+		sb.WriteString(url.QueryEscape(t.Value))
+	}
+	return aws.String(sb.String())
+}
+
+// GenerateObjectObservation returns the observation of an Object from the
+// output of a HeadObject request.
+func GenerateObjectObservation(o *s3.HeadObjectOutput) manualv1alpha1.ObjectObservation {
+	return manualv1alpha1.ObjectObservation{
+		ETag:           o.ETag,
+		ChecksumSHA256: o.ChecksumSHA256,
+		VersionID:      o.VersionId,
+		ContentLength:  o.ContentLength,
+	}
+}
+
+// IsObjectContentUpToDate returns whether the content of the observed object
+// matches the desired content. The SHA-256 checksum is compared if S3 stores
+// one for the object. Otherwise the ETag is compared, which is the MD5 sum of
+// the content for objects that are not encrypted with SSE-KMS.
+func IsObjectContentUpToDate(o *s3.HeadObjectOutput, content []byte) bool {
+	if o.ChecksumSHA256 != nil {
+		return aws.ToString(o.ChecksumSHA256) == ObjectContentSHA256(content)
+	}
+	sum := md5.Sum(content) //nolint:gosec // S3 ETags of single part uploads are MD5 sums
+	return strings.Trim(aws.ToString(o.ETag), `"`) == hex.EncodeToString(sum[:])
+}
+
+// IsObjectUpToDate returns whether the observed object matches the desired
+// parameters, except for its tags. The content is ignored if
+// ignoreContentDrift is set.
+func IsObjectUpToDate(p manualv1alpha1.ObjectParameters, o *s3.HeadObjectOutput, content []byte) bool {
+	if !aws.ToBool(p.IgnoreContentDrift) && !IsObjectContentUpToDate(o, content) {
+		return false
+	}
+	if p.ContentType != nil && aws.ToString(p.ContentType) != aws.ToString(o.ContentType) {
+		return false
+	}
+	if p.CacheControl != nil && aws.ToString(p.CacheControl) != aws.ToString(o.CacheControl) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && aws.ToString(p.ServerSideEncryption) != string(o.ServerSideEncryption) {
+		return false
+	}
+	if p.SSEKMSKeyID != nil && aws.ToString(p.SSEKMSKeyID) != aws.ToString(o.SSEKMSKeyId) {
+		return false
+	}
+	if len(p.Metadata) != len(o.Metadata) {
+		return false
+	}
+	for k, v := range p.Metadata {
+		if ov, ok := o.Metadata[strings.ToLower(k)]; !ok || ov != v {
+			return false
+		}
+	}
+	return true
+}
+
+// AreObjectTagsUpToDate returns whether the observed tags of an object match
+// the desired tags.
+func AreObjectTagsUpToDate(desired []v1beta1.Tag, observed []s3types.Tag) bool {
+	if len(desired) != len(observed) {
+		return false
+	}
+	obs := make(map[string]string, len(observed))
+	for _, t := range observed {
+		obs[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	for _, t := range desired {
+		if v, ok := obs[t.Key]; !ok || v != t.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateObjectTagSet returns the S3 tag set of the supplied tags sorted by
+// key.
+func GenerateObjectTagSet(tags []v1beta1.Tag) []s3types.Tag {
+	res := make([]s3types.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = s3types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return aws.ToString(res[i].Key) < aws.ToString(res[j].Key)
+	})
+	return res
+}
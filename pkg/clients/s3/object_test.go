@@ -0,0 +1,172 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/manualv1alpha1"
+)
+
+func TestGetObjectContent(t *testing.T) {
+	secretRef := &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "s", Namespace: "default"}, Key: "data"}
+	configMapRef := &manualv1alpha1.ConfigMapKeySelector{Name: "c", Namespace: "default", Key: "data"}
+
+	type args struct {
+		kube client.Reader
+		p    manualv1alpha1.ObjectParameters
+	}
+	type want struct {
+		content []byte
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Inline": {
+			args: args{p: manualv1alpha1.ObjectParameters{Content: aws.String("hello")}},
+			want: want{content: []byte("hello")},
+		},
+		"Base64": {
+			args: args{p: manualv1alpha1.ObjectParameters{ContentBase64: aws.String("aGVsbG8=")}},
+			want: want{content: []byte("hello")},
+		},
+		"InvalidBase64": {
+			args: args{p: manualv1alpha1.ObjectParameters{ContentBase64: aws.String("!")}},
+			want: want{err: errors.Wrap(errors.New("illegal base64 data at input byte 0"), errDecodeContent)},
+		},
+		"ConfigMapBinaryData": {
+			args: args{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.ConfigMap).BinaryData = map[string][]byte{"data": {0x1, 0x2}}
+					return nil
+				}},
+				p: manualv1alpha1.ObjectParameters{ContentConfigMapRef: configMapRef},
+			},
+			want: want{content: []byte{0x1, 0x2}},
+		},
+		"ConfigMapKeyMissing": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				p:    manualv1alpha1.ObjectParameters{ContentConfigMapRef: configMapRef},
+			},
+			want: want{err: errors.Errorf(errConfigMapKeyFmt, "data")},
+		},
+		"Secret": {
+			args: args{
+				kube: &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*corev1.Secret).Data = map[string][]byte{"data": []byte("hello")}
+					return nil
+				}},
+				p: manualv1alpha1.ObjectParameters{ContentSecretRef: secretRef},
+			},
+			want: want{content: []byte("hello")},
+		},
+		"NoContent": {
+			want: want{err: errors.New(errNoContent)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			content, err := GetObjectContent(context.Background(), tc.args.kube, tc.args.p)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.content, content); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsObjectUpToDate(t *testing.T) {
+	content := []byte("hello")
+
+	type args struct {
+		p manualv1alpha1.ObjectParameters
+		o *s3.HeadObjectOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"ChecksumMatches": {
+			args: args{o: &s3.HeadObjectOutput{ChecksumSHA256: aws.String(ObjectContentSHA256(content))}},
+			want: true,
+		},
+		"ChecksumDiffers": {
+			args: args{o: &s3.HeadObjectOutput{ChecksumSHA256: aws.String(ObjectContentSHA256([]byte("other")))}},
+			want: false,
+		},
+		"ETagMatches": {
+			args: args{o: &s3.HeadObjectOutput{ETag: aws.String(`"5d41402abc4b2a76b9719d911017c592"`)}},
+			want: true,
+		},
+		"ETagDiffersIgnored": {
+			args: args{
+				p: manualv1alpha1.ObjectParameters{IgnoreContentDrift: aws.Bool(true)},
+				o: &s3.HeadObjectOutput{ETag: aws.String(`"other"`)},
+			},
+			want: true,
+		},
+		"MetadataMatchesLowerCase": {
+			args: args{
+				p: manualv1alpha1.ObjectParameters{Metadata: map[string]string{"Owner": "team"}, ContentType: aws.String("text/plain")},
+				o: &s3.HeadObjectOutput{
+					ChecksumSHA256: aws.String(ObjectContentSHA256(content)),
+					ContentType:    aws.String("text/plain"),
+					Metadata:       map[string]string{"owner": "team"},
+				},
+			},
+			want: true,
+		},
+		"SSEDiffers": {
+			args: args{
+				p: manualv1alpha1.ObjectParameters{ServerSideEncryption: aws.String("aws:kms")},
+				o: &s3.HeadObjectOutput{
+					ChecksumSHA256:       aws.String(ObjectContentSHA256(content)),
+					ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsObjectUpToDate(tc.args.p, tc.args.o, content)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
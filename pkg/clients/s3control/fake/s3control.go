@@ -5,6 +5,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3control"
 	"github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 )
 
 // MockS3ControlClient is a mock implementation of the S3ControlAPI interface
@@ -30,6 +32,54 @@ type MockS3ControlClient struct {
 
 	DeleteAccessPointPolicyWithContextOutput s3control.DeleteAccessPointPolicyOutput
 	DeleteAccessPointPolicyWithContextErr    error
+
+	GetMultiRegionAccessPointWithContextOutput s3control.GetMultiRegionAccessPointOutput
+	GetMultiRegionAccessPointWithContextErr    error
+
+	CreateMultiRegionAccessPointWithContextOutput s3control.CreateMultiRegionAccessPointOutput
+	CreateMultiRegionAccessPointWithContextErr    error
+
+	DeleteMultiRegionAccessPointWithContextOutput s3control.DeleteMultiRegionAccessPointOutput
+	DeleteMultiRegionAccessPointWithContextErr    error
+
+	DescribeMultiRegionAccessPointOperationWithContextOutput s3control.DescribeMultiRegionAccessPointOperationOutput
+	DescribeMultiRegionAccessPointOperationWithContextErr    error
+
+	GetMultiRegionAccessPointPolicyWithContextOutput s3control.GetMultiRegionAccessPointPolicyOutput
+	GetMultiRegionAccessPointPolicyWithContextErr    error
+
+	PutMultiRegionAccessPointPolicyWithContextOutput s3control.PutMultiRegionAccessPointPolicyOutput
+	PutMultiRegionAccessPointPolicyWithContextErr    error
+
+	GetMultiRegionAccessPointRoutesWithContextOutput s3control.GetMultiRegionAccessPointRoutesOutput
+	GetMultiRegionAccessPointRoutesWithContextErr    error
+
+	SubmitMultiRegionAccessPointRoutesWithContextOutput s3control.SubmitMultiRegionAccessPointRoutesOutput
+	SubmitMultiRegionAccessPointRoutesWithContextErr    error
+
+	GetAccessPointForObjectLambdaWithContextOutput s3control.GetAccessPointForObjectLambdaOutput
+	GetAccessPointForObjectLambdaWithContextErr    error
+
+	CreateAccessPointForObjectLambdaWithContextOutput s3control.CreateAccessPointForObjectLambdaOutput
+	CreateAccessPointForObjectLambdaWithContextErr    error
+
+	DeleteAccessPointForObjectLambdaWithContextOutput s3control.DeleteAccessPointForObjectLambdaOutput
+	DeleteAccessPointForObjectLambdaWithContextErr    error
+
+	GetAccessPointConfigurationForObjectLambdaWithContextOutput s3control.GetAccessPointConfigurationForObjectLambdaOutput
+	GetAccessPointConfigurationForObjectLambdaWithContextErr    error
+
+	PutAccessPointConfigurationForObjectLambdaWithContextOutput s3control.PutAccessPointConfigurationForObjectLambdaOutput
+	PutAccessPointConfigurationForObjectLambdaWithContextErr    error
+
+	GetAccessPointPolicyForObjectLambdaWithContextOutput s3control.GetAccessPointPolicyForObjectLambdaOutput
+	GetAccessPointPolicyForObjectLambdaWithContextErr    error
+
+	PutAccessPointPolicyForObjectLambdaWithContextOutput s3control.PutAccessPointPolicyForObjectLambdaOutput
+	PutAccessPointPolicyForObjectLambdaWithContextErr    error
+
+	DeleteAccessPointPolicyForObjectLambdaWithContextOutput s3control.DeleteAccessPointPolicyForObjectLambdaOutput
+	DeleteAccessPointPolicyForObjectLambdaWithContextErr    error
 }
 
 // DeleteAccessPointWithContext is the fake method call to invoke the internal mock method
@@ -61,3 +111,97 @@ func (m *MockS3ControlClient) PutAccessPointPolicyWithContext(_ aws.Context, _ *
 func (m *MockS3ControlClient) DeleteAccessPointPolicyWithContext(_ aws.Context, _ *s3control.DeleteAccessPointPolicyInput, _ ...request.Option) (*s3control.DeleteAccessPointPolicyOutput, error) {
 	return &m.DeleteAccessPointPolicyWithContextOutput, m.DeleteAccessPointPolicyWithContextErr
 }
+
+// GetMultiRegionAccessPointWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetMultiRegionAccessPointWithContext(aws.Context, *s3control.GetMultiRegionAccessPointInput, ...request.Option) (*s3control.GetMultiRegionAccessPointOutput, error) {
+	return &m.GetMultiRegionAccessPointWithContextOutput, m.GetMultiRegionAccessPointWithContextErr
+}
+
+// CreateMultiRegionAccessPointWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) CreateMultiRegionAccessPointWithContext(aws.Context, *s3control.CreateMultiRegionAccessPointInput, ...request.Option) (*s3control.CreateMultiRegionAccessPointOutput, error) {
+	return &m.CreateMultiRegionAccessPointWithContextOutput, m.CreateMultiRegionAccessPointWithContextErr
+}
+
+// DeleteMultiRegionAccessPointWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DeleteMultiRegionAccessPointWithContext(aws.Context, *s3control.DeleteMultiRegionAccessPointInput, ...request.Option) (*s3control.DeleteMultiRegionAccessPointOutput, error) {
+	return &m.DeleteMultiRegionAccessPointWithContextOutput, m.DeleteMultiRegionAccessPointWithContextErr
+}
+
+// DescribeMultiRegionAccessPointOperationWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DescribeMultiRegionAccessPointOperationWithContext(aws.Context, *s3control.DescribeMultiRegionAccessPointOperationInput, ...request.Option) (*s3control.DescribeMultiRegionAccessPointOperationOutput, error) {
+	return &m.DescribeMultiRegionAccessPointOperationWithContextOutput, m.DescribeMultiRegionAccessPointOperationWithContextErr
+}
+
+// GetMultiRegionAccessPointPolicyWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetMultiRegionAccessPointPolicyWithContext(aws.Context, *s3control.GetMultiRegionAccessPointPolicyInput, ...request.Option) (*s3control.GetMultiRegionAccessPointPolicyOutput, error) {
+	return &m.GetMultiRegionAccessPointPolicyWithContextOutput, m.GetMultiRegionAccessPointPolicyWithContextErr
+}
+
+// PutMultiRegionAccessPointPolicyWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) PutMultiRegionAccessPointPolicyWithContext(aws.Context, *s3control.PutMultiRegionAccessPointPolicyInput, ...request.Option) (*s3control.PutMultiRegionAccessPointPolicyOutput, error) {
+	return &m.PutMultiRegionAccessPointPolicyWithContextOutput, m.PutMultiRegionAccessPointPolicyWithContextErr
+}
+
+// GetMultiRegionAccessPointRoutesWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetMultiRegionAccessPointRoutesWithContext(aws.Context, *s3control.GetMultiRegionAccessPointRoutesInput, ...request.Option) (*s3control.GetMultiRegionAccessPointRoutesOutput, error) {
+	return &m.GetMultiRegionAccessPointRoutesWithContextOutput, m.GetMultiRegionAccessPointRoutesWithContextErr
+}
+
+// SubmitMultiRegionAccessPointRoutesWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) SubmitMultiRegionAccessPointRoutesWithContext(aws.Context, *s3control.SubmitMultiRegionAccessPointRoutesInput, ...request.Option) (*s3control.SubmitMultiRegionAccessPointRoutesOutput, error) {
+	return &m.SubmitMultiRegionAccessPointRoutesWithContextOutput, m.SubmitMultiRegionAccessPointRoutesWithContextErr
+}
+
+// GetAccessPointForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetAccessPointForObjectLambdaWithContext(aws.Context, *s3control.GetAccessPointForObjectLambdaInput, ...request.Option) (*s3control.GetAccessPointForObjectLambdaOutput, error) {
+	return &m.GetAccessPointForObjectLambdaWithContextOutput, m.GetAccessPointForObjectLambdaWithContextErr
+}
+
+// CreateAccessPointForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) CreateAccessPointForObjectLambdaWithContext(aws.Context, *s3control.CreateAccessPointForObjectLambdaInput, ...request.Option) (*s3control.CreateAccessPointForObjectLambdaOutput, error) {
+	return &m.CreateAccessPointForObjectLambdaWithContextOutput, m.CreateAccessPointForObjectLambdaWithContextErr
+}
+
+// DeleteAccessPointForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DeleteAccessPointForObjectLambdaWithContext(aws.Context, *s3control.DeleteAccessPointForObjectLambdaInput, ...request.Option) (*s3control.DeleteAccessPointForObjectLambdaOutput, error) {
+	return &m.DeleteAccessPointForObjectLambdaWithContextOutput, m.DeleteAccessPointForObjectLambdaWithContextErr
+}
+
+// GetAccessPointConfigurationForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetAccessPointConfigurationForObjectLambdaWithContext(aws.Context, *s3control.GetAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*s3control.GetAccessPointConfigurationForObjectLambdaOutput, error) {
+	return &m.GetAccessPointConfigurationForObjectLambdaWithContextOutput, m.GetAccessPointConfigurationForObjectLambdaWithContextErr
+}
+
+// PutAccessPointConfigurationForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) PutAccessPointConfigurationForObjectLambdaWithContext(aws.Context, *s3control.PutAccessPointConfigurationForObjectLambdaInput, ...request.Option) (*s3control.PutAccessPointConfigurationForObjectLambdaOutput, error) {
+	return &m.PutAccessPointConfigurationForObjectLambdaWithContextOutput, m.PutAccessPointConfigurationForObjectLambdaWithContextErr
+}
+
+// GetAccessPointPolicyForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) GetAccessPointPolicyForObjectLambdaWithContext(aws.Context, *s3control.GetAccessPointPolicyForObjectLambdaInput, ...request.Option) (*s3control.GetAccessPointPolicyForObjectLambdaOutput, error) {
+	return &m.GetAccessPointPolicyForObjectLambdaWithContextOutput, m.GetAccessPointPolicyForObjectLambdaWithContextErr
+}
+
+// PutAccessPointPolicyForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) PutAccessPointPolicyForObjectLambdaWithContext(aws.Context, *s3control.PutAccessPointPolicyForObjectLambdaInput, ...request.Option) (*s3control.PutAccessPointPolicyForObjectLambdaOutput, error) {
+	return &m.PutAccessPointPolicyForObjectLambdaWithContextOutput, m.PutAccessPointPolicyForObjectLambdaWithContextErr
+}
+
+// DeleteAccessPointPolicyForObjectLambdaWithContext is the fake method call to invoke the internal mock method
+func (m *MockS3ControlClient) DeleteAccessPointPolicyForObjectLambdaWithContext(aws.Context, *s3control.DeleteAccessPointPolicyForObjectLambdaInput, ...request.Option) (*s3control.DeleteAccessPointPolicyForObjectLambdaOutput, error) {
+	return &m.DeleteAccessPointPolicyForObjectLambdaWithContextOutput, m.DeleteAccessPointPolicyForObjectLambdaWithContextErr
+}
+
+// MockSTSClient is a mock implementation of the STSAPI interface used to
+// resolve the account ID of S3 Control resources.
+type MockSTSClient struct {
+	stsiface.STSAPI
+
+	GetCallerIdentityWithContextOutput sts.GetCallerIdentityOutput
+	GetCallerIdentityWithContextErr    error
+}
+
+// GetCallerIdentityWithContext is the fake method call to invoke the internal mock method
+func (m *MockSTSClient) GetCallerIdentityWithContext(aws.Context, *sts.GetCallerIdentityInput, ...request.Option) (*sts.GetCallerIdentityOutput, error) {
+	return &m.GetCallerIdentityWithContextOutput, m.GetCallerIdentityWithContextErr
+}
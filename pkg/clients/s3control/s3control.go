@@ -0,0 +1,207 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3control
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3control/manualv1alpha1"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	// MultiRegionAccessPointControlRegion is the Region all requests to the
+	// control plane of Multi-Region Access Points are sent to.
+	MultiRegionAccessPointControlRegion = "us-west-2"
+
+	// Request statuses of asynchronous Multi-Region Access Point operations.
+	asyncRequestStatusSucceeded = "SUCCEEDED"
+	asyncRequestStatusFailed    = "FAILED"
+
+	errGetCallerIdentity = "cannot get the caller identity"
+	errNoAccount         = "caller identity does not contain an account ID"
+	errAsyncOperationFmt = "asynchronous operation %s failed"
+)
+
+// ResolveAccountID returns the given account ID or, if it is not set, the ID
+// of the account that owns the credentials of the given STS client.
+func ResolveAccountID(ctx context.Context, client stsiface.STSAPI, accountID *string) (string, error) {
+	if pointer.StringValue(accountID) != "" {
+		return *accountID, nil
+	}
+	out, err := client.GetCallerIdentityWithContext(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", errorutils.Wrap(err, errGetCallerIdentity)
+	}
+	if pointer.StringValue(out.Account) == "" {
+		return "", errors.New(errNoAccount)
+	}
+	return *out.Account, nil
+}
+
+// IsMultiRegionAccessPointNotFound returns whether the given error reports a
+// Multi-Region Access Point that does not exist.
+func IsMultiRegionAccessPointNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchMultiRegionAccessPoint"
+}
+
+// IsMultiRegionAccessPointPolicyNotFound returns whether the given error
+// reports a Multi-Region Access Point without a policy.
+func IsMultiRegionAccessPointPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchMultiRegionAccessPointPolicy"
+}
+
+// IsAccessPointNotFound returns whether the given error reports an access
+// point that does not exist.
+func IsAccessPointNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchAccessPoint"
+}
+
+// IsAccessPointPolicyNotFound returns whether the given error reports an
+// access point without a policy.
+func IsAccessPointPolicyNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error) //nolint:errorlint
+	return ok && awsErr.Code() == "NoSuchAccessPointPolicy"
+}
+
+// IsAsyncOperationDone returns whether the given asynchronous operation has
+// finished and, if it failed, the error it failed with.
+func IsAsyncOperationDone(op *svcsdk.AsyncOperation) (bool, error) {
+	if op == nil {
+		return true, nil
+	}
+	switch pointer.StringValue(op.RequestStatus) {
+	case asyncRequestStatusSucceeded:
+		return true, nil
+	case asyncRequestStatusFailed:
+		err := errors.Errorf(errAsyncOperationFmt, pointer.StringValue(op.Operation))
+		if op.ResponseDetails != nil && op.ResponseDetails.ErrorDetails != nil {
+			d := op.ResponseDetails.ErrorDetails
+			err = errors.Wrapf(awserr.New(pointer.StringValue(d.Code), pointer.StringValue(d.Message), nil), errAsyncOperationFmt, pointer.StringValue(op.Operation))
+		}
+		return true, err
+	}
+	return false, nil
+}
+
+// GenerateMultiRegionAccessPointObservation returns the observation of the
+// given Multi-Region Access Point.
+func GenerateMultiRegionAccessPointObservation(accountID string, ap *svcsdk.MultiRegionAccessPointReport) manualv1alpha1.MultiRegionAccessPointObservation {
+	obs := manualv1alpha1.MultiRegionAccessPointObservation{
+		Alias:  ap.Alias,
+		Status: ap.Status,
+	}
+	if ap.Alias != nil {
+		obs.ARN = pointer.ToOrNilIfZeroValue("arn:aws:s3::" + accountID + ":accesspoint/" + *ap.Alias)
+	}
+	if ap.CreatedAt != nil {
+		obs.CreatedAt = pointer.TimeToMetaTime(ap.CreatedAt)
+	}
+	return obs
+}
+
+// GenerateCreateMultiRegionAccessPointInput returns the input to create the
+// given Multi-Region Access Point.
+func GenerateCreateMultiRegionAccessPointInput(name, accountID string, p manualv1alpha1.MultiRegionAccessPointParameters) *svcsdk.CreateMultiRegionAccessPointInput {
+	details := &svcsdk.CreateMultiRegionAccessPointInput_{
+		Name:              pointer.ToOrNilIfZeroValue(name),
+		PublicAccessBlock: generatePublicAccessBlock(p.PublicAccessBlockConfiguration),
+		Regions:           make([]*svcsdk.Region, len(p.Regions)),
+	}
+	for i, r := range p.Regions {
+		details.Regions[i] = &svcsdk.Region{
+			Bucket:          r.Bucket,
+			BucketAccountId: r.BucketAccountID,
+		}
+	}
+	return &svcsdk.CreateMultiRegionAccessPointInput{
+		AccountId: pointer.ToOrNilIfZeroValue(accountID),
+		Details:   details,
+	}
+}
+
+// GenerateObjectLambdaConfiguration returns the configuration of the given
+// Object Lambda Access Point.
+func GenerateObjectLambdaConfiguration(c manualv1alpha1.ObjectLambdaConfiguration) *svcsdk.ObjectLambdaConfiguration {
+	conf := &svcsdk.ObjectLambdaConfiguration{
+		AllowedFeatures:              pointer.SliceValueToPtr(c.AllowedFeatures),
+		CloudWatchMetricsEnabled:     c.CloudWatchMetricsEnabled,
+		SupportingAccessPoint:        c.SupportingAccessPoint,
+		TransformationConfigurations: make([]*svcsdk.ObjectLambdaTransformationConfiguration, len(c.TransformationConfigurations)),
+	}
+	for i, t := range c.TransformationConfigurations {
+		conf.TransformationConfigurations[i] = &svcsdk.ObjectLambdaTransformationConfiguration{
+			Actions: pointer.SliceValueToPtr(t.Actions),
+			ContentTransformation: &svcsdk.ObjectLambdaContentTransformation{
+				AwsLambda: &svcsdk.AwsLambdaTransformation{
+					FunctionArn:     t.ContentTransformation.AWSLambda.FunctionARN,
+					FunctionPayload: t.ContentTransformation.AWSLambda.FunctionPayload,
+				},
+			},
+		}
+	}
+	return conf
+}
+
+func generatePublicAccessBlock(c *manualv1alpha1.PublicAccessBlockConfiguration) *svcsdk.PublicAccessBlockConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &svcsdk.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       c.BlockPublicACLs,
+		BlockPublicPolicy:     c.BlockPublicPolicy,
+		IgnorePublicAcls:      c.IgnorePublicACLs,
+		RestrictPublicBuckets: c.RestrictPublicBuckets,
+	}
+}
+
+// IsObjectLambdaConfigurationUpToDate returns whether the observed
+// configuration of an Object Lambda Access Point matches the desired one.
+func IsObjectLambdaConfigurationUpToDate(desired manualv1alpha1.ObjectLambdaConfiguration, observed *svcsdk.ObjectLambdaConfiguration) bool {
+	if observed == nil {
+		return false
+	}
+	if !sets.New(desired.AllowedFeatures...).Equal(sets.New(pointer.SlicePtrToValue(observed.AllowedFeatures)...)) ||
+		pointer.BoolValue(desired.CloudWatchMetricsEnabled) != pointer.BoolValue(observed.CloudWatchMetricsEnabled) ||
+		pointer.StringValue(desired.SupportingAccessPoint) != pointer.StringValue(observed.SupportingAccessPoint) ||
+		len(desired.TransformationConfigurations) != len(observed.TransformationConfigurations) {
+		return false
+	}
+	for i, d := range desired.TransformationConfigurations {
+		o := observed.TransformationConfigurations[i]
+		if o == nil || o.ContentTransformation == nil || o.ContentTransformation.AwsLambda == nil {
+			return false
+		}
+		if !sets.New(d.Actions...).Equal(sets.New(pointer.SlicePtrToValue(o.Actions)...)) ||
+			pointer.StringValue(d.ContentTransformation.AWSLambda.FunctionARN) != pointer.StringValue(o.ContentTransformation.AwsLambda.FunctionArn) ||
+			pointer.StringValue(d.ContentTransformation.AWSLambda.FunctionPayload) != pointer.StringValue(o.ContentTransformation.AwsLambda.FunctionPayload) {
+			return false
+		}
+	}
+	return true
+}
@@ -30,6 +30,10 @@ type MockSecretsManagerClient struct {
 	MockDescribeSecretWithContext    func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error)
 	MockGetSecretValueWithContext    func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)
 	MockGetResourcePolicyWithContext func(*secretsmanager.GetResourcePolicyInput) (*secretsmanager.GetResourcePolicyOutput, error)
+	MockCreateSecretWithContext      func(*secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error)
+	MockPutSecretValueWithContext    func(*secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error)
+	MockTagResourceWithContext       func(*secretsmanager.TagResourceInput) (*secretsmanager.TagResourceOutput, error)
+	MockDeleteSecretWithContext      func(*secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error)
 }
 
 // DescribeSecretWithContext calls c.MockDescribeSecretWithContext
@@ -46,3 +50,23 @@ func (c *MockSecretsManagerClient) GetSecretValueWithContext(_ aws.Context, in *
 func (c *MockSecretsManagerClient) GetResourcePolicyWithContext(_ aws.Context, in *secretsmanager.GetResourcePolicyInput, _ ...request.Option) (*secretsmanager.GetResourcePolicyOutput, error) {
 	return c.MockGetResourcePolicyWithContext(in)
 }
+
+// CreateSecretWithContext calls c.MockCreateSecretWithContext
+func (c *MockSecretsManagerClient) CreateSecretWithContext(_ aws.Context, in *secretsmanager.CreateSecretInput, _ ...request.Option) (*secretsmanager.CreateSecretOutput, error) {
+	return c.MockCreateSecretWithContext(in)
+}
+
+// PutSecretValueWithContext calls c.MockPutSecretValueWithContext
+func (c *MockSecretsManagerClient) PutSecretValueWithContext(_ aws.Context, in *secretsmanager.PutSecretValueInput, _ ...request.Option) (*secretsmanager.PutSecretValueOutput, error) {
+	return c.MockPutSecretValueWithContext(in)
+}
+
+// TagResourceWithContext calls c.MockTagResourceWithContext
+func (c *MockSecretsManagerClient) TagResourceWithContext(_ aws.Context, in *secretsmanager.TagResourceInput, _ ...request.Option) (*secretsmanager.TagResourceOutput, error) {
+	return c.MockTagResourceWithContext(in)
+}
+
+// DeleteSecretWithContext calls c.MockDeleteSecretWithContext
+func (c *MockSecretsManagerClient) DeleteSecretWithContext(_ aws.Context, in *secretsmanager.DeleteSecretInput, _ ...request.Option) (*secretsmanager.DeleteSecretOutput, error) {
+	return c.MockDeleteSecretWithContext(in)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+)
+
+// MockSSMClient mocks the ssmiface.SSMAPI.
+type MockSSMClient struct {
+	ssmiface.SSMAPI
+
+	MockGetParameterWithContext        func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error)
+	MockPutParameterWithContext        func(*ssm.PutParameterInput) (*ssm.PutParameterOutput, error)
+	MockDeleteParameterWithContext     func(*ssm.DeleteParameterInput) (*ssm.DeleteParameterOutput, error)
+	MockListTagsForResourceWithContext func(*ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error)
+	MockAddTagsToResourceWithContext   func(*ssm.AddTagsToResourceInput) (*ssm.AddTagsToResourceOutput, error)
+}
+
+// GetParameterWithContext calls c.MockGetParameterWithContext
+func (c *MockSSMClient) GetParameterWithContext(_ aws.Context, in *ssm.GetParameterInput, _ ...request.Option) (*ssm.GetParameterOutput, error) {
+	return c.MockGetParameterWithContext(in)
+}
+
+// PutParameterWithContext calls c.MockPutParameterWithContext
+func (c *MockSSMClient) PutParameterWithContext(_ aws.Context, in *ssm.PutParameterInput, _ ...request.Option) (*ssm.PutParameterOutput, error) {
+	return c.MockPutParameterWithContext(in)
+}
+
+// DeleteParameterWithContext calls c.MockDeleteParameterWithContext
+func (c *MockSSMClient) DeleteParameterWithContext(_ aws.Context, in *ssm.DeleteParameterInput, _ ...request.Option) (*ssm.DeleteParameterOutput, error) {
+	return c.MockDeleteParameterWithContext(in)
+}
+
+// ListTagsForResourceWithContext calls c.MockListTagsForResourceWithContext
+func (c *MockSSMClient) ListTagsForResourceWithContext(_ aws.Context, in *ssm.ListTagsForResourceInput, _ ...request.Option) (*ssm.ListTagsForResourceOutput, error) {
+	return c.MockListTagsForResourceWithContext(in)
+}
+
+// AddTagsToResourceWithContext calls c.MockAddTagsToResourceWithContext
+func (c *MockSSMClient) AddTagsToResourceWithContext(_ aws.Context, in *ssm.AddTagsToResourceInput, _ ...request.Option) (*ssm.AddTagsToResourceOutput, error) {
+	return c.MockAddTagsToResourceWithContext(in)
+}
@@ -23,7 +23,6 @@ import (
 	awsacm "github.com/aws/aws-sdk-go-v2/service/acm"
 	awsacmtypes "github.com/aws/aws-sdk-go-v2/service/acm/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -31,7 +30,9 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/acm/v1beta1"
@@ -39,7 +40,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acm"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -74,7 +77,7 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -85,11 +88,16 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.CertificateGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.Certificate{}, &v1beta1.CertificateList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.Certificate{}).
+		For(&v1beta1.Certificate{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -13,11 +13,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/apigateway/v1alpha1"
 	apigwclient "github.com/crossplane-contrib/provider-aws/pkg/clients/apigateway"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -46,6 +50,7 @@ func SetupMethod(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -56,11 +61,16 @@ func SetupMethod(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(svcapitypes.MethodGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.Method{}, &svcapitypes.MethodList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&svcapitypes.Method{}).
+		For(&svcapitypes.Method{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a DHCPOptions resource"
+
+	errDescribe      = "failed to describe DHCPOptions"
+	errMultipleItems = "retrieved multiple DHCPOptions for the given dhcpOptionsId"
+	errCreate        = "failed to create the DHCPOptions resource"
+	errDelete        = "failed to delete the DHCPOptions resource"
+	errDescribeVPCs  = "failed to describe the VPCs associated with the DHCPOptions resource"
+	errDisassociate  = "failed to associate a VPC with the default DHCP options set"
+	errCreateTags    = "failed to create tags for the DHCPOptions resource"
+	errDeleteTags    = "failed to delete tags for the DHCPOptions resource"
+)
+
+// SetupDHCPOptions adds a controller that reconciles DHCPOptions.
+func SetupDHCPOptions(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.DHCPOptionsGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewDHCPOptionsClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.DHCPOptionsGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.DHCPOptions{}, &manualv1alpha1.DHCPOptionsList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.DHCPOptions{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.DHCPOptionsClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.DHCPOptionsClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	res, err := e.client.DescribeDhcpOptions(ctx, &awsec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(res.DhcpOptions) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := res.DhcpOptions[0]
+	cr.Status.AtProvider = ec2.GenerateDHCPOptionsObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsDHCPOptionsUpToDate(cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.CreateDhcpOptions(ctx, ec2.GenerateCreateDHCPOptionsInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(res.DhcpOptions.DhcpOptionsId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.DescribeDhcpOptions(ctx, &awsec2.DescribeDhcpOptionsInput{
+		DhcpOptionsIds: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+	if len(res.DhcpOptions) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), res.DhcpOptions[0].Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.DHCPOptions)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// A DHCP options set cannot be deleted while it is associated with a
+	// VPC, so those VPCs fall back to the default options set first.
+	vpcs, err := ec2.GetVPCsByDHCPOptions(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errorutils.Wrap(err, errDescribeVPCs)
+	}
+	for _, id := range vpcs {
+		_, err := e.client.AssociateDhcpOptions(ctx, &awsec2.AssociateDhcpOptionsInput{
+			DhcpOptionsId: aws.String(ec2.DefaultDHCPOptionsID),
+			VpcId:         aws.String(id),
+		})
+		if ec2.IsVPCNotFoundErr(err) {
+			continue
+		}
+		if err != nil {
+			return managed.ExternalDelete{}, errorutils.Wrap(err, errDisassociate)
+		}
+	}
+
+	_, err = e.client.DeleteDhcpOptions(ctx, &awsec2.DeleteDhcpOptionsInput{
+		DhcpOptionsId: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsDHCPOptionsNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,427 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	optionsID  = "dopt-123"
+	vpcID      = "vpc-123"
+	domainName = "corp.example.com"
+	ownerID    = "123456789012"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.DHCPOptionsClient
+	cr     *manualv1alpha1.DHCPOptions
+}
+
+type dhcpOptionsModifier func(*manualv1alpha1.DHCPOptions)
+
+func withExternalName(name string) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.DHCPOptionsParameters) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.DHCPOptionsObservation) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) dhcpOptionsModifier {
+	return func(r *manualv1alpha1.DHCPOptions) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func dhcpOptions(m ...dhcpOptionsModifier) *manualv1alpha1.DHCPOptions {
+	cr := &manualv1alpha1.DHCPOptions{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.DHCPOptions
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return &awsec2.DescribeDhcpOptionsOutput{DhcpOptions: []awsec2types.DhcpOptions{{
+							DhcpOptionsId: aws.String(optionsID),
+							OwnerId:       aws.String(ownerID),
+						}}}, nil
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID),
+					withStatus(manualv1alpha1.DHCPOptionsObservation{DHCPOptionsID: optionsID, OwnerID: ownerID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return &awsec2.DescribeDhcpOptionsOutput{DhcpOptions: []awsec2types.DhcpOptions{{
+							DhcpOptionsId: aws.String(optionsID),
+						}}}, nil
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID),
+					withSpec(manualv1alpha1.DHCPOptionsParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}})),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID),
+					withSpec(manualv1alpha1.DHCPOptionsParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}}),
+					withStatus(manualv1alpha1.DHCPOptionsObservation{DHCPOptionsID: optionsID}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{},
+				cr:     dhcpOptions(),
+			},
+			want: want{
+				cr: dhcpOptions(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.DHCPOptionsIDNotFound}
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(optionsID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.DHCPOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.CreateDhcpOptionsOutput, error) {
+						if diff := cmp.Diff([]awsec2types.NewDhcpConfiguration{
+							{Key: aws.String("domain-name"), Values: []string{domainName}},
+							{Key: aws.String("domain-name-servers"), Values: []string{"10.0.0.2", "10.0.0.3"}},
+							{Key: aws.String("netbios-node-type"), Values: []string{"2"}},
+						}, input.DhcpConfigurations, cmp.AllowUnexported(awsec2types.NewDhcpConfiguration{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateDhcpOptionsOutput{DhcpOptions: &awsec2types.DhcpOptions{
+							DhcpOptionsId: aws.String(optionsID),
+						}}, nil
+					},
+				},
+				cr: dhcpOptions(withSpec(manualv1alpha1.DHCPOptionsParameters{
+					DomainName:        aws.String(domainName),
+					DomainNameServers: []string{"10.0.0.2", "10.0.0.3"},
+					NetBIOSNodeType:   aws.String("2"),
+				})),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID), withSpec(manualv1alpha1.DHCPOptionsParameters{
+					DomainName:        aws.String(domainName),
+					DomainNameServers: []string{"10.0.0.2", "10.0.0.3"},
+					NetBIOSNodeType:   aws.String("2"),
+				})),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.CreateDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(),
+			},
+			want: want{
+				cr:  dhcpOptions(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return &awsec2.DescribeDhcpOptionsOutput{DhcpOptions: []awsec2types.DhcpOptions{{
+							DhcpOptionsId: aws.String(optionsID),
+							Tags:          []awsec2types.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+						}}}, nil
+					},
+					MockDeleteTags: func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+						return &awsec2.DeleteTagsOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID),
+					withSpec(manualv1alpha1.DHCPOptionsParameters{Tags: []manualv1alpha1.Tag{{Key: "new", Value: "v"}}})),
+			},
+		},
+		"CreateTagsFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeDhcpOptionsOutput, error) {
+						return &awsec2.DescribeDhcpOptionsOutput{DhcpOptions: []awsec2types.DhcpOptions{{
+							DhcpOptionsId: aws.String(optionsID),
+						}}}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID),
+					withSpec(manualv1alpha1.DHCPOptionsParameters{Tags: []manualv1alpha1.Tag{{Key: "new", Value: "v"}}})),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.DHCPOptions
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulWithAssociatedVPC": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{VpcId: aws.String(vpcID)}}}, nil
+					},
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						if aws.ToString(input.DhcpOptionsId) != ec2.DefaultDHCPOptionsID || aws.ToString(input.VpcId) != vpcID {
+							t.Errorf("unexpected association of %s with %s", aws.ToString(input.VpcId), aws.ToString(input.DhcpOptionsId))
+						}
+						return &awsec2.AssociateDhcpOptionsOutput{}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error) {
+						return &awsec2.DeleteDhcpOptionsOutput{}, nil
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AssociatedVPCDeleted": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{VpcId: aws.String(vpcID)}}}, nil
+					},
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPCIDNotFound}
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(optionsID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteDhcpOptionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.DHCPOptionsIDNotFound}
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr: dhcpOptions(withExternalName(optionsID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AssociateFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{VpcId: aws.String(vpcID)}}}, nil
+					},
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: dhcpOptions(withExternalName(optionsID)),
+			},
+			want: want{
+				cr:  dhcpOptions(withExternalName(optionsID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDisassociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
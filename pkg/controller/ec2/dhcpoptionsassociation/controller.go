@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptionsassociation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a DHCPOptionsAssociation resource"
+
+	errDescribe      = "failed to describe the VPC of the DHCPOptionsAssociation"
+	errMultipleItems = "retrieved multiple VPCs for the given vpcId"
+	errAssociate     = "failed to associate the DHCP options set with the VPC"
+	errDisassociate  = "failed to associate the VPC with the default DHCP options set"
+)
+
+// SetupDHCPOptionsAssociation adds a controller that reconciles
+// DHCPOptionsAssociations.
+func SetupDHCPOptionsAssociation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.DHCPOptionsAssociationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewDHCPOptionsAssociationClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.DHCPOptionsAssociationGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.DHCPOptionsAssociation{}, &svcapitypes.DHCPOptionsAssociationList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.DHCPOptionsAssociation{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.DHCPOptionsAssociationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.DHCPOptionsAssociation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.DHCPOptionsAssociationClient
+}
+
+// getDHCPOptionsID returns the ID of the DHCP options set the VPC is
+// currently associated with.
+func (e *external) getDHCPOptionsID(ctx context.Context, vpcID string) (string, error) {
+	res, err := e.client.DescribeVpcs(ctx, &awsec2.DescribeVpcsInput{
+		VpcIds: []string{vpcID},
+	})
+	if err != nil {
+		return "", err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.Vpcs) != 1 {
+		return "", errors.New(errMultipleItems)
+	}
+	return aws.ToString(res.Vpcs[0].DhcpOptionsId), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*svcapitypes.DHCPOptionsAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current, err := e.getDHCPOptionsID(ctx, aws.ToString(cr.Spec.ForProvider.VPCID))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsVPCNotFoundErr, err), errDescribe)
+	}
+	upToDate := current == aws.ToString(cr.Spec.ForProvider.DHCPOptionsID)
+
+	// The association is gone once the VPC fell back to any other options
+	// set during deletion.
+	if meta.WasDeleted(cr) && !upToDate {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*svcapitypes.DHCPOptionsAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if err := e.associate(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.VPCID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*svcapitypes.DHCPOptionsAssociation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalUpdate{}, e.associate(ctx, cr)
+}
+
+func (e *external) associate(ctx context.Context, cr *svcapitypes.DHCPOptionsAssociation) error {
+	_, err := e.client.AssociateDhcpOptions(ctx, &awsec2.AssociateDhcpOptionsInput{
+		DhcpOptionsId: cr.Spec.ForProvider.DHCPOptionsID,
+		VpcId:         cr.Spec.ForProvider.VPCID,
+	})
+	return errorutils.Wrap(err, errAssociate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*svcapitypes.DHCPOptionsAssociation)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.AssociateDhcpOptions(ctx, &awsec2.AssociateDhcpOptionsInput{
+		DhcpOptionsId: aws.String(ec2.DefaultDHCPOptionsID),
+		VpcId:         cr.Spec.ForProvider.VPCID,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsVPCNotFoundErr, err), errDisassociate)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dhcpoptionsassociation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	optionsID = "dopt-123"
+	vpcID     = "vpc-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.DHCPOptionsAssociationClient
+	cr     *svcapitypes.DHCPOptionsAssociation
+}
+
+type associationModifier func(*svcapitypes.DHCPOptionsAssociation)
+
+func withExternalName(name string) associationModifier {
+	return func(r *svcapitypes.DHCPOptionsAssociation) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *svcapitypes.DHCPOptionsAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionTimestamp(t metav1.Time) associationModifier {
+	return func(r *svcapitypes.DHCPOptionsAssociation) { r.SetDeletionTimestamp(&t) }
+}
+
+func association(m ...associationModifier) *svcapitypes.DHCPOptionsAssociation {
+	cr := &svcapitypes.DHCPOptionsAssociation{
+		Spec: svcapitypes.DHCPOptionsAssociationSpec{
+			ForProvider: svcapitypes.DHCPOptionsAssociationParameters{
+				VPCID:         aws.String(vpcID),
+				DHCPOptionsID: aws.String(optionsID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVpcs(dhcpOptionsID string) func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+		return &awsec2.DescribeVpcsOutput{Vpcs: []awsec2types.Vpc{{
+			VpcId:         aws.String(vpcID),
+			DhcpOptionsId: aws.String(dhcpOptionsID),
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	now := metav1.NewTime(time.Now())
+
+	type want struct {
+		cr     *svcapitypes.DHCPOptionsAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Associated": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{MockDescribeVpcs: describeVpcs(optionsID)},
+				cr:     association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AssociatedWithOtherOptions": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{MockDescribeVpcs: describeVpcs(ec2.DefaultDHCPOptionsID)},
+				cr:     association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"DeletedAndDisassociated": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{MockDescribeVpcs: describeVpcs(ec2.DefaultDHCPOptionsID)},
+				cr:     association(withExternalName(vpcID), withDeletionTimestamp(now)),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID), withDeletionTimestamp(now)),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{},
+				cr:     association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"VPCNotFound": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPCIDNotFound}
+					},
+				},
+				cr: association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockDescribeVpcs: func(ctx context.Context, input *awsec2.DescribeVpcsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr:  association(withExternalName(vpcID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *svcapitypes.DHCPOptionsAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						if aws.ToString(input.DhcpOptionsId) != optionsID || aws.ToString(input.VpcId) != vpcID {
+							t.Errorf("unexpected association of %s with %s", aws.ToString(input.VpcId), aws.ToString(input.DhcpOptionsId))
+						}
+						return &awsec2.AssociateDhcpOptionsOutput{}, nil
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID)),
+			},
+		},
+		"AssociateFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: errorutils.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *svcapitypes.DHCPOptionsAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						if aws.ToString(input.DhcpOptionsId) != ec2.DefaultDHCPOptionsID {
+							t.Errorf("expected the VPC to fall back to the default options, got %s", aws.ToString(input.DhcpOptionsId))
+						}
+						return &awsec2.AssociateDhcpOptionsOutput{}, nil
+					},
+				},
+				cr: association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"VPCNotFound": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPCIDNotFound}
+					},
+				},
+				cr: association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr: association(withExternalName(vpcID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AssociateFail": {
+			args: args{
+				client: &fake.MockDHCPOptionsClient{
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateDhcpOptionsInput, opts []func(*awsec2.Options)) (*awsec2.AssociateDhcpOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withExternalName(vpcID)),
+			},
+			want: want{
+				cr:  association(withExternalName(vpcID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDisassociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ebsvolumeattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an EBSVolumeAttachment resource"
+
+	errDescribe      = "failed to describe the volume of the EBSVolumeAttachment"
+	errMultipleItems = "retrieved multiple Volumes for the given volumeId"
+	errAttach        = "failed to attach the volume"
+	errDetach        = "failed to detach the volume"
+)
+
+// SetupEBSVolumeAttachment adds a controller that reconciles EBSVolumeAttachments.
+func SetupEBSVolumeAttachment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.EBSVolumeAttachmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewEBSVolumeAttachmentClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.EBSVolumeAttachmentGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.EBSVolumeAttachment{}, &manualv1alpha1.EBSVolumeAttachmentList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.EBSVolumeAttachment{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the references to the instance and the volume.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.EBSVolumeAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	r := reference.NewAPIResolver(c, cr)
+
+	rsp, err := r.Resolve(ctx, ec2.InstanceIDReference(cr.Spec.ForProvider.InstanceID, cr.Spec.ForProvider.InstanceIDRef, cr.Spec.ForProvider.InstanceIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instanceId")
+	}
+	cr.Spec.ForProvider.InstanceID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.InstanceIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, ec2.VolumeIDReference(cr.Spec.ForProvider.VolumeID, cr.Spec.ForProvider.VolumeIDRef, cr.Spec.ForProvider.VolumeIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.volumeId")
+	}
+	cr.Spec.ForProvider.VolumeID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.VolumeIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.EBSVolumeAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.EBSVolumeAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.EBSVolumeAttachmentClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.EBSVolumeAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	res, err := e.client.DescribeVolumes(ctx, &awsec2.DescribeVolumesInput{
+		VolumeIds: []string{aws.ToString(cr.Spec.ForProvider.VolumeID)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(res.Volumes) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	attachment := ec2.FindVolumeAttachment(res.Volumes[0], aws.ToString(cr.Spec.ForProvider.InstanceID))
+	if attachment == nil || attachment.State == awsec2types.VolumeAttachmentStateDetached {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateEBSVolumeAttachmentObservation(*attachment)
+
+	switch attachment.State { //nolint:exhaustive
+	case awsec2types.VolumeAttachmentStateAttaching:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.VolumeAttachmentStateDetaching:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// All parameters of an attachment are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.EBSVolumeAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if _, err := e.client.AttachVolume(ctx, &awsec2.AttachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		VolumeId:   cr.Spec.ForProvider.VolumeID,
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errAttach)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.VolumeID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.EBSVolumeAttachment)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The volume is already being detached.
+	if cr.Status.AtProvider.State == string(awsec2types.VolumeAttachmentStateDetaching) {
+		return managed.ExternalDelete{}, nil
+	}
+
+	_, err := e.client.DetachVolume(ctx, &awsec2.DetachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		VolumeId:   cr.Spec.ForProvider.VolumeID,
+		Force:      cr.Spec.ForProvider.ForceDetach,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDetach)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
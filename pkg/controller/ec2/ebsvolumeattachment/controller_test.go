@@ -0,0 +1,326 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ebsvolumeattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	volumeID   = "vol-123"
+	instanceID = "i-123"
+	device     = "/dev/sdh"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.EBSVolumeAttachmentClient
+	cr     *manualv1alpha1.EBSVolumeAttachment
+}
+
+type attachmentModifier func(*manualv1alpha1.EBSVolumeAttachment)
+
+func withExternalName(name string) attachmentModifier {
+	return func(r *manualv1alpha1.EBSVolumeAttachment) { meta.SetExternalName(r, name) }
+}
+
+func withStatus(s manualv1alpha1.EBSVolumeAttachmentObservation) attachmentModifier {
+	return func(r *manualv1alpha1.EBSVolumeAttachment) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *manualv1alpha1.EBSVolumeAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func attachment(m ...attachmentModifier) *manualv1alpha1.EBSVolumeAttachment {
+	cr := &manualv1alpha1.EBSVolumeAttachment{
+		Spec: manualv1alpha1.EBSVolumeAttachmentSpec{
+			ForProvider: manualv1alpha1.EBSVolumeAttachmentParameters{
+				Device:     device,
+				InstanceID: aws.String(instanceID),
+				VolumeID:   aws.String(volumeID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeVolumes(attachments ...awsec2types.VolumeAttachment) func(ctx context.Context, input *awsec2.DescribeVolumesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeVolumesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+		return &awsec2.DescribeVolumesOutput{Volumes: []awsec2types.Volume{{
+			VolumeId:    aws.String(volumeID),
+			Attachments: attachments,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.EBSVolumeAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Attached": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDescribe: describeVolumes(awsec2types.VolumeAttachment{
+						InstanceId: aws.String(instanceID),
+						Device:     aws.String(device),
+						State:      awsec2types.VolumeAttachmentStateAttached,
+					}),
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID),
+					withStatus(manualv1alpha1.EBSVolumeAttachmentObservation{State: "attached"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Attaching": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDescribe: describeVolumes(awsec2types.VolumeAttachment{
+						InstanceId: aws.String(instanceID),
+						State:      awsec2types.VolumeAttachmentStateAttaching,
+					}),
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID),
+					withStatus(manualv1alpha1.EBSVolumeAttachmentObservation{State: "attaching"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AttachedToOtherInstance": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDescribe: describeVolumes(awsec2types.VolumeAttachment{
+						InstanceId: aws.String("i-other"),
+						State:      awsec2types.VolumeAttachmentStateAttached,
+					}),
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID)),
+			},
+		},
+		"VolumeNotFound": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVolumesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VolumeIDNotFound}
+					},
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVolumesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(volumeID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.EBSVolumeAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockAttach: func(ctx context.Context, input *awsec2.AttachVolumeInput, opts []func(*awsec2.Options)) (*awsec2.AttachVolumeOutput, error) {
+						return &awsec2.AttachVolumeOutput{}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID)),
+			},
+		},
+		"AttachFail": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockAttach: func(ctx context.Context, input *awsec2.AttachVolumeInput, opts []func(*awsec2.Options)) (*awsec2.AttachVolumeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: errorutils.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.EBSVolumeAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDetach: func(ctx context.Context, input *awsec2.DetachVolumeInput, opts []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+						return &awsec2.DetachVolumeOutput{}, nil
+					},
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDetaching": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{},
+				cr: attachment(withExternalName(volumeID),
+					withStatus(manualv1alpha1.EBSVolumeAttachmentObservation{State: "detaching"})),
+			},
+			want: want{
+				cr: attachment(withExternalName(volumeID),
+					withStatus(manualv1alpha1.EBSVolumeAttachmentObservation{State: "detaching"}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DetachFail": {
+			args: args{
+				client: &fake.MockEBSVolumeAttachmentClient{
+					MockDetach: func(ctx context.Context, input *awsec2.DetachVolumeInput, opts []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withExternalName(volumeID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(volumeID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Image resource"
+
+	errDescribe               = "failed to describe Image"
+	errDescribeAttribute      = "failed to describe the launch permissions of the Image"
+	errMultipleItems          = "retrieved multiple Images for the given imageId"
+	errNoSource               = "either instanceId or snapshotId has to be set"
+	errCreate                 = "failed to create the Image resource"
+	errRegister               = "failed to register the Image resource"
+	errModifyLaunchPermission = "failed to modify the launch permissions of the Image"
+	errDeregister             = "failed to deregister the Image resource"
+	errCreateTags             = "failed to create tags for the Image resource"
+	errDeleteTags             = "failed to delete tags for the Image resource"
+)
+
+// SetupImage adds a controller that reconciles Images.
+func SetupImage(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.ImageGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewImageClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.ImageGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.Image{}, &manualv1alpha1.ImageList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.Image{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ImageClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.Image)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.ImageClient
+}
+
+// describe returns the AMI with the given ID, or nil if it does not exist.
+// ec2 keeps returning deregistered AMIs for a while.
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.Image, error) {
+	res, err := e.client.DescribeImages(ctx, &awsec2.DescribeImagesInput{
+		ImageIds: []string{id},
+	})
+	if err != nil {
+		return nil, resource.Ignore(ec2.IsImageNotFoundErr, err)
+	}
+	switch {
+	case len(res.Images) == 0:
+		return nil, nil
+	case len(res.Images) > 1:
+		return nil, errors.New(errMultipleItems)
+	case res.Images[0].State == awsec2types.ImageStateDeregistered:
+		return nil, nil
+	}
+	return &res.Images[0], nil
+}
+
+func (e *external) getLaunchPermissionAccountIDs(ctx context.Context, id string) ([]string, error) {
+	res, err := e.client.DescribeImageAttribute(ctx, &awsec2.DescribeImageAttributeInput{
+		ImageId:   aws.String(id),
+		Attribute: awsec2types.ImageAttributeNameLaunchPermission,
+	})
+	if err != nil {
+		return nil, errorutils.Wrap(err, errDescribeAttribute)
+	}
+	return ec2.GetLaunchPermissionAccountIDs(res.LaunchPermissions), nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateImageObservation(*observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.ImageStateAvailable:
+		cr.SetConditions(xpv1.Available())
+	case awsec2types.ImageStatePending:
+		// The AMI can only be modified once it is available.
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.StateMessage))
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	accounts, err := e.getLaunchPermissionAccountIDs(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ec2.CompareTagsManualV1alpha1(cr.Spec.ForProvider.Tags, observed.Tags) &&
+			ec2.DiffLaunchPermissions(cr.Spec.ForProvider.LaunchPermissionAccountIDs, accounts) == nil,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	switch {
+	case cr.Spec.ForProvider.InstanceID != nil:
+		res, err := e.client.CreateImage(ctx, ec2.GenerateCreateImageInput(cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+		}
+		meta.SetExternalName(cr, aws.ToString(res.ImageId))
+	case cr.Spec.ForProvider.SnapshotID != nil:
+		res, err := e.client.RegisterImage(ctx, ec2.GenerateRegisterImageInput(cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalCreation{}, errorutils.Wrap(err, errRegister)
+		}
+		meta.SetExternalName(cr, aws.ToString(res.ImageId))
+	default:
+		return managed.ExternalCreation{}, errors.New(errNoSource)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, errors.New(errDescribe)
+	}
+	if err := e.updateTags(ctx, id, cr.Spec.ForProvider.Tags, observed.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	accounts, err := e.getLaunchPermissionAccountIDs(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if mod := ec2.DiffLaunchPermissions(cr.Spec.ForProvider.LaunchPermissionAccountIDs, accounts); mod != nil {
+		if _, err := e.client.ModifyImageAttribute(ctx, &awsec2.ModifyImageAttributeInput{
+			ImageId:          aws.String(id),
+			LaunchPermission: mod,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyLaunchPermission)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) updateTags(ctx context.Context, id string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(desired), observed)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}); err != nil {
+			return errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}); err != nil {
+			return errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.Image)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeregisterImage(ctx, &awsec2.DeregisterImageInput{
+		ImageId: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsImageNotFoundErr, err), errDeregister)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,368 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package image
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	imageID    = "ami-123"
+	instanceID = "i-123"
+	snapshotID = "snap-123"
+	accountID  = "123456789012"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.ImageClient
+	cr     *manualv1alpha1.Image
+}
+
+type imageModifier func(*manualv1alpha1.Image)
+
+func withExternalName(name string) imageModifier {
+	return func(r *manualv1alpha1.Image) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.ImageParameters) imageModifier {
+	return func(r *manualv1alpha1.Image) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.ImageObservation) imageModifier {
+	return func(r *manualv1alpha1.Image) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) imageModifier {
+	return func(r *manualv1alpha1.Image) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func image(m ...imageModifier) *manualv1alpha1.Image {
+	cr := &manualv1alpha1.Image{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeImage(i awsec2types.Image) func(ctx context.Context, input *awsec2.DescribeImagesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeImagesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+		return &awsec2.DescribeImagesOutput{Images: []awsec2types.Image{i}}, nil
+	}
+}
+
+func describeLaunchPermissions(accounts ...string) func(ctx context.Context, input *awsec2.DescribeImageAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImageAttributeOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeImageAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImageAttributeOutput, error) {
+		out := &awsec2.DescribeImageAttributeOutput{}
+		for _, a := range accounts {
+			out.LaunchPermissions = append(out.LaunchPermissions, awsec2types.LaunchPermission{UserId: aws.String(a)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.Image
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: describeImage(awsec2types.Image{
+						ImageId: aws.String(imageID),
+						State:   awsec2types.ImageStateAvailable,
+						BlockDeviceMappings: []awsec2types.BlockDeviceMapping{{
+							Ebs: &awsec2types.EbsBlockDevice{SnapshotId: aws.String(snapshotID)},
+						}},
+					}),
+					MockDescribeAttribute: describeLaunchPermissions(accountID),
+				},
+				cr: image(withExternalName(imageID),
+					withSpec(manualv1alpha1.ImageParameters{LaunchPermissionAccountIDs: []string{accountID}})),
+			},
+			want: want{
+				cr: image(withExternalName(imageID),
+					withSpec(manualv1alpha1.ImageParameters{LaunchPermissionAccountIDs: []string{accountID}}),
+					withStatus(manualv1alpha1.ImageObservation{ImageID: imageID, State: "available", SnapshotIDs: []string{snapshotID}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LaunchPermissionsChanged": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: describeImage(awsec2types.Image{
+						ImageId: aws.String(imageID),
+						State:   awsec2types.ImageStateAvailable,
+					}),
+					MockDescribeAttribute: describeLaunchPermissions(),
+				},
+				cr: image(withExternalName(imageID),
+					withSpec(manualv1alpha1.ImageParameters{LaunchPermissionAccountIDs: []string{accountID}})),
+			},
+			want: want{
+				cr: image(withExternalName(imageID),
+					withSpec(manualv1alpha1.ImageParameters{LaunchPermissionAccountIDs: []string{accountID}}),
+					withStatus(manualv1alpha1.ImageObservation{ImageID: imageID, State: "available"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: describeImage(awsec2types.Image{
+						ImageId: aws.String(imageID),
+						State:   awsec2types.ImageStatePending,
+					}),
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID),
+					withStatus(manualv1alpha1.ImageObservation{ImageID: imageID, State: "pending"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deregistered": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: describeImage(awsec2types.Image{
+						ImageId: aws.String(imageID),
+						State:   awsec2types.ImageStateDeregistered,
+					}),
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr: image(withExternalName(imageID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeImagesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeImagesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: image(withExternalName(imageID)),
+			},
+			want: want{
+				cr:  image(withExternalName(imageID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.Image
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"FromInstance": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateImageInput, opts []func(*awsec2.Options)) (*awsec2.CreateImageOutput, error) {
+						return &awsec2.CreateImageOutput{ImageId: aws.String(imageID)}, nil
+					},
+				},
+				cr: image(withSpec(manualv1alpha1.ImageParameters{InstanceID: aws.String(instanceID)})),
+			},
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(manualv1alpha1.ImageParameters{InstanceID: aws.String(instanceID)})),
+			},
+		},
+		"FromSnapshot": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockRegister: func(ctx context.Context, input *awsec2.RegisterImageInput, opts []func(*awsec2.Options)) (*awsec2.RegisterImageOutput, error) {
+						if aws.ToString(input.BlockDeviceMappings[0].Ebs.SnapshotId) != snapshotID {
+							t.Errorf("unexpected root snapshot %s", aws.ToString(input.BlockDeviceMappings[0].Ebs.SnapshotId))
+						}
+						return &awsec2.RegisterImageOutput{ImageId: aws.String(imageID)}, nil
+					},
+				},
+				cr: image(withSpec(manualv1alpha1.ImageParameters{SnapshotID: aws.String(snapshotID)})),
+			},
+			want: want{
+				cr: image(withExternalName(imageID), withSpec(manualv1alpha1.ImageParameters{SnapshotID: aws.String(snapshotID)})),
+			},
+		},
+		"NoSource": {
+			args: args{
+				client: &fake.MockImageClient{},
+				cr:     image(),
+			},
+			want: want{
+				cr:  image(),
+				err: errors.New(errNoSource),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateImageInput, opts []func(*awsec2.Options)) (*awsec2.CreateImageOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: image(withSpec(manualv1alpha1.ImageParameters{InstanceID: aws.String(instanceID)})),
+			},
+			want: want{
+				cr:  image(withSpec(manualv1alpha1.ImageParameters{InstanceID: aws.String(instanceID)})),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ShareWithAccount": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: describeImage(awsec2types.Image{
+						ImageId: aws.String(imageID),
+						State:   awsec2types.ImageStateAvailable,
+					}),
+					MockDescribeAttribute: describeLaunchPermissions("210987654321"),
+					MockModifyAttribute: func(ctx context.Context, input *awsec2.ModifyImageAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyImageAttributeOutput, error) {
+						want := &awsec2types.LaunchPermissionModifications{
+							Add:    []awsec2types.LaunchPermission{{UserId: aws.String(accountID)}},
+							Remove: []awsec2types.LaunchPermission{{UserId: aws.String("210987654321")}},
+						}
+						if diff := cmp.Diff(want, input.LaunchPermission, cmp.AllowUnexported(awsec2types.LaunchPermissionModifications{}, awsec2types.LaunchPermission{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyImageAttributeOutput{}, nil
+					},
+				},
+				cr: image(withExternalName(imageID),
+					withSpec(manualv1alpha1.ImageParameters{LaunchPermissionAccountIDs: []string{accountID}})),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockImageClient{
+					MockDescribe: describeImage(awsec2types.Image{
+						ImageId: aws.String(imageID),
+						State:   awsec2types.ImageStateAvailable,
+					}),
+					MockDescribeAttribute: describeLaunchPermissions(),
+					MockModifyAttribute: func(ctx context.Context, input *awsec2.ModifyImageAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyImageAttributeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: image(withExternalName(imageID),
+					withSpec(manualv1alpha1.ImageParameters{LaunchPermissionAccountIDs: []string{accountID}})),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errModifyLaunchPermission),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
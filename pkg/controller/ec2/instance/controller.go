@@ -23,7 +23,6 @@ import (
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -32,8 +31,9 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
-	"k8s.io/utils/ptr"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
@@ -41,7 +41,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -57,6 +59,13 @@ const (
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errStop                     = "failed to stop the Instance resource"
+	errStart                    = "failed to start the Instance resource"
+	errStopNotAllowed           = "the Instance must be stopped to apply the update, set allowStopForUpdate to let the controller stop it"
+	errHibernationNotConfigured = "the Instance cannot be hibernated, it is not enabled for hibernation in hibernationOptions"
+
+	msgStoppedForUpdate = "Instance is stopped to apply an update"
+	msgStopping         = "Instance is stopping"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -77,7 +86,7 @@ func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -88,11 +97,16 @@ func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(svcapitypes.InstanceGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.Instance{}, &svcapitypes.InstanceList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&svcapitypes.Instance{}).
+		For(&svcapitypes.Instance{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -149,14 +163,20 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	observation := ec2.GenerateInstanceObservation(observed, &o)
 	condition := ec2.GenerateInstanceCondition(observation)
 
-	switch condition {
-	case ec2.Creating:
+	switch {
+	case isStoppedForUpdate(cr) && condition != ec2.Deleted:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgStoppedForUpdate))
+	case ec2.IsInstanceStopDesired(cr.Spec.ForProvider) && observation.State == string(types.InstanceStateNameStopped):
+		cr.SetConditions(xpv1.Available())
+	case ec2.IsInstanceStopDesired(cr.Spec.ForProvider) && observation.State == string(types.InstanceStateNameStopping):
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgStopping))
+	case condition == ec2.Creating:
 		cr.SetConditions(xpv1.Creating())
-	case ec2.Available:
+	case condition == ec2.Available:
 		cr.SetConditions(xpv1.Available())
-	case ec2.Deleting:
+	case condition == ec2.Deleting:
 		cr.SetConditions(xpv1.Deleting())
-	case ec2.Deleted:
+	case condition == ec2.Deleted:
 		// Terminated instances remain visible on API calls for a time before
 		// being automatically deleted. Rather than having the delete command
 		// hang for that entire time, return an empty ExternalObservation in
@@ -170,7 +190,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o),
+		ResourceUpToDate:        ec2.IsInstanceUpToDate(cr.Spec.ForProvider, observed, o) && !isStoppedForUpdate(cr) && ec2.IsInstanceInDesiredState(cr.Spec.ForProvider, observation.State),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
@@ -279,88 +299,120 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	return managed.ExternalCreation{}, nil
 }
 
-func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { //nolint:gocyclo
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
 	cr, ok := mgd.(*svcapitypes.Instance)
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	if !ptr.Equal(cr.Spec.ForProvider.DisableAPITermination, cr.Status.AtProvider.DisableAPITermination) {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			DisableApiTermination: &types.AttributeBooleanValue{
-				Value: cr.Spec.ForProvider.DisableAPITermination,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
-
-		if err != nil {
+	for _, in := range ec2.GenerateModifyInstanceAttributeInputs(meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider) {
+		if _, err := e.client.ModifyInstanceAttribute(ctx, in); err != nil {
 			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyInstanceAttributes)
 		}
 	}
 
-	if cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior != pointer.StringValue(cr.Status.AtProvider.InstanceInitiatedShutdownBehavior) {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			InstanceInitiatedShutdownBehavior: &types.AttributeValue{
-				Value: aws.String(cr.Spec.ForProvider.InstanceInitiatedShutdownBehavior),
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
-
-		if err != nil {
-			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyInstanceAttributes)
-		}
+	if err := e.updateState(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
 	}
 
-	if !ptr.Equal(cr.Spec.ForProvider.KernelID, cr.Status.AtProvider.KernelID) {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Kernel: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.KernelID,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags),
+	})
 
-		if err != nil {
-			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyInstanceAttributes)
-		}
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+}
+
+// updateState brings the instance into its desired power state and applies the
+// changes to attributes that can only be modified while it is stopped.
+func (e *external) updateState(ctx context.Context, cr *svcapitypes.Instance) error {
+	state := types.InstanceStateName(cr.Status.AtProvider.State)
+	if ec2.IsInstanceStopDesired(cr.Spec.ForProvider) && state == types.InstanceStateNameRunning {
+		// Pending changes are applied once the instance is stopped.
+		return e.stop(ctx, cr)
 	}
 
-	if !ptr.Equal(cr.Spec.ForProvider.RAMDiskID, cr.Status.AtProvider.RAMDiskID) {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			Ramdisk: &types.AttributeValue{
-				Value: cr.Spec.ForProvider.RAMDiskID,
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	// An instance that was stopped for an update is started by updateStopped.
+	stoppedForUpdate := isStoppedForUpdate(cr)
+	if err := e.updateStopped(ctx, cr); err != nil {
+		return err
+	}
+	if stoppedForUpdate || state != types.InstanceStateNameStopped ||
+		pointer.StringValue(cr.Spec.ForProvider.DesiredState) != svcapitypes.InstanceDesiredStateRunning {
+		return nil
+	}
+	_, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: []string{meta.GetExternalName(cr)}})
+	return errorutils.Wrap(err, errStart)
+}
 
-		if err != nil {
-			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyInstanceAttributes)
-		}
+// stop stops the instance, or hibernates it if that is its desired state.
+func (e *external) stop(ctx context.Context, cr *svcapitypes.Instance) error {
+	hibernate := pointer.StringValue(cr.Spec.ForProvider.DesiredState) == svcapitypes.InstanceDesiredStateHibernated
+	if hibernate && (cr.Spec.ForProvider.HibernationOptions == nil || !pointer.BoolValue(cr.Spec.ForProvider.HibernationOptions.Configured)) {
+		return errors.New(errHibernationNotConfigured)
 	}
+	_, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+		InstanceIds: []string{meta.GetExternalName(cr)},
+		Hibernate:   pointer.ToOrNilIfZeroValue(hibernate),
+	})
+	return errorutils.Wrap(err, errStop)
+}
 
-	if !ptr.Equal(cr.Spec.ForProvider.UserData, cr.Status.AtProvider.UserData) {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+// updateStopped applies the changes to attributes that can only be modified
+// while the instance is stopped. If allowed, a running instance is stopped
+// first and started again once the changes are applied, unless it should be
+// kept stopped. The instance state changes asynchronously, so each step is
+// taken in its own reconcile.
+func (e *external) updateStopped(ctx context.Context, cr *svcapitypes.Instance) error { //nolint:gocyclo
+	in, err := ec2.GenerateStoppedModifyInstanceAttributeInputs(meta.GetExternalName(cr), cr.Spec.ForProvider, cr.Status.AtProvider)
+	if err != nil {
+		return errorutils.Wrap(err, errModifyInstanceAttributes)
+	}
+	stoppedForUpdate := isStoppedForUpdate(cr)
+	state := types.InstanceStateName(cr.Status.AtProvider.State)
 
-		if err != nil {
-			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyInstanceAttributes)
+	switch {
+	case len(in) == 0 && !stoppedForUpdate:
+		return nil
+	case state == types.InstanceStateNameStopped:
+		for i := range in {
+			if _, err := e.client.ModifyInstanceAttribute(ctx, in[i]); err != nil {
+				return errorutils.Wrap(err, errModifyInstanceAttributes)
+			}
 		}
+		if !stoppedForUpdate {
+			return nil
+		}
+		if !ec2.IsInstanceStopDesired(cr.Spec.ForProvider) {
+			if _, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{InstanceIds: []string{meta.GetExternalName(cr)}}); err != nil {
+				return errorutils.Wrap(err, errStart)
+			}
+		}
+		meta.RemoveAnnotations(cr, svcapitypes.AnnotationKeyStoppedForUpdate)
+		return errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+	case state == types.InstanceStateNameRunning && len(in) == 0:
+		// The instance was started before the update was applied, and the
+		// update is no longer needed.
+		meta.RemoveAnnotations(cr, svcapitypes.AnnotationKeyStoppedForUpdate)
+		return errors.Wrap(e.kube.Update(ctx, cr), errKubeUpdateFailed)
+	case state != types.InstanceStateNameRunning:
+		// Wait for the instance to be stopped.
+		return nil
+	case !pointer.BoolValue(cr.Spec.ForProvider.AllowStopForUpdate):
+		return errors.New(errStopNotAllowed)
 	}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags),
-	})
+	// Record that the instance is stopped by us before stopping it, so that
+	// it is started again even if the update is interrupted.
+	meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyStoppedForUpdate: "true"})
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return errors.Wrap(err, errKubeUpdateFailed)
+	}
+	return e.stop(ctx, cr)
+}
 
-	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+func isStoppedForUpdate(cr *svcapitypes.Instance) bool {
+	return cr.GetAnnotations()[svcapitypes.AnnotationKeyStoppedForUpdate] == "true"
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
@@ -63,6 +63,10 @@ func withSpec(p manualv1alpha1.InstanceParameters) instanceModifier {
 	return func(r *manualv1alpha1.Instance) { r.Spec.ForProvider = p }
 }
 
+func withAnnotations(a map[string]string) instanceModifier {
+	return func(r *manualv1alpha1.Instance) { meta.AddAnnotations(r, a) }
+}
+
 func withStatus(s manualv1alpha1.InstanceObservation) instanceModifier {
 	return func(r *manualv1alpha1.Instance) { r.Status.AtProvider = s }
 }
@@ -138,6 +142,52 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"StoppedAsDesired": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				instance: &fake.MockInstanceClient{
+					MockDescribeInstances: func(ctx context.Context, input *awsec2.DescribeInstancesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstancesOutput, error) {
+						return &awsec2.DescribeInstancesOutput{
+							Reservations: []types.Reservation{{
+								Instances: []types.Instance{
+									{
+										InstanceId:   &instanceID,
+										InstanceType: types.InstanceTypeM1Small,
+										State: &types.InstanceState{
+											Name: types.InstanceStateNameStopped,
+										},
+									},
+								},
+							}},
+						}, nil
+					},
+					MockDescribeInstanceAttribute: func(ctx context.Context, input *awsec2.DescribeInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.DescribeInstanceAttributeOutput, error) {
+						return &awsec2.DescribeInstanceAttributeOutput{InstanceId: &instanceID}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped),
+				}), withExternalName(instanceID)),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceID:   &instanceID,
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        "stopped",
+				}), withExternalName(instanceID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"MultipleInstances": {
 			args: args{
 				kube: &test.MockClient{
@@ -418,6 +468,226 @@ func TestUpdate(t *testing.T) {
 				err: errorutils.Wrap(errBoom, errUpdate),
 			},
 		},
+		"StopForUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", AllowStopForUpdate: aws.Bool(true)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(instanceID),
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", AllowStopForUpdate: aws.Bool(true)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "running"}),
+				),
+			},
+		},
+		"StopNotAllowed": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large"}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large"}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "running"}),
+				),
+				err: errors.New(errStopNotAllowed),
+			},
+		},
+		"WaitForStop": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", AllowStopForUpdate: aws.Bool(true)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "stopping"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", AllowStopForUpdate: aws.Bool(true)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "stopping"}),
+				),
+			},
+		},
+		"ModifyStoppedAndStart": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if diff := cmp.Diff("m5.large", aws.ToString(input.InstanceType.Value)); diff != "" {
+							t.Errorf("ModifyInstanceAttribute: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(instanceID),
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", AllowStopForUpdate: aws.Bool(true)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "stopped"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", AllowStopForUpdate: aws.Bool(true)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "stopped"}),
+				),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopped"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopped"}),
+				),
+				err: errorutils.Wrap(errBoom, errStart),
+			},
+		},
+		"Hibernate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if !aws.ToBool(input.Hibernate) {
+							t.Error("StopInstances: expected the instance to be hibernated")
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{
+						DesiredState:       aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+						HibernationOptions: &manualv1alpha1.HibernationOptionsRequest{Configured: aws.Bool(true)},
+					}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{
+						DesiredState:       aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+						HibernationOptions: &manualv1alpha1.HibernationOptionsRequest{Configured: aws.Bool(true)},
+					}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "running"}),
+				),
+			},
+		},
+		"HibernationNotConfigured": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "running"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "running"}),
+				),
+				err: errors.New(errHibernationNotConfigured),
+			},
+		},
+		"StartStopped": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopped"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning)}),
+					withStatus(manualv1alpha1.InstanceObservation{State: "stopped"}),
+				),
+			},
+		},
+		"KeepStoppedAfterUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+				},
+				cr: instance(
+					withExternalName(instanceID),
+					withAnnotations(map[string]string{manualv1alpha1.AnnotationKeyStoppedForUpdate: "true"}),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "stopped"}),
+				),
+			},
+			want: want{
+				cr: instance(
+					withExternalName(instanceID),
+					withSpec(manualv1alpha1.InstanceParameters{InstanceType: "m5.large", DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateStopped)}),
+					withStatus(manualv1alpha1.InstanceObservation{InstanceType: "t3.micro", State: "stopped"}),
+				),
+			},
+		},
 	}
 
 	for name, tc := range cases {
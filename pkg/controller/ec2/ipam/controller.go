@@ -0,0 +1,261 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an IPAM resource"
+
+	errDescribe      = "failed to describe IPAM"
+	errMultipleItems = "retrieved multiple IPAMs for the given ipamId"
+	errCreate        = "failed to create the IPAM resource"
+	errModify        = "failed to modify the IPAM resource"
+	errDelete        = "failed to delete the IPAM resource"
+	errCreateTags    = "failed to create tags for the IPAM resource"
+	errDeleteTags    = "failed to delete tags for the IPAM resource"
+)
+
+// SetupIPAM adds a controller that reconciles IPAMs.
+func SetupIPAM(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.IPAMGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewIPAMClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.IPAMGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.IPAM{}, &manualv1alpha1.IPAMList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.IPAM{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.IPAMClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.IPAM)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.IPAMClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.Ipam, error) {
+	res, err := e.client.DescribeIpams(ctx, &awsec2.DescribeIpamsInput{
+		IpamIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.Ipams) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.Ipams[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsIPAMNotFoundErr, err), errDescribe)
+	}
+
+	if ec2.IsIPAMStateGone(string(observed.State)) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeIPAM(&cr.Spec.ForProvider, observed)
+
+	cr.Status.AtProvider = ec2.GenerateIPAMObservation(*observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.IpamStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		}, nil
+	case awsec2types.IpamStateCreateComplete,
+		awsec2types.IpamStateModifyInProgress,
+		awsec2types.IpamStateModifyComplete:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsIPAMUpToDate(cr.Spec.ForProvider, *observed) && ec2.CompareTagsManualV1alpha1(cr.Spec.ForProvider.Tags, observed.Tags),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.CreateIpam(ctx, ec2.GenerateCreateIpamInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(res.Ipam.IpamId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	if !ec2.IsIPAMUpToDate(cr.Spec.ForProvider, *observed) {
+		add, remove := ec2.DiffIPAMOperatingRegions(cr.Spec.ForProvider.OperatingRegions, observed.OperatingRegions)
+		if _, err := e.client.ModifyIpam(ctx, &awsec2.ModifyIpamInput{
+			IpamId:                 aws.String(meta.GetExternalName(cr)),
+			Description:            cr.Spec.ForProvider.Description,
+			Tier:                   awsec2types.IpamTier(aws.ToString(cr.Spec.ForProvider.Tier)),
+			AddOperatingRegions:    add,
+			RemoveOperatingRegions: remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModify)
+		}
+	}
+
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteIpam(ctx, &awsec2.DeleteIpamInput{
+		IpamId:  aws.String(meta.GetExternalName(cr)),
+		Cascade: cr.Spec.ForProvider.Cascade,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsIPAMNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
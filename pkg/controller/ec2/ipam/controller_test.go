@@ -0,0 +1,428 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	ipamID      = "ipam-123"
+	region      = "us-east-1"
+	description = "ipam"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMClient
+	cr     *manualv1alpha1.IPAM
+}
+
+type ipamModifier func(*manualv1alpha1.IPAM)
+
+func withExternalName(name string) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.IPAMParameters) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.IPAMObservation) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) ipamModifier {
+	return func(r *manualv1alpha1.IPAM) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func ipam(m ...ipamModifier) *manualv1alpha1.IPAM {
+	cr := &manualv1alpha1.IPAM{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.IpamState, regions ...string) func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+		i := awsec2types.Ipam{
+			IpamId:      aws.String(ipamID),
+			Description: aws.String(description),
+			Tier:        awsec2types.IpamTierFree,
+			State:       state,
+		}
+		for _, r := range regions {
+			i.OperatingRegions = append(i.OperatingRegions, awsec2types.IpamOperatingRegion{RegionName: aws.String(r)})
+		}
+		return &awsec2.DescribeIpamsOutput{Ipams: []awsec2types.Ipam{i}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IPAM
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: describe(awsec2types.IpamStateCreateComplete, region),
+				},
+				cr: ipam(withExternalName(ipamID), withSpec(manualv1alpha1.IPAMParameters{
+					Region:           region,
+					OperatingRegions: []string{region},
+				})),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID),
+					withSpec(manualv1alpha1.IPAMParameters{
+						Region:           region,
+						Description:      aws.String(description),
+						OperatingRegions: []string{region},
+						Tier:             aws.String("free"),
+					}),
+					withStatus(manualv1alpha1.IPAMObservation{IPAMID: ipamID, State: "create-complete"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"OperatingRegionsChanged": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: describe(awsec2types.IpamStateCreateComplete, region),
+				},
+				cr: ipam(withExternalName(ipamID), withSpec(manualv1alpha1.IPAMParameters{
+					Region:           region,
+					Description:      aws.String(description),
+					OperatingRegions: []string{region, "eu-west-1"},
+					Tier:             aws.String("free"),
+				})),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID),
+					withSpec(manualv1alpha1.IPAMParameters{
+						Region:           region,
+						Description:      aws.String(description),
+						OperatingRegions: []string{region, "eu-west-1"},
+						Tier:             aws.String("free"),
+					}),
+					withStatus(manualv1alpha1.IPAMObservation{IPAMID: ipamID, State: "create-complete"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: describe(awsec2types.IpamStateCreateInProgress),
+				},
+				cr: ipam(withExternalName(ipamID), withSpec(manualv1alpha1.IPAMParameters{
+					Region:      region,
+					Description: aws.String(description),
+					Tier:        aws.String("free"),
+				})),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID),
+					withSpec(manualv1alpha1.IPAMParameters{
+						Region:      region,
+						Description: aws.String(description),
+						Tier:        aws.String("free"),
+					}),
+					withStatus(manualv1alpha1.IPAMObservation{IPAMID: ipamID, State: "create-in-progress"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleting": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: describe(awsec2types.IpamStateDeleteInProgress),
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMIDNotFound}
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr:  ipam(withExternalName(ipamID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAM
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreateIpam: func(ctx context.Context, input *awsec2.CreateIpamInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamOutput, error) {
+						return &awsec2.CreateIpamOutput{Ipam: &awsec2types.Ipam{IpamId: aws.String(ipamID)}}, nil
+					},
+				},
+				cr: ipam(),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreateIpam: func(ctx context.Context, input *awsec2.CreateIpamInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(),
+			},
+			want: want{
+				cr:  ipam(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyOperatingRegions": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: describe(awsec2types.IpamStateCreateComplete, region, "us-west-2"),
+					MockModifyIpam: func(ctx context.Context, input *awsec2.ModifyIpamInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamOutput, error) {
+						if diff := cmp.Diff("eu-west-1", aws.ToString(input.AddOperatingRegions[0].RegionName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff("us-west-2", aws.ToString(input.RemoveOperatingRegions[0].RegionName)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyIpamOutput{}, nil
+					},
+				},
+				cr: ipam(withExternalName(ipamID), withSpec(manualv1alpha1.IPAMParameters{
+					Region:           region,
+					Description:      aws.String(description),
+					OperatingRegions: []string{region, "eu-west-1"},
+				})),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpams: describe(awsec2types.IpamStateCreateComplete, region),
+					MockModifyIpam: func(ctx context.Context, input *awsec2.ModifyIpamInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID), withSpec(manualv1alpha1.IPAMParameters{
+					Region:           region,
+					Description:      aws.String("new"),
+					OperatingRegions: []string{region},
+				})),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAM
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpam: func(ctx context.Context, input *awsec2.DeleteIpamInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+						return &awsec2.DeleteIpamOutput{}, nil
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpam: func(ctx context.Context, input *awsec2.DeleteIpamInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMIDNotFound}
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpam: func(ctx context.Context, input *awsec2.DeleteIpamInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr:  ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
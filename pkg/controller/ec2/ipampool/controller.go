@@ -0,0 +1,297 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampool
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an IPAMPool resource"
+
+	errDescribe      = "failed to describe IPAMPool"
+	errMultipleItems = "retrieved multiple IPAMPools for the given ipamPoolId"
+	errGetCIDRs      = "failed to get the CIDRs of the IPAMPool"
+	errCreate        = "failed to create the IPAMPool resource"
+	errModify        = "failed to modify the IPAMPool resource"
+	errProvision     = "failed to provision a CIDR into the IPAMPool"
+	errDeprovision   = "failed to deprovision a CIDR from the IPAMPool"
+	errDelete        = "failed to delete the IPAMPool resource"
+	errCreateTags    = "failed to create tags for the IPAMPool resource"
+	errDeleteTags    = "failed to delete tags for the IPAMPool resource"
+)
+
+// SetupIPAMPool adds a controller that reconciles IPAMPools.
+func SetupIPAMPool(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.IPAMPoolGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewIPAMPoolClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.IPAMPoolGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.IPAMPool{}, &manualv1alpha1.IPAMPoolList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.IPAMPool{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.IPAMPoolClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.IPAMPool)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.IPAMPoolClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.IpamPool, error) {
+	res, err := e.client.DescribeIpamPools(ctx, &awsec2.DescribeIpamPoolsInput{
+		IpamPoolIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.IpamPools) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.IpamPools[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errDescribe)
+	}
+
+	if ec2.IsIPAMStateGone(string(observed.State)) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeIPAMPool(&cr.Spec.ForProvider, observed)
+
+	cidrs, err := ec2.GetIPAMPoolCIDRs(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetCIDRs)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateIPAMPoolObservation(*observed, cidrs)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.IpamPoolStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		}, nil
+	case awsec2types.IpamPoolStateCreateComplete,
+		awsec2types.IpamPoolStateModifyInProgress,
+		awsec2types.IpamPoolStateModifyComplete:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        isUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed, cidrs),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+// isUpToDate returns true if the attributes, the provisioned CIDRs and the
+// tags of the pool match the parameters.
+func isUpToDate(id string, p manualv1alpha1.IPAMPoolParameters, observed awsec2types.IpamPool, cidrs []awsec2types.IpamPoolCidr) bool {
+	if ec2.GenerateModifyIpamPoolInput(id, p, observed) != nil {
+		return false
+	}
+	provision, deprovision := ec2.DiffIPAMPoolCIDRs(p.CIDRs, cidrs)
+	if len(provision) > 0 || len(deprovision) > 0 {
+		return false
+	}
+	return ec2.CompareTagsManualV1alpha1(p.Tags, observed.Tags)
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.CreateIpamPool(ctx, ec2.GenerateCreateIpamPoolInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(res.IpamPool.IpamPoolId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	if in := ec2.GenerateModifyIpamPoolInput(meta.GetExternalName(cr), cr.Spec.ForProvider, *observed); in != nil {
+		if _, err := e.client.ModifyIpamPool(ctx, in); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModify)
+		}
+	}
+
+	cidrs, err := ec2.GetIPAMPoolCIDRs(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGetCIDRs)
+	}
+	provision, deprovision := ec2.DiffIPAMPoolCIDRs(cr.Spec.ForProvider.CIDRs, cidrs)
+	for _, c := range provision {
+		if _, err := e.client.ProvisionIpamPoolCidr(ctx, &awsec2.ProvisionIpamPoolCidrInput{
+			IpamPoolId: aws.String(meta.GetExternalName(cr)),
+			Cidr:       aws.String(c),
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errProvision)
+		}
+	}
+	for _, c := range deprovision {
+		if _, err := e.client.DeprovisionIpamPoolCidr(ctx, &awsec2.DeprovisionIpamPoolCidrInput{
+			IpamPoolId: aws.String(meta.GetExternalName(cr)),
+			Cidr:       aws.String(c),
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeprovision)
+		}
+	}
+
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.IPAMPool)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteIpamPool(ctx, &awsec2.DeleteIpamPoolInput{
+		IpamPoolId: aws.String(meta.GetExternalName(cr)),
+		Cascade:    cr.Spec.ForProvider.Cascade,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
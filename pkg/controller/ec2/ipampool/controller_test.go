@@ -0,0 +1,378 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	poolID  = "ipam-pool-123"
+	scopeID = "ipam-scope-123"
+	cidr    = "10.0.0.0/8"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMPoolClient
+	cr     *manualv1alpha1.IPAMPool
+}
+
+type poolModifier func(*manualv1alpha1.IPAMPool)
+
+func withExternalName(name string) poolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { meta.SetExternalName(r, name) }
+}
+
+func withCIDRs(c ...string) poolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { r.Spec.ForProvider.CIDRs = c }
+}
+
+func withLateInit() poolModifier {
+	return func(r *manualv1alpha1.IPAMPool) {
+		r.Spec.ForProvider.Locale = aws.String("us-east-1")
+		r.Spec.ForProvider.AllocationDefaultNetmaskLength = aws.Int32(16)
+	}
+}
+
+func withStatus(s manualv1alpha1.IPAMPoolObservation) poolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) poolModifier {
+	return func(r *manualv1alpha1.IPAMPool) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func pool(m ...poolModifier) *manualv1alpha1.IPAMPool {
+	cr := &manualv1alpha1.IPAMPool{
+		Spec: manualv1alpha1.IPAMPoolSpec{
+			ForProvider: manualv1alpha1.IPAMPoolParameters{
+				IPAMScopeID:   aws.String(scopeID),
+				AddressFamily: "ipv4",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.IpamPoolState) func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+		return &awsec2.DescribeIpamPoolsOutput{IpamPools: []awsec2types.IpamPool{{
+			IpamPoolId:                     aws.String(poolID),
+			Locale:                         aws.String("us-east-1"),
+			AllocationDefaultNetmaskLength: aws.Int32(16),
+			State:                          state,
+		}}}, nil
+	}
+}
+
+func getCIDRs(cidrs ...string) func(ctx context.Context, input *awsec2.GetIpamPoolCidrsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetIpamPoolCidrsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+		out := &awsec2.GetIpamPoolCidrsOutput{}
+		for _, c := range cidrs {
+			out.IpamPoolCidrs = append(out.IpamPoolCidrs, awsec2types.IpamPoolCidr{Cidr: aws.String(c), State: awsec2types.IpamPoolCidrStateProvisioned})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IPAMPool
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamPools: describe(awsec2types.IpamPoolStateCreateComplete),
+					MockGetIpamPoolCidrs:  getCIDRs(cidr),
+				},
+				cr: pool(withExternalName(poolID), withCIDRs(cidr)),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID), withCIDRs(cidr), withLateInit(),
+					withStatus(manualv1alpha1.IPAMPoolObservation{IPAMPoolID: poolID, State: "create-complete", ProvisionedCIDRs: []string{cidr}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"CIDRNotProvisioned": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamPools: describe(awsec2types.IpamPoolStateCreateComplete),
+					MockGetIpamPoolCidrs:  getCIDRs(),
+				},
+				cr: pool(withExternalName(poolID), withCIDRs(cidr), withLateInit()),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID), withCIDRs(cidr), withLateInit(),
+					withStatus(manualv1alpha1.IPAMPoolObservation{IPAMPoolID: poolID, State: "create-complete"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamPools: func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound}
+					},
+				},
+				cr: pool(withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID)),
+			},
+		},
+		"GetCIDRsFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamPools: describe(awsec2types.IpamPoolStateCreateComplete),
+					MockGetIpamPoolCidrs: func(ctx context.Context, input *awsec2.GetIpamPoolCidrsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withExternalName(poolID), withLateInit()),
+			},
+			want: want{
+				cr:  pool(withExternalName(poolID), withLateInit()),
+				err: errorutils.Wrap(errBoom, errGetCIDRs),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAMPool
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreateIpamPool: func(ctx context.Context, input *awsec2.CreateIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamPoolOutput, error) {
+						return &awsec2.CreateIpamPoolOutput{IpamPool: &awsec2types.IpamPool{IpamPoolId: aws.String(poolID)}}, nil
+					},
+				},
+				cr: pool(),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreateIpamPool: func(ctx context.Context, input *awsec2.CreateIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamPoolOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(),
+			},
+			want: want{
+				cr:  pool(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProvisionAndDeprovision": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamPools: describe(awsec2types.IpamPoolStateCreateComplete),
+					MockGetIpamPoolCidrs:  getCIDRs("172.16.0.0/12"),
+					MockProvisionIpamPoolCidr: func(ctx context.Context, input *awsec2.ProvisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.ProvisionIpamPoolCidrOutput, error) {
+						if diff := cmp.Diff(cidr, aws.ToString(input.Cidr)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ProvisionIpamPoolCidrOutput{}, nil
+					},
+					MockDeprovisionIpamPoolCidr: func(ctx context.Context, input *awsec2.DeprovisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.DeprovisionIpamPoolCidrOutput, error) {
+						if diff := cmp.Diff("172.16.0.0/12", aws.ToString(input.Cidr)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DeprovisionIpamPoolCidrOutput{}, nil
+					},
+				},
+				cr: pool(withExternalName(poolID), withCIDRs(cidr), withLateInit()),
+			},
+		},
+		"ProvisionFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamPools: describe(awsec2types.IpamPoolStateCreateComplete),
+					MockGetIpamPoolCidrs:  getCIDRs(),
+					MockProvisionIpamPoolCidr: func(ctx context.Context, input *awsec2.ProvisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.ProvisionIpamPoolCidrOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withExternalName(poolID), withCIDRs(cidr), withLateInit()),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errProvision),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAMPool
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpamPool: func(ctx context.Context, input *awsec2.DeleteIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamPoolOutput, error) {
+						return &awsec2.DeleteIpamPoolOutput{}, nil
+					},
+				},
+				cr: pool(withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpamPool: func(ctx context.Context, input *awsec2.DeleteIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamPoolOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withExternalName(poolID)),
+			},
+			want: want{
+				cr:  pool(withExternalName(poolID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
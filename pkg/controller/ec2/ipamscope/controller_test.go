@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipamscope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	scopeID     = "ipam-scope-123"
+	ipamID      = "ipam-123"
+	description = "scope"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMScopeClient
+	cr     *manualv1alpha1.IPAMScope
+}
+
+type scopeModifier func(*manualv1alpha1.IPAMScope)
+
+func withExternalName(name string) scopeModifier {
+	return func(r *manualv1alpha1.IPAMScope) { meta.SetExternalName(r, name) }
+}
+
+func withDescription(d string) scopeModifier {
+	return func(r *manualv1alpha1.IPAMScope) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withStatus(s manualv1alpha1.IPAMScopeObservation) scopeModifier {
+	return func(r *manualv1alpha1.IPAMScope) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) scopeModifier {
+	return func(r *manualv1alpha1.IPAMScope) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func scope(m ...scopeModifier) *manualv1alpha1.IPAMScope {
+	cr := &manualv1alpha1.IPAMScope{
+		Spec: manualv1alpha1.IPAMScopeSpec{
+			ForProvider: manualv1alpha1.IPAMScopeParameters{
+				IPAMID: aws.String(ipamID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.IpamScopeState) func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+		return &awsec2.DescribeIpamScopesOutput{IpamScopes: []awsec2types.IpamScope{{
+			IpamScopeId:   aws.String(scopeID),
+			IpamScopeType: awsec2types.IpamScopeTypePrivate,
+			Description:   aws.String(description),
+			State:         state,
+		}}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.IPAMScope
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamScopes: describe(awsec2types.IpamScopeStateCreateComplete),
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withDescription(description),
+					withStatus(manualv1alpha1.IPAMScopeObservation{IPAMScopeID: scopeID, IPAMScopeType: "private", State: "create-complete"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"DescriptionChanged": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamScopes: describe(awsec2types.IpamScopeStateCreateComplete),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("new")),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withDescription("new"),
+					withStatus(manualv1alpha1.IPAMScopeObservation{IPAMScopeID: scopeID, IPAMScopeType: "private", State: "create-complete"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamScopes: func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMScopeIDNotFound}
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribeIpamScopes: func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr:  scope(withExternalName(scopeID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAMScope
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreateIpamScope: func(ctx context.Context, input *awsec2.CreateIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamScopeOutput, error) {
+						if diff := cmp.Diff(ipamID, aws.ToString(input.IpamId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateIpamScopeOutput{IpamScope: &awsec2types.IpamScope{IpamScopeId: aws.String(scopeID)}}, nil
+					},
+				},
+				cr: scope(),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreateIpamScope: func(ctx context.Context, input *awsec2.CreateIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamScopeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(),
+			},
+			want: want{
+				cr:  scope(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.IPAMScope
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpamScope: func(ctx context.Context, input *awsec2.DeleteIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamScopeOutput, error) {
+						return &awsec2.DeleteIpamScopeOutput{}, nil
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDeleteIpamScope: func(ctx context.Context, input *awsec2.DeleteIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamScopeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr:  scope(withExternalName(scopeID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keypair
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a KeyPair resource"
+
+	errDescribe          = "failed to describe KeyPair"
+	errMultipleItems     = "retrieved multiple KeyPairs for the given name"
+	errCreate            = "failed to create the KeyPair resource"
+	errImport            = "failed to import the KeyPair resource"
+	errDelete            = "failed to delete the KeyPair resource"
+	errCreateTags        = "failed to create tags for the KeyPair resource"
+	errDeleteTags        = "failed to delete tags for the KeyPair resource"
+	errGetPublicKey      = "cannot get the public key from the referenced secret"
+	errPublicKeyNotFound = "the referenced secret does not contain a public key"
+)
+
+// SetupKeyPair adds a controller that reconciles KeyPairs.
+func SetupKeyPair(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.KeyPairGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewKeyPairClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.KeyPairGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.KeyPair{}, &svcapitypes.KeyPairList{}, secretRefs)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.KeyPair{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// secretRefs returns the Kubernetes Secrets referenced by a KeyPair.
+func secretRefs(mg resource.Managed) []types.NamespacedName {
+	cr, ok := mg.(*svcapitypes.KeyPair)
+	if !ok {
+		return nil
+	}
+	return kube.SecretKeySelectorRefs(cr.Spec.ForProvider.PublicKeySecretRef)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.KeyPairClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.KeyPair)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.KeyPairClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*svcapitypes.KeyPair)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	res, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(res.KeyPairs) != 1 {
+		return managed.ExternalObservation{}, errors.New(errMultipleItems)
+	}
+
+	observed := res.KeyPairs[0]
+	cr.Status.AtProvider = ec2.GenerateKeyPairObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	// The private key is only returned once by CreateKeyPair, so it is only
+	// published on creation.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsKeyPairUpToDate(cr.Spec.ForProvider, observed),
+		ConnectionDetails: managed.ConnectionDetails{
+			ec2.KeyPairName:        []byte(aws.ToString(observed.KeyName)),
+			ec2.KeyPairFingerprint: []byte(aws.ToString(observed.KeyFingerprint)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*svcapitypes.KeyPair)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	name := meta.GetExternalName(cr)
+	publicKey, err := e.getPublicKey(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if publicKey != "" {
+		_, err := e.client.ImportKeyPair(ctx, ec2.GenerateImportKeyPairInput(name, cr.Spec.ForProvider, publicKey))
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errImport)
+	}
+
+	res, err := e.client.CreateKeyPair(ctx, ec2.GenerateCreateKeyPairInput(name, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			ec2.KeyPairPrivateKey:  []byte(aws.ToString(res.KeyMaterial)),
+			ec2.KeyPairName:        []byte(aws.ToString(res.KeyName)),
+			ec2.KeyPairFingerprint: []byte(aws.ToString(res.KeyFingerprint)),
+		},
+	}, nil
+}
+
+// getPublicKey returns the public key to import, or an empty string if AWS
+// should generate the key pair.
+func (e *external) getPublicKey(ctx context.Context, cr *svcapitypes.KeyPair) (string, error) {
+	if cr.Spec.ForProvider.PublicKeyMaterial != nil {
+		return *cr.Spec.ForProvider.PublicKeyMaterial, nil
+	}
+	ref := cr.Spec.ForProvider.PublicKeySecretRef
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetPublicKey)
+	}
+	if len(s.Data[ref.Key]) == 0 {
+		return "", errors.New(errPublicKeyNotFound)
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*svcapitypes.KeyPair)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.DescribeKeyPairs(ctx, &awsec2.DescribeKeyPairsInput{
+		KeyNames: []string{meta.GetExternalName(cr)},
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+	if len(res.KeyPairs) != 1 {
+		return managed.ExternalUpdate{}, errors.New(errMultipleItems)
+	}
+	observed := res.KeyPairs[0]
+
+	// Tags of a key pair are set by its ID rather than its name.
+	id := aws.ToString(observed.KeyPairId)
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*svcapitypes.KeyPair)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteKeyPair(ctx, &awsec2.DeleteKeyPairInput{
+		KeyName: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsKeyPairNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
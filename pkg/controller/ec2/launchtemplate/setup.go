@@ -6,17 +6,20 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -38,7 +41,7 @@ func SetupLaunchTemplate(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -49,17 +52,23 @@ func SetupLaunchTemplate(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(svcapitypes.LaunchTemplateGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.LaunchTemplate{}, &svcapitypes.LaunchTemplateList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&svcapitypes.LaunchTemplate{}).
+		For(&svcapitypes.LaunchTemplate{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
 func setupExternal() option {
 	return func(e *external) {
 		e.preObserve = preObserve
+		e.preCreate = preCreate
 		e.preUpdate = preUpdate
 		e.preDelete = preDelete
 		e.postCreate = postCreate
@@ -72,6 +81,18 @@ func preObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.D
 	return nil
 }
 
+func preCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.CreateLaunchTemplateInput) error {
+	if cr.Spec.ForProvider.KeyName != nil {
+		if obj.LaunchTemplateData == nil {
+			obj.LaunchTemplateData = &svcsdk.RequestLaunchTemplateData{}
+		}
+		if obj.LaunchTemplateData.KeyName == nil {
+			obj.LaunchTemplateData.KeyName = cr.Spec.ForProvider.KeyName
+		}
+	}
+	return nil
+}
+
 func preUpdate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.ModifyLaunchTemplateInput) error {
 	obj.LaunchTemplateName = aws.String(meta.GetExternalName(cr))
 	return nil
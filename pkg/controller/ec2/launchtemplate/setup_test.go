@@ -168,3 +168,45 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestPreCreate(t *testing.T) {
+	type want struct {
+		obj *svcsdk.CreateLaunchTemplateInput
+	}
+
+	cases := map[string]struct {
+		cr  *svcapitypes.LaunchTemplate
+		obj *svcsdk.CreateLaunchTemplateInput
+		want
+	}{
+		"KeyNameFromReference": {
+			cr: launchTemplate(withSpec(svcapitypes.LaunchTemplateParameters{
+				CustomLaunchTemplateParameters: svcapitypes.CustomLaunchTemplateParameters{KeyName: ptr.To("key")},
+			})),
+			obj: &svcsdk.CreateLaunchTemplateInput{LaunchTemplateData: &svcsdk.RequestLaunchTemplateData{}},
+			want: want{
+				obj: &svcsdk.CreateLaunchTemplateInput{LaunchTemplateData: &svcsdk.RequestLaunchTemplateData{KeyName: ptr.To("key")}},
+			},
+		},
+		"KeyNameInLaunchTemplateData": {
+			cr: launchTemplate(withSpec(svcapitypes.LaunchTemplateParameters{
+				CustomLaunchTemplateParameters: svcapitypes.CustomLaunchTemplateParameters{KeyName: ptr.To("key")},
+			})),
+			obj: &svcsdk.CreateLaunchTemplateInput{LaunchTemplateData: &svcsdk.RequestLaunchTemplateData{KeyName: ptr.To("other")}},
+			want: want{
+				obj: &svcsdk.CreateLaunchTemplateInput{LaunchTemplateData: &svcsdk.RequestLaunchTemplateData{KeyName: ptr.To("other")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if err := preCreate(context.Background(), tc.cr, tc.obj); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
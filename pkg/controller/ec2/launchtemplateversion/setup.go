@@ -7,17 +7,20 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -47,7 +50,7 @@ func SetupLaunchTemplateVersion(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -58,17 +61,30 @@ func SetupLaunchTemplateVersion(mgr ctrl.Manager, o controller.Options) error {
 		cpresource.ManagedKind(svcapitypes.LaunchTemplateVersionGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.LaunchTemplateVersion{}, &svcapitypes.LaunchTemplateVersionList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(cpresource.DesiredStateChanged()).
-		For(&svcapitypes.LaunchTemplateVersion{}).
+		For(&svcapitypes.LaunchTemplateVersion{}, builder.WithPredicates(cpresource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
 func preCreate(_ context.Context, cr *svcapitypes.LaunchTemplateVersion, obj *svcsdk.CreateLaunchTemplateVersionInput) error {
 	obj.LaunchTemplateName = cr.Spec.ForProvider.LaunchTemplateName
 	obj.LaunchTemplateId = cr.Spec.ForProvider.LaunchTemplateID
+	if cr.Spec.ForProvider.KeyName != nil {
+		if obj.LaunchTemplateData == nil {
+			obj.LaunchTemplateData = &svcsdk.RequestLaunchTemplateData{}
+		}
+		if obj.LaunchTemplateData.KeyName == nil {
+			obj.LaunchTemplateData.KeyName = cr.Spec.ForProvider.KeyName
+		}
+	}
 	return nil
 }
 
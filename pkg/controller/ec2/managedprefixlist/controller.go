@@ -0,0 +1,301 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ManagedPrefixList resource"
+
+	errDescribe      = "failed to describe ManagedPrefixList"
+	errGetEntries    = "failed to get the entries of the ManagedPrefixList"
+	errMultipleItems = "retrieved multiple ManagedPrefixLists for the given prefixListId"
+	errCreate        = "failed to create the ManagedPrefixList resource"
+	errModify        = "failed to modify the ManagedPrefixList resource"
+	errResize        = "failed to change the maximum number of entries of the ManagedPrefixList resource"
+	errDelete        = "failed to delete the ManagedPrefixList resource"
+	errCreateTags    = "failed to create tags for the ManagedPrefixList resource"
+	errDeleteTags    = "failed to delete tags for the ManagedPrefixList resource"
+)
+
+// SetupManagedPrefixList adds a controller that reconciles ManagedPrefixLists.
+func SetupManagedPrefixList(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.ManagedPrefixListGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewManagedPrefixListClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.ManagedPrefixListGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.ManagedPrefixList{}, &manualv1alpha1.ManagedPrefixListList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.ManagedPrefixList{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ManagedPrefixListClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.ManagedPrefixListClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsManagedPrefixListNotFoundErr, err), errDescribe)
+	}
+	if observed.State == awsec2types.PrefixListStateDeleteComplete {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateManagedPrefixListObservation(*observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.PrefixListStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.PrefixListStateDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case awsec2types.PrefixListStateCreateFailed,
+		awsec2types.PrefixListStateModifyFailed,
+		awsec2types.PrefixListStateRestoreFailed,
+		awsec2types.PrefixListStateDeleteFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// The prefix list can only be modified once the previous change is
+	// complete.
+	if ec2.IsManagedPrefixListPending(observed.State) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	entries, err := ec2.GetManagedPrefixListEntries(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetEntries)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.IsManagedPrefixListUpToDate(cr.Spec.ForProvider, *observed, entries),
+	}, nil
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.ManagedPrefixList, error) {
+	res, err := e.client.DescribeManagedPrefixLists(ctx, &awsec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.PrefixLists) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.PrefixLists[0], nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.CreateManagedPrefixList(ctx, ec2.GenerateCreateManagedPrefixListInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(res.PrefixList.PrefixListId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := meta.GetExternalName(cr)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+	entries, err := ec2.GetManagedPrefixListEntries(ctx, e.client, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGetEntries)
+	}
+
+	if err := e.updateTags(ctx, cr, observed.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	p := cr.Spec.ForProvider
+	add, remove := ec2.DiffPrefixListEntries(p.Entries, entries)
+	modify := len(add) > 0 || len(remove) > 0 || p.PrefixListName != aws.ToString(observed.PrefixListName)
+	resize := p.MaxEntries != aws.ToInt32(observed.MaxEntries)
+
+	// The maximum number of entries cannot be changed together with the
+	// entries. The prefix list is grown before entries are added and shrunk
+	// after they are removed, each change waiting for the previous one to
+	// complete.
+	if resize && (p.MaxEntries > aws.ToInt32(observed.MaxEntries) || !modify) {
+		_, err := e.client.ModifyManagedPrefixList(ctx, &awsec2.ModifyManagedPrefixListInput{
+			PrefixListId: aws.String(id),
+			MaxEntries:   aws.Int32(p.MaxEntries),
+		})
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errResize)
+	}
+	if !modify {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	in := &awsec2.ModifyManagedPrefixListInput{
+		PrefixListId:   aws.String(id),
+		PrefixListName: aws.String(p.PrefixListName),
+		AddEntries:     add,
+		RemoveEntries:  remove,
+	}
+	// The current version guards against concurrent modifications of the
+	// entries.
+	if len(add) > 0 || len(remove) > 0 {
+		in.CurrentVersion = observed.Version
+	}
+	_, err = e.client.ModifyManagedPrefixList(ctx, in)
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errModify)
+}
+
+func (e *external) updateTags(ctx context.Context, cr *manualv1alpha1.ManagedPrefixList, observed []awsec2types.Tag) error {
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteManagedPrefixList(ctx, &awsec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsManagedPrefixListNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	prefixListID = "pl-123"
+	name         = "some-list"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.ManagedPrefixListClient
+	cr     *manualv1alpha1.ManagedPrefixList
+}
+
+type prefixListModifier func(*manualv1alpha1.ManagedPrefixList)
+
+func withExternalName(name string) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { meta.SetExternalName(r, name) }
+}
+
+func withMaxEntries(n int32) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Spec.ForProvider.MaxEntries = n }
+}
+
+func withEntries(cidrs ...string) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) {
+		r.Spec.ForProvider.Entries = nil
+		for _, c := range cidrs {
+			r.Spec.ForProvider.Entries = append(r.Spec.ForProvider.Entries, manualv1alpha1.PrefixListEntry{CIDR: c})
+		}
+	}
+}
+
+func withStatus(s manualv1alpha1.ManagedPrefixListObservation) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) prefixListModifier {
+	return func(r *manualv1alpha1.ManagedPrefixList) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func prefixList(m ...prefixListModifier) *manualv1alpha1.ManagedPrefixList {
+	cr := &manualv1alpha1.ManagedPrefixList{
+		Spec: manualv1alpha1.ManagedPrefixListSpec{
+			ForProvider: manualv1alpha1.ManagedPrefixListParameters{
+				PrefixListName: name,
+				AddressFamily:  "IPv4",
+				MaxEntries:     2,
+				Entries:        []manualv1alpha1.PrefixListEntry{{CIDR: "10.0.0.0/16"}},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.PrefixListState, maxEntries int32) func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+		return &awsec2.DescribeManagedPrefixListsOutput{PrefixLists: []awsec2types.ManagedPrefixList{{
+			PrefixListId:   aws.String(prefixListID),
+			PrefixListName: aws.String(name),
+			MaxEntries:     aws.Int32(maxEntries),
+			State:          state,
+			Version:        aws.Int64(3),
+		}}}, nil
+	}
+}
+
+func getEntries(cidrs ...string) func(ctx context.Context, input *awsec2.GetManagedPrefixListEntriesInput, opts []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetManagedPrefixListEntriesInput, opts []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+		out := &awsec2.GetManagedPrefixListEntriesOutput{}
+		for _, c := range cidrs {
+			out.Entries = append(out.Entries, awsec2types.PrefixListEntry{Cidr: aws.String(c)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.ManagedPrefixList
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(awsec2types.PrefixListStateModifyComplete, 2),
+					MockGetEntries: getEntries("10.0.0.0/16"),
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{PrefixListID: prefixListID, State: "modify-complete", Version: 3}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntriesChanged": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(awsec2types.PrefixListStateCreateComplete, 2),
+					MockGetEntries: getEntries("10.1.0.0/16"),
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{PrefixListID: prefixListID, State: "create-complete", Version: 3}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ModifyInProgress": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(awsec2types.PrefixListStateModifyInProgress, 1),
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID),
+					withStatus(manualv1alpha1.ManagedPrefixListObservation{PrefixListID: prefixListID, State: "modify-in-progress", Version: 3}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PrefixListIDNotFound}
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				cr:  prefixList(withExternalName(prefixListID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.ManagedPrefixList
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						return &awsec2.CreateManagedPrefixListOutput{PrefixList: &awsec2types.ManagedPrefixList{PrefixListId: aws.String(prefixListID)}}, nil
+					},
+				},
+				cr: prefixList(),
+			},
+			want: want{
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(),
+			},
+			want: want{
+				cr:  prefixList(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modify *awsec2.ModifyManagedPrefixListInput
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyEntries": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(awsec2types.PrefixListStateCreateComplete, 2),
+					MockGetEntries: getEntries("10.1.0.0/16"),
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(prefixListID),
+					PrefixListName: aws.String(name),
+					CurrentVersion: aws.Int64(3),
+					AddEntries:     []awsec2types.AddPrefixListEntry{{Cidr: aws.String("10.0.0.0/16")}},
+					RemoveEntries:  []awsec2types.RemovePrefixListEntry{{Cidr: aws.String("10.1.0.0/16")}},
+				},
+			},
+		},
+		"GrowBeforeAdding": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(awsec2types.PrefixListStateCreateComplete, 1),
+					MockGetEntries: getEntries("10.0.0.0/16"),
+				},
+				cr: prefixList(withExternalName(prefixListID), withEntries("10.0.0.0/16", "10.1.0.0/16")),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String(prefixListID),
+					MaxEntries:   aws.Int32(2),
+				},
+			},
+		},
+		"RemoveBeforeShrinking": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(awsec2types.PrefixListStateCreateComplete, 2),
+					MockGetEntries: getEntries("10.0.0.0/16", "10.1.0.0/16"),
+				},
+				cr: prefixList(withExternalName(prefixListID), withMaxEntries(1)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(prefixListID),
+					PrefixListName: aws.String(name),
+					CurrentVersion: aws.Int64(3),
+					RemoveEntries:  []awsec2types.RemovePrefixListEntry{{Cidr: aws.String("10.1.0.0/16")}},
+				},
+			},
+		},
+		"ModifyFailed": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(awsec2types.PrefixListStateCreateComplete, 2),
+					MockGetEntries: getEntries(),
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(prefixListID),
+					PrefixListName: aws.String(name),
+					CurrentVersion: aws.Int64(3),
+					AddEntries:     []awsec2types.AddPrefixListEntry{{Cidr: aws.String("10.0.0.0/16")}},
+				},
+				err: errorutils.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *awsec2.ModifyManagedPrefixListInput
+			client := tc.client.(*fake.MockManagedPrefixListClient)
+			client.MockModify = func(ctx context.Context, input *awsec2.ModifyManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.ModifyManagedPrefixListOutput, error) {
+				got = input
+				if tc.want.err != nil {
+					return nil, errBoom
+				}
+				return &awsec2.ModifyManagedPrefixListOutput{}, nil
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, got, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(awsec2.ModifyManagedPrefixListInput{}, awsec2types.AddPrefixListEntry{}, awsec2types.RemovePrefixListEntry{})); diff != "" {
+				t.Errorf("ModifyManagedPrefixList: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PrefixListIDNotFound}
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(prefixListID)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
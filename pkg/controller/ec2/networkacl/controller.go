@@ -0,0 +1,368 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NetworkACL resource"
+
+	errDescribe         = "failed to describe NetworkACL"
+	errDescribeDefault  = "failed to describe the default NetworkACL of the VPC"
+	errNoDefault        = "cannot find the default NetworkACL of the VPC"
+	errDescribeSubnets  = "failed to describe the NetworkACL associations of the subnets"
+	errMultipleItems    = "retrieved multiple NetworkACLs for the given networkAclId"
+	errCreate           = "failed to create the NetworkACL resource"
+	errDelete           = "failed to delete the NetworkACL resource"
+	errCreateEntry      = "failed to create NetworkACL entry"
+	errReplaceEntry     = "failed to replace NetworkACL entry"
+	errDeleteEntry      = "failed to delete NetworkACL entry"
+	errAssociate        = "failed to associate subnet with the NetworkACL"
+	errDisassociate     = "failed to associate subnet with the default NetworkACL of the VPC"
+	errSubnetNotFound   = "cannot find the NetworkACL association of subnet"
+	errCreateTags       = "failed to create tags for the NetworkACL resource"
+	errDeleteTags       = "failed to delete tags for the NetworkACL resource"
+	errKubeUpdateFailed = "cannot update NetworkACL custom resource"
+)
+
+// SetupNetworkACL adds a controller that reconciles NetworkACLs.
+func SetupNetworkACL(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.NetworkACLGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.NetworkACLGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.NetworkACL{}, &svcapitypes.NetworkACLList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.NetworkACL{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.NetworkACLClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.NetworkACLClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*svcapitypes.NetworkACL)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeNetworkACL(&cr.Spec.ForProvider, observed)
+	if !cmp.Equal(current, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+		}
+	}
+
+	cr.Status.AtProvider = ec2.GenerateNetworkACLObservation(*observed)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsNetworkACLUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.NetworkAcl, error) {
+	res, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.NetworkAcls) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.NetworkAcls[0], nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*svcapitypes.NetworkACL)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	in := &awsec2.CreateNetworkAclInput{VpcId: cr.Spec.ForProvider.VPCID}
+	if len(cr.Spec.ForProvider.Tags) > 0 {
+		in.TagSpecifications = []awsec2types.TagSpecification{{
+			ResourceType: awsec2types.ResourceTypeNetworkAcl,
+			Tags:         ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags),
+		}}
+	}
+	res, err := e.client.CreateNetworkAcl(ctx, in)
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	// Entries and subnet associations are applied by the subsequent update.
+	meta.SetExternalName(cr, aws.ToString(res.NetworkAcl.NetworkAclId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*svcapitypes.NetworkACL)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	if err := e.updateTags(ctx, cr, observed.Tags); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if err := e.updateEntries(ctx, cr, observed.Entries); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return managed.ExternalUpdate{}, e.updateAssociations(ctx, cr, observed)
+}
+
+func (e *external) updateTags(ctx context.Context, cr *svcapitypes.NetworkACL, observed []awsec2types.Tag) error {
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+func (e *external) updateEntries(ctx context.Context, cr *svcapitypes.NetworkACL, observed []awsec2types.NetworkAclEntry) error {
+	id := meta.GetExternalName(cr)
+	create, replace, remove := ec2.DiffNetworkACLEntries(
+		ec2.DesiredNetworkACLEntries(cr.Spec.ForProvider),
+		ec2.ManagedNetworkACLEntries(cr.Spec.ForProvider, observed),
+	)
+	for i := range remove {
+		if _, err := e.client.DeleteNetworkAclEntry(ctx, ec2.GenerateDeleteNetworkACLEntryInput(id, remove[i])); resource.Ignore(ec2.IsNetworkACLEntryNotFoundErr, err) != nil {
+			return errorutils.Wrap(err, errDeleteEntry)
+		}
+	}
+	for i := range replace {
+		if _, err := e.client.ReplaceNetworkAclEntry(ctx, ec2.GenerateReplaceNetworkACLEntryInput(id, replace[i])); err != nil {
+			return errorutils.Wrap(err, errReplaceEntry)
+		}
+	}
+	for i := range create {
+		if _, err := e.client.CreateNetworkAclEntry(ctx, ec2.GenerateCreateNetworkACLEntryInput(id, create[i])); err != nil {
+			return errorutils.Wrap(err, errCreateEntry)
+		}
+	}
+	return nil
+}
+
+// updateAssociations associates the desired subnets with the network ACL.
+// Subnets cannot be without a network ACL, so the subnets that are no longer
+// desired are associated with the default network ACL of the VPC.
+func (e *external) updateAssociations(ctx context.Context, cr *svcapitypes.NetworkACL, observed *awsec2types.NetworkAcl) error {
+	associate, disassociate := ec2.DiffNetworkACLAssociations(cr.Spec.ForProvider.SubnetIDs, observed.Associations)
+	if err := e.disassociate(ctx, aws.ToString(observed.VpcId), disassociate); err != nil {
+		return err
+	}
+	if len(associate) == 0 {
+		return nil
+	}
+
+	// The current association of each subnet is replaced, so it has to be
+	// looked up in the network ACL the subnet is associated with now.
+	res, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{{
+			Name:   aws.String("association.subnet-id"),
+			Values: associate,
+		}},
+	})
+	if err != nil {
+		return errorutils.Wrap(err, errDescribeSubnets)
+	}
+	current := map[string]string{}
+	for _, acl := range res.NetworkAcls {
+		for _, a := range acl.Associations {
+			current[aws.ToString(a.SubnetId)] = aws.ToString(a.NetworkAclAssociationId)
+		}
+	}
+	for _, subnetID := range associate {
+		associationID, ok := current[subnetID]
+		if !ok {
+			return errors.Errorf("%s %s", errSubnetNotFound, subnetID)
+		}
+		if _, err := e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: aws.String(associationID),
+			NetworkAclId:  aws.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return errorutils.Wrap(err, errAssociate)
+		}
+	}
+	return nil
+}
+
+// disassociate associates the subnets of the supplied associations with the
+// default network ACL of the supplied VPC.
+func (e *external) disassociate(ctx context.Context, vpcID string, associations []awsec2types.NetworkAclAssociation) error {
+	if len(associations) == 0 {
+		return nil
+	}
+	res, err := e.client.DescribeNetworkAcls(ctx, &awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2types.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	})
+	if err != nil {
+		return errorutils.Wrap(err, errDescribeDefault)
+	}
+	if len(res.NetworkAcls) != 1 {
+		return errors.New(errNoDefault)
+	}
+	for _, a := range associations {
+		if _, err := e.client.ReplaceNetworkAclAssociation(ctx, &awsec2.ReplaceNetworkAclAssociationInput{
+			AssociationId: a.NetworkAclAssociationId,
+			NetworkAclId:  res.NetworkAcls[0].NetworkAclId,
+		}); err != nil {
+			return errorutils.Wrap(err, errDisassociate)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*svcapitypes.NetworkACL)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// A network ACL cannot be deleted while subnets are associated with it.
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDescribe)
+	}
+	if err := e.disassociate(ctx, aws.ToString(observed.VpcId), observed.Associations); err != nil {
+		return managed.ExternalDelete{}, err
+	}
+
+	_, err = e.client.DeleteNetworkAcl(ctx, &awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsNetworkACLNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,387 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	aclID        = "acl-123"
+	defaultACLID = "acl-default"
+	vpcID        = "vpc-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	acl  ec2.NetworkACLClient
+	kube client.Client
+	cr   *svcapitypes.NetworkACL
+}
+
+type aclModifier func(*svcapitypes.NetworkACL)
+
+func withExternalName(name string) aclModifier {
+	return func(r *svcapitypes.NetworkACL) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p svcapitypes.NetworkACLParameters) aclModifier {
+	return func(r *svcapitypes.NetworkACL) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s svcapitypes.NetworkACLObservation) aclModifier {
+	return func(r *svcapitypes.NetworkACL) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *svcapitypes.NetworkACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func networkACL(m ...aclModifier) *svcapitypes.NetworkACL {
+	cr := &svcapitypes.NetworkACL{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func ingressRule() svcapitypes.NetworkACLRule {
+	return svcapitypes.NetworkACLRule{
+		RuleNumber: 100,
+		Protocol:   "tcp",
+		RuleAction: "allow",
+		CIDRBlock:  aws.String("10.0.0.0/16"),
+		FromPort:   aws.Int32(443),
+		ToPort:     aws.Int32(443),
+	}
+}
+
+func observedEntry() awsec2types.NetworkAclEntry {
+	return awsec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(100),
+		Egress:     aws.Bool(false),
+		Protocol:   aws.String("6"),
+		RuleAction: awsec2types.RuleActionAllow,
+		CidrBlock:  aws.String("10.0.0.0/16"),
+		PortRange:  &awsec2types.PortRange{From: aws.Int32(443), To: aws.Int32(443)},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.NetworkACL
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+							Entries:      []awsec2types.NetworkAclEntry{observedEntry()},
+						}}}, nil
+					},
+				},
+				cr: networkACL(withExternalName(aclID), withSpec(svcapitypes.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Ingress: []svcapitypes.NetworkACLRule{ingressRule()},
+				})),
+			},
+			want: want{
+				cr: networkACL(withExternalName(aclID), withSpec(svcapitypes.NetworkACLParameters{
+					VPCID:   aws.String(vpcID),
+					Ingress: []svcapitypes.NetworkACLRule{ingressRule()},
+				}), withStatus(svcapitypes.NetworkACLObservation{
+					NetworkACLID: aclID,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitAndUnmanagedEntry": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+							Entries:      []awsec2types.NetworkAclEntry{observedEntry()},
+						}}}, nil
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(aclID), withSpec(svcapitypes.NetworkACLParameters{
+					VPCID: aws.String(vpcID),
+				}), withStatus(svcapitypes.NetworkACLObservation{
+					NetworkACLID: aclID,
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: networkACL(withExternalName(aclID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  networkACL(withExternalName(aclID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		associated    []string
+		disassociated []string
+		created       []int32
+		deleted       []int32
+		err           error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ApplyEntriesAndAssociations": {
+			args: args{
+				cr: networkACL(withExternalName(aclID), withSpec(svcapitypes.NetworkACLParameters{
+					SubnetIDs: []string{"subnet-new"},
+					Ingress:   []svcapitypes.NetworkACLRule{ingressRule()},
+				})),
+			},
+			want: want{
+				associated:    []string{"aclassoc-new"},
+				disassociated: []string{"aclassoc-old"},
+				created:       []int32{100},
+				deleted:       []int32{200},
+			},
+		},
+		"IgnoreIngress": {
+			args: args{
+				cr: networkACL(withExternalName(aclID), withSpec(svcapitypes.NetworkACLParameters{
+					SubnetIDs:     []string{"subnet-old"},
+					IgnoreIngress: aws.Bool(true),
+				})),
+			},
+			want: want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			client := &fake.MockNetworkACLClient{
+				MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+					switch {
+					case len(input.NetworkAclIds) == 1:
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(aclID),
+							VpcId:        aws.String(vpcID),
+							Associations: []awsec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-old"), NetworkAclAssociationId: aws.String("aclassoc-old")}},
+							Entries: []awsec2types.NetworkAclEntry{{
+								RuleNumber: aws.Int32(200),
+								Egress:     aws.Bool(false),
+								Protocol:   aws.String("-1"),
+								RuleAction: awsec2types.RuleActionAllow,
+								CidrBlock:  aws.String("0.0.0.0/0"),
+							}},
+						}}}, nil
+					case aws.ToString(input.Filters[0].Name) == "association.subnet-id":
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+							NetworkAclId: aws.String(defaultACLID),
+							Associations: []awsec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-new"), NetworkAclAssociationId: aws.String("aclassoc-new")}},
+						}}}, nil
+					default:
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}}}, nil
+					}
+				},
+				MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+					got.created = append(got.created, aws.ToInt32(input.RuleNumber))
+					return &awsec2.CreateNetworkAclEntryOutput{}, nil
+				},
+				MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+					got.deleted = append(got.deleted, aws.ToInt32(input.RuleNumber))
+					return &awsec2.DeleteNetworkAclEntryOutput{}, nil
+				},
+				MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+					if aws.ToString(input.NetworkAclId) == aclID {
+						got.associated = append(got.associated, aws.ToString(input.AssociationId))
+					} else {
+						got.disassociated = append(got.disassociated, aws.ToString(input.AssociationId))
+					}
+					return &awsec2.ReplaceNetworkAclAssociationOutput{}, nil
+				},
+			}
+			e := &external{kube: tc.kube, client: client}
+			_, got.err = e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DisassociateAndDelete": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						if len(input.NetworkAclIds) == 1 {
+							return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+								NetworkAclId: aws.String(aclID),
+								VpcId:        aws.String(vpcID),
+								Associations: []awsec2types.NetworkAclAssociation{{SubnetId: aws.String("subnet-1"), NetworkAclAssociationId: aws.String("aclassoc-1")}},
+							}}}, nil
+						}
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(defaultACLID)}}}, nil
+					},
+					MockReplaceAssociation: func(ctx context.Context, input *awsec2.ReplaceNetworkAclAssociationInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceNetworkAclAssociationOutput, error) {
+						if diff := cmp.Diff(defaultACLID, aws.ToString(input.NetworkAclId)); diff != "" {
+							t.Errorf("ReplaceNetworkAclAssociation: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ReplaceNetworkAclAssociationOutput{}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return &awsec2.DeleteNetworkAclOutput{}, nil
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				acl: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{NetworkAclId: aws.String(aclID)}}}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteNetworkAclInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: networkACL(withExternalName(aclID)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.acl}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
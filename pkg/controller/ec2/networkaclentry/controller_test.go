@@ -0,0 +1,308 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkaclentry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	aclID = "acl-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.NetworkACLEntryClient
+	cr     *svcapitypes.NetworkACLEntry
+}
+
+type entryModifier func(*svcapitypes.NetworkACLEntry)
+
+func withExternalName(name string) entryModifier {
+	return func(r *svcapitypes.NetworkACLEntry) { meta.SetExternalName(r, name) }
+}
+
+func withAction(action string) entryModifier {
+	return func(r *svcapitypes.NetworkACLEntry) { r.Spec.ForProvider.RuleAction = action }
+}
+
+func withStatus(s svcapitypes.NetworkACLEntryObservation) entryModifier {
+	return func(r *svcapitypes.NetworkACLEntry) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) entryModifier {
+	return func(r *svcapitypes.NetworkACLEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func entry(m ...entryModifier) *svcapitypes.NetworkACLEntry {
+	cr := &svcapitypes.NetworkACLEntry{
+		Spec: svcapitypes.NetworkACLEntrySpec{
+			ForProvider: svcapitypes.NetworkACLEntryParameters{
+				NetworkACLID: aws.String(aclID),
+				Egress:       aws.Bool(true),
+				NetworkACLRule: svcapitypes.NetworkACLRule{
+					RuleNumber: 100,
+					Protocol:   "udp",
+					RuleAction: "allow",
+					CIDRBlock:  aws.String("0.0.0.0/0"),
+					FromPort:   aws.Int32(53),
+					ToPort:     aws.Int32(53),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeACL(entries ...awsec2types.NetworkAclEntry) func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+		return &awsec2.DescribeNetworkAclsOutput{NetworkAcls: []awsec2types.NetworkAcl{{
+			NetworkAclId: aws.String(aclID),
+			Entries:      entries,
+		}}}, nil
+	}
+}
+
+func observedEntry(egress bool) awsec2types.NetworkAclEntry {
+	return awsec2types.NetworkAclEntry{
+		RuleNumber: aws.Int32(100),
+		Egress:     aws.Bool(egress),
+		Protocol:   aws.String("17"),
+		RuleAction: awsec2types.RuleActionAllow,
+		CidrBlock:  aws.String("0.0.0.0/0"),
+		PortRange:  &awsec2types.PortRange{From: aws.Int32(53), To: aws.Int32(53)},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.NetworkACLEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &fake.MockNetworkACLClient{MockDescribe: describeACL(observedEntry(false), observedEntry(true))},
+				cr:     entry(withExternalName("x")),
+			},
+			want: want{
+				cr: entry(withExternalName("x"), withStatus(svcapitypes.NetworkACLEntryObservation{Protocol: "17"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Changed": {
+			args: args{
+				client: &fake.MockNetworkACLClient{MockDescribe: describeACL(observedEntry(true))},
+				cr:     entry(withExternalName("x"), withAction("deny")),
+			},
+			want: want{
+				cr: entry(withExternalName("x"), withAction("deny"), withStatus(svcapitypes.NetworkACLEntryObservation{Protocol: "17"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"OtherDirectionOnly": {
+			args: args{
+				client: &fake.MockNetworkACLClient{MockDescribe: describeACL(observedEntry(false))},
+				cr:     entry(withExternalName("x")),
+			},
+			want: want{
+				cr: entry(withExternalName("x")),
+			},
+		},
+		"NetworkACLNotFound": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLIDNotFound}
+					},
+				},
+				cr: entry(withExternalName("x")),
+			},
+			want: want{
+				cr: entry(withExternalName("x")),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeNetworkAclsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeNetworkAclsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(withExternalName("x")),
+			},
+			want: want{
+				cr:  entry(withExternalName("x")),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *svcapitypes.NetworkACLEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						if diff := cmp.Diff("17", aws.ToString(input.Protocol)); diff != "" {
+							t.Errorf("CreateNetworkAclEntry: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateNetworkAclEntryOutput{}, nil
+					},
+				},
+				cr: entry(),
+			},
+			want: want{
+				cr: entry(withExternalName(aclID + ":egress:100")),
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockCreateEntry: func(ctx context.Context, input *awsec2.CreateNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.CreateNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(),
+			},
+			want: want{
+				cr:  entry(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.NetworkACLEntryNotFound}
+					},
+				},
+				cr: entry(withExternalName("x")),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockNetworkACLClient{
+					MockDeleteEntry: func(ctx context.Context, input *awsec2.DeleteNetworkAclEntryInput, opts []func(*awsec2.Options)) (*awsec2.DeleteNetworkAclEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: entry(withExternalName("x")),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
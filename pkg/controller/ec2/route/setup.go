@@ -5,20 +5,23 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -49,7 +52,7 @@ func SetupRoute(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -60,11 +63,16 @@ func SetupRoute(mgr ctrl.Manager, o controller.Options) error {
 		cpresource.ManagedKind(svcapitypes.RouteGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.Route{}, &svcapitypes.RouteList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(cpresource.DesiredStateChanged()).
-		For(&svcapitypes.Route{}).
+		For(&svcapitypes.Route{}, builder.WithPredicates(cpresource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -75,6 +83,7 @@ func preCreate(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.CreateRoute
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
 	obj.InstanceId = cr.Spec.ForProvider.InstanceID
 	obj.GatewayId = cr.Spec.ForProvider.GatewayID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return nil
 }
 
@@ -102,6 +111,7 @@ func (e *external) observer(ctx context.Context, cr *svcapitypes.Route) (managed
 
 func preDelete(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.DeleteRouteInput) (bool, error) {
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return false, nil
 }
 
@@ -124,6 +134,12 @@ func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.R
 
 	for _, route := range response.RouteTables[0].Routes {
 		if pointer.StringValue(route.Origin) == svcsdk.RouteOriginCreateRoute {
+			if cr.Spec.ForProvider.DestinationPrefixListID != nil {
+				if pointer.StringValue(route.DestinationPrefixListId) == pointer.StringValue(cr.Spec.ForProvider.DestinationPrefixListID) {
+					return route, nil
+				}
+				continue
+			}
 			if ec2.CIDRBlocksEqual(pointer.StringValue(route.DestinationCidrBlock), pointer.StringValue(cr.Spec.ForProvider.DestinationCIDRBlock)) {
 				return route, nil
 			}
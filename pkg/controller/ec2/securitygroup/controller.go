@@ -24,17 +24,19 @@ import (
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
@@ -42,7 +44,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -79,13 +83,13 @@ func SetupSecurityGroup(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient}),
 		managed.WithCreationGracePeriod(3 * time.Minute),
-		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
 		managed.WithInitializers(),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -96,14 +100,50 @@ func SetupSecurityGroup(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.SecurityGroupGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.SecurityGroup{}, &v1beta1.SecurityGroupList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.SecurityGroup{}).
+		For(&v1beta1.SecurityGroup{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
+// resolveReferences resolves the references to managed prefix lists in the
+// ingress and egress rules.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.SecurityGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	r := reference.NewAPIResolver(c, cr)
+
+	for _, rules := range []struct {
+		path  string
+		rules []v1beta1.IPPermission
+	}{
+		{path: "spec.forProvider.ingress", rules: cr.Spec.ForProvider.Ingress},
+		{path: "spec.forProvider.egress", rules: cr.Spec.ForProvider.Egress},
+	} {
+		for i := range rules.rules {
+			for j := range rules.rules[i].PrefixListIDs {
+				pl := &rules.rules[i].PrefixListIDs[j]
+				rsp, err := r.Resolve(ctx, ec2.ManagedPrefixListIDReference(&pl.PrefixListID, pl.PrefixListIDRef, pl.PrefixListIDSelector))
+				if err != nil {
+					return errors.Wrapf(err, "%s[%d].prefixListIds[%d].prefixListId", rules.path, i, j)
+				}
+				pl.PrefixListID = rsp.ResolvedValue
+				pl.PrefixListIDRef = rsp.ResolvedReference
+			}
+		}
+	}
+	return nil
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) ec2.SecurityGroupClient
@@ -31,9 +31,11 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
@@ -1062,3 +1064,70 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveReferences(t *testing.T) {
+	prefixListID := "pl-123"
+	withPrefixList := func(id string, ref *xpv1.Reference) []v1beta1.IPPermission {
+		return []v1beta1.IPPermission{{
+			IPProtocol:    tcpProtocol,
+			FromPort:      &port80,
+			ToPort:        &port80,
+			PrefixListIDs: []v1beta1.PrefixListID{{PrefixListID: id, PrefixListIDRef: ref}},
+		}}
+	}
+
+	type want struct {
+		cr  *v1beta1.SecurityGroup
+		err bool
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		cr   *v1beta1.SecurityGroup
+		want want
+	}{
+		"ResolvePrefixListOfEgressRule": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					pl := obj.(*manualv1alpha1.ManagedPrefixList)
+					meta.SetExternalName(pl, prefixListID)
+					return nil
+				},
+			},
+			cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+				Egress: withPrefixList("", &xpv1.Reference{Name: "pl"}),
+			})),
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Egress: withPrefixList(prefixListID, &xpv1.Reference{Name: "pl"}),
+				})),
+			},
+		},
+		"PrefixListNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+				Ingress: withPrefixList("", &xpv1.Reference{Name: "pl"}),
+			})),
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress: withPrefixList("", &xpv1.Reference{Name: "pl"}),
+				})),
+				err: true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := resolveReferences(context.Background(), tc.kube, tc.cr)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("resolveReferences(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, cmpopts.IgnoreTypes(metav1.ObjectMeta{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
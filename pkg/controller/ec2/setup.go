@@ -21,27 +21,45 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/address"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/dhcpoptions"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/dhcpoptionsassociation"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ebsvolumeattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/flowlog"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/image"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipam"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipampool"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipamscope"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/keypair"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplateversion"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/managedprefixlist"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/natgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/networkacl"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/networkaclentry"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/securitygroup"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/securitygrouprule"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/snapshot"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/subnet"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewaypeeringattachment"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewaypeeringattachmentaccepter"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroute"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroutetableassociation"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayroutetablepropagation"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/transitgatewayvpcattachmentaccepter"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/volume"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpc"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpccidrblock"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcendpoint"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/vpcpeeringconnectionaccepter"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -50,26 +68,44 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
 		address.SetupAddress,
+		dhcpoptions.SetupDHCPOptions,
+		dhcpoptionsassociation.SetupDHCPOptionsAssociation,
+		ebsvolumeattachment.SetupEBSVolumeAttachment,
 		flowlog.SetupFlowLog,
+		image.SetupImage,
 		instance.SetupInstance,
 		internetgateway.SetupInternetGateway,
+		ipam.SetupIPAM,
+		ipampool.SetupIPAMPool,
+		ipamscope.SetupIPAMScope,
+		keypair.SetupKeyPair,
 		launchtemplate.SetupLaunchTemplate,
 		launchtemplateversion.SetupLaunchTemplateVersion,
+		managedprefixlist.SetupManagedPrefixList,
 		natgateway.SetupNatGateway,
+		networkacl.SetupNetworkACL,
+		networkaclentry.SetupNetworkACLEntry,
 		route.SetupRoute,
 		routetable.SetupRouteTable,
 		securitygroup.SetupSecurityGroup,
 		securitygrouprule.SetupSecurityGroupRule,
+		snapshot.SetupSnapshot,
 		subnet.SetupSubnet,
 		transitgateway.SetupTransitGateway,
+		transitgatewaypeeringattachment.SetupTransitGatewayPeeringAttachment,
+		transitgatewaypeeringattachmentaccepter.SetupTransitGatewayPeeringAttachmentAccepter,
 		transitgatewayroute.SetupTransitGatewayRoute,
 		transitgatewayroutetable.SetupTransitGatewayRouteTable,
+		transitgatewayroutetableassociation.SetupTransitGatewayRouteTableAssociation,
+		transitgatewayroutetablepropagation.SetupTransitGatewayRouteTablePropagation,
 		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
+		transitgatewayvpcattachmentaccepter.SetupTransitGatewayVPCAttachmentAccepter,
 		volume.SetupVolume,
 		vpc.SetupVPC,
 		vpccidrblock.SetupVPCCIDRBlock,
 		vpcendpoint.SetupVPCEndpoint,
 		vpcendpointserviceconfiguration.SetupVPCEndpointServiceConfiguration,
 		vpcpeeringconnection.SetupVPCPeeringConnection,
+		vpcpeeringconnectionaccepter.SetupVPCPeeringConnectionAccepter,
 	)
 }
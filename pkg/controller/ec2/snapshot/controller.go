@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a Snapshot resource"
+
+	errDescribe      = "failed to describe Snapshot"
+	errMultipleItems = "retrieved multiple Snapshots for the given snapshotId"
+	errNoSource      = "either volumeId or sourceSnapshotId has to be set"
+	errCreate        = "failed to create the Snapshot resource"
+	errCopy          = "failed to copy the source snapshot"
+	errDelete        = "failed to delete the Snapshot resource"
+	errCreateTags    = "failed to create tags for the Snapshot resource"
+	errDeleteTags    = "failed to delete tags for the Snapshot resource"
+)
+
+// SetupSnapshot adds a controller that reconciles Snapshots.
+func SetupSnapshot(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.SnapshotGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSnapshotClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.SnapshotGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.Snapshot{}, &manualv1alpha1.SnapshotList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.Snapshot{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the reference to the volume. The reference to
+// the source snapshot is generated.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, ec2.VolumeIDReference(cr.Spec.ForProvider.VolumeID, cr.Spec.ForProvider.VolumeIDRef, cr.Spec.ForProvider.VolumeIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.volumeId")
+	}
+	cr.Spec.ForProvider.VolumeID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.VolumeIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.SnapshotClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.SnapshotClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.Snapshot, error) {
+	res, err := e.client.DescribeSnapshots(ctx, &awsec2.DescribeSnapshotsInput{
+		SnapshotIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.Snapshots) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.Snapshots[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsSnapshotNotFoundErr, err), errDescribe)
+	}
+
+	cr.Status.AtProvider = ec2.GenerateSnapshotObservation(*observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.SnapshotStatePending:
+		// Tags can only be updated once the snapshot is completed.
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	case awsec2types.SnapshotStateError:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.CompareTagsManualV1alpha1(cr.Spec.ForProvider.Tags, observed.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	switch {
+	case cr.Spec.ForProvider.SourceSnapshotID != nil:
+		res, err := e.client.CopySnapshot(ctx, ec2.GenerateCopySnapshotInput(cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalCreation{}, errorutils.Wrap(err, errCopy)
+		}
+		meta.SetExternalName(cr, aws.ToString(res.SnapshotId))
+	case cr.Spec.ForProvider.VolumeID != nil:
+		res, err := e.client.CreateSnapshot(ctx, ec2.GenerateCreateSnapshotInput(cr.Spec.ForProvider))
+		if err != nil {
+			return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+		}
+		meta.SetExternalName(cr, aws.ToString(res.SnapshotId))
+	default:
+		return managed.ExternalCreation{}, errors.New(errNoSource)
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+	return managed.ExternalUpdate{}, e.updateTags(ctx, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags, observed.Tags)
+}
+
+func (e *external) updateTags(ctx context.Context, id string, desired []manualv1alpha1.Tag, observed []awsec2types.Tag) error {
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(desired), observed)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}); err != nil {
+			return errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}); err != nil {
+			return errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteSnapshot(ctx, &awsec2.DeleteSnapshotInput{
+		SnapshotId: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsSnapshotNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	snapshotID = "snap-123"
+	sourceID   = "snap-456"
+	volumeID   = "vol-123"
+	region     = "us-west-2"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.SnapshotClient
+	cr     *manualv1alpha1.Snapshot
+}
+
+type snapshotModifier func(*manualv1alpha1.Snapshot)
+
+func withExternalName(name string) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p manualv1alpha1.SnapshotParameters) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s manualv1alpha1.SnapshotObservation) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(r *manualv1alpha1.Snapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func snapshot(m ...snapshotModifier) *manualv1alpha1.Snapshot {
+	cr := &manualv1alpha1.Snapshot{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeSnapshot(s awsec2types.Snapshot) func(ctx context.Context, input *awsec2.DescribeSnapshotsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeSnapshotsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+		return &awsec2.DescribeSnapshotsOutput{Snapshots: []awsec2types.Snapshot{s}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.Snapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Completed": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: describeSnapshot(awsec2types.Snapshot{
+						SnapshotId: aws.String(snapshotID),
+						State:      awsec2types.SnapshotStateCompleted,
+						Progress:   aws.String("100%"),
+					}),
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID),
+					withStatus(manualv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: "completed", Progress: "100%"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingWithChangedTags": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: describeSnapshot(awsec2types.Snapshot{
+						SnapshotId: aws.String(snapshotID),
+						State:      awsec2types.SnapshotStatePending,
+					}),
+				},
+				cr: snapshot(withExternalName(snapshotID),
+					withSpec(manualv1alpha1.SnapshotParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID),
+					withSpec(manualv1alpha1.SnapshotParameters{Tags: []manualv1alpha1.Tag{{Key: "k", Value: "v"}}}),
+					withStatus(manualv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: "pending"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Error": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: describeSnapshot(awsec2types.Snapshot{
+						SnapshotId:   aws.String(snapshotID),
+						State:        awsec2types.SnapshotStateError,
+						StateMessage: aws.String("failed"),
+					}),
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID),
+					withStatus(manualv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: "error", StateMessage: "failed"}),
+					withConditions(xpv1.Unavailable().WithMessage("failed"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSnapshotsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.SnapshotIDNotFound}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSnapshotsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				cr:  snapshot(withExternalName(snapshotID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.Snapshot
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"FromVolume": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateSnapshotInput, opts []func(*awsec2.Options)) (*awsec2.CreateSnapshotOutput, error) {
+						if aws.ToString(input.VolumeId) != volumeID {
+							t.Errorf("unexpected volume %s", aws.ToString(input.VolumeId))
+						}
+						return &awsec2.CreateSnapshotOutput{SnapshotId: aws.String(snapshotID)}, nil
+					},
+				},
+				cr: snapshot(withSpec(manualv1alpha1.SnapshotParameters{VolumeID: aws.String(volumeID)})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(manualv1alpha1.SnapshotParameters{VolumeID: aws.String(volumeID)})),
+			},
+		},
+		"CopyFromSameRegion": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockCopy: func(ctx context.Context, input *awsec2.CopySnapshotInput, opts []func(*awsec2.Options)) (*awsec2.CopySnapshotOutput, error) {
+						if aws.ToString(input.SourceRegion) != region || aws.ToString(input.SourceSnapshotId) != sourceID {
+							t.Errorf("unexpected source %s in %s", aws.ToString(input.SourceSnapshotId), aws.ToString(input.SourceRegion))
+						}
+						return &awsec2.CopySnapshotOutput{SnapshotId: aws.String(snapshotID)}, nil
+					},
+				},
+				cr: snapshot(withSpec(manualv1alpha1.SnapshotParameters{Region: region, SourceSnapshotID: aws.String(sourceID)})),
+			},
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withSpec(manualv1alpha1.SnapshotParameters{Region: region, SourceSnapshotID: aws.String(sourceID)})),
+			},
+		},
+		"NoSource": {
+			args: args{
+				client: &fake.MockSnapshotClient{},
+				cr:     snapshot(),
+			},
+			want: want{
+				cr:  snapshot(),
+				err: errors.New(errNoSource),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateSnapshotInput, opts []func(*awsec2.Options)) (*awsec2.CreateSnapshotOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withSpec(manualv1alpha1.SnapshotParameters{VolumeID: aws.String(volumeID)})),
+			},
+			want: want{
+				cr:  snapshot(withSpec(manualv1alpha1.SnapshotParameters{VolumeID: aws.String(volumeID)})),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteSnapshotInput, opts []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+						return &awsec2.DeleteSnapshotOutput{}, nil
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteSnapshotInput, opts []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.SnapshotIDNotFound}
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockSnapshotClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteSnapshotInput, opts []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
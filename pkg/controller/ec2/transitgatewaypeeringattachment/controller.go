@@ -0,0 +1,271 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewaypeeringattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayPeeringAttachment resource"
+
+	errDescribe      = "failed to describe TransitGatewayPeeringAttachment"
+	errMultipleItems = "retrieved multiple TransitGatewayPeeringAttachments for the given transitGatewayAttachmentId"
+	errCreate        = "failed to create the TransitGatewayPeeringAttachment resource"
+	errDelete        = "failed to delete the TransitGatewayPeeringAttachment resource"
+	errCreateTags    = "failed to create tags for the TransitGatewayPeeringAttachment resource"
+	errDeleteTags    = "failed to delete tags for the TransitGatewayPeeringAttachment resource"
+)
+
+// SetupTransitGatewayPeeringAttachment adds a controller that reconciles
+// TransitGatewayPeeringAttachments.
+func SetupTransitGatewayPeeringAttachment(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.TransitGatewayPeeringAttachmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayPeeringAttachmentClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.TransitGatewayPeeringAttachmentGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.TransitGatewayPeeringAttachment{}, &manualv1alpha1.TransitGatewayPeeringAttachmentList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.TransitGatewayPeeringAttachment{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the references to the requester and the
+// accepter transit gateways.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayPeeringAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	r := reference.NewAPIResolver(c, cr)
+
+	rsp, err := r.Resolve(ctx, ec2.TransitGatewayIDReference(cr.Spec.ForProvider.TransitGatewayID, cr.Spec.ForProvider.TransitGatewayIDRef, cr.Spec.ForProvider.TransitGatewayIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayId")
+	}
+	cr.Spec.ForProvider.TransitGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.TransitGatewayIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, ec2.TransitGatewayIDReference(cr.Spec.ForProvider.PeerTransitGatewayID, cr.Spec.ForProvider.PeerTransitGatewayIDRef, cr.Spec.ForProvider.PeerTransitGatewayIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.peerTransitGatewayId")
+	}
+	cr.Spec.ForProvider.PeerTransitGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.PeerTransitGatewayIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayPeeringAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayPeeringAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayPeeringAttachmentClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.TransitGatewayPeeringAttachment, error) {
+	res, err := e.client.DescribeTransitGatewayPeeringAttachments(ctx, &awsec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.TransitGatewayPeeringAttachments) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.TransitGatewayPeeringAttachments[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayPeeringAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDescribe)
+	}
+
+	if ec2.IsTransitGatewayAttachmentGone(observed.State) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayPeeringAttachmentObservation(*observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.TransitGatewayAttachmentStateInitiating,
+		awsec2types.TransitGatewayAttachmentStateInitiatingRequest,
+		awsec2types.TransitGatewayAttachmentStatePendingAcceptance,
+		awsec2types.TransitGatewayAttachmentStatePending:
+		// The peering is waiting to be accepted by the owner of the peer
+		// transit gateway.
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	case awsec2types.TransitGatewayAttachmentStateAvailable,
+		awsec2types.TransitGatewayAttachmentStateModifying:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.StatusMessage))
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.CompareTagsManualV1alpha1(cr.Spec.ForProvider.Tags, observed.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayPeeringAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	res, err := e.client.CreateTransitGatewayPeeringAttachment(ctx, ec2.GenerateCreateTransitGatewayPeeringAttachmentInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, aws.ToString(res.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayPeeringAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayPeeringAttachment)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteTransitGatewayPeeringAttachment(ctx, &awsec2.DeleteTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
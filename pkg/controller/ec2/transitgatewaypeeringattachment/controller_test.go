@@ -0,0 +1,441 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewaypeeringattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	attachmentID         = "tgw-attach-123"
+	transitGatewayID     = "tgw-123"
+	peerTransitGatewayID = "tgw-456"
+	peerAccountID        = "123456789012"
+	peerRegion           = "eu-west-1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TransitGatewayPeeringAttachmentClient
+	cr     *manualv1alpha1.TransitGatewayPeeringAttachment
+}
+
+type attachmentModifier func(*manualv1alpha1.TransitGatewayPeeringAttachment)
+
+func withExternalName(name string) attachmentModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachment) { meta.SetExternalName(r, name) }
+}
+
+func withTags(tags ...manualv1alpha1.Tag) attachmentModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachment) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withStatus(s manualv1alpha1.TransitGatewayPeeringAttachmentObservation) attachmentModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachment) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func attachment(m ...attachmentModifier) *manualv1alpha1.TransitGatewayPeeringAttachment {
+	cr := &manualv1alpha1.TransitGatewayPeeringAttachment{
+		Spec: manualv1alpha1.TransitGatewayPeeringAttachmentSpec{
+			ForProvider: manualv1alpha1.TransitGatewayPeeringAttachmentParameters{
+				TransitGatewayID:     aws.String(transitGatewayID),
+				PeerTransitGatewayID: aws.String(peerTransitGatewayID),
+				PeerAccountID:        peerAccountID,
+				PeerRegion:           peerRegion,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.TransitGatewayAttachmentState, tags ...awsec2types.Tag) func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+		return &awsec2.DescribeTransitGatewayPeeringAttachmentsOutput{
+			TransitGatewayPeeringAttachments: []awsec2types.TransitGatewayPeeringAttachment{{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				State:                      state,
+				Tags:                       tags,
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TransitGatewayPeeringAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateAvailable),
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "available",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateAvailable),
+				},
+				cr: attachment(withExternalName(attachmentID), withTags(manualv1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withTags(manualv1alpha1.Tag{Key: "k", Value: "v"}),
+					withStatus(manualv1alpha1.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "available",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStatePendingAcceptance),
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "pendingAcceptance",
+					}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Rejected": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateRejected),
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayPeeringAttachmentObservation{
+						TransitGatewayAttachmentID: attachmentID,
+						State:                      "rejected",
+					}),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateDeleted),
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentIDNotFound}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID)),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{},
+				cr:     attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayPeeringAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockCreatePeeringAttachment: func(ctx context.Context, input *awsec2.CreateTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.CreateTransitGatewayPeeringAttachmentOutput, error) {
+						if diff := cmp.Diff(peerAccountID, aws.ToString(input.PeerAccountId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateTransitGatewayPeeringAttachmentOutput{
+							TransitGatewayPeeringAttachment: &awsec2types.TransitGatewayPeeringAttachment{
+								TransitGatewayAttachmentId: aws.String(attachmentID),
+							},
+						}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockCreatePeeringAttachment: func(ctx context.Context, input *awsec2.CreateTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.CreateTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateAvailable,
+						awsec2types.Tag{Key: aws.String("old"), Value: aws.String("v")}),
+					MockDeleteTags: func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+						return &awsec2.DeleteTagsOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withTags(manualv1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"CreateTagsFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateAvailable),
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withExternalName(attachmentID), withTags(manualv1alpha1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errCreateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayPeeringAttachment
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeletePeeringAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return &awsec2.DeleteTransitGatewayPeeringAttachmentOutput{}, nil
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeletePeeringAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentIDNotFound}
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: attachment(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeletePeeringAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  attachment(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
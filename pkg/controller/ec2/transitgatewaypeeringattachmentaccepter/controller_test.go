@@ -0,0 +1,304 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewaypeeringattachmentaccepter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	attachmentID = "tgw-attach-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TransitGatewayPeeringAttachmentAccepterClient
+	cr     *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter
+}
+
+type accepterModifier func(*manualv1alpha1.TransitGatewayPeeringAttachmentAccepter)
+
+func withExternalName(name string) accepterModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter) { meta.SetExternalName(r, name) }
+}
+
+func withStatus(s manualv1alpha1.TransitGatewayPeeringAttachmentAccepterObservation) accepterModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) accepterModifier {
+	return func(r *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter) {
+		r.Status.ConditionedStatus.Conditions = c
+	}
+}
+
+func accepter(m ...accepterModifier) *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter {
+	cr := &manualv1alpha1.TransitGatewayPeeringAttachmentAccepter{
+		Spec: manualv1alpha1.TransitGatewayPeeringAttachmentAccepterSpec{
+			ForProvider: manualv1alpha1.TransitGatewayPeeringAttachmentAccepterParameters{
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.TransitGatewayAttachmentState) func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+		return &awsec2.DescribeTransitGatewayPeeringAttachmentsOutput{
+			TransitGatewayPeeringAttachments: []awsec2types.TransitGatewayPeeringAttachment{{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				State:                      state,
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStateAvailable),
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayPeeringAttachmentAccepterObservation{State: "available"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStatePending),
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayPeeringAttachmentAccepterObservation{State: "pending"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: describe(awsec2types.TransitGatewayAttachmentStatePendingAcceptance),
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentIDNotFound}
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribePeeringAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayPeeringAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayPeeringAttachmentsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockAcceptPeeringAttachment: func(ctx context.Context, input *awsec2.AcceptTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+						return &awsec2.AcceptTransitGatewayPeeringAttachmentOutput{}, nil
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID)),
+			},
+		},
+		"AcceptFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockAcceptPeeringAttachment: func(ctx context.Context, input *awsec2.AcceptTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.AcceptTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: errorutils.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayPeeringAttachmentAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeletePeeringAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return &awsec2.DeleteTransitGatewayPeeringAttachmentOutput{}, nil
+					},
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeletePeeringAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayPeeringAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayPeeringAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  accepter(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetableassociation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayRouteTableAssociation resource"
+
+	errDescribe           = "failed to describe the associations of the TransitGatewayRouteTable"
+	errDescribeAttachment = "failed to describe the TransitGatewayAttachment"
+	errAssociate          = "failed to associate the TransitGatewayAttachment with the TransitGatewayRouteTable"
+	errDisassociate       = "failed to disassociate the TransitGatewayAttachment from the TransitGatewayRouteTable"
+	errReplace            = "failed to disassociate the TransitGatewayAttachment from its current TransitGatewayRouteTable"
+)
+
+// SetupTransitGatewayRouteTableAssociation adds a controller that reconciles
+// TransitGatewayRouteTableAssociations.
+func SetupTransitGatewayRouteTableAssociation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.TransitGatewayRouteTableAssociationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayRouteTableAssociationClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.TransitGatewayRouteTableAssociationGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.TransitGatewayRouteTableAssociation{}, &manualv1alpha1.TransitGatewayRouteTableAssociationList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.TransitGatewayRouteTableAssociation{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the references to the route table and the VPC
+// attachment.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayRouteTableAssociation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	r := reference.NewAPIResolver(c, cr)
+
+	rsp, err := r.Resolve(ctx, ec2.TransitGatewayRouteTableIDReference(cr.Spec.ForProvider.TransitGatewayRouteTableID, cr.Spec.ForProvider.TransitGatewayRouteTableIDRef, cr.Spec.ForProvider.TransitGatewayRouteTableIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayRouteTableId")
+	}
+	cr.Spec.ForProvider.TransitGatewayRouteTableID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.TransitGatewayRouteTableIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, ec2.TransitGatewayVPCAttachmentIDReference(cr.Spec.ForProvider.TransitGatewayAttachmentID, cr.Spec.ForProvider.TransitGatewayAttachmentIDRef, cr.Spec.ForProvider.TransitGatewayAttachmentIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayAttachmentId")
+	}
+	cr.Spec.ForProvider.TransitGatewayAttachmentID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.TransitGatewayAttachmentIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayRouteTableAssociationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayRouteTableAssociation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayRouteTableAssociationClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayRouteTableAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	association, err := ec2.GetTransitGatewayRouteTableAssociation(ctx, e.client, aws.ToString(cr.Spec.ForProvider.TransitGatewayRouteTableID), aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteTableNotFoundErr, err), errDescribe)
+	}
+	if association == nil || association.State == awsec2types.TransitGatewayAssociationStateDisassociated {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = manualv1alpha1.TransitGatewayRouteTableAssociationObservation{
+		State:        string(association.State),
+		ResourceID:   aws.ToString(association.ResourceId),
+		ResourceType: string(association.ResourceType),
+	}
+
+	switch association.State { //nolint:exhaustive
+	case awsec2types.TransitGatewayAssociationStateAssociating:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.TransitGatewayAssociationStateDisassociating:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// All parameters of an association are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayRouteTableAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if aws.ToBool(cr.Spec.ForProvider.ReplaceExistingAssociation) {
+		if err := e.disassociateExisting(ctx, cr.Spec.ForProvider); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	if _, err := e.client.AssociateTransitGatewayRouteTable(ctx, &awsec2.AssociateTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errAssociate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	return managed.ExternalCreation{}, nil
+}
+
+// disassociateExisting disassociates the attachment from the route table it
+// is currently associated with, if that is not the desired one. The
+// association fails until the disassociation is completed and is retried by
+// the next reconciliation.
+func (e *external) disassociateExisting(ctx context.Context, p manualv1alpha1.TransitGatewayRouteTableAssociationParameters) error {
+	res, err := e.client.DescribeTransitGatewayAttachments(ctx, &awsec2.DescribeTransitGatewayAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{aws.ToString(p.TransitGatewayAttachmentID)},
+	})
+	if err != nil {
+		return errorutils.Wrap(err, errDescribeAttachment)
+	}
+	for _, a := range res.TransitGatewayAttachments {
+		if a.Association == nil ||
+			a.Association.State != awsec2types.TransitGatewayAssociationStateAssociated ||
+			aws.ToString(a.Association.TransitGatewayRouteTableId) == aws.ToString(p.TransitGatewayRouteTableID) {
+			continue
+		}
+		if _, err := e.client.DisassociateTransitGatewayRouteTable(ctx, &awsec2.DisassociateTransitGatewayRouteTableInput{
+			TransitGatewayRouteTableId: a.Association.TransitGatewayRouteTableId,
+			TransitGatewayAttachmentId: a.TransitGatewayAttachmentId,
+		}); err != nil {
+			return errorutils.Wrap(err, errReplace)
+		}
+	}
+	return nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayRouteTableAssociation)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The attachment is already being disassociated.
+	if cr.Status.AtProvider.State == string(awsec2types.TransitGatewayAssociationStateDisassociating) {
+		return managed.ExternalDelete{}, nil
+	}
+
+	_, err := e.client.DisassociateTransitGatewayRouteTable(ctx, &awsec2.DisassociateTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(isNotFound, err), errDisassociate)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return ec2.IsTransitGatewayRouteTableNotFoundErr(err) || ec2.IsTransitGatewayAttachmentNotFoundErr(err)
+}
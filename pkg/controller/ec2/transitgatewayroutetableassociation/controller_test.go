@@ -0,0 +1,387 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetableassociation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	routeTableID = "tgw-rtb-123"
+	attachmentID = "tgw-attach-123"
+	vpcID        = "vpc-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TransitGatewayRouteTableAssociationClient
+	cr     *manualv1alpha1.TransitGatewayRouteTableAssociation
+}
+
+type associationModifier func(*manualv1alpha1.TransitGatewayRouteTableAssociation)
+
+func withExternalName(name string) associationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTableAssociation) { meta.SetExternalName(r, name) }
+}
+
+func withReplaceExistingAssociation() associationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTableAssociation) {
+		r.Spec.ForProvider.ReplaceExistingAssociation = aws.Bool(true)
+	}
+}
+
+func withStatus(s manualv1alpha1.TransitGatewayRouteTableAssociationObservation) associationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTableAssociation) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTableAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func association(m ...associationModifier) *manualv1alpha1.TransitGatewayRouteTableAssociation {
+	cr := &manualv1alpha1.TransitGatewayRouteTableAssociation{
+		Spec: manualv1alpha1.TransitGatewayRouteTableAssociationSpec{
+			ForProvider: manualv1alpha1.TransitGatewayRouteTableAssociationParameters{
+				TransitGatewayRouteTableID: aws.String(routeTableID),
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getAssociations(associations ...awsec2types.TransitGatewayRouteTableAssociation) func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTableAssociationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTableAssociationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+		return &awsec2.GetTransitGatewayRouteTableAssociationsOutput{Associations: associations}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TransitGatewayRouteTableAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Associated": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetAssociations: getAssociations(awsec2types.TransitGatewayRouteTableAssociation{
+						TransitGatewayAttachmentId: aws.String(attachmentID),
+						ResourceId:                 aws.String(vpcID),
+						ResourceType:               awsec2types.TransitGatewayAttachmentResourceTypeVpc,
+						State:                      awsec2types.TransitGatewayAssociationStateAssociated,
+					}),
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTableAssociationObservation{
+						State:        "associated",
+						ResourceID:   vpcID,
+						ResourceType: "vpc",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Associating": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetAssociations: getAssociations(awsec2types.TransitGatewayRouteTableAssociation{
+						TransitGatewayAttachmentId: aws.String(attachmentID),
+						State:                      awsec2types.TransitGatewayAssociationStateAssociating,
+					}),
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTableAssociationObservation{State: "associating"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetAssociations: getAssociations(),
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID)),
+			},
+		},
+		"RouteTableNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetAssociations: func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTableAssociationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayRouteTableIDNotFound}
+					},
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetAssociations: func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTableAssociationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTableAssociationsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  association(withExternalName(attachmentID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayRouteTableAssociation
+		err error
+	}
+
+	associate := func(ctx context.Context, input *awsec2.AssociateTransitGatewayRouteTableInput, opts []func(*awsec2.Options)) (*awsec2.AssociateTransitGatewayRouteTableOutput, error) {
+		return &awsec2.AssociateTransitGatewayRouteTableOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockAssociate: associate,
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID)),
+			},
+		},
+		"ReplaceExistingAssociation": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayAttachmentsOutput, error) {
+						return &awsec2.DescribeTransitGatewayAttachmentsOutput{
+							TransitGatewayAttachments: []awsec2types.TransitGatewayAttachment{{
+								TransitGatewayAttachmentId: aws.String(attachmentID),
+								Association: &awsec2types.TransitGatewayAttachmentAssociation{
+									TransitGatewayRouteTableId: aws.String("tgw-rtb-default"),
+									State:                      awsec2types.TransitGatewayAssociationStateAssociated,
+								},
+							}},
+						}, nil
+					},
+					MockDisassociate: func(ctx context.Context, input *awsec2.DisassociateTransitGatewayRouteTableInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateTransitGatewayRouteTableOutput, error) {
+						if diff := cmp.Diff("tgw-rtb-default", aws.ToString(input.TransitGatewayRouteTableId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.DisassociateTransitGatewayRouteTableOutput{}, nil
+					},
+					MockAssociate: associate,
+				},
+				cr: association(withReplaceExistingAssociation()),
+			},
+			want: want{
+				cr: association(withReplaceExistingAssociation(), withExternalName(attachmentID)),
+			},
+		},
+		"ReplaceFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayAttachmentsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withReplaceExistingAssociation()),
+			},
+			want: want{
+				cr:  association(withReplaceExistingAssociation()),
+				err: errorutils.Wrap(errBoom, errDescribeAttachment),
+			},
+		},
+		"AssociateFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockAssociate: func(ctx context.Context, input *awsec2.AssociateTransitGatewayRouteTableInput, opts []func(*awsec2.Options)) (*awsec2.AssociateTransitGatewayRouteTableOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: errorutils.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayRouteTableAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDisassociate: func(ctx context.Context, input *awsec2.DisassociateTransitGatewayRouteTableInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateTransitGatewayRouteTableOutput, error) {
+						return &awsec2.DisassociateTransitGatewayRouteTableOutput{}, nil
+					},
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDisassociating": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{},
+				cr: association(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTableAssociationObservation{State: "disassociating"})),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTableAssociationObservation{State: "disassociating"}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AttachmentNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDisassociate: func(ctx context.Context, input *awsec2.DisassociateTransitGatewayRouteTableInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateTransitGatewayRouteTableOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentIDNotFound}
+					},
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: association(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisassociateFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDisassociate: func(ctx context.Context, input *awsec2.DisassociateTransitGatewayRouteTableInput, opts []func(*awsec2.Options)) (*awsec2.DisassociateTransitGatewayRouteTableOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  association(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDisassociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
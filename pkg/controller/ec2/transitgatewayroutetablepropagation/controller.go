@@ -0,0 +1,234 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetablepropagation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayRouteTablePropagation resource"
+
+	errDescribe = "failed to describe the propagations of the TransitGatewayRouteTable"
+	errEnable   = "failed to enable the propagation of the TransitGatewayAttachment"
+	errDisable  = "failed to disable the propagation of the TransitGatewayAttachment"
+)
+
+// SetupTransitGatewayRouteTablePropagation adds a controller that reconciles
+// TransitGatewayRouteTablePropagations.
+func SetupTransitGatewayRouteTablePropagation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.TransitGatewayRouteTablePropagationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayRouteTablePropagationClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.TransitGatewayRouteTablePropagationGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.TransitGatewayRouteTablePropagation{}, &manualv1alpha1.TransitGatewayRouteTablePropagationList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.TransitGatewayRouteTablePropagation{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the references to the route table and the VPC
+// attachment.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	r := reference.NewAPIResolver(c, cr)
+
+	rsp, err := r.Resolve(ctx, ec2.TransitGatewayRouteTableIDReference(cr.Spec.ForProvider.TransitGatewayRouteTableID, cr.Spec.ForProvider.TransitGatewayRouteTableIDRef, cr.Spec.ForProvider.TransitGatewayRouteTableIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayRouteTableId")
+	}
+	cr.Spec.ForProvider.TransitGatewayRouteTableID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.TransitGatewayRouteTableIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, ec2.TransitGatewayVPCAttachmentIDReference(cr.Spec.ForProvider.TransitGatewayAttachmentID, cr.Spec.ForProvider.TransitGatewayAttachmentIDRef, cr.Spec.ForProvider.TransitGatewayAttachmentIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayAttachmentId")
+	}
+	cr.Spec.ForProvider.TransitGatewayAttachmentID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.TransitGatewayAttachmentIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayRouteTablePropagationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayRouteTablePropagationClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	propagation, err := ec2.GetTransitGatewayRouteTablePropagation(ctx, e.client, aws.ToString(cr.Spec.ForProvider.TransitGatewayRouteTableID), aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsTransitGatewayRouteTableNotFoundErr, err), errDescribe)
+	}
+	if propagation == nil || propagation.State == awsec2types.TransitGatewayPropagationStateDisabled {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = manualv1alpha1.TransitGatewayRouteTablePropagationObservation{
+		State:        string(propagation.State),
+		ResourceID:   aws.ToString(propagation.ResourceId),
+		ResourceType: string(propagation.ResourceType),
+	}
+
+	switch propagation.State { //nolint:exhaustive
+	case awsec2types.TransitGatewayPropagationStateEnabling:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.TransitGatewayPropagationStateDisabling:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// All parameters of a propagation are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if _, err := e.client.EnableTransitGatewayRouteTablePropagation(ctx, &awsec2.EnableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errEnable)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayRouteTablePropagation)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The propagation is already being disabled.
+	if cr.Status.AtProvider.State == string(awsec2types.TransitGatewayPropagationStateDisabling) {
+		return managed.ExternalDelete{}, nil
+	}
+
+	_, err := e.client.DisableTransitGatewayRouteTablePropagation(ctx, &awsec2.DisableTransitGatewayRouteTablePropagationInput{
+		TransitGatewayRouteTableId: cr.Spec.ForProvider.TransitGatewayRouteTableID,
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(isNotFound, err), errDisable)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
+
+func isNotFound(err error) bool {
+	return ec2.IsTransitGatewayRouteTableNotFoundErr(err) || ec2.IsTransitGatewayAttachmentNotFoundErr(err)
+}
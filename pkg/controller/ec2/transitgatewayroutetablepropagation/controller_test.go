@@ -0,0 +1,339 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayroutetablepropagation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	routeTableID = "tgw-rtb-123"
+	attachmentID = "tgw-attach-123"
+	vpcID        = "vpc-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TransitGatewayRouteTablePropagationClient
+	cr     *manualv1alpha1.TransitGatewayRouteTablePropagation
+}
+
+type propagationModifier func(*manualv1alpha1.TransitGatewayRouteTablePropagation)
+
+func withExternalName(name string) propagationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTablePropagation) { meta.SetExternalName(r, name) }
+}
+
+func withStatus(s manualv1alpha1.TransitGatewayRouteTablePropagationObservation) propagationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTablePropagation) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) propagationModifier {
+	return func(r *manualv1alpha1.TransitGatewayRouteTablePropagation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func propagation(m ...propagationModifier) *manualv1alpha1.TransitGatewayRouteTablePropagation {
+	cr := &manualv1alpha1.TransitGatewayRouteTablePropagation{
+		Spec: manualv1alpha1.TransitGatewayRouteTablePropagationSpec{
+			ForProvider: manualv1alpha1.TransitGatewayRouteTablePropagationParameters{
+				TransitGatewayRouteTableID: aws.String(routeTableID),
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPropagations(propagations ...awsec2types.TransitGatewayRouteTablePropagation) func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTablePropagationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTablePropagationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+		return &awsec2.GetTransitGatewayRouteTablePropagationsOutput{TransitGatewayRouteTablePropagations: propagations}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TransitGatewayRouteTablePropagation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Enabled": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetPropagations: getPropagations(awsec2types.TransitGatewayRouteTablePropagation{
+						TransitGatewayAttachmentId: aws.String(attachmentID),
+						ResourceId:                 aws.String(vpcID),
+						ResourceType:               awsec2types.TransitGatewayAttachmentResourceTypeVpc,
+						State:                      awsec2types.TransitGatewayPropagationStateEnabled,
+					}),
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTablePropagationObservation{
+						State:        "enabled",
+						ResourceID:   vpcID,
+						ResourceType: "vpc",
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Enabling": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetPropagations: getPropagations(awsec2types.TransitGatewayRouteTablePropagation{
+						TransitGatewayAttachmentId: aws.String(attachmentID),
+						State:                      awsec2types.TransitGatewayPropagationStateEnabling,
+					}),
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTablePropagationObservation{State: "enabling"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotEnabled": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetPropagations: getPropagations(),
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID)),
+			},
+		},
+		"RouteTableNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetPropagations: func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTablePropagationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayRouteTableIDNotFound}
+					},
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockGetPropagations: func(ctx context.Context, input *awsec2.GetTransitGatewayRouteTablePropagationsInput, opts []func(*awsec2.Options)) (*awsec2.GetTransitGatewayRouteTablePropagationsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  propagation(withExternalName(attachmentID)),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayRouteTablePropagation
+		err error
+	}
+
+	enable := func(ctx context.Context, input *awsec2.EnableTransitGatewayRouteTablePropagationInput, opts []func(*awsec2.Options)) (*awsec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
+		return &awsec2.EnableTransitGatewayRouteTablePropagationOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockEnablePropagation: enable,
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID)),
+			},
+		},
+		"EnableFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockEnablePropagation: func(ctx context.Context, input *awsec2.EnableTransitGatewayRouteTablePropagationInput, opts []func(*awsec2.Options)) (*awsec2.EnableTransitGatewayRouteTablePropagationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: propagation(),
+			},
+			want: want{
+				cr:  propagation(),
+				err: errorutils.Wrap(errBoom, errEnable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayRouteTablePropagation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDisablePropagation: func(ctx context.Context, input *awsec2.DisableTransitGatewayRouteTablePropagationInput, opts []func(*awsec2.Options)) (*awsec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+						return &awsec2.DisableTransitGatewayRouteTablePropagationOutput{}, nil
+					},
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDisabling": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{},
+				cr: propagation(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTablePropagationObservation{State: "disabling"})),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayRouteTablePropagationObservation{State: "disabling"}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AttachmentNotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDisablePropagation: func(ctx context.Context, input *awsec2.DisableTransitGatewayRouteTablePropagationInput, opts []func(*awsec2.Options)) (*awsec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentIDNotFound}
+					},
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: propagation(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisableFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDisablePropagation: func(ctx context.Context, input *awsec2.DisableTransitGatewayRouteTablePropagationInput, opts []func(*awsec2.Options)) (*awsec2.DisableTransitGatewayRouteTablePropagationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: propagation(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  propagation(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDisable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
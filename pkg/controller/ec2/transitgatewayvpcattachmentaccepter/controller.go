@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachmentaccepter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayVPCAttachmentAccepter resource"
+
+	errDescribe      = "failed to describe the TransitGatewayVPCAttachment"
+	errMultipleItems = "retrieved multiple TransitGatewayVPCAttachments for the given transitGatewayAttachmentId"
+	errAccept        = "failed to accept the TransitGatewayVPCAttachment"
+	errDelete        = "failed to delete the TransitGatewayVPCAttachment"
+	errCreateTags    = "failed to create tags for the TransitGatewayVPCAttachment"
+	errDeleteTags    = "failed to delete tags for the TransitGatewayVPCAttachment"
+)
+
+// SetupTransitGatewayVPCAttachmentAccepter adds a controller that
+// reconciles TransitGatewayVPCAttachmentAccepters.
+func SetupTransitGatewayVPCAttachmentAccepter(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.TransitGatewayVPCAttachmentAccepterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayVPCAttachmentAccepterClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.TransitGatewayVPCAttachmentAccepterGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.TransitGatewayVPCAttachmentAccepter{}, &manualv1alpha1.TransitGatewayVPCAttachmentAccepterList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.TransitGatewayVPCAttachmentAccepter{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the reference to the VPC attachment.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, ec2.TransitGatewayVPCAttachmentIDReference(cr.Spec.ForProvider.TransitGatewayAttachmentID, cr.Spec.ForProvider.TransitGatewayAttachmentIDRef, cr.Spec.ForProvider.TransitGatewayAttachmentIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.transitGatewayAttachmentId")
+	}
+	cr.Spec.ForProvider.TransitGatewayAttachmentID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.TransitGatewayAttachmentIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.TransitGatewayVPCAttachmentAccepterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayVPCAttachmentAccepterClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.TransitGatewayVpcAttachment, error) {
+	res, err := e.client.DescribeTransitGatewayVpcAttachments(ctx, &awsec2.DescribeTransitGatewayVpcAttachmentsInput{
+		TransitGatewayAttachmentIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.TransitGatewayVpcAttachments) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.TransitGatewayVpcAttachments[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDescribe)
+	}
+
+	// An attachment that is pending acceptance is created by accepting it.
+	if observed.State == awsec2types.TransitGatewayAttachmentStatePendingAcceptance || ec2.IsTransitGatewayAttachmentGone(observed.State) {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateTransitGatewayVPCAttachmentAccepterObservation(*observed)
+
+	switch observed.State { //nolint:exhaustive
+	case awsec2types.TransitGatewayAttachmentStateInitiating,
+		awsec2types.TransitGatewayAttachmentStateInitiatingRequest,
+		awsec2types.TransitGatewayAttachmentStatePending:
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	case awsec2types.TransitGatewayAttachmentStateAvailable,
+		awsec2types.TransitGatewayAttachmentStateModifying:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ec2.CompareTagsManualV1alpha1(cr.Spec.ForProvider.Tags, observed.Tags),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if _, err := e.client.AcceptTransitGatewayVpcAttachment(ctx, &awsec2.AcceptTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errAccept)
+	}
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.ToString(cr.Spec.ForProvider.TransitGatewayAttachmentID)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The owner of the transit gateway can delete the attachments of other
+	// accounts.
+	_, err := e.client.DeleteTransitGatewayVpcAttachment(ctx, &awsec2.DeleteTransitGatewayVpcAttachmentInput{
+		TransitGatewayAttachmentId: cr.Spec.ForProvider.TransitGatewayAttachmentID,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsTransitGatewayAttachmentNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachmentaccepter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	attachmentID = "tgw-attach-123"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.TransitGatewayVPCAttachmentAccepterClient
+	cr     *manualv1alpha1.TransitGatewayVPCAttachmentAccepter
+}
+
+type accepterModifier func(*manualv1alpha1.TransitGatewayVPCAttachmentAccepter)
+
+func withExternalName(name string) accepterModifier {
+	return func(r *manualv1alpha1.TransitGatewayVPCAttachmentAccepter) { meta.SetExternalName(r, name) }
+}
+
+func withStatus(s manualv1alpha1.TransitGatewayVPCAttachmentAccepterObservation) accepterModifier {
+	return func(r *manualv1alpha1.TransitGatewayVPCAttachmentAccepter) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) accepterModifier {
+	return func(r *manualv1alpha1.TransitGatewayVPCAttachmentAccepter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func accepter(m ...accepterModifier) *manualv1alpha1.TransitGatewayVPCAttachmentAccepter {
+	cr := &manualv1alpha1.TransitGatewayVPCAttachmentAccepter{
+		Spec: manualv1alpha1.TransitGatewayVPCAttachmentAccepterSpec{
+			ForProvider: manualv1alpha1.TransitGatewayVPCAttachmentAccepterParameters{
+				TransitGatewayAttachmentID: aws.String(attachmentID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.TransitGatewayAttachmentState) func(ctx context.Context, input *awsec2.DescribeTransitGatewayVpcAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeTransitGatewayVpcAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+		return &awsec2.DescribeTransitGatewayVpcAttachmentsOutput{
+			TransitGatewayVpcAttachments: []awsec2types.TransitGatewayVpcAttachment{{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				State:                      state,
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.TransitGatewayVPCAttachmentAccepter
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeVpcAttachments: describe(awsec2types.TransitGatewayAttachmentStateAvailable),
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayVPCAttachmentAccepterObservation{State: "available"}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeVpcAttachments: describe(awsec2types.TransitGatewayAttachmentStatePending),
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID),
+					withStatus(manualv1alpha1.TransitGatewayVPCAttachmentAccepterObservation{State: "pending"}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeVpcAttachments: describe(awsec2types.TransitGatewayAttachmentStatePendingAcceptance),
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeVpcAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayVpcAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.TransitGatewayAttachmentIDNotFound}
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDescribeVpcAttachments: func(ctx context.Context, input *awsec2.DescribeTransitGatewayVpcAttachmentsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeTransitGatewayVpcAttachmentsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayVPCAttachmentAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockAcceptVpcAttachment: func(ctx context.Context, input *awsec2.AcceptTransitGatewayVpcAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.AcceptTransitGatewayVpcAttachmentOutput, error) {
+						return &awsec2.AcceptTransitGatewayVpcAttachmentOutput{}, nil
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID)),
+			},
+		},
+		"AcceptFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockAcceptVpcAttachment: func(ctx context.Context, input *awsec2.AcceptTransitGatewayVpcAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.AcceptTransitGatewayVpcAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: errorutils.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.TransitGatewayVPCAttachmentAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeleteVpcAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayVpcAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+						return &awsec2.DeleteTransitGatewayVpcAttachmentOutput{}, nil
+					},
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockTransitGatewayClient{
+					MockDeleteVpcAttachment: func(ctx context.Context, input *awsec2.DeleteTransitGatewayVpcAttachmentInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTransitGatewayVpcAttachmentOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withExternalName(attachmentID)),
+			},
+			want: want{
+				cr:  accepter(withExternalName(attachmentID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
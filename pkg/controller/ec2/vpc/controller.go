@@ -24,15 +24,17 @@ import (
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
@@ -40,7 +42,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -71,13 +76,13 @@ func SetupVPC(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCClient}),
 		managed.WithCreationGracePeriod(3 * time.Minute),
-		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
 		managed.WithConnectionPublishers(),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -88,14 +93,35 @@ func SetupVPC(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.VPCGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.VPC{}, &v1beta1.VPCList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.VPC{}).
+		For(&v1beta1.VPC{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
+// resolveReferences resolves the reference to the IPAM pool. The other
+// references are generated.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.VPC)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, ec2.IPAMPoolIDReference(cr.Spec.ForProvider.IPv4IPAMPoolID, cr.Spec.ForProvider.IPv4IPAMPoolIDRef, cr.Spec.ForProvider.IPv4IPAMPoolIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ipv4IpamPoolId")
+	}
+	cr.Spec.ForProvider.IPv4IPAMPoolID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.IPv4IPAMPoolIDRef = rsp.ResolvedReference
+	return nil
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) ec2.VPCClient
@@ -211,7 +237,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	result, err := e.client.CreateVpc(ctx, &awsec2.CreateVpcInput{
-		CidrBlock:                   aws.String(cr.Spec.ForProvider.CIDRBlock),
+		CidrBlock:                   pointer.ToOrNilIfZeroValue(cr.Spec.ForProvider.CIDRBlock),
+		Ipv4IpamPoolId:              cr.Spec.ForProvider.IPv4IPAMPoolID,
+		Ipv4NetmaskLength:           cr.Spec.ForProvider.IPv4NetmaskLength,
 		Ipv6CidrBlock:               cr.Spec.ForProvider.Ipv6CIDRBlock,
 		AmazonProvidedIpv6CidrBlock: cr.Spec.ForProvider.AmazonProvidedIpv6CIDRBlock,
 		Ipv6Pool:                    cr.Spec.ForProvider.Ipv6Pool,
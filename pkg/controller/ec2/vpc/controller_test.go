@@ -31,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
@@ -237,6 +238,37 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulFromIPAMPool": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpcInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpcOutput, error) {
+						if input.CidrBlock != nil {
+							t.Errorf("CidrBlock must not be set when allocating from an IPAM pool")
+						}
+						if diff := cmp.Diff("ipam-pool-123", aws.ToString(input.Ipv4IpamPoolId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.CreateVpcOutput{
+							Vpc: &awsec2types.Vpc{
+								VpcId:     aws.String(vpcID),
+								CidrBlock: aws.String(cidr),
+							},
+						}, nil
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					IPv4IPAMPoolID:    aws.String("ipam-pool-123"),
+					IPv4NetmaskLength: aws.Int32(16),
+				})),
+			},
+			want: want{
+				cr: vpc(withExternalName(vpcID), withSpec(v1beta1.VPCParameters{
+					IPv4IPAMPoolID:    aws.String("ipam-pool-123"),
+					IPv4NetmaskLength: aws.Int32(16),
+				})),
+				result: managed.ExternalCreation{},
+			},
+		},
 		"SuccessfulWithAttributes": {
 			args: args{
 				vpc: &fake.MockVPCClient{
@@ -443,3 +475,73 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveReferences(t *testing.T) {
+	poolID := "ipam-pool-123"
+
+	type want struct {
+		cr  *v1beta1.VPC
+		err bool
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		cr   *v1beta1.VPC
+		want want
+	}{
+		"ResolveIPAMPool": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					meta.SetExternalName(obj.(*manualv1alpha1.IPAMPool), poolID)
+					return nil
+				},
+			},
+			cr: vpc(withSpec(v1beta1.VPCParameters{
+				IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+			})),
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					IPv4IPAMPoolID:    &poolID,
+					IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+				})),
+			},
+		},
+		"IPAMPoolNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: vpc(withSpec(v1beta1.VPCParameters{
+				IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+			})),
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+				})),
+				err: true,
+			},
+		},
+		"NoReference": {
+			kube: &test.MockClient{},
+			cr: vpc(withSpec(v1beta1.VPCParameters{
+				CIDRBlock: "10.0.0.0/16",
+			})),
+			want: want{
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					CIDRBlock: "10.0.0.0/16",
+				})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := resolveReferences(context.Background(), tc.kube, tc.cr)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("resolveReferences(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -25,14 +25,17 @@ import (
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	awsgo "github.com/aws/aws-sdk-go/aws"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
@@ -40,7 +43,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -65,13 +70,13 @@ func SetupVPCCIDRBlock(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCCIDRBlockClient}),
 		managed.WithCreationGracePeriod(3 * time.Minute),
-		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
 		managed.WithConnectionPublishers(),
 		managed.WithInitializers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -82,14 +87,35 @@ func SetupVPCCIDRBlock(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.VPCCIDRBlockGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.VPCCIDRBlock{}, &v1beta1.VPCCIDRBlockList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.VPCCIDRBlock{}).
+		For(&v1beta1.VPCCIDRBlock{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
+// resolveReferences resolves the reference to the IPAM pool. The other
+// references are generated.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.VPCCIDRBlock)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, ec2.IPAMPoolIDReference(cr.Spec.ForProvider.IPv4IPAMPoolID, cr.Spec.ForProvider.IPv4IPAMPoolIDRef, cr.Spec.ForProvider.IPv4IPAMPoolIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ipv4IpamPoolId")
+	}
+	cr.Spec.ForProvider.IPv4IPAMPoolID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.IPv4IPAMPoolIDRef = rsp.ResolvedReference
+	return nil
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) ec2.VPCCIDRBlockClient
@@ -167,9 +193,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.Status.AtProvider = ec2.GenerateVpcCIDRBlockObservation(meta.GetExternalName(cr), observed)
 
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeVPCCIDRBlock(&cr.Spec.ForProvider, cr.Status.AtProvider)
+
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
 	}, nil
 }
 
@@ -182,6 +212,8 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	result, err := e.client.AssociateVpcCidrBlock(ctx, &awsec2.AssociateVpcCidrBlockInput{
 		AmazonProvidedIpv6CidrBlock:     cr.Spec.ForProvider.AmazonProvidedIPv6CIDRBlock,
 		CidrBlock:                       cr.Spec.ForProvider.CIDRBlock,
+		Ipv4IpamPoolId:                  cr.Spec.ForProvider.IPv4IPAMPoolID,
+		Ipv4NetmaskLength:               cr.Spec.ForProvider.IPv4NetmaskLength,
 		Ipv6CidrBlock:                   cr.Spec.ForProvider.IPv6CIDRBlock,
 		Ipv6CidrBlockNetworkBorderGroup: cr.Spec.ForProvider.IPv6CIDRBlockNetworkBorderGroup,
 		Ipv6Pool:                        cr.Spec.ForProvider.IPv6Pool,
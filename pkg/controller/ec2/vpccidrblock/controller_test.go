@@ -31,6 +31,7 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
@@ -415,3 +416,73 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveReferences(t *testing.T) {
+	poolID := "ipam-pool-123"
+
+	type want struct {
+		cr  *v1beta1.VPCCIDRBlock
+		err bool
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		cr   *v1beta1.VPCCIDRBlock
+		want want
+	}{
+		"ResolveIPAMPool": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					meta.SetExternalName(obj.(*manualv1alpha1.IPAMPool), poolID)
+					return nil
+				},
+			},
+			cr: vpcCIDRBlock(withSpec(v1beta1.VPCCIDRBlockParameters{
+				IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+			})),
+			want: want{
+				cr: vpcCIDRBlock(withSpec(v1beta1.VPCCIDRBlockParameters{
+					IPv4IPAMPoolID:    &poolID,
+					IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+				})),
+			},
+		},
+		"IPAMPoolNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: vpcCIDRBlock(withSpec(v1beta1.VPCCIDRBlockParameters{
+				IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+			})),
+			want: want{
+				cr: vpcCIDRBlock(withSpec(v1beta1.VPCCIDRBlockParameters{
+					IPv4IPAMPoolIDRef: &xpv1.Reference{Name: "pool"},
+				})),
+				err: true,
+			},
+		},
+		"NoReference": {
+			kube: &test.MockClient{},
+			cr: vpcCIDRBlock(withSpec(v1beta1.VPCCIDRBlockParameters{
+				CIDRBlock: &cidr,
+			})),
+			want: want{
+				cr: vpcCIDRBlock(withSpec(v1beta1.VPCCIDRBlockParameters{
+					CIDRBlock: &cidr,
+				})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := resolveReferences(context.Background(), tc.kube, tc.cr)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("resolveReferences(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
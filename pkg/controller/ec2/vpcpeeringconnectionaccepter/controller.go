@@ -0,0 +1,282 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnectionaccepter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCPeeringConnectionAccepter resource"
+
+	errDescribe      = "failed to describe the VPCPeeringConnection"
+	errMultipleItems = "retrieved multiple VPCPeeringConnections for the given vpcPeeringConnectionId"
+	errAccept        = "failed to accept the VPCPeeringConnection"
+	errModifyOptions = "failed to modify the accepter options of the VPCPeeringConnection"
+	errDelete        = "failed to delete the VPCPeeringConnection"
+	errCreateTags    = "failed to create tags for the VPCPeeringConnection"
+	errDeleteTags    = "failed to delete tags for the VPCPeeringConnection"
+)
+
+// SetupVPCPeeringConnectionAccepter adds a controller that reconciles
+// VPCPeeringConnectionAccepters.
+func SetupVPCPeeringConnectionAccepter(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.VPCPeeringConnectionAccepterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVPCPeeringConnectionAccepterClient}),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.VPCPeeringConnectionAccepterGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.VPCPeeringConnectionAccepter{}, &manualv1alpha1.VPCPeeringConnectionAccepterList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.VPCPeeringConnectionAccepter{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// resolveReferences resolves the reference to the VPC peering connection.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*manualv1alpha1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, ec2.VPCPeeringConnectionIDReference(cr.Spec.ForProvider.VPCPeeringConnectionID, cr.Spec.ForProvider.VPCPeeringConnectionIDRef, cr.Spec.ForProvider.VPCPeeringConnectionIDSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.vpcPeeringConnectionId")
+	}
+	cr.Spec.ForProvider.VPCPeeringConnectionID = reference.ToPtrValue(rsp.ResolvedValue)
+	cr.Spec.ForProvider.VPCPeeringConnectionIDRef = rsp.ResolvedReference
+	return nil
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VPCPeeringConnectionAccepterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.VPCPeeringConnectionAccepterClient
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.VpcPeeringConnection, error) {
+	res, err := e.client.DescribeVpcPeeringConnections(ctx, &awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(res.VpcPeeringConnections) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &res.VpcPeeringConnections[0], nil
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, aws.ToString(cr.Spec.ForProvider.VPCPeeringConnectionID))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDescribe)
+	}
+
+	var code awsec2types.VpcPeeringConnectionStateReasonCode
+	if observed.Status != nil {
+		code = observed.Status.Code
+	}
+
+	switch code { //nolint:exhaustive
+	case awsec2types.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+		awsec2types.VpcPeeringConnectionStateReasonCodeDeleting,
+		awsec2types.VpcPeeringConnectionStateReasonCodeDeleted:
+		// A peering connection that is pending acceptance is created by
+		// accepting it.
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateVPCPeeringConnectionAccepterObservation(*observed)
+
+	switch code { //nolint:exhaustive
+	case awsec2types.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+		awsec2types.VpcPeeringConnectionStateReasonCodeProvisioning:
+		cr.SetConditions(xpv1.Creating())
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	case awsec2types.VpcPeeringConnectionStateReasonCodeActive:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.StatusMessage))
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: true,
+		}, nil
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ec2.CompareTagsManualV1alpha1(cr.Spec.ForProvider.Tags, observed.Tags) &&
+			ec2.IsVPCPeeringConnectionAccepterOptionsUpToDate(cr.Spec.ForProvider.PeeringOptions, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	if _, err := e.client.AcceptVpcPeeringConnection(ctx, &awsec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: cr.Spec.ForProvider.VPCPeeringConnectionID,
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errAccept)
+	}
+	meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.VPCPeeringConnectionID))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	id := aws.ToString(cr.Spec.ForProvider.VPCPeeringConnectionID)
+	observed, err := e.describe(ctx, id)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDescribe)
+	}
+
+	if !ec2.IsVPCPeeringConnectionAccepterOptionsUpToDate(cr.Spec.ForProvider.PeeringOptions, *observed) {
+		if _, err := e.client.ModifyVpcPeeringConnectionOptions(ctx, &awsec2.ModifyVpcPeeringConnectionOptionsInput{
+			VpcPeeringConnectionId: aws.String(id),
+			AccepterPeeringConnectionOptions: &awsec2types.PeeringConnectionOptionsRequest{
+				AllowDnsResolutionFromRemoteVpc: cr.Spec.ForProvider.PeeringOptions.AllowDNSResolutionFromRemoteVPC,
+			},
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errModifyOptions)
+		}
+	}
+
+	add, remove := ec2.DiffEC2Tags(ec2.GenerateEC2TagsManualV1alpha1(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{id},
+			Tags:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreateTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.VPCPeeringConnectionAccepter)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	// The owner of either VPC can delete an active peering connection.
+	_, err := e.client.DeleteVpcPeeringConnection(ctx, &awsec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: cr.Spec.ForProvider.VPCPeeringConnectionID,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(ec2.IsVPCPeeringConnectionNotFoundErr, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnectionaccepter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	peeringID = "pcx-123"
+	vpcID     = "vpc-123"
+	peerVPCID = "vpc-456"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.VPCPeeringConnectionAccepterClient
+	cr     *manualv1alpha1.VPCPeeringConnectionAccepter
+}
+
+type accepterModifier func(*manualv1alpha1.VPCPeeringConnectionAccepter)
+
+func withExternalName(name string) accepterModifier {
+	return func(r *manualv1alpha1.VPCPeeringConnectionAccepter) { meta.SetExternalName(r, name) }
+}
+
+func withDNSResolution(v bool) accepterModifier {
+	return func(r *manualv1alpha1.VPCPeeringConnectionAccepter) {
+		r.Spec.ForProvider.PeeringOptions = &manualv1alpha1.VPCPeeringConnectionAccepterOptions{
+			AllowDNSResolutionFromRemoteVPC: aws.Bool(v),
+		}
+	}
+}
+
+func withStatus(s manualv1alpha1.VPCPeeringConnectionAccepterObservation) accepterModifier {
+	return func(r *manualv1alpha1.VPCPeeringConnectionAccepter) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) accepterModifier {
+	return func(r *manualv1alpha1.VPCPeeringConnectionAccepter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func accepter(m ...accepterModifier) *manualv1alpha1.VPCPeeringConnectionAccepter {
+	cr := &manualv1alpha1.VPCPeeringConnectionAccepter{
+		Spec: manualv1alpha1.VPCPeeringConnectionAccepterSpec{
+			ForProvider: manualv1alpha1.VPCPeeringConnectionAccepterParameters{
+				VPCPeeringConnectionID: aws.String(peeringID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(code awsec2types.VpcPeeringConnectionStateReasonCode, dnsResolution bool) func(ctx context.Context, input *awsec2.DescribeVpcPeeringConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcPeeringConnectionsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeVpcPeeringConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcPeeringConnectionsOutput, error) {
+		return &awsec2.DescribeVpcPeeringConnectionsOutput{
+			VpcPeeringConnections: []awsec2types.VpcPeeringConnection{{
+				VpcPeeringConnectionId: aws.String(peeringID),
+				Status:                 &awsec2types.VpcPeeringConnectionStateReason{Code: code},
+				AccepterVpcInfo: &awsec2types.VpcPeeringConnectionVpcInfo{
+					VpcId: aws.String(vpcID),
+					PeeringOptions: &awsec2types.VpcPeeringConnectionOptionsDescription{
+						AllowDnsResolutionFromRemoteVpc: aws.Bool(dnsResolution),
+					},
+				},
+				RequesterVpcInfo: &awsec2types.VpcPeeringConnectionVpcInfo{
+					VpcId: aws.String(peerVPCID),
+				},
+			}},
+		}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.VPCPeeringConnectionAccepter
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: describe(awsec2types.VpcPeeringConnectionStateReasonCodeActive, true),
+				},
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true)),
+			},
+			want: want{
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true),
+					withStatus(manualv1alpha1.VPCPeeringConnectionAccepterObservation{
+						Status:    "active",
+						VPCID:     vpcID,
+						PeerVPCID: peerVPCID,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"OptionsChanged": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: describe(awsec2types.VpcPeeringConnectionStateReasonCodeActive, false),
+				},
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true)),
+			},
+			want: want{
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true),
+					withStatus(manualv1alpha1.VPCPeeringConnectionAccepterObservation{
+						Status:    "active",
+						VPCID:     vpcID,
+						PeerVPCID: peerVPCID,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Provisioning": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: describe(awsec2types.VpcPeeringConnectionStateReasonCodeProvisioning, false),
+				},
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true)),
+			},
+			want: want{
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true),
+					withStatus(manualv1alpha1.VPCPeeringConnectionAccepterObservation{
+						Status:    "provisioning",
+						VPCID:     vpcID,
+						PeerVPCID: peerVPCID,
+					}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingAcceptance": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: describe(awsec2types.VpcPeeringConnectionStateReasonCodePendingAcceptance, false),
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcPeeringConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcPeeringConnectionsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.VPCPeeringConnectionIDNotFound}
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeVpcPeeringConnectionsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeVpcPeeringConnectionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.VPCPeeringConnectionAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockAccept: func(ctx context.Context, input *awsec2.AcceptVpcPeeringConnectionInput, opts []func(*awsec2.Options)) (*awsec2.AcceptVpcPeeringConnectionOutput, error) {
+						return &awsec2.AcceptVpcPeeringConnectionOutput{}, nil
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withExternalName(peeringID)),
+			},
+		},
+		"AcceptFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockAccept: func(ctx context.Context, input *awsec2.AcceptVpcPeeringConnectionInput, opts []func(*awsec2.Options)) (*awsec2.AcceptVpcPeeringConnectionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: errorutils.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyOptions": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: describe(awsec2types.VpcPeeringConnectionStateReasonCodeActive, false),
+					MockModifyOptions: func(ctx context.Context, input *awsec2.ModifyVpcPeeringConnectionOptionsInput, opts []func(*awsec2.Options)) (*awsec2.ModifyVpcPeeringConnectionOptionsOutput, error) {
+						if input.RequesterPeeringConnectionOptions != nil {
+							t.Errorf("requester options must not be modified")
+						}
+						if diff := cmp.Diff(true, aws.ToBool(input.AccepterPeeringConnectionOptions.AllowDnsResolutionFromRemoteVpc)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ModifyVpcPeeringConnectionOptionsOutput{}, nil
+					},
+				},
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true)),
+			},
+		},
+		"ModifyOptionsFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDescribe: describe(awsec2types.VpcPeeringConnectionStateReasonCodeActive, false),
+					MockModifyOptions: func(ctx context.Context, input *awsec2.ModifyVpcPeeringConnectionOptionsInput, opts []func(*awsec2.Options)) (*awsec2.ModifyVpcPeeringConnectionOptionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withExternalName(peeringID), withDNSResolution(true)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errModifyOptions),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.VPCPeeringConnectionAccepter
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpcPeeringConnectionInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpcPeeringConnectionOutput, error) {
+						return &awsec2.DeleteVpcPeeringConnectionOutput{}, nil
+					},
+				},
+				cr: accepter(withExternalName(peeringID)),
+			},
+			want: want{
+				cr: accepter(withExternalName(peeringID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockVPCPeeringConnectionAccepterClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteVpcPeeringConnectionInput, opts []func(*awsec2.Options)) (*awsec2.DeleteVpcPeeringConnectionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(withExternalName(peeringID)),
+			},
+			want: want{
+				cr:  accepter(withExternalName(peeringID), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,490 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessentry
+
+import (
+	"context"
+	"testing"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	clusterName    = "my-cool-cluster"
+	principalARN   = "arn:aws:iam::123456789:role/my-cool-role"
+	accessEntryARN = "arn:aws:eks:us-east-1:123456789:access-entry/my-cool-cluster/role/123456789/my-cool-role/abc"
+	username       = "my-cool-user"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.AccessEntry
+}
+
+type accessEntryModifier func(*manualv1alpha1.AccessEntry)
+
+func withConditions(c ...xpv1.Condition) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPrincipal(arn string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.PrincipalARN = arn }
+}
+
+func withExternalName(n string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { meta.SetExternalName(r, n) }
+}
+
+func withGroups(g ...string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.KubernetesGroups = g }
+}
+
+func withUsername(u string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.Username = &u }
+}
+
+func withTags(t map[string]string) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation(o manualv1alpha1.AccessEntryObservation) accessEntryModifier {
+	return func(r *manualv1alpha1.AccessEntry) { r.Status.AtProvider = o }
+}
+
+func accessEntry(m ...accessEntryModifier) *manualv1alpha1.AccessEntry {
+	cr := &manualv1alpha1.AccessEntry{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "AccessEntry",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "name",
+		},
+	}
+	cr.Spec.ForProvider.ClusterName = clusterName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessEntry
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn:   &accessEntryARN,
+								KubernetesGroups: []string{"viewers"},
+								Username:         &username,
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN), withGroups("viewers"), withUsername(username)),
+			},
+			want: want{
+				cr: accessEntry(
+					withPrincipal(principalARN),
+					withGroups("viewers"),
+					withUsername(username),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.AccessEntryObservation{AccessEntryARN: accessEntryARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitSuccess": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn: &accessEntryARN,
+								Username:       &username,
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(
+					withPrincipal(principalARN),
+					withUsername(username),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.AccessEntryObservation{AccessEntryARN: accessEntryARN})),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"GroupsChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn: &accessEntryARN,
+								Username:       &username,
+							},
+						}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN), withGroups("viewers"), withUsername(username)),
+			},
+			want: want{
+				cr: accessEntry(
+					withPrincipal(principalARN),
+					withGroups("viewers"),
+					withUsername(username),
+					withConditions(xpv1.Available()),
+					withObservation(manualv1alpha1.AccessEntryObservation{AccessEntryARN: accessEntryARN})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NoPrincipal": {
+			args: args{
+				cr: accessEntry(),
+			},
+			want: want{
+				cr:  accessEntry(),
+				err: errors.New(errNoPrincipal),
+			},
+		},
+		"FailedDescribeRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withPrincipal(principalARN)),
+				err: errorutils.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessEntry
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreateAccessEntry: func(ctx context.Context, input *awseks.CreateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.CreateAccessEntryOutput, error) {
+						return &awseks.CreateAccessEntryOutput{}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(
+					withPrincipal(principalARN),
+					withExternalName(principalARN),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockCreateAccessEntry: func(ctx context.Context, input *awseks.CreateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.CreateAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(
+					withPrincipal(principalARN),
+					withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessEntry
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulConfigAndTags": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn: &accessEntryARN,
+								Tags:           map[string]string{"old": "tag"},
+							},
+						}, nil
+					},
+					MockUntagResource: func(ctx context.Context, input *awseks.UntagResourceInput, opts []func(*awseks.Options)) (*awseks.UntagResourceOutput, error) {
+						return &awseks.UntagResourceOutput{}, nil
+					},
+					MockTagResource: func(ctx context.Context, input *awseks.TagResourceInput, opts []func(*awseks.Options)) (*awseks.TagResourceOutput, error) {
+						return &awseks.TagResourceOutput{}, nil
+					},
+					MockUpdateAccessEntry: func(ctx context.Context, input *awseks.UpdateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.UpdateAccessEntryOutput, error) {
+						return &awseks.UpdateAccessEntryOutput{}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN), withGroups("viewers"), withTags(map[string]string{"new": "tag"})),
+			},
+			want: want{
+				cr: accessEntry(withPrincipal(principalARN), withGroups("viewers"), withTags(map[string]string{"new": "tag"})),
+			},
+		},
+		"TagsOnly": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn: &accessEntryARN,
+							},
+						}, nil
+					},
+					MockTagResource: func(ctx context.Context, input *awseks.TagResourceInput, opts []func(*awseks.Options)) (*awseks.TagResourceOutput, error) {
+						return &awseks.TagResourceOutput{}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN), withTags(map[string]string{"new": "tag"})),
+			},
+			want: want{
+				cr: accessEntry(withPrincipal(principalARN), withTags(map[string]string{"new": "tag"})),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withPrincipal(principalARN)),
+				err: errorutils.Wrap(errBoom, errDescribeFailed),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeAccessEntry: func(ctx context.Context, input *awseks.DescribeAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DescribeAccessEntryOutput, error) {
+						return &awseks.DescribeAccessEntryOutput{
+							AccessEntry: &awsekstypes.AccessEntry{
+								AccessEntryArn: &accessEntryARN,
+							},
+						}, nil
+					},
+					MockUpdateAccessEntry: func(ctx context.Context, input *awseks.UpdateAccessEntryInput, opts []func(*awseks.Options)) (*awseks.UpdateAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN), withGroups("viewers")),
+			},
+			want: want{
+				cr:  accessEntry(withPrincipal(principalARN), withGroups("viewers")),
+				err: errorutils.Wrap(errBoom, errUpdateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessEntry
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return &awseks.DeleteAccessEntryOutput{}, nil
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withPrincipal(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessEntry(withPrincipal(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDeleteAccessEntry: func(ctx context.Context, input *awseks.DeleteAccessEntryInput, opts []func(*awseks.Options)) (*awseks.DeleteAccessEntryOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessEntry(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr:  accessEntry(withPrincipal(principalARN), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDeleteFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
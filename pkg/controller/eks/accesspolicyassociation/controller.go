@@ -0,0 +1,188 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspolicyassociation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errNotEKSAccessPolicyAssociation = "managed resource is not an EKS access policy association custom resource"
+	errNoPrincipal                   = "principalArn or a reference to an IAM Role or User is required"
+	errListFailed                    = "cannot list the access policies associated with the EKS access entry"
+	errAssociateFailed               = "cannot associate access policy with EKS access entry"
+	errDisassociateFailed            = "cannot disassociate access policy from EKS access entry"
+)
+
+// SetupAccessPolicyAssociation adds a controller that reconciles
+// AccessPolicyAssociations.
+func SetupAccessPolicyAssociation(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.AccessPolicyAssociationGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.AccessPolicyAssociationGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.AccessPolicyAssociation{}, &manualv1alpha1.AccessPolicyAssociationList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.AccessPolicyAssociation{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube           client.Client
+	newEKSClientFn func(config aws.Config) eks.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return nil, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newEKSClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client eks.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	if cr.Spec.ForProvider.PrincipalARN == "" {
+		return managed.ExternalObservation{}, errors.New(errNoPrincipal)
+	}
+
+	// A missing access entry means that the policy is not associated.
+	ap, err := eks.FindAssociatedAccessPolicy(ctx, e.client, cr.Spec.ForProvider.ClusterName, cr.Spec.ForProvider.PrincipalARN, cr.Spec.ForProvider.PolicyARN)
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errListFailed)
+	}
+	if ap == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = eks.GenerateAccessPolicyAssociationObservation(ap)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: eks.IsAccessPolicyAssociationUpToDate(cr.Spec.ForProvider, ap),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	if _, err := e.client.AssociateAccessPolicy(ctx, eks.GenerateAssociateAccessPolicyInput(cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errAssociateFailed)
+	}
+	meta.SetExternalName(cr, cr.Spec.ForProvider.PolicyARN)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+
+	// Associating an already associated policy replaces its access scope.
+	_, err := e.client.AssociateAccessPolicy(ctx, eks.GenerateAssociateAccessPolicyInput(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errAssociateFailed)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*manualv1alpha1.AccessPolicyAssociation)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errNotEKSAccessPolicyAssociation)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DisassociateAccessPolicy(ctx, &awseks.DisassociateAccessPolicyInput{
+		ClusterName:  &cr.Spec.ForProvider.ClusterName,
+		PrincipalArn: &cr.Spec.ForProvider.PrincipalARN,
+		PolicyArn:    &cr.Spec.ForProvider.PolicyARN,
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDisassociateFailed)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
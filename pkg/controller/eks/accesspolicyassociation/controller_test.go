@@ -0,0 +1,404 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accesspolicyassociation
+
+import (
+	"context"
+	"testing"
+
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/eks/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	clusterName  = "my-cool-cluster"
+	principalARN = "arn:aws:iam::123456789:role/my-cool-role"
+	policyARN    = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	eks eks.Client
+	cr  *manualv1alpha1.AccessPolicyAssociation
+}
+
+type associationModifier func(*manualv1alpha1.AccessPolicyAssociation)
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withPrincipal(arn string) associationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { r.Spec.ForProvider.PrincipalARN = arn }
+}
+
+func withExternalName(n string) associationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) { meta.SetExternalName(r, n) }
+}
+
+func withNamespaces(ns ...string) associationModifier {
+	return func(r *manualv1alpha1.AccessPolicyAssociation) {
+		r.Spec.ForProvider.AccessScope = manualv1alpha1.AccessScope{
+			Type:       manualv1alpha1.AccessScopeTypeNamespace,
+			Namespaces: ns,
+		}
+	}
+}
+
+func accessPolicyAssociation(m ...associationModifier) *manualv1alpha1.AccessPolicyAssociation {
+	cr := &manualv1alpha1.AccessPolicyAssociation{
+		TypeMeta: metav1.TypeMeta{
+			Kind: "AccessPolicyAssociation",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "name",
+		},
+	}
+	cr.Spec.ForProvider.ClusterName = clusterName
+	cr.Spec.ForProvider.PolicyARN = policyARN
+	cr.Spec.ForProvider.AccessScope = manualv1alpha1.AccessScope{Type: manualv1alpha1.AccessScopeTypeCluster}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func associatedPolicies(ap ...awsekstypes.AssociatedAccessPolicy) func(context.Context, *awseks.ListAssociatedAccessPoliciesInput, []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+	return func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+		return &awseks.ListAssociatedAccessPoliciesOutput{AssociatedAccessPolicies: ap}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessPolicyAssociation
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: associatedPolicies(awsekstypes.AssociatedAccessPolicy{
+						PolicyArn:   &policyARN,
+						AccessScope: &awsekstypes.AccessScope{Type: awsekstypes.AccessScopeTypeCluster},
+					}),
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withPrincipal(principalARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ScopeChanged": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: associatedPolicies(awsekstypes.AssociatedAccessPolicy{
+						PolicyArn:   &policyARN,
+						AccessScope: &awsekstypes.AccessScope{Type: awsekstypes.AccessScopeTypeCluster},
+					}),
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN), withNamespaces("default")),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withPrincipal(principalARN),
+					withNamespaces("default"),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotAssociated": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: associatedPolicies(),
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+		},
+		"AccessEntryNotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+		},
+		"NoPrincipal": {
+			args: args{
+				cr: accessPolicyAssociation(),
+			},
+			want: want{
+				cr:  accessPolicyAssociation(),
+				err: errors.New(errNoPrincipal),
+			},
+		},
+		"FailedListRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListAssociatedAccessPolicies: func(ctx context.Context, input *awseks.ListAssociatedAccessPoliciesInput, opts []func(*awseks.Options)) (*awseks.ListAssociatedAccessPoliciesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr:  accessPolicyAssociation(withPrincipal(principalARN)),
+				err: errorutils.Wrap(errBoom, errListFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *manualv1alpha1.AccessPolicyAssociation
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return &awseks.AssociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withPrincipal(principalARN),
+					withExternalName(policyARN),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(
+					withPrincipal(principalARN),
+					withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessPolicyAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						if diff := cmp.Diff([]string{"default"}, input.AccessScope.Namespaces); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awseks.AssociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN), withNamespaces("default")),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withPrincipal(principalARN), withNamespaces("default")),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockAssociateAccessPolicy: func(ctx context.Context, input *awseks.AssociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.AssociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr:  accessPolicyAssociation(withPrincipal(principalARN)),
+				err: errorutils.Wrap(errBoom, errAssociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.AccessPolicyAssociation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return &awseks.DisassociateAccessPolicyOutput{}, nil
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withPrincipal(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr: accessPolicyAssociation(withPrincipal(principalARN), withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDisassociateAccessPolicy: func(ctx context.Context, input *awseks.DisassociateAccessPolicyInput, opts []func(*awseks.Options)) (*awseks.DisassociateAccessPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accessPolicyAssociation(withPrincipal(principalARN)),
+			},
+			want: want{
+				cr:  accessPolicyAssociation(withPrincipal(principalARN), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDisassociateFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eks}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/accessentry"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/accesspolicyassociation"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/addon"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/cluster"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/eks/fargateprofile"
@@ -32,6 +34,8 @@ import (
 func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
+		accessentry.SetupAccessEntry,
+		accesspolicyassociation.SetupAccessPolicyAssociation,
 		addon.SetupAddon,
 		cluster.SetupCluster,
 		fargateprofile.SetupFargateProfile,
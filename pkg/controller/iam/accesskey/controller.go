@@ -18,19 +18,22 @@ package accesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -38,7 +41,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -49,6 +54,14 @@ const (
 	errCreate           = "failed to create the AccessKey resource"
 	errDelete           = "failed to delete the AccessKey resource"
 	errUpdate           = "failed to update the AccessKey resource"
+	errKubeUpdate       = "failed to store the ID of the rotated AccessKey"
+	errKubeUpdateDelete = "failed to remove the ID of the deleted previous AccessKey"
+	errTooManyKeys      = "cannot rotate the AccessKey because the user already has the maximum number of access keys"
+	errGetConnSecret    = "failed to get the connection secret of the AccessKey"
+	errNotPublished     = "cannot deactivate the previous AccessKey because the current one is not published to the connection secret yet"
+
+	// maxAccessKeysPerUser is the number of access keys a user can have.
+	maxAccessKeysPerUser = 2
 )
 
 // SetupAccessKey adds a controller that reconciles AccessKeys.
@@ -67,7 +80,7 @@ func SetupAccessKey(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -78,11 +91,16 @@ func SetupAccessKey(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.AccessKeyGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.AccessKey{}, &v1beta1.AccessKeyList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.AccessKey{}).
+		For(&v1beta1.AccessKey{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -118,29 +136,29 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	if err != nil || len(keys.AccessKeyMetadata) == 0 {
 		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
 	}
-	found := false
-	var accessKey awsiamtypes.AccessKeyMetadata
-	for _, key := range keys.AccessKeyMetadata {
-		if aws.ToString(key.AccessKeyId) == meta.GetExternalName(cr) {
-			found = true
-			accessKey = key
-		}
-	}
-	if !found {
+	current, previous := findAccessKeys(keys.AccessKeyMetadata, meta.GetExternalName(cr), previousAccessKeyID(cr))
+	if current == nil {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
-	switch accessKey.Status {
+	switch current.Status {
 	case awsiamtypes.StatusTypeActive:
 		cr.SetConditions(xpv1.Available())
 	case awsiamtypes.StatusTypeInactive:
 		cr.SetConditions(xpv1.Unavailable())
 	}
-	current := cr.Spec.ForProvider.Status
-	cr.Spec.ForProvider.Status = pointer.LateInitializeValueFromPtr(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
+	cr.Status.AtProvider.AccessKeyID = aws.ToString(current.AccessKeyId)
+	cr.Status.AtProvider.CreateDate = pointer.TimeToMetaTime(current.CreateDate)
+	cr.Status.AtProvider.PreviousAccessKeyID = nil
+	if previous != nil {
+		cr.Status.AtProvider.PreviousAccessKeyID = previous.AccessKeyId
+	}
+	initialStatus := cr.Spec.ForProvider.Status
+	cr.Spec.ForProvider.Status = pointer.LateInitializeValueFromPtr(cr.Spec.ForProvider.Status, aws.String(string(current.Status)))
+	rotation := iam.NextAccessKeyRotationAction(cr.Spec.ForProvider.Rotation, *current, previous, time.Now())
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
-		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        string(current.Status) == cr.Spec.ForProvider.Status && rotation == iam.AccessKeyRotationNone,
+		ResourceLateInitialized: initialStatus != cr.Spec.ForProvider.Status,
 	}, nil
 }
 
@@ -177,8 +195,87 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		Status:      awsiamtypes.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 	})
+	if err != nil || cr.Spec.ForProvider.Rotation == nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	}
+	return e.rotate(ctx, cr)
+}
 
-	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+// rotate performs the next step of the rotation of the access key.
+func (e *external) rotate(ctx context.Context, cr *v1beta1.AccessKey) (managed.ExternalUpdate, error) { //nolint:gocyclo
+	keys, err := e.client.ListAccessKeys(ctx, &awsiam.ListAccessKeysInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errList)
+	}
+	current, previous := findAccessKeys(keys.AccessKeyMetadata, meta.GetExternalName(cr), previousAccessKeyID(cr))
+	if current == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	switch iam.NextAccessKeyRotationAction(cr.Spec.ForProvider.Rotation, *current, previous, time.Now()) {
+	case iam.AccessKeyRotationCreate:
+		if len(keys.AccessKeyMetadata) >= maxAccessKeysPerUser {
+			return managed.ExternalUpdate{}, errors.New(errTooManyKeys)
+		}
+		response, err := e.client.CreateAccessKey(ctx, &awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+		if err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreate)
+		}
+		previousID := meta.GetExternalName(cr)
+		meta.SetExternalName(cr, aws.ToString(response.AccessKey.AccessKeyId))
+		meta.AddAnnotations(cr, map[string]string{v1beta1.AnnotationKeyPreviousAccessKeyID: previousID})
+		// The external name is only persisted by the managed reconciler
+		// after a creation, so it is stored here together with the ID of
+		// the previous access key.
+		if err := e.kube.Update(ctx, cr); err != nil {
+			// The new key could not be tracked, so it is deleted again to
+			// not exceed the number of access keys of the user.
+			_, _ = e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+				UserName:    aws.String(cr.Spec.ForProvider.Username),
+				AccessKeyId: response.AccessKey.AccessKeyId,
+			})
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdate)
+		}
+		cr.Status.AtProvider.AccessKeyID = aws.ToString(response.AccessKey.AccessKeyId)
+		cr.Status.AtProvider.CreateDate = pointer.TimeToMetaTime(response.AccessKey.CreateDate)
+		cr.Status.AtProvider.PreviousAccessKeyID = aws.String(previousID)
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(response.AccessKey.AccessKeyId)),
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(response.AccessKey.SecretAccessKey)),
+		}}, nil
+	case iam.AccessKeyRotationDeactivate:
+		// The secret of the current key is only returned on creation, so
+		// consumers are cut off if the previous key is deactivated before
+		// the current one was published.
+		published, err := e.isPublished(ctx, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGetConnSecret)
+		}
+		if !published {
+			return managed.ExternalUpdate{}, errors.New(errNotPublished)
+		}
+		_, err = e.client.UpdateAccessKey(ctx, &awsiam.UpdateAccessKeyInput{
+			AccessKeyId: previous.AccessKeyId,
+			Status:      awsiamtypes.StatusTypeInactive,
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+		})
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	case iam.AccessKeyRotationDelete:
+		_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+			AccessKeyId: previous.AccessKeyId,
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errDelete)
+		}
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyPreviousAccessKeyID)
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdateDelete)
+		}
+		cr.Status.AtProvider.PreviousAccessKeyID = nil
+	case iam.AccessKeyRotationNone:
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
@@ -189,6 +286,16 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if id := previousAccessKeyID(cr); id != "" {
+		_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+			AccessKeyId: aws.String(id),
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return managed.ExternalDelete{}, errorutils.Wrap(err, errDelete)
+		}
+	}
+
 	_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
@@ -201,3 +308,37 @@ func (e *external) Disconnect(ctx context.Context) error {
 	// Unimplemented, required by newer versions of crossplane-runtime
 	return nil
 }
+
+// isPublished returns whether the connection secret of the access key holds
+// the current access key.
+func (e *external) isPublished(ctx context.Context, cr *v1beta1.AccessKey) (bool, error) {
+	ref := cr.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return false, nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return false, resource.IgnoreNotFound(err)
+	}
+	return string(s.Data[xpv1.ResourceCredentialsSecretUserKey]) == meta.GetExternalName(cr), nil
+}
+
+// previousAccessKeyID returns the ID of the access key that was replaced by the
+// current one and has not been deleted yet, if any.
+func previousAccessKeyID(cr *v1beta1.AccessKey) string {
+	return cr.GetAnnotations()[v1beta1.AnnotationKeyPreviousAccessKeyID]
+}
+
+// findAccessKeys returns the current and the previous access key among the
+// given ones, if they exist.
+func findAccessKeys(keys []awsiamtypes.AccessKeyMetadata, currentID, previousID string) (current, previous *awsiamtypes.AccessKeyMetadata) {
+	for i := range keys {
+		switch id := aws.ToString(keys[i].AccessKeyId); {
+		case id == currentID:
+			current = &keys[i]
+		case previousID != "" && id == previousID:
+			previous = &keys[i]
+		}
+	}
+	return current, previous
+}
@@ -19,6 +19,7 @@ package accesskey
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
@@ -30,12 +31,15 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 )
 
 var (
@@ -46,6 +50,10 @@ var (
 	inactiveStatus = awsiamtypes.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	expiredDate    = time.Now().Add(-91 * 24 * time.Hour)
+	rotatedDate    = time.Now().Add(-48 * time.Hour)
+	rotation       = &v1beta1.AccessKeyRotation{MaxAge: metav1.Duration{Duration: 90 * 24 * time.Hour}}
 
 	errBoom = errors.New("boom")
 )
@@ -80,6 +88,51 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withPreviousAccessKey(keyid string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		meta.AddAnnotations(r, map[string]string{v1beta1.AnnotationKeyPreviousAccessKeyID: keyid})
+	}
+}
+
+func withConnectionSecret() accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "conn", Namespace: "default"})
+	}
+}
+
+// connectionSecret returns a kube client that returns a connection secret
+// holding the supplied access key ID.
+func connectionSecret(keyid string) *test.MockClient {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{xpv1.ResourceCredentialsSecretUserKey: []byte(keyid)}
+			return nil
+		}),
+	}
+}
+
+func withRotation(r *v1beta1.AccessKeyRotation) accessModifier {
+	return func(r2 *v1beta1.AccessKey) {
+		r2.Spec.ForProvider.Rotation = r
+	}
+}
+
+func withObservation(keyid string, createDate *time.Time, previous *string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Status.AtProvider = v1beta1.AccessKeyObservation{
+			AccessKeyID:         keyid,
+			CreateDate:          pointer.TimeToMetaTime(createDate),
+			PreviousAccessKeyID: previous,
+		}
+	}
+}
+
+func listKeys(keys ...awsiamtypes.AccessKeyMetadata) func(context.Context, *awsiam.ListAccessKeysInput, []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+	return func(context.Context, *awsiam.ListAccessKeysInput, []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+		return &awsiam.ListAccessKeysOutput{AccessKeyMetadata: keys}, nil
+	}
+}
+
 func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 	cr := &v1beta1.AccessKey{}
 	for _, f := range m {
@@ -118,6 +171,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(accessKeyID, nil, nil),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -144,6 +198,7 @@ func TestObserve(t *testing.T) {
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
 					withStatus(string(activeStatus)),
+					withObservation(accessKeyID, nil, nil),
 					withConditions(xpv1.Unavailable())),
 				result: managed.ExternalObservation{
 					ResourceExists:   true,
@@ -151,6 +206,57 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDue": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}),
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotation(rotation)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(rotation),
+					withObservation(accessKeyID, &expiredDate, nil),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"PreviousKeyFromAnnotation": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(newAccessKeyID),
+						CreateDate:  &rotatedDate,
+						Status:      activeStatus,
+					}, awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}),
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(newAccessKeyID), withPreviousAccessKey(accessKeyID), withStatus(string(activeStatus)), withRotation(rotation)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(newAccessKeyID),
+					withPreviousAccessKey(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(rotation),
+					withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID)),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
 		"ValidInputNotExists": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -349,6 +455,24 @@ func TestDelete(t *testing.T) {
 				err: errorutils.Wrap(errBoom, errDelete),
 			},
 		},
+		"DeletesPreviousKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == accessKeyID {
+							return nil, errBoom
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, nil, aws.String(accessKeyID))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, nil, aws.String(accessKeyID)),
+					withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -403,6 +527,192 @@ func TestUpdate(t *testing.T) {
 				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus))),
 			},
 		},
+		"RotationCreatesKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}),
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{
+							AccessKey: &awsiamtypes.AccessKey{
+								AccessKeyId:     aws.String(newAccessKeyID),
+								CreateDate:      &rotatedDate,
+								SecretAccessKey: aws.String(secretKeyID),
+								Status:          activeStatus,
+							},
+						}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+				update: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+					},
+				},
+			},
+		},
+		"RotationTooManyKeys": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}, awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String("unmanaged"),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}),
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation)),
+			},
+			want: want{
+				cr:  accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation)),
+				err: errors.New(errTooManyKeys),
+			},
+		},
+		"RotationDeactivatesPreviousKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == accessKeyID && input.Status != inactiveStatus {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(newAccessKeyID),
+						CreateDate:  &rotatedDate,
+						Status:      activeStatus,
+					}, awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}),
+				},
+				kube: connectionSecret(newAccessKeyID),
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withConnectionSecret(), withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withConnectionSecret(), withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+			},
+		},
+		"RotationWaitsForPublishedKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == accessKeyID && input.Status == inactiveStatus {
+							// The previous key must not be deactivated.
+							return nil, errBoom
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(newAccessKeyID),
+						CreateDate:  &rotatedDate,
+						Status:      activeStatus,
+					}, awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      activeStatus,
+					}),
+				},
+				kube: connectionSecret(accessKeyID),
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withConnectionSecret(), withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withConnectionSecret(), withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+				err: errors.New(errNotPublished),
+			},
+		},
+		"RotationDeletesPreviousKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(newAccessKeyID),
+						CreateDate:  &rotatedDate,
+						Status:      activeStatus,
+					}, awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      inactiveStatus,
+					}),
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != accessKeyID {
+							return nil, errBoom
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withObservation(newAccessKeyID, &rotatedDate, nil)),
+			},
+		},
+		"RotationDeletesPreviousKeyUpdateError": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+					MockListAccessKeys: listKeys(awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(newAccessKeyID),
+						CreateDate:  &rotatedDate,
+						Status:      activeStatus,
+					}, awsiamtypes.AccessKeyMetadata{
+						AccessKeyId: aws.String(accessKeyID),
+						CreateDate:  &expiredDate,
+						Status:      inactiveStatus,
+					}),
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != accessKeyID {
+							return nil, errBoom
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withPreviousAccessKey(accessKeyID), withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotation(rotation),
+					withObservation(newAccessKeyID, &rotatedDate, aws.String(accessKeyID))),
+				err: errors.Wrap(errBoom, errKubeUpdateDelete),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -430,7 +740,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
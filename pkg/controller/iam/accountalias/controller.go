@@ -0,0 +1,227 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AccountAlias resource"
+
+	errList         = "cannot list the aliases of the account"
+	errCreate       = "cannot create the alias of the account"
+	errDelete       = "cannot delete the alias of the account"
+	errAccountID    = "cannot get the ID of the account"
+	errOwner        = "cannot determine the AccountAlias that manages the alias"
+	errConflictFmt  = "the alias of the account is managed by AccountAlias %s"
+	errUnmanagedFmt = "the account already has alias %q that was not created by this AccountAlias"
+)
+
+// SetupAccountAlias adds a controller that reconciles AccountAliases.
+func SetupAccountAlias(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.AccountAliasGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccountAliasClient, newSTSClientFn: iam.NewSTSClient}),
+		managed.WithInitializers(custommanaged.NewFixedExternalName(mgr.GetClient(), iam.AccountAliasExternalName)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.AccountAliasGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.AccountAlias{}, &manualv1alpha1.AccountAliasList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.AccountAlias{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube           client.Client
+	newClientFn    func(config aws.Config) iam.AccountAliasClient
+	newSTSClientFn func(config aws.Config) iam.STSClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.AccountAliasClient
+	sts    iam.STSClient
+	kube   client.Reader
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.AccountAlias)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	id, err := iam.GetAccountID(ctx, e.sts)
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errAccountID)
+	}
+	cr.Status.AtProvider.AccountID = aws.String(id)
+
+	owner, err := custommanaged.GetSingletonOwner(ctx, e.kube, cr, &manualv1alpha1.AccountAliasList{}, accountID)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errOwner)
+	}
+	if owner != cr.GetName() {
+		// An AccountAlias that does not manage the alias can be deleted
+		// without deleting it.
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Errorf(errConflictFmt, owner)
+	}
+
+	out, err := e.client.ListAccountAliases(ctx, &awsiam.ListAccountAliasesInput{})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errList)
+	}
+	if len(out.AccountAliases) == 0 {
+		cr.Status.AtProvider.AccountAlias = nil
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := out.AccountAliases[0]
+	cr.Status.AtProvider.AccountAlias = aws.String(current)
+	if current != cr.Spec.ForProvider.AccountAlias && meta.GetExternalCreateSucceeded(cr).IsZero() {
+		// An alias that was not created by this AccountAlias is never
+		// replaced.
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		return managed.ExternalObservation{}, errors.Errorf(errUnmanagedFmt, current)
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: current == cr.Spec.ForProvider.AccountAlias,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.AccountAlias)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateAccountAlias(ctx, &awsiam.CreateAccountAliasInput{
+		AccountAlias: aws.String(cr.Spec.ForProvider.AccountAlias),
+	})
+	return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.AccountAlias)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// Creating an alias replaces the current one. It is not deleted first,
+	// since aliases are globally unique and the account would be left
+	// without an alias if the desired one is taken.
+	_, err := e.client.CreateAccountAlias(ctx, &awsiam.CreateAccountAliasInput{
+		AccountAlias: aws.String(cr.Spec.ForProvider.AccountAlias),
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errCreate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.AccountAlias)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	alias := cr.Status.AtProvider.AccountAlias
+	if alias == nil {
+		alias = aws.String(cr.Spec.ForProvider.AccountAlias)
+	}
+	_, err := e.client.DeleteAccountAlias(ctx, &awsiam.DeleteAccountAliasInput{AccountAlias: alias})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
+
+// accountID returns the ID of the account the supplied AccountAlias belongs to.
+func accountID(mg resource.Managed) string {
+	if cr, ok := mg.(*manualv1alpha1.AccountAlias); ok {
+		return aws.ToString(cr.Status.AtProvider.AccountID)
+	}
+	return ""
+}
@@ -0,0 +1,417 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	aliasName = "account-alias"
+	ownerName = "older-account-alias"
+	alias     = "my-company"
+	oldAlias  = "my-old-company"
+
+	callerAccountID = "123456789012"
+	otherAccountID  = "210987654321"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sts  *fake.MockSTSClient
+	iam  *fake.MockAccountAliasClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type aliasModifier func(*manualv1alpha1.AccountAlias)
+
+func withAccountID(id string) aliasModifier {
+	return func(r *manualv1alpha1.AccountAlias) { r.Status.AtProvider.AccountID = aws.String(id) }
+}
+
+func withConditions(c ...xpv1.Condition) aliasModifier {
+	return func(r *manualv1alpha1.AccountAlias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAlias(s string) aliasModifier {
+	return func(r *manualv1alpha1.AccountAlias) { r.Spec.ForProvider.AccountAlias = s }
+}
+
+func withObservedAlias(s string) aliasModifier {
+	return func(r *manualv1alpha1.AccountAlias) { r.Status.AtProvider.AccountAlias = aws.String(s) }
+}
+
+func withCreateSucceeded() aliasModifier {
+	return func(r *manualv1alpha1.AccountAlias) { meta.SetExternalCreateSucceeded(r, time.Unix(150, 0)) }
+}
+
+func withDeletionTimestamp() aliasModifier {
+	return func(r *manualv1alpha1.AccountAlias) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(200, 0)}) }
+}
+
+func accountAlias(m ...aliasModifier) *manualv1alpha1.AccountAlias {
+	cr := &manualv1alpha1.AccountAlias{}
+	cr.SetName(aliasName)
+	cr.SetCreationTimestamp(metav1.Time{Time: time.Unix(100, 0)})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// listWith returns a kube client that lists the supplied AccountAliases.
+func listWith(items ...manualv1alpha1.AccountAlias) client.Client {
+	return &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*manualv1alpha1.AccountAliasList).Items = items
+			return nil
+		},
+	}
+}
+
+func olderAlias(m ...aliasModifier) manualv1alpha1.AccountAlias {
+	cr := manualv1alpha1.AccountAlias{}
+	cr.SetName(ownerName)
+	cr.SetCreationTimestamp(metav1.Time{Time: time.Unix(1, 0)})
+	for _, f := range m {
+		f(&cr)
+	}
+	return cr
+}
+
+func listAliases(aliases ...string) *fake.MockAccountAliasClient {
+	return &fake.MockAccountAliasClient{
+		MockListAccountAliases: func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+			return &awsiam.ListAccountAliasesOutput{AccountAliases: aliases}, nil
+		},
+	}
+}
+
+func callerIdentity(id string) *fake.MockSTSClient {
+	return &fake.MockSTSClient{
+		MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+			return &sts.GetCallerIdentityOutput{Account: aws.String(id)}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"AccountIDError": {
+			args: args{
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr:  accountAlias(withAlias(alias)),
+				err: errorutils.Wrap(errBoom, errAccountID),
+			},
+		},
+		"Conflict": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(olderAlias()),
+				cr:   accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr:  accountAlias(withAccountID(callerAccountID), withAlias(alias)),
+				err: errors.Errorf(errConflictFmt, ownerName),
+			},
+		},
+		"OtherAccount": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(olderAlias(withAccountID(otherAccountID))),
+				iam:  listAliases(),
+				cr:   accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAccountID(callerAccountID), withAlias(alias)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: func(ctx context.Context, input *awsiam.ListAccountAliasesInput, opts []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr:  accountAlias(withAccountID(callerAccountID), withAlias(alias)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"NoAlias": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam:  listAliases(),
+				cr:   accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAccountID(callerAccountID), withAlias(alias)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam:  listAliases(alias),
+				cr:   accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAccountID(callerAccountID), withAlias(alias),
+					withObservedAlias(alias),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"UnmanagedAlias": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam:  listAliases(oldAlias),
+				cr:   accountAlias(withAlias(alias)),
+			},
+			want: want{
+				cr: accountAlias(withAccountID(callerAccountID), withAlias(alias),
+					withObservedAlias(oldAlias)),
+				err: errors.Errorf(errUnmanagedFmt, oldAlias),
+			},
+		},
+		"UnmanagedAliasDeleted": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam:  listAliases(oldAlias),
+				cr:   accountAlias(withAlias(alias), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: accountAlias(withAccountID(callerAccountID), withAlias(alias),
+					withDeletionTimestamp(),
+					withObservedAlias(oldAlias)),
+			},
+		},
+		"ManagedAliasChanged": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam:  listAliases(oldAlias),
+				cr:   accountAlias(withAlias(alias), withCreateSucceeded()),
+			},
+			want: want{
+				cr: accountAlias(withAccountID(callerAccountID), withAlias(alias),
+					withCreateSucceeded(),
+					withObservedAlias(oldAlias),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, sts: tc.sts, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		deleted []string
+		created []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *manualv1alpha1.AccountAlias
+		createErr error
+		want
+	}{
+		"Successful": {
+			cr: accountAlias(withAlias(alias), withObservedAlias(oldAlias)),
+			want: want{
+				created: []string{alias},
+			},
+		},
+		"CreateError": {
+			cr:        accountAlias(withAlias(alias), withObservedAlias(oldAlias)),
+			createErr: errBoom,
+			want: want{
+				created: []string{alias},
+				err:     errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted, created []string
+			e := &external{client: &fake.MockAccountAliasClient{
+				MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+					deleted = append(deleted, aws.ToString(input.AccountAlias))
+					return &awsiam.DeleteAccountAliasOutput{}, nil
+				},
+				MockCreateAccountAlias: func(ctx context.Context, input *awsiam.CreateAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+					created = append(created, aws.ToString(input.AccountAlias))
+					return &awsiam.CreateAccountAliasOutput{}, tc.createErr
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		deleted string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr  *manualv1alpha1.AccountAlias
+		err error
+		want
+	}{
+		"ObservedAlias": {
+			cr: accountAlias(withAlias(alias), withObservedAlias(oldAlias)),
+			want: want{
+				deleted: oldAlias,
+			},
+		},
+		"DesiredAlias": {
+			cr: accountAlias(withAlias(alias)),
+			want: want{
+				deleted: alias,
+			},
+		},
+		"NotFound": {
+			cr:  accountAlias(withAlias(alias)),
+			err: &iamtypes.NoSuchEntityException{},
+			want: want{
+				deleted: alias,
+			},
+		},
+		"ClientError": {
+			cr:  accountAlias(withAlias(alias)),
+			err: errBoom,
+			want: want{
+				deleted: alias,
+				err:     errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted string
+			e := &external{client: &fake.MockAccountAliasClient{
+				MockDeleteAccountAlias: func(ctx context.Context, input *awsiam.DeleteAccountAliasInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+					deleted = aws.ToString(input.AccountAlias)
+					return &awsiam.DeleteAccountAliasOutput{}, tc.err
+				},
+			}}
+			_, err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff([]xpv1.Condition{xpv1.Deleting()}, tc.cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("conditions: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
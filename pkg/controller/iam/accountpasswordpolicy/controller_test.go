@@ -0,0 +1,431 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpasswordpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	policyName = "password-policy"
+	ownerName  = "older-password-policy"
+
+	callerAccountID = "123456789012"
+	otherAccountID  = "210987654321"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	sts  *fake.MockSTSClient
+	iam  *fake.MockAccountPasswordPolicyClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type policyModifier func(*manualv1alpha1.AccountPasswordPolicy)
+
+func withAccountID(id string) policyModifier {
+	return func(r *manualv1alpha1.AccountPasswordPolicy) { r.Status.AtProvider.AccountID = aws.String(id) }
+}
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *manualv1alpha1.AccountPasswordPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withMinimumPasswordLength(l int32) policyModifier {
+	return func(r *manualv1alpha1.AccountPasswordPolicy) { r.Spec.ForProvider.MinimumPasswordLength = aws.Int32(l) }
+}
+
+func withExpirePasswords(b bool) policyModifier {
+	return func(r *manualv1alpha1.AccountPasswordPolicy) { r.Status.AtProvider.ExpirePasswords = aws.Bool(b) }
+}
+
+func withDeletionTimestamp() policyModifier {
+	return func(r *manualv1alpha1.AccountPasswordPolicy) {
+		r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(200, 0)})
+	}
+}
+
+func passwordPolicy(m ...policyModifier) *manualv1alpha1.AccountPasswordPolicy {
+	cr := &manualv1alpha1.AccountPasswordPolicy{}
+	cr.SetName(policyName)
+	cr.SetCreationTimestamp(metav1.Time{Time: time.Unix(100, 0)})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// listWith returns a kube client that lists the supplied
+// AccountPasswordPolicies.
+func listWith(items ...manualv1alpha1.AccountPasswordPolicy) client.Client {
+	return &test.MockClient{
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			obj.(*manualv1alpha1.AccountPasswordPolicyList).Items = items
+			return nil
+		},
+	}
+}
+
+func olderPolicy(m ...policyModifier) manualv1alpha1.AccountPasswordPolicy {
+	cr := manualv1alpha1.AccountPasswordPolicy{}
+	cr.SetName(ownerName)
+	cr.SetCreationTimestamp(metav1.Time{Time: time.Unix(1, 0)})
+	for _, f := range m {
+		f(&cr)
+	}
+	return cr
+}
+
+func callerIdentity(id string) *fake.MockSTSClient {
+	return &fake.MockSTSClient{
+		MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+			return &sts.GetCallerIdentityOutput{Account: aws.String(id)}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"AccountIDError": {
+			args: args{
+				sts: &fake.MockSTSClient{
+					MockGetCallerIdentity: func(ctx context.Context, input *sts.GetCallerIdentityInput, opts []func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(),
+				err: errorutils.Wrap(errBoom, errAccountID),
+			},
+		},
+		"Conflict": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(olderPolicy()),
+				cr:   passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(withAccountID(callerAccountID)),
+				err: errors.Errorf(errConflictFmt, ownerName),
+			},
+		},
+		"ConflictDeleted": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(olderPolicy()),
+				cr:   passwordPolicy(withDeletionTimestamp()),
+			},
+			want: want{
+				cr: passwordPolicy(withAccountID(callerAccountID), withDeletionTimestamp()),
+			},
+		},
+		"OtherAccount": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(olderPolicy(withAccountID(otherAccountID))),
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withAccountID(callerAccountID)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(withAccountID(callerAccountID)),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withAccountID(callerAccountID)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return &awsiam.GetAccountPasswordPolicyOutput{
+							PasswordPolicy: &iamtypes.PasswordPolicy{MinimumPasswordLength: aws.Int32(12)},
+						}, nil
+					},
+				},
+				cr: passwordPolicy(withMinimumPasswordLength(12)),
+			},
+			want: want{
+				cr: passwordPolicy(withAccountID(callerAccountID), withMinimumPasswordLength(12),
+					withExpirePasswords(false),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				sts:  callerIdentity(callerAccountID),
+				kube: listWith(),
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockGetAccountPasswordPolicy: func(ctx context.Context, input *awsiam.GetAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+						return &awsiam.GetAccountPasswordPolicyOutput{
+							PasswordPolicy: &iamtypes.PasswordPolicy{MinimumPasswordLength: aws.Int32(8)},
+						}, nil
+					},
+				},
+				cr: passwordPolicy(withMinimumPasswordLength(12)),
+			},
+			want: want{
+				cr: passwordPolicy(withAccountID(callerAccountID), withMinimumPasswordLength(12),
+					withExpirePasswords(false),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, sts: tc.sts, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(ctx context.Context, input *awsiam.UpdateAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						if aws.ToInt32(input.MinimumPasswordLength) != 12 {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateAccountPasswordPolicyOutput{}, nil
+					},
+				},
+				cr: passwordPolicy(withMinimumPasswordLength(12)),
+			},
+			want: want{
+				cr: passwordPolicy(withMinimumPasswordLength(12),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(ctx context.Context, input *awsiam.UpdateAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(ctx context.Context, input *awsiam.DeleteAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return &awsiam.DeleteAccountPasswordPolicyOutput{}, nil
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(ctx context.Context, input *awsiam.DeleteAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr: passwordPolicy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(ctx context.Context, input *awsiam.DeleteAccountPasswordPolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: passwordPolicy(),
+			},
+			want: want{
+				cr:  passwordPolicy(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a LoginProfile resource"
+
+	errGet              = "cannot get LoginProfile in AWS"
+	errCreate           = "cannot create LoginProfile in AWS"
+	errUpdate           = "cannot update LoginProfile in AWS"
+	errDelete           = "cannot delete LoginProfile in AWS"
+	errGetPassword      = "cannot get the password of the LoginProfile"
+	errGeneratePassword = "cannot generate a password for the LoginProfile"
+	errHashPassword     = "cannot hash the password of the LoginProfile"
+	errKubeUpdate       = "cannot record the hash of the password of the LoginProfile"
+)
+
+// SetupLoginProfile adds a controller that reconciles LoginProfiles.
+func SetupLoginProfile(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.LoginProfileGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewLoginProfileClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.LoginProfileGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.LoginProfile{}, &manualv1alpha1.LoginProfileList{}, secretRefs)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.LoginProfile{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// secretRefs returns the Kubernetes Secrets referenced by a LoginProfile.
+func secretRefs(mg resource.Managed) []types.NamespacedName {
+	cr, ok := mg.(*manualv1alpha1.LoginProfile)
+	if !ok || cr.Spec.ForProvider.PasswordSecretRef == nil {
+		return nil
+	}
+	ref := cr.Spec.ForProvider.PasswordSecretRef
+	return []types.NamespacedName{{Namespace: ref.Namespace, Name: ref.Name}}
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.LoginProfileClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.LoginProfileClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.LoginProfile)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	out, err := e.client.GetLoginProfile(ctx, &awsiam.GetLoginProfileInput{
+		UserName: aws.String(cr.Spec.ForProvider.Username),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if out.LoginProfile == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = manualv1alpha1.LoginProfileObservation{
+		CreateDate:            pointer.TimeToMetaTime(out.LoginProfile.CreateDate),
+		PasswordResetRequired: out.LoginProfile.PasswordResetRequired,
+	}
+	cr.SetConditions(xpv1.Available())
+
+	obs := managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey: []byte(cr.Spec.ForProvider.Username),
+		},
+	}
+	if meta.WasDeleted(cr) {
+		return obs, nil
+	}
+
+	// The password of a login profile cannot be observed, so it is up to
+	// date as long as the referenced password is the one that was last set.
+	// Whether a password reset is required is only set along with the
+	// password, since IAM clears it once the user changed their password.
+	pw, err := iam.GetLoginProfilePassword(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+	obs.ResourceUpToDate = iam.IsLoginProfilePasswordUpToDate(pw, cr.GetAnnotations()[manualv1alpha1.AnnotationKeyPasswordHash])
+	return obs, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.LoginProfile)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	pw, err := iam.GetLoginProfilePassword(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
+	}
+	if pw == "" {
+		if pw, err = iam.GenerateLoginProfilePassword(); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+		}
+	}
+	hash, err := iam.HashLoginProfilePassword(pw)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errHashPassword)
+	}
+
+	if _, err := e.client.CreateLoginProfile(ctx, &awsiam.CreateLoginProfileInput{
+		UserName:              aws.String(cr.Spec.ForProvider.Username),
+		Password:              aws.String(pw),
+		PasswordResetRequired: pointer.BoolValue(cr.Spec.ForProvider.PasswordResetRequired),
+	}); err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	// The annotations are persisted by the managed reconciler after a
+	// creation.
+	meta.SetExternalName(cr, cr.Spec.ForProvider.Username)
+	meta.AddAnnotations(cr, map[string]string{manualv1alpha1.AnnotationKeyPasswordHash: hash})
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.ForProvider.Username),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.LoginProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	pw, err := iam.GetLoginProfilePassword(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	if iam.IsLoginProfilePasswordUpToDate(pw, cr.GetAnnotations()[manualv1alpha1.AnnotationKeyPasswordHash]) {
+		return managed.ExternalUpdate{}, nil
+	}
+	hash, err := iam.HashLoginProfilePassword(pw)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errHashPassword)
+	}
+
+	if _, err := e.client.UpdateLoginProfile(ctx, &awsiam.UpdateLoginProfileInput{
+		UserName:              aws.String(cr.Spec.ForProvider.Username),
+		Password:              aws.String(pw),
+		PasswordResetRequired: cr.Spec.ForProvider.PasswordResetRequired,
+	}); err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+	}
+	meta.AddAnnotations(cr, map[string]string{manualv1alpha1.AnnotationKeyPasswordHash: hash})
+	if err := e.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errKubeUpdate)
+	}
+
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.LoginProfile)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteLoginProfile(ctx, &awsiam.DeleteLoginProfileInput{
+		UserName: aws.String(cr.Spec.ForProvider.Username),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,512 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loginprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	userName    = "some-user"
+	password    = "Secret-Passw0rd!"
+	newPassword = "New-Secret-Passw0rd!"
+
+	passwordRef = &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+		Key:             "password",
+	}
+
+	errBoom = errors.New("boom")
+)
+
+// passwordHash is the hash of password. It is computed once, since bcrypt
+// hashes are salted.
+var passwordHash, _ = iam.HashLoginProfilePassword(password)
+
+type args struct {
+	iam  *fake.MockLoginProfileClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type loginProfileModifier func(*manualv1alpha1.LoginProfile)
+
+func withConditions(c ...xpv1.Condition) loginProfileModifier {
+	return func(r *manualv1alpha1.LoginProfile) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) loginProfileModifier {
+	return func(r *manualv1alpha1.LoginProfile) { meta.SetExternalName(r, n) }
+}
+
+func withPasswordSecretRef() loginProfileModifier {
+	return func(r *manualv1alpha1.LoginProfile) {
+		r.Spec.ForProvider.PasswordSecretRef = passwordRef
+	}
+}
+
+func withPasswordHash() loginProfileModifier {
+	return func(r *manualv1alpha1.LoginProfile) {
+		meta.AddAnnotations(r, map[string]string{manualv1alpha1.AnnotationKeyPasswordHash: passwordHash})
+	}
+}
+
+func withPasswordResetRequired(b bool) loginProfileModifier {
+	return func(r *manualv1alpha1.LoginProfile) { r.Spec.ForProvider.PasswordResetRequired = aws.Bool(b) }
+}
+
+func withAtProvider(o manualv1alpha1.LoginProfileObservation) loginProfileModifier {
+	return func(r *manualv1alpha1.LoginProfile) { r.Status.AtProvider = o }
+}
+
+func loginProfile(m ...loginProfileModifier) *manualv1alpha1.LoginProfile {
+	cr := &manualv1alpha1.LoginProfile{}
+	cr.Spec.ForProvider.Username = userName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// secret returns a kube client that returns the referenced password.
+func secret(pw string) *test.MockClient {
+	return &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{passwordRef.Key: []byte(pw)}
+			return nil
+		}),
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+}
+
+// passwordHashOf returns whether the recorded password hash of the supplied
+// LoginProfile matches the supplied password and removes it, since the hash
+// is salted and cannot be compared.
+func passwordHashOf(cr resource.Managed, pw string) bool {
+	if cr == nil {
+		return false
+	}
+	ok := iam.IsLoginProfilePasswordUpToDate(pw, cr.GetAnnotations()[manualv1alpha1.AnnotationKeyPasswordHash])
+	meta.RemoveAnnotations(cr, manualv1alpha1.AnnotationKeyPasswordHash)
+	return ok
+}
+
+func getLoginProfile(resetRequired bool) func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+		return &awsiam.GetLoginProfileOutput{LoginProfile: &iamtypes.LoginProfile{
+			UserName:              input.UserName,
+			PasswordResetRequired: resetRequired,
+		}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	userDetails := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(userName)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr: loginProfile(),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockGetLoginProfile: func(ctx context.Context, input *awsiam.GetLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.GetLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"GeneratedPassword": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{MockGetLoginProfile: getLoginProfile(true)},
+				cr:  loginProfile(withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withPasswordResetRequired(true),
+					withAtProvider(manualv1alpha1.LoginProfileObservation{PasswordResetRequired: true}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: userDetails,
+				},
+			},
+		},
+		"PasswordReset": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{MockGetLoginProfile: getLoginProfile(false)},
+				cr:  loginProfile(withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withPasswordResetRequired(true),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: userDetails,
+				},
+			},
+		},
+		"PasswordUnchanged": {
+			args: args{
+				iam:  &fake.MockLoginProfileClient{MockGetLoginProfile: getLoginProfile(false)},
+				kube: secret(password),
+				cr:   loginProfile(withPasswordSecretRef(), withPasswordHash()),
+			},
+			want: want{
+				cr: loginProfile(withPasswordSecretRef(), withPasswordHash(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: userDetails,
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				iam:  &fake.MockLoginProfileClient{MockGetLoginProfile: getLoginProfile(false)},
+				kube: secret(newPassword),
+				cr:   loginProfile(withPasswordSecretRef(), withPasswordHash()),
+			},
+			want: want{
+				cr: loginProfile(withPasswordSecretRef(), withPasswordHash(),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: userDetails,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr       resource.Managed
+		password string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"PasswordFromSecret": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						if aws.ToString(input.Password) != password || !input.PasswordResetRequired {
+							return nil, errBoom
+						}
+						return &awsiam.CreateLoginProfileOutput{}, nil
+					},
+				},
+				kube: secret(password),
+				cr:   loginProfile(withPasswordSecretRef(), withPasswordResetRequired(true)),
+			},
+			want: want{
+				cr: loginProfile(withPasswordSecretRef(), withPasswordResetRequired(true),
+					withExternalName(userName),
+					withConditions(xpv1.Creating())),
+				password: password,
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if err == nil && !passwordHashOf(tc.args.cr, tc.want.password) {
+				t.Errorf("Create(...): the recorded hash does not match the password")
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.password, string(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+				t.Errorf("password: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateGeneratedPassword(t *testing.T) {
+	var created string
+	e := &external{client: &fake.MockLoginProfileClient{
+		MockCreateLoginProfile: func(ctx context.Context, input *awsiam.CreateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.CreateLoginProfileOutput, error) {
+			created = aws.ToString(input.Password)
+			return &awsiam.CreateLoginProfileOutput{}, nil
+		},
+	}}
+	cr := loginProfile()
+	o, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): unexpected error %v", err)
+	}
+	if created == "" {
+		t.Errorf("Create(...): no password was generated")
+	}
+	if !passwordHashOf(cr, created) {
+		t.Errorf("Create(...): the recorded hash does not match the generated password")
+	}
+	if diff := cmp.Diff(created, string(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+		t.Errorf("Create(...): published password: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		updated  string
+		recorded bool
+		password string
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Unchanged": {
+			args: args{
+				kube: secret(password),
+				cr:   loginProfile(withPasswordSecretRef(), withPasswordHash()),
+			},
+		},
+		"Changed": {
+			args: args{
+				kube: secret(newPassword),
+				cr:   loginProfile(withPasswordSecretRef(), withPasswordHash()),
+			},
+			want: want{
+				updated:  newPassword,
+				recorded: true,
+				password: newPassword,
+			},
+		},
+		"NotRecorded": {
+			args: args{
+				kube: secret(password),
+				cr:   loginProfile(withPasswordSecretRef()),
+			},
+			want: want{
+				updated:  password,
+				recorded: true,
+				password: password,
+			},
+		},
+		"KubeUpdateError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    secret(newPassword).MockGet,
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				cr: loginProfile(withPasswordSecretRef(), withPasswordHash()),
+			},
+			want: want{
+				updated:  newPassword,
+				recorded: true,
+				err:      errors.Wrap(errBoom, errKubeUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{client: &fake.MockLoginProfileClient{
+				MockUpdateLoginProfile: func(ctx context.Context, input *awsiam.UpdateLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.UpdateLoginProfileOutput, error) {
+					got.updated = aws.ToString(input.Password)
+					return &awsiam.UpdateLoginProfileOutput{}, nil
+				},
+			}, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+			got.err = err
+			got.password = string(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey])
+			got.recorded = got.updated != "" && passwordHashOf(tc.args.cr, got.updated)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return &awsiam.DeleteLoginProfileOutput{}, nil
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr: loginProfile(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr: loginProfile(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockLoginProfileClient{
+					MockDeleteLoginProfile: func(ctx context.Context, input *awsiam.DeleteLoginProfileInput, opts []func(*awsiam.Options)) (*awsiam.DeleteLoginProfileOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: loginProfile(),
+			},
+			want: want{
+				cr:  loginProfile(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package policy
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -25,14 +26,15 @@ import (
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -40,7 +42,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
@@ -59,6 +63,8 @@ const (
 	errKubeUpdateFailed = "cannot late initialize IAM Policy"
 	errTag              = "cannot tag policy"
 	errUntag            = "cannot untag policy"
+	errListVersions     = "cannot list policy versions"
+	errVersionLimit     = "cannot create a new policy version: all existing versions are default or protected"
 )
 
 // SetupPolicy adds a controller that reconciles IAM Policy.
@@ -78,7 +84,7 @@ func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -89,11 +95,16 @@ func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.PolicyGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.Policy{}, &v1beta1.PolicyList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.Policy{}).
+		For(&v1beta1.Policy{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -173,6 +184,13 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errorutils.Wrap(err, errUpToDate)
 	}
 
+	versions, err := e.listPolicyVersions(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errListVersions)
+	}
+	cr.Status.AtProvider.Versions = iam.GeneratePolicyVersions(versions)
+	pruned := len(iam.PolicyVersionsToPrune(versions, versionsToKeep(cr.Spec.ForProvider), cr.Spec.ForProvider.ProtectedVersionIDs)) == 0
+
 	crTagMap := make(map[string]string, len(cr.Spec.ForProvider.Tags))
 	for _, v := range cr.Spec.ForProvider.Tags {
 		crTagMap[v.Key] = v.Value
@@ -181,7 +199,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: update && areRolesUpdated,
+		ResourceUpToDate: update && areRolesUpdated && pruned,
 		Diff:             diff,
 	}, nil
 }
@@ -224,22 +242,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// An update to AWS Policy is a new version of that policy.
-	// A maximum of 5 versions are allowed. Below, the oldest version is deleted
-	// for an update request when 5 versions already exist.
-	// The new version is set as default.
-
-	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
-		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
-	}
-
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
-		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
-		SetAsDefault:   true,
-	})
-
-	if err != nil {
+	if err := e.updateDocument(ctx, cr); err != nil {
 		return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
 	}
 
@@ -317,35 +320,106 @@ func (e *external) listPolicyVersions(ctx context.Context, policyArn string) ([]
 	return resp.Versions, nil
 }
 
-func (e *external) deleteOldestVersion(ctx context.Context, arn string) error {
-	allVersions, err := e.listPolicyVersions(ctx, arn)
+// updateDocument makes the spec document the default version of the policy.
+// An update to AWS Policy is a new version of that policy, unless an existing
+// version already has the desired document, in which case that version is set
+// as default instead. Versions beyond versionsToKeep are pruned oldest first,
+// skipping the default and protected versions.
+func (e *external) updateDocument(ctx context.Context, cr *v1beta1.Policy) error {
+	policyArn := meta.GetExternalName(cr)
+	keep := versionsToKeep(cr.Spec.ForProvider)
+	protected := cr.Spec.ForProvider.ProtectedVersionIDs
+
+	versions, err := e.listPolicyVersions(ctx, policyArn)
 	if err != nil {
 		return err
 	}
 
-	if len(allVersions) < 5 {
-		return nil
+	match, err := e.findPolicyVersion(ctx, policyArn, cr.Spec.ForProvider.Document, versions)
+	if err != nil {
+		return err
+	}
+	if match != nil {
+		if !match.IsDefaultVersion {
+			if _, err := e.client.SetDefaultPolicyVersion(ctx, &awsiam.SetDefaultPolicyVersionInput{
+				PolicyArn: aws.String(policyArn),
+				VersionId: match.VersionId,
+			}); err != nil {
+				return err
+			}
+			for i := range versions {
+				versions[i].IsDefaultVersion = aws.ToString(versions[i].VersionId) == aws.ToString(match.VersionId)
+			}
+		}
+		return e.deletePolicyVersions(ctx, policyArn, iam.PolicyVersionsToPrune(versions, keep, protected))
 	}
 
-	var oldestVersion awsiamtypes.PolicyVersion
+	// Make room for the new version. The previous default version can only
+	// be pruned once the new version has taken its place.
+	prune := iam.PolicyVersionsToPrune(versions, keep-1, protected)
+	if len(versions)-len(prune) >= iam.MaxPolicyVersions {
+		return errors.New(errVersionLimit)
+	}
+	if err := e.deletePolicyVersions(ctx, policyArn, prune); err != nil {
+		return err
+	}
 
-	// loop through all version to find the oldest version.
-	for _, version := range allVersions {
-		if version.IsDefaultVersion {
+	if _, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+		PolicyArn:      aws.String(policyArn),
+		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+		SetAsDefault:   true,
+	}); err != nil {
+		return err
+	}
+
+	versions, err = e.listPolicyVersions(ctx, policyArn)
+	if err != nil {
+		return err
+	}
+	return e.deletePolicyVersions(ctx, policyArn, iam.PolicyVersionsToPrune(versions, keep, protected))
+}
+
+// findPolicyVersion returns the version whose document matches the supplied
+// one, preferring the default version. It returns nil if there is none.
+func (e *external) findPolicyVersion(ctx context.Context, policyArn, document string, versions []awsiamtypes.PolicyVersion) (*awsiamtypes.PolicyVersion, error) {
+	sorted := make([]awsiamtypes.PolicyVersion, len(versions))
+	copy(sorted, versions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].IsDefaultVersion && !sorted[j].IsDefaultVersion
+	})
+
+	for i := range sorted {
+		resp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: sorted[i].VersionId,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if resp.PolicyVersion == nil {
 			continue
 		}
-		if oldestVersion.CreateDate == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+		upToDate, _, err := iam.IsPolicyDocumentUpToDate(document, resp.PolicyVersion.Document)
+		if err != nil {
+			return nil, err
+		}
+		if upToDate {
+			return &sorted[i], nil
 		}
 	}
+	return nil, nil
+}
 
-	_, err = e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
-		PolicyArn: aws.String(arn),
-		VersionId: oldestVersion.VersionId,
-	})
-
-	return err
+func (e *external) deletePolicyVersions(ctx context.Context, policyArn string, versions []awsiamtypes.PolicyVersion) error {
+	for _, version := range versions {
+		if _, err := e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(policyArn),
+			VersionId: version.VersionId,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (e *external) deleteNonDefaultVersions(ctx context.Context, policyArn string) error {
@@ -370,6 +444,14 @@ func (e *external) deleteNonDefaultVersions(ctx context.Context, policyArn strin
 	return nil
 }
 
+// versionsToKeep returns the number of policy versions to retain.
+func versionsToKeep(p v1beta1.PolicyParameters) int {
+	if p.VersionsToKeep == nil {
+		return iam.MaxPolicyVersions
+	}
+	return int(*p.VersionsToKeep)
+}
+
 // getPolicyArnByNameAndPath will attempt to determine the arn for a policy using the current caller identity
 func (e *external) getPolicyArnByNameAndPath(ctx context.Context, policyName string, policyPath *string) (*string, error) {
 
@@ -20,6 +20,7 @@ import (
 	"context"
 	"net/url"
 	"testing"
+	"time"
 
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
@@ -34,6 +35,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -147,6 +149,20 @@ func withTags(tagMaps ...map[string]string) policyModifier {
 	}
 }
 
+func withVersions(v ...v1beta1.PolicyVersion) policyModifier {
+	return func(r *v1beta1.Policy) {
+		r.Status.AtProvider.Versions = v
+	}
+}
+
+func policyVersion(id string, created int64, isDefault bool) awsiamtypes.PolicyVersion {
+	return awsiamtypes.PolicyVersion{
+		VersionId:        aws.String(id),
+		CreateDate:       aws.Time(time.Unix(created, 0)),
+		IsDefaultVersion: isDefault,
+	}
+}
+
 func policy(m ...policyModifier) *v1beta1.Policy {
 	cr := &v1beta1.Policy{}
 	cr.Spec.ForProvider.Name = name
@@ -176,6 +192,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -209,6 +228,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -234,6 +256,54 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"VersionsExceedLimit": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{DefaultVersionId: aws.String("v2")},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v1", 100, false),
+								policyVersion("v2", 200, true),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{
+								Document: &document,
+							},
+						}, nil
+					},
+				},
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document:       document,
+					Name:           name,
+					VersionsToKeep: aws.Int32(1),
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document:       document,
+					Name:           name,
+					VersionsToKeep: aws.Int32(1),
+				}), withExternalName(policyArn),
+					withConditions(xpv1.Available()),
+					func(r *v1beta1.Policy) { r.Status.AtProvider.DefaultVersionID = "v2" },
+					withVersions(
+						v1beta1.PolicyVersion{VersionID: "v2", CreateDate: &metav1.Time{Time: time.Unix(200, 0)}, IsDefaultVersion: true},
+						v1beta1.PolicyVersion{VersionID: "v1", CreateDate: &metav1.Time{Time: time.Unix(100, 0)}},
+					)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -265,6 +335,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -296,6 +369,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, &awsiamtypes.NoSuchEntityException{}
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -326,6 +402,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, &awsiamtypes.NoSuchEntityException{}
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -356,6 +435,9 @@ func TestObserve(t *testing.T) {
 							Policy: &awsiamtypes.Policy{},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -386,6 +468,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
 						return &awsiam.GetPolicyVersionOutput{
 							PolicyVersion: &awsiamtypes.PolicyVersion{
@@ -586,6 +671,125 @@ func TestUpdate(t *testing.T) {
 				cr: policy(withExternalName(policyArn)),
 			},
 		},
+		"RevertToExistingVersion": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v1", 100, false),
+								policyVersion("v2", 200, true),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						doc := `{"Version": "2012-10-17", "Statement": []}`
+						if aws.StringValue(input.VersionId) == "v1" {
+							doc = document
+						}
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{Document: &doc},
+						}, nil
+					},
+					MockSetDefaultPolicyVersion: func(ctx context.Context, input *awsiam.SetDefaultPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.SetDefaultPolicyVersionOutput, error) {
+						if aws.StringValue(input.VersionId) != "v1" {
+							return nil, errBoom
+						}
+						return &awsiam.SetDefaultPolicyVersionOutput{}, nil
+					},
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+				},
+				cr: policy(withSpec(v1beta1.PolicyParameters{Document: document}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1beta1.PolicyParameters{Document: document}), withExternalName(policyArn)),
+			},
+		},
+		"PruneOldestUnprotectedVersion": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v1", 100, false),
+								policyVersion("v2", 200, false),
+								policyVersion("v3", 300, true),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						doc := `{"Version": "2012-10-17", "Statement": []}`
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{Document: &doc},
+						}, nil
+					},
+					MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
+						if aws.StringValue(input.VersionId) != "v2" {
+							return nil, errBoom
+						}
+						return &awsiam.DeletePolicyVersionOutput{}, nil
+					},
+					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return &awsiam.CreatePolicyVersionOutput{}, nil
+					},
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+				},
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document:            document,
+					VersionsToKeep:      aws.Int32(3),
+					ProtectedVersionIDs: []string{"v1"},
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document:            document,
+					VersionsToKeep:      aws.Int32(3),
+					ProtectedVersionIDs: []string{"v1"},
+				}), withExternalName(policyArn)),
+			},
+		},
+		"VersionLimitReached": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								policyVersion("v1", 100, false),
+								policyVersion("v2", 200, false),
+								policyVersion("v3", 300, false),
+								policyVersion("v4", 400, false),
+								policyVersion("v5", 500, true),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						doc := `{"Version": "2012-10-17", "Statement": []}`
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{Document: &doc},
+						}, nil
+					},
+				},
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document:            document,
+					ProtectedVersionIDs: []string{"v1", "v2", "v3", "v4"},
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document:            document,
+					ProtectedVersionIDs: []string{"v1", "v2", "v3", "v4"},
+				}), withExternalName(policyArn)),
+				err: errorutils.Wrap(errors.New(errVersionLimit), errUpdate),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
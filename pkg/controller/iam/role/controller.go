@@ -18,19 +18,24 @@ package role
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -38,7 +43,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -53,6 +61,19 @@ const (
 
 	errKubeUpdateFailed = "cannot late initialize Role"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
+
+	errListAttachedPolicies   = "failed to list the managed policies attached to the Role"
+	errAttachPolicy           = "failed to attach a managed policy to the Role"
+	errDetachPolicy           = "failed to detach a managed policy from the Role"
+	errListInlinePolicies     = "failed to list the inline policies of the Role"
+	errGetInlinePolicy        = "failed to get an inline policy of the Role"
+	errPutInlinePolicy        = "failed to put an inline policy of the Role"
+	errDeleteInlinePolicy     = "failed to delete an inline policy of the Role"
+	errInvalidInlinePolicy    = "the inline policy document is invalid"
+	errInlinePolicyUpToDate   = "cannot check whether the inline policy is up-to-date"
+	errManagedPoliciesDiffFmt = "managed policies to attach: %v, to detach: %v"
+	errInlinePoliciesDiffFmt  = "inline policies to put: %v, to delete: %v"
+	errServiceAccountTrust    = "failed to render the service account trust of the Role"
 )
 
 // SetupRole adds a controller that reconciles Roles.
@@ -67,13 +88,13 @@ func SetupRole(mgr ctrl.Manager, o controller.Options) error {
 	reconcilerOpts := []managed.ReconcilerOption{
 		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
 		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
-		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithReferenceResolver(custommanaged.NewAPIFnReferenceResolver(mgr.GetClient(), resolveReferences)),
 		managed.WithConnectionPublishers(),
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -84,14 +105,40 @@ func SetupRole(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.RoleGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.Role{}, &v1beta1.RoleList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.Role{}).
+		For(&v1beta1.Role{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
+// resolveReferences resolves the reference to the EKS Cluster of the service
+// account trust of a Role. It cannot be generated because eks v1beta1
+// imports iam v1beta1.
+func resolveReferences(ctx context.Context, c client.Reader, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.Role)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	trust := cr.Spec.ForProvider.ServiceAccountTrust
+	if trust == nil {
+		return nil
+	}
+	rsp, err := reference.NewAPIResolver(c, cr).Resolve(ctx, iam.ClusterOIDCProviderARNReference(trust.OIDCProviderARN, trust.ClusterRef, trust.ClusterSelector))
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.serviceAccountTrust.oidcProviderArn")
+	}
+	trust.OIDCProviderARN = reference.ToPtrValue(rsp.ResolvedValue)
+	trust.ClusterRef = rsp.ResolvedReference
+	return nil
+}
+
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) iam.RoleClient
@@ -139,12 +186,25 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 
 	cr.SetConditions(xpv1.Available())
 
-	cr.Status.AtProvider = iam.GenerateRoleObservation(*observed.Role)
+	obs := iam.GenerateRoleObservation(*observed.Role)
+	obs.DetachedPolicyARNs = cr.Status.AtProvider.DetachedPolicyARNs
+	obs.DeletedInlinePolicyNames = cr.Status.AtProvider.DeletedInlinePolicyNames
+	cr.Status.AtProvider = obs
 
-	upToDate, diff, err := iam.IsRoleUpToDate(cr.Spec.ForProvider, role)
+	desired, err := desiredParameters(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	upToDate, diff, err := iam.IsRoleUpToDate(*desired, role)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	if upToDate {
+		upToDate, diff, err = e.arePoliciesUpToDate(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
@@ -164,7 +224,12 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Creating())
 
-	_, err := e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	desired, err := desiredParameters(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	_, err = e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), desired))
 	return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
 }
 
@@ -208,7 +273,12 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	patch, err := iam.CreatePatch(observed.Role, &cr.Spec.ForProvider)
+	desired, err := desiredParameters(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	patch, err := iam.CreatePatch(observed.Role, desired)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCreatePatch)
 	}
@@ -248,14 +318,14 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	if patch.AssumeRolePolicyDocument != "" {
 		_, err = e.client.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
-			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
+			PolicyDocument: &desired.AssumeRolePolicyDocument,
 			RoleName:       aws.String(meta.GetExternalName(cr)),
 		})
 		if err != nil {
 			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+	return managed.ExternalUpdate{}, e.updatePolicies(ctx, cr)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
@@ -266,6 +336,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.Ex
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	// A role can only be deleted once it has no policies. The policies of a
+	// role that manages them exclusively are owned by it.
+	if err := e.removePolicies(ctx, cr); err != nil {
+		return managed.ExternalDelete{}, resource.Ignore(iam.IsErrorNotFound, err)
+	}
+
 	_, err := e.client.DeleteRole(ctx, &awsiam.DeleteRoleInput{
 		RoleName: aws.String(meta.GetExternalName(cr)),
 	})
@@ -277,3 +353,180 @@ func (e *external) Disconnect(ctx context.Context) error {
 	// Unimplemented, required by newer versions of crossplane-runtime
 	return nil
 }
+
+// desiredParameters returns the parameters of the role with its service
+// account trust, if any, rendered into the assume role policy document.
+func desiredParameters(cr *v1beta1.Role) (*v1beta1.RoleParameters, error) {
+	p := cr.Spec.ForProvider.DeepCopy()
+	trust := p.ServiceAccountTrust
+	if trust == nil {
+		return p, nil
+	}
+	document, err := iam.RenderAssumeRolePolicyDocument(p.AssumeRolePolicyDocument, aws.ToString(trust.OIDCProviderARN), *trust)
+	if err != nil {
+		return nil, errors.Wrap(err, errServiceAccountTrust)
+	}
+	p.AssumeRolePolicyDocument = document
+	return p, nil
+}
+
+// isPolicyManagementExclusive returns whether the managed and inline policies
+// of the role are managed exclusively by the Role.
+func isPolicyManagementExclusive(cr *v1beta1.Role) bool {
+	return pointer.BoolValue(cr.Spec.ForProvider.ExclusivePolicyManagement)
+}
+
+// arePoliciesUpToDate returns whether the managed and inline policies of the
+// role match the desired ones exactly, if they are managed by the Role.
+func (e *external) arePoliciesUpToDate(ctx context.Context, cr *v1beta1.Role) (bool, string, error) {
+	if !isPolicyManagementExclusive(cr) {
+		return true, "", nil
+	}
+	roleName := meta.GetExternalName(cr)
+	attached, err := iam.ListAttachedRolePolicyARNs(ctx, e.client, roleName)
+	if err != nil {
+		return false, "", errorutils.Wrap(err, errListAttachedPolicies)
+	}
+	attach, detach := iam.DiffManagedPolicyARNs(cr.Spec.ForProvider.ManagedPolicyARNs, attached)
+	if len(attach) != 0 || len(detach) != 0 {
+		return false, fmt.Sprintf(errManagedPoliciesDiffFmt, attach, detach), nil
+	}
+	names, err := iam.ListRolePolicyNames(ctx, e.client, roleName)
+	if err != nil {
+		return false, "", errorutils.Wrap(err, errListInlinePolicies)
+	}
+	missing, extra := iam.DiffInlinePolicyNames(cr.Spec.ForProvider.InlinePolicies, names)
+	if len(missing) != 0 || len(extra) != 0 {
+		return false, fmt.Sprintf(errInlinePoliciesDiffFmt, missing, extra), nil
+	}
+	for _, name := range sets.List(sets.KeySet(cr.Spec.ForProvider.InlinePolicies)) {
+		upToDate, diff, err := e.isInlinePolicyUpToDate(ctx, roleName, name, cr.Spec.ForProvider.InlinePolicies[name])
+		if err != nil || !upToDate {
+			return false, diff, err
+		}
+	}
+	return true, "", nil
+}
+
+func (e *external) isInlinePolicyUpToDate(ctx context.Context, roleName, policyName string, document extv1.JSON) (bool, string, error) {
+	observed, err := e.client.GetRolePolicy(ctx, &awsiam.GetRolePolicyInput{
+		PolicyName: aws.String(policyName),
+		RoleName:   aws.String(roleName),
+	})
+	if iam.IsErrorNotFound(err) {
+		return false, "", nil
+	}
+	if err != nil {
+		return false, "", errorutils.Wrap(err, errGetInlinePolicy)
+	}
+	upToDate, diff, err := iam.IsPolicyDocumentUpToDate(string(document.Raw), observed.PolicyDocument)
+	return upToDate, diff, errors.Wrap(err, errInlinePolicyUpToDate)
+}
+
+// updatePolicies attaches, puts, detaches and deletes the managed and inline
+// policies of the role so that they match the desired ones exactly. The
+// policies that are removed are recorded in the status of the Role.
+func (e *external) updatePolicies(ctx context.Context, cr *v1beta1.Role) error { //nolint:gocyclo
+	if !isPolicyManagementExclusive(cr) {
+		return nil
+	}
+	roleName := meta.GetExternalName(cr)
+	attached, err := iam.ListAttachedRolePolicyARNs(ctx, e.client, roleName)
+	if err != nil {
+		return errorutils.Wrap(err, errListAttachedPolicies)
+	}
+	// Desired policies are attached first, so that the role keeps its
+	// permissions while a policy is replaced by another one.
+	attach, detach := iam.DiffManagedPolicyARNs(cr.Spec.ForProvider.ManagedPolicyARNs, attached)
+	for _, arn := range attach {
+		if _, err := e.client.AttachRolePolicy(ctx, &awsiam.AttachRolePolicyInput{
+			PolicyArn: aws.String(arn),
+			RoleName:  aws.String(roleName),
+		}); err != nil {
+			return errorutils.Wrap(err, errAttachPolicy)
+		}
+	}
+	for _, arn := range detach {
+		if _, err := e.client.DetachRolePolicy(ctx, &awsiam.DetachRolePolicyInput{
+			PolicyArn: aws.String(arn),
+			RoleName:  aws.String(roleName),
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errorutils.Wrap(err, errDetachPolicy)
+		}
+	}
+	if len(detach) != 0 {
+		cr.Status.AtProvider.DetachedPolicyARNs = detach
+	}
+
+	names, err := iam.ListRolePolicyNames(ctx, e.client, roleName)
+	if err != nil {
+		return errorutils.Wrap(err, errListInlinePolicies)
+	}
+	for _, name := range sets.List(sets.KeySet(cr.Spec.ForProvider.InlinePolicies)) {
+		document := string(cr.Spec.ForProvider.InlinePolicies[name].Raw)
+		upToDate, _, err := e.isInlinePolicyUpToDate(ctx, roleName, name, cr.Spec.ForProvider.InlinePolicies[name])
+		if err != nil {
+			return err
+		}
+		if upToDate {
+			continue
+		}
+		if err := iam.ValidatePolicyObject(document); err != nil {
+			return errors.Wrap(err, errInvalidInlinePolicy)
+		}
+		if _, err := e.client.PutRolePolicy(ctx, &awsiam.PutRolePolicyInput{
+			PolicyDocument: aws.String(document),
+			PolicyName:     aws.String(name),
+			RoleName:       aws.String(roleName),
+		}); err != nil {
+			return errorutils.Wrap(err, errPutInlinePolicy)
+		}
+	}
+	_, extra := iam.DiffInlinePolicyNames(cr.Spec.ForProvider.InlinePolicies, names)
+	for _, name := range extra {
+		if _, err := e.client.DeleteRolePolicy(ctx, &awsiam.DeleteRolePolicyInput{
+			PolicyName: aws.String(name),
+			RoleName:   aws.String(roleName),
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errorutils.Wrap(err, errDeleteInlinePolicy)
+		}
+	}
+	if len(extra) != 0 {
+		cr.Status.AtProvider.DeletedInlinePolicyNames = extra
+	}
+	return nil
+}
+
+// removePolicies detaches all managed policies and deletes all inline
+// policies of the role, if they are managed by the Role.
+func (e *external) removePolicies(ctx context.Context, cr *v1beta1.Role) error {
+	if !isPolicyManagementExclusive(cr) {
+		return nil
+	}
+	roleName := meta.GetExternalName(cr)
+	attached, err := iam.ListAttachedRolePolicyARNs(ctx, e.client, roleName)
+	if err != nil {
+		return errorutils.Wrap(err, errListAttachedPolicies)
+	}
+	for _, arn := range attached {
+		if _, err := e.client.DetachRolePolicy(ctx, &awsiam.DetachRolePolicyInput{
+			PolicyArn: aws.String(arn),
+			RoleName:  aws.String(roleName),
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errorutils.Wrap(err, errDetachPolicy)
+		}
+	}
+	names, err := iam.ListRolePolicyNames(ctx, e.client, roleName)
+	if err != nil {
+		return errorutils.Wrap(err, errListInlinePolicies)
+	}
+	for _, name := range names {
+		if _, err := e.client.DeleteRolePolicy(ctx, &awsiam.DeleteRolePolicyInput{
+			PolicyName: aws.String(name),
+			RoleName:   aws.String(roleName),
+		}); resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return errorutils.Wrap(err, errDeleteInlinePolicy)
+		}
+	}
+	return nil
+}
@@ -31,12 +31,16 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	eksv1beta1 "github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	policyutils "github.com/crossplane-contrib/provider-aws/pkg/utils/policy"
 )
 
 var (
@@ -58,12 +62,38 @@ var (
 		]
 	   }`
 
+	policyARN      = "arn:aws:iam::aws:policy/ReadOnlyAccess"
+	extraPolicyARN = "arn:aws:iam::aws:policy/AdministratorAccess"
+	inlinePolicy   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+
+	oidcIssuer = "oidc.eks.us-east-1.amazonaws.com/id/ABCDEF"
+	oidcARN    = "arn:aws:iam::123456789012:oidc-provider/" + oidcIssuer
+	irsaPolicy = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			"Effect": "Allow",
+			"Principal": {
+			  "Federated": "arn:aws:iam::123456789012:oidc-provider/` + oidcIssuer + `"
+			},
+			"Action": "sts:AssumeRoleWithWebIdentity",
+			"Condition": {
+			  "StringEquals": {
+				"` + oidcIssuer + `:aud": "sts.amazonaws.com",
+				"` + oidcIssuer + `:sub": "system:serviceaccount:default:web"
+			  }
+			}
+		  }
+		]
+	   }`
+
 	errBoom = errors.New("boom")
 )
 
 type args struct {
-	iam iam.RoleClient
-	cr  resource.Managed
+	kube client.Client
+	iam  iam.RoleClient
+	cr   resource.Managed
 }
 
 type roleModifier func(*v1beta1.Role)
@@ -92,6 +122,42 @@ func withDescription() roleModifier {
 	}
 }
 
+func withExclusivePolicyManagement() roleModifier {
+	return func(r *v1beta1.Role) { r.Spec.ForProvider.ExclusivePolicyManagement = aws.Bool(true) }
+}
+
+func withManagedPolicyARNs(arns ...string) roleModifier {
+	return func(r *v1beta1.Role) { r.Spec.ForProvider.ManagedPolicyARNs = arns }
+}
+
+func withInlinePolicy(name, document string) roleModifier {
+	return func(r *v1beta1.Role) {
+		r.Spec.ForProvider.InlinePolicies = map[string]extv1.JSON{name: {Raw: []byte(document)}}
+	}
+}
+
+func withDetachedPolicyARNs(arns ...string) roleModifier {
+	return func(r *v1beta1.Role) { r.Status.AtProvider.DetachedPolicyARNs = arns }
+}
+
+func withDeletedInlinePolicyNames(names ...string) roleModifier {
+	return func(r *v1beta1.Role) { r.Status.AtProvider.DeletedInlinePolicyNames = names }
+}
+
+func attachedPolicies(arns ...string) func(context.Context, *awsiam.ListAttachedRolePoliciesInput, []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+	return func(context.Context, *awsiam.ListAttachedRolePoliciesInput, []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+		out := &awsiam.ListAttachedRolePoliciesOutput{}
+		for _, a := range arns {
+			out.AttachedPolicies = append(out.AttachedPolicies, awsiamtypes.AttachedPolicy{PolicyArn: aws.String(a)})
+		}
+		return out, nil
+	}
+}
+
+func withServiceAccountTrust(t *v1beta1.ServiceAccountTrust) roleModifier {
+	return func(r *v1beta1.Role) { r.Spec.ForProvider.ServiceAccountTrust = t }
+}
+
 func role(m ...roleModifier) *v1beta1.Role {
 	cr := &v1beta1.Role{}
 	for _, f := range m {
@@ -140,6 +206,124 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ExtraManagedPolicy": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: pointer.ToOrNilIfZeroValue(arn),
+							},
+						}, nil
+					},
+					MockListAttachedRolePolicies: attachedPolicies(policyARN, extraPolicyARN),
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN), withDetachedPolicyARNs(extraPolicyARN)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withManagedPolicyARNs(policyARN),
+					withArn(arn),
+					withDetachedPolicyARNs(extraPolicyARN),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+					Diff:           "managed policies to attach: [], to detach: [" + extraPolicyARN + "]",
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
+		"InlinePolicyUpToDate": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: pointer.ToOrNilIfZeroValue(arn),
+							},
+						}, nil
+					},
+					MockListAttachedRolePolicies: attachedPolicies(),
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{PolicyNames: []string{"read"}}, nil
+					},
+					MockGetRolePolicy: func(ctx context.Context, input *awsiam.GetRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return &awsiam.GetRolePolicyOutput{PolicyDocument: aws.String(url.QueryEscape(inlinePolicy))}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withInlinePolicy("read", inlinePolicy)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withInlinePolicy("read", inlinePolicy),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
+		"PoliciesNotManaged": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{
+								Arn: pointer.ToOrNilIfZeroValue(arn),
+							},
+						}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withManagedPolicyARNs(policyARN),
+					withArn(arn),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: map[string][]byte{
+						"arn": []byte(arn),
+					},
+				},
+			},
+		},
+		"ListAttachedPoliciesError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withManagedPolicyARNs(policyARN),
+					withConditions(xpv1.Available())),
+				err: errorutils.Wrap(errBoom, errListAttachedPolicies),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -223,6 +407,28 @@ func TestCreate(t *testing.T) {
 					withConditions(xpv1.Creating())),
 			},
 		},
+		"ServiceAccountTrust": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockCreateRole: func(ctx context.Context, input *awsiam.CreateRoleInput, opts []func(*awsiam.Options)) (*awsiam.CreateRoleOutput, error) {
+						if !policyutils.ArePolicyDocumentsEqual(aws.ToString(input.AssumeRolePolicyDocument), irsaPolicy) {
+							return nil, errBoom
+						}
+						return &awsiam.CreateRoleOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+					OIDCProviderARN: aws.String(oidcARN),
+					ServiceAccounts: []v1beta1.ServiceAccount{{Namespace: "default", Name: "web"}},
+				})),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName), withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+					OIDCProviderARN: aws.String(oidcARN),
+					ServiceAccounts: []v1beta1.ServiceAccount{{Namespace: "default", Name: "web"}},
+				}), withConditions(xpv1.Creating())),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -250,7 +456,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -305,6 +511,116 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
+		"ExclusivePolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockUpdateRole: func(ctx context.Context, input *awsiam.UpdateRoleInput, opts []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+						return &awsiam.UpdateRoleOutput{}, nil
+					},
+					MockListAttachedRolePolicies: attachedPolicies(extraPolicyARN),
+					MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyArn) != policyARN {
+							return nil, errBoom
+						}
+						return &awsiam.AttachRolePolicyOutput{}, nil
+					},
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyArn) != extraPolicyARN {
+							return nil, errBoom
+						}
+						return &awsiam.DetachRolePolicyOutput{}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{PolicyNames: []string{"manual"}}, nil
+					},
+					MockGetRolePolicy: func(ctx context.Context, input *awsiam.GetRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetRolePolicyOutput, error) {
+						return nil, &awsiamtypes.NoSuchEntityException{}
+					},
+					MockPutRolePolicy: func(ctx context.Context, input *awsiam.PutRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.PutRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyName) != "read" {
+							return nil, errBoom
+						}
+						return &awsiam.PutRolePolicyOutput{}, nil
+					},
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						if aws.ToString(input.PolicyName) != "manual" {
+							return nil, errBoom
+						}
+						return &awsiam.DeleteRolePolicyOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN), withInlinePolicy("read", inlinePolicy)),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withManagedPolicyARNs(policyARN),
+					withInlinePolicy("read", inlinePolicy),
+					withDetachedPolicyARNs(extraPolicyARN),
+					withDeletedInlinePolicyNames("manual")),
+			},
+		},
+		"ExclusiveWithoutPolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockUpdateRole: func(ctx context.Context, input *awsiam.UpdateRoleInput, opts []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+						return &awsiam.UpdateRoleOutput{}, nil
+					},
+					MockListAttachedRolePolicies: attachedPolicies(extraPolicyARN),
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						return &awsiam.DetachRolePolicyOutput{}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{PolicyNames: []string{"manual"}}, nil
+					},
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						return &awsiam.DeleteRolePolicyOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement()),
+			},
+			want: want{
+				cr: role(
+					withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withDetachedPolicyARNs(extraPolicyARN),
+					withDeletedInlinePolicyNames("manual")),
+			},
+		},
+		"AttachPolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockGetRole: func(ctx context.Context, input *awsiam.GetRoleInput, opts []func(*awsiam.Options)) (*awsiam.GetRoleOutput, error) {
+						return &awsiam.GetRoleOutput{
+							Role: &awsiamtypes.Role{},
+						}, nil
+					},
+					MockUpdateRole: func(ctx context.Context, input *awsiam.UpdateRoleInput, opts []func(*awsiam.Options)) (*awsiam.UpdateRoleOutput, error) {
+						return &awsiam.UpdateRoleOutput{}, nil
+					},
+					MockListAttachedRolePolicies: attachedPolicies(),
+					MockAttachRolePolicy: func(ctx context.Context, input *awsiam.AttachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.AttachRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr:  role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN)),
+				err: errorutils.Wrap(errBoom, errAttachPolicy),
+			},
+		},
 		"ClientUpdateRoleError": {
 			args: args{
 				iam: &fake.MockRoleClient{
@@ -414,6 +730,50 @@ func TestDelete(t *testing.T) {
 				err: errorutils.Wrap(errBoom, errDelete),
 			},
 		},
+		"RemovesExclusivePolicies": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockListAttachedRolePolicies: attachedPolicies(policyARN),
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						return &awsiam.DetachRolePolicyOutput{}, nil
+					},
+					MockListRolePolicies: func(ctx context.Context, input *awsiam.ListRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListRolePoliciesOutput, error) {
+						return &awsiam.ListRolePoliciesOutput{PolicyNames: []string{"manual"}}, nil
+					},
+					MockDeleteRolePolicy: func(ctx context.Context, input *awsiam.DeleteRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRolePolicyOutput, error) {
+						return &awsiam.DeleteRolePolicyOutput{}, nil
+					},
+					MockDeleteRole: func(ctx context.Context, input *awsiam.DeleteRoleInput, opts []func(*awsiam.Options)) (*awsiam.DeleteRoleOutput, error) {
+						return &awsiam.DeleteRoleOutput{}, nil
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withManagedPolicyARNs(policyARN),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"DetachPolicyError": {
+			args: args{
+				iam: &fake.MockRoleClient{
+					MockListAttachedRolePolicies: attachedPolicies(policyARN),
+					MockDetachRolePolicy: func(ctx context.Context, input *awsiam.DetachRolePolicyInput, opts []func(*awsiam.Options)) (*awsiam.DetachRolePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: role(withRoleName(&roleName), withExclusivePolicyManagement(), withManagedPolicyARNs(policyARN)),
+			},
+			want: want{
+				cr: role(withRoleName(&roleName),
+					withExclusivePolicyManagement(),
+					withManagedPolicyARNs(policyARN),
+					withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDetachPolicy),
+			},
+		},
 		"ResourceDoesNotExist": {
 			args: args{
 				iam: &fake.MockRoleClient{
@@ -444,3 +804,94 @@ func TestDelete(t *testing.T) {
 	}
 
 }
+
+func TestResolveReferences(t *testing.T) {
+	serviceAccounts := []v1beta1.ServiceAccount{{Namespace: "default", Name: "web"}}
+
+	type want struct {
+		cr  *v1beta1.Role
+		err bool
+	}
+
+	cases := map[string]struct {
+		kube client.Reader
+		cr   *v1beta1.Role
+		want want
+	}{
+		"ResolveCluster": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					c := obj.(*eksv1beta1.Cluster)
+					c.Status.AtProvider.Arn = "arn:aws:eks:us-east-1:123456789012:cluster/demo"
+					c.Status.AtProvider.Identity.OIDC.Issuer = "https://" + oidcIssuer
+					return nil
+				},
+			},
+			cr: role(withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+				ClusterRef:      &xpv1.Reference{Name: "demo"},
+				ServiceAccounts: serviceAccounts,
+			})),
+			want: want{
+				cr: role(withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+					OIDCProviderARN: aws.String(oidcARN),
+					ClusterRef:      &xpv1.Reference{Name: "demo"},
+					ServiceAccounts: serviceAccounts,
+				})),
+			},
+		},
+		"ClusterWithoutIssuer": {
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					obj.(*eksv1beta1.Cluster).Status.AtProvider.Arn = "arn:aws:eks:us-east-1:123456789012:cluster/demo"
+					return nil
+				},
+			},
+			cr: role(withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+				ClusterRef:      &xpv1.Reference{Name: "demo"},
+				ServiceAccounts: serviceAccounts,
+			})),
+			want: want{
+				cr: role(withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+					ClusterRef:      &xpv1.Reference{Name: "demo"},
+					ServiceAccounts: serviceAccounts,
+				})),
+				err: true,
+			},
+		},
+		"ClusterNotFound": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: role(withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+				ClusterRef:      &xpv1.Reference{Name: "demo"},
+				ServiceAccounts: serviceAccounts,
+			})),
+			want: want{
+				cr: role(withServiceAccountTrust(&v1beta1.ServiceAccountTrust{
+					ClusterRef:      &xpv1.Reference{Name: "demo"},
+					ServiceAccounts: serviceAccounts,
+				})),
+				err: true,
+			},
+		},
+		"NoServiceAccountTrust": {
+			kube: &test.MockClient{},
+			cr:   role(withRoleName(&roleName)),
+			want: want{
+				cr: role(withRoleName(&roleName)),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := resolveReferences(context.Background(), tc.kube, tc.cr)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("resolveReferences(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
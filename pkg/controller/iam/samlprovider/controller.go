@@ -0,0 +1,253 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SAMLProvider resource"
+
+	errList        = "cannot list SAMLProviders in AWS"
+	errGet         = "cannot get SAMLProvider in AWS"
+	errCreate      = "cannot create SAMLProvider in AWS"
+	errUpdate      = "cannot update SAMLProvider in AWS"
+	errDelete      = "cannot delete SAMLProvider in AWS"
+	errAddTags     = "cannot add tags to SAMLProvider in AWS"
+	errRemoveTags  = "cannot remove tags from SAMLProvider in AWS"
+	errGetDocument = "cannot get the SAML metadata document"
+)
+
+// SetupSAMLProvider adds a controller that reconciles SAMLProviders.
+func SetupSAMLProvider(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.SAMLProviderGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewSAMLProviderClient}),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.SAMLProviderGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.SAMLProvider{}, &manualv1alpha1.SAMLProviderList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.SAMLProvider{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.SAMLProviderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.SAMLProviderClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.SAMLProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		providers, err := e.client.ListSAMLProviders(ctx, &awsiam.ListSAMLProvidersInput{})
+		if err != nil {
+			return managed.ExternalObservation{}, errorutils.Wrap(err, errList)
+		}
+		arn := iam.FindSAMLProviderARN(providers, cr.Spec.ForProvider.Name)
+		if arn == "" {
+			return managed.ExternalObservation{}, nil
+		}
+		meta.SetExternalName(cr, arn)
+		_ = e.kube.Update(ctx, cr)
+	}
+
+	out, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+
+	cr.Status.AtProvider = iam.GenerateSAMLProviderObservation(meta.GetExternalName(cr), out)
+	cr.SetConditions(xpv1.Available())
+
+	// The metadata document is not needed to delete the SAML provider, e.g.
+	// if its ConfigMap is deleted along with it.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	document, err := iam.GetSAMLMetadataDocument(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetDocument)
+	}
+	_, _, tagsUpToDate := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, out.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsSAMLMetadataDocumentUpToDate(document, out) && tagsUpToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.SAMLProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	document, err := iam.GetSAMLMetadataDocument(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetDocument)
+	}
+	out, err := e.client.CreateSAMLProvider(ctx, &awsiam.CreateSAMLProviderInput{
+		Name:                 aws.String(cr.Spec.ForProvider.Name),
+		SAMLMetadataDocument: aws.String(document),
+		Tags:                 iam.BuildIAMTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(out.SAMLProviderArn))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.SAMLProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	arn := aws.String(meta.GetExternalName(cr))
+	out, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{SAMLProviderArn: arn})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGet)
+	}
+	document, err := iam.GetSAMLMetadataDocument(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDocument)
+	}
+
+	if !iam.IsSAMLMetadataDocumentUpToDate(document, out) {
+		if _, err := e.client.UpdateSAMLProvider(ctx, &awsiam.UpdateSAMLProviderInput{
+			SAMLMetadataDocument: aws.String(document),
+			SAMLProviderArn:      arn,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		}
+	}
+
+	addTags, removeTags, _ := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, out.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.TagSAMLProvider(ctx, &awsiam.TagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			Tags:            addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.UntagSAMLProvider(ctx, &awsiam.UntagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			TagKeys:         removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.SAMLProvider)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteSAMLProvider(ctx, &awsiam.DeleteSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
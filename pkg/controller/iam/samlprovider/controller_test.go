@@ -0,0 +1,517 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	providerName = "my-idp"
+	providerArn  = "arn:aws:iam::123456789012:saml-provider/my-idp"
+	document     = "<EntityDescriptor/>"
+	newDocument  = "<EntityDescriptor entityID=\"new\"/>"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockSAMLProviderClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type samlProviderModifier func(*manualv1alpha1.SAMLProvider)
+
+func withConditions(c ...xpv1.Condition) samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) { meta.SetExternalName(r, n) }
+}
+
+func withDocument(d string) samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) { r.Spec.ForProvider.SAMLMetadataDocument = aws.String(d) }
+}
+
+func withDocumentConfigMapRef() samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) {
+		r.Spec.ForProvider.SAMLMetadataDocumentConfigMapRef = &manualv1alpha1.ConfigMapKeySelector{
+			Name:      "idp-metadata",
+			Namespace: "default",
+			Key:       "metadata.xml",
+		}
+	}
+}
+
+func withTags(tags ...v1beta1.Tag) samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withAtProvider(o manualv1alpha1.SAMLProviderObservation) samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() samlProviderModifier {
+	return func(r *manualv1alpha1.SAMLProvider) { r.SetDeletionTimestamp(&metav1.Time{Time: time.Unix(200, 0)}) }
+}
+
+func samlProvider(m ...samlProviderModifier) *manualv1alpha1.SAMLProvider {
+	cr := &manualv1alpha1.SAMLProvider{}
+	cr.Spec.ForProvider.Name = providerName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getProvider(doc string, tags ...iamtypes.Tag) func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+	return func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+		if aws.ToString(input.SAMLProviderArn) != providerArn {
+			return nil, &iamtypes.NoSuchEntityException{}
+		}
+		return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(doc), Tags: tags}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalNameNotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockListSAMLProviders: func(ctx context.Context, input *awsiam.ListSAMLProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListSAMLProvidersOutput, error) {
+						return &awsiam.ListSAMLProvidersOutput{
+							SAMLProviderList: []iamtypes.SAMLProviderListEntry{
+								{Arn: aws.String("arn:aws:iam::123456789012:saml-provider/other")},
+							},
+						}, nil
+					},
+				},
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document)),
+			},
+		},
+		"NoExternalNameListError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockListSAMLProviders: func(ctx context.Context, input *awsiam.ListSAMLProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListSAMLProvidersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr:  samlProvider(withDocument(document)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"NoExternalNameExisting": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockListSAMLProviders: func(ctx context.Context, input *awsiam.ListSAMLProvidersInput, opts []func(*awsiam.Options)) (*awsiam.ListSAMLProvidersOutput, error) {
+						return &awsiam.ListSAMLProvidersOutput{
+							SAMLProviderList: []iamtypes.SAMLProviderListEntry{{Arn: aws.String(providerArn)}},
+						}, nil
+					},
+					MockGetSAMLProvider: getProvider(document),
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document),
+					withExternalName(providerArn),
+					withAtProvider(manualv1alpha1.SAMLProviderObservation{ARN: providerArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withDocument(document), withExternalName(providerArn)),
+				err: errorutils.Wrap(errBoom, errGet),
+			},
+		},
+		"DocumentFromConfigMap": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getProvider(document),
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"metadata.xml": newDocument}
+						return nil
+					}),
+				},
+				cr: samlProvider(withDocumentConfigMapRef(), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withDocumentConfigMapRef(),
+					withExternalName(providerArn),
+					withAtProvider(manualv1alpha1.SAMLProviderObservation{ARN: providerArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ConfigMapError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getProvider(document),
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   samlProvider(withDocumentConfigMapRef(), withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withDocumentConfigMapRef(),
+					withExternalName(providerArn),
+					withAtProvider(manualv1alpha1.SAMLProviderObservation{ARN: providerArn}),
+					withConditions(xpv1.Available())),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get the referenced ConfigMap"), errGetDocument),
+			},
+		},
+		"DeletedWithoutConfigMap": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getProvider(document),
+				},
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				cr:   samlProvider(withDocumentConfigMapRef(), withExternalName(providerArn), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: samlProvider(withDocumentConfigMapRef(),
+					withExternalName(providerArn),
+					withDeletionTimestamp(),
+					withAtProvider(manualv1alpha1.SAMLProviderObservation{ARN: providerArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: getProvider(document),
+				},
+				cr: samlProvider(withDocument(document), withExternalName(providerArn),
+					withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document),
+					withExternalName(providerArn),
+					withTags(v1beta1.Tag{Key: "k", Value: "v"}),
+					withAtProvider(manualv1alpha1.SAMLProviderObservation{ARN: providerArn}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						if aws.ToString(input.Name) != providerName || aws.ToString(input.SAMLMetadataDocument) != document {
+							return nil, errBoom
+						}
+						return &awsiam.CreateSAMLProviderOutput{SAMLProviderArn: aws.String(providerArn)}, nil
+					},
+				},
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document),
+					withExternalName(providerArn),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"NoDocument": {
+			args: args{
+				cr: samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New("one of samlMetadataDocument or samlMetadataDocumentConfigMapRef is required"), errGetDocument),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr:  samlProvider(withDocument(document), withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		updated     *string
+		tagged      []iamtypes.Tag
+		untaggedKey []string
+		err         error
+	}
+
+	cases := map[string]struct {
+		cr       *manualv1alpha1.SAMLProvider
+		observed string
+		tags     []iamtypes.Tag
+		want
+	}{
+		"Document": {
+			cr:       samlProvider(withDocument(newDocument), withExternalName(providerArn)),
+			observed: document,
+			want: want{
+				updated: aws.String(newDocument),
+			},
+		},
+		"Tags": {
+			cr:       samlProvider(withDocument(document), withExternalName(providerArn), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			observed: document,
+			tags:     []iamtypes.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+			want: want{
+				tagged:      []iamtypes.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				untaggedKey: []string{"old"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := want{}
+			e := &external{client: &fake.MockSAMLProviderClient{
+				MockGetSAMLProvider: getProvider(tc.observed, tc.tags...),
+				MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+					got.updated = input.SAMLMetadataDocument
+					return &awsiam.UpdateSAMLProviderOutput{}, nil
+				},
+				MockTagSAMLProvider: func(ctx context.Context, input *awsiam.TagSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.TagSAMLProviderOutput, error) {
+					got.tagged = input.Tags
+					return &awsiam.TagSAMLProviderOutput{}, nil
+				},
+				MockUntagSAMLProvider: func(ctx context.Context, input *awsiam.UntagSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UntagSAMLProviderOutput, error) {
+					got.untaggedKey = input.TagKeys
+					return &awsiam.UntagSAMLProviderOutput{}, nil
+				},
+			}}
+			_, got.err = e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, got, test.EquateErrors(), cmp.AllowUnexported(want{}), cmpopts.IgnoreUnexported(iamtypes.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return &awsiam.DeleteSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn), withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servercertificate
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ServerCertificate resource"
+
+	errGet        = "cannot get ServerCertificate in AWS"
+	errCreate     = "cannot upload ServerCertificate to AWS"
+	errUpdate     = "cannot update ServerCertificate in AWS"
+	errDelete     = "cannot delete ServerCertificate in AWS"
+	errAddTags    = "cannot add tags to ServerCertificate in AWS"
+	errRemoveTags = "cannot remove tags from ServerCertificate in AWS"
+	errGetContent = "cannot get the certificate of the ServerCertificate"
+
+	errCertificateChanged = "the certificate of a ServerCertificate cannot be changed, create a new ServerCertificate for the changed certificate"
+)
+
+// SetupServerCertificate adds a controller that reconciles ServerCertificates.
+func SetupServerCertificate(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.ServerCertificateGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewServerCertificateClient}),
+		managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.ServerCertificateGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.ServerCertificate{}, &manualv1alpha1.ServerCertificateList{}, secretRefs)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.ServerCertificate{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.ServerCertificateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client iam.ServerCertificateClient
+	kube   client.Client
+}
+
+// secretRefs returns the Kubernetes Secrets referenced by a
+// ServerCertificate.
+func secretRefs(mg resource.Managed) []types.NamespacedName {
+	cr, ok := mg.(*manualv1alpha1.ServerCertificate)
+	if !ok {
+		return nil
+	}
+	ref := cr.Spec.ForProvider.CertificateSecretRef
+	return []types.NamespacedName{{Namespace: ref.Namespace, Name: ref.Name}}
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServerCertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	out, err := e.client.GetServerCertificate(ctx, &awsiam.GetServerCertificateInput{
+		ServerCertificateName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if out.ServerCertificate == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = iam.GenerateServerCertificateObservation(*out.ServerCertificate)
+	cr.SetConditions(xpv1.Available())
+
+	// The certificate is not needed to delete the server certificate, e.g. if
+	// its Secret is deleted along with it.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	content, err := iam.GetServerCertificateContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetContent)
+	}
+	_, _, tagsUpToDate := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, out.ServerCertificate.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: iam.IsServerCertificateContentUpToDate(content, *out.ServerCertificate) &&
+			iam.IsServerCertificatePathUpToDate(cr.Spec.ForProvider, *out.ServerCertificate) &&
+			tagsUpToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServerCertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	content, err := iam.GetServerCertificateContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetContent)
+	}
+	_, err = e.client.UploadServerCertificate(ctx, iam.GenerateUploadServerCertificateInput(meta.GetExternalName(cr), cr.Spec.ForProvider, content))
+	return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServerCertificate)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	name := aws.String(meta.GetExternalName(cr))
+	out, err := e.client.GetServerCertificate(ctx, &awsiam.GetServerCertificateInput{ServerCertificateName: name})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errGet)
+	}
+	content, err := iam.GetServerCertificateContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetContent)
+	}
+
+	if !iam.IsServerCertificatePathUpToDate(cr.Spec.ForProvider, *out.ServerCertificate) {
+		if _, err := e.client.UpdateServerCertificate(ctx, &awsiam.UpdateServerCertificateInput{
+			NewPath:               cr.Spec.ForProvider.Path,
+			ServerCertificateName: name,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+		}
+	}
+
+	addTags, removeTags, _ := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, out.ServerCertificate.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.TagServerCertificate(ctx, &awsiam.TagServerCertificateInput{
+			ServerCertificateName: name,
+			Tags:                  addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.UntagServerCertificate(ctx, &awsiam.UntagServerCertificateInput{
+			ServerCertificateName: name,
+			TagKeys:               removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTags)
+		}
+	}
+
+	// The certificate of a server certificate cannot be changed in place.
+	// Replacing it would delete a server certificate that may still be in
+	// use, so a changed certificate is only reported.
+	if !iam.IsServerCertificateContentUpToDate(content, *out.ServerCertificate) {
+		return managed.ExternalUpdate{}, errors.New(errCertificateChanged)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServerCertificate)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteServerCertificate(ctx, &awsiam.DeleteServerCertificateInput{
+		ServerCertificateName: aws.String(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicespecificcredential
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ServiceSpecificCredential resource"
+
+	errList   = "cannot list ServiceSpecificCredentials in AWS"
+	errCreate = "cannot create ServiceSpecificCredential in AWS"
+	errUpdate = "cannot update ServiceSpecificCredential in AWS"
+	errDelete = "cannot delete ServiceSpecificCredential in AWS"
+)
+
+// SetupServiceSpecificCredential adds a controller that reconciles
+// ServiceSpecificCredentials.
+func SetupServiceSpecificCredential(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.ServiceSpecificCredentialGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewServiceSpecificCredentialClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.ServiceSpecificCredentialGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.ServiceSpecificCredential{}, &manualv1alpha1.ServiceSpecificCredentialList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.ServiceSpecificCredential{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.ServiceSpecificCredentialClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client iam.ServiceSpecificCredentialClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	out, err := e.client.ListServiceSpecificCredentials(ctx, &awsiam.ListServiceSpecificCredentialsInput{
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+		ServiceName: aws.String(cr.Spec.ForProvider.ServiceName),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
+	}
+	credential := iam.FindServiceSpecificCredential(out, meta.GetExternalName(cr))
+	if credential == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = iam.GenerateServiceSpecificCredentialObservation(*credential)
+	switch credential.Status {
+	case awsiamtypes.StatusTypeActive:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	cr.Spec.ForProvider.Status = pointer.LateInitialize(cr.Spec.ForProvider.Status, aws.String(string(credential.Status)))
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        iam.IsServiceSpecificCredentialUpToDate(cr.Spec.ForProvider, *credential),
+		ResourceLateInitialized: pointer.StringValue(current.Status) != pointer.StringValue(cr.Spec.ForProvider.Status),
+		ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretUserKey: []byte(aws.ToString(credential.ServiceUserName)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateServiceSpecificCredential(ctx, &awsiam.CreateServiceSpecificCredentialInput{
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+		ServiceName: aws.String(cr.Spec.ForProvider.ServiceName),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	credential := out.ServiceSpecificCredential
+
+	meta.SetExternalName(cr, aws.ToString(credential.ServiceSpecificCredentialId))
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(credential.ServiceUserName)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(credential.ServicePassword)),
+	}}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	if cr.Spec.ForProvider.Status == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.UpdateServiceSpecificCredential(ctx, &awsiam.UpdateServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(meta.GetExternalName(cr)),
+		UserName:                    aws.String(cr.Spec.ForProvider.Username),
+		Status:                      awsiamtypes.StatusType(*cr.Spec.ForProvider.Status),
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.ServiceSpecificCredential)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteServiceSpecificCredential(ctx, &awsiam.DeleteServiceSpecificCredentialInput{
+		ServiceSpecificCredentialId: aws.String(meta.GetExternalName(cr)),
+		UserName:                    aws.String(cr.Spec.ForProvider.Username),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
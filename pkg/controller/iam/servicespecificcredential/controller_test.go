@@ -0,0 +1,392 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicespecificcredential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	userName        = "some-user"
+	serviceName     = "codecommit.amazonaws.com"
+	credentialID    = "ACCA1234567890EXAMPLE"
+	serviceUserName = "some-user-at-123456789012"
+	servicePassword = "secret"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam *fake.MockServiceSpecificCredentialClient
+	cr  resource.Managed
+}
+
+type credentialModifier func(*manualv1alpha1.ServiceSpecificCredential)
+
+func withConditions(c ...xpv1.Condition) credentialModifier {
+	return func(r *manualv1alpha1.ServiceSpecificCredential) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) credentialModifier {
+	return func(r *manualv1alpha1.ServiceSpecificCredential) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s string) credentialModifier {
+	return func(r *manualv1alpha1.ServiceSpecificCredential) { r.Spec.ForProvider.Status = aws.String(s) }
+}
+
+func withAtProvider(o manualv1alpha1.ServiceSpecificCredentialObservation) credentialModifier {
+	return func(r *manualv1alpha1.ServiceSpecificCredential) { r.Status.AtProvider = o }
+}
+
+func credential(m ...credentialModifier) *manualv1alpha1.ServiceSpecificCredential {
+	cr := &manualv1alpha1.ServiceSpecificCredential{}
+	cr.Spec.ForProvider.Username = userName
+	cr.Spec.ForProvider.ServiceName = serviceName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listCredentials(status iamtypes.StatusType) func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+		return &awsiam.ListServiceSpecificCredentialsOutput{
+			ServiceSpecificCredentials: []iamtypes.ServiceSpecificCredentialMetadata{{
+				ServiceSpecificCredentialId: aws.String(credentialID),
+				ServiceUserName:             aws.String(serviceUserName),
+				Status:                      status,
+			}},
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := manualv1alpha1.ServiceSpecificCredentialObservation{
+		ServiceSpecificCredentialID: credentialID,
+		ServiceUserName:             serviceUserName,
+	}
+	userDetails := managed.ConnectionDetails{xpv1.ResourceCredentialsSecretUserKey: []byte(serviceUserName)}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: credential(),
+			},
+			want: want{
+				cr: credential(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeActive),
+				},
+				cr: credential(withExternalName("other")),
+			},
+			want: want{
+				cr: credential(withExternalName("other")),
+			},
+		},
+		"UserNotFound": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID)),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: func(ctx context.Context, input *awsiam.ListServiceSpecificCredentialsInput, opts []func(*awsiam.Options)) (*awsiam.ListServiceSpecificCredentialsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr:  credential(withExternalName(credentialID)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeActive),
+				},
+				cr: credential(withExternalName(credentialID)),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID),
+					withStatus("Active"),
+					withAtProvider(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       userDetails,
+				},
+			},
+		},
+		"StatusChanged": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeActive),
+				},
+				cr: credential(withExternalName(credentialID), withStatus("Inactive")),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID),
+					withStatus("Inactive"),
+					withAtProvider(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ConnectionDetails: userDetails,
+				},
+			},
+		},
+		"Inactive": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockListServiceSpecificCredentials: listCredentials(iamtypes.StatusTypeInactive),
+				},
+				cr: credential(withExternalName(credentialID), withStatus("Inactive")),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID),
+					withStatus("Inactive"),
+					withAtProvider(observation),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: userDetails,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockCreateServiceSpecificCredential: func(ctx context.Context, input *awsiam.CreateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.CreateServiceSpecificCredentialOutput, error) {
+						if aws.ToString(input.UserName) != userName || aws.ToString(input.ServiceName) != serviceName {
+							return nil, errBoom
+						}
+						return &awsiam.CreateServiceSpecificCredentialOutput{ServiceSpecificCredential: &iamtypes.ServiceSpecificCredential{
+							ServiceSpecificCredentialId: aws.String(credentialID),
+							ServiceUserName:             aws.String(serviceUserName),
+							ServicePassword:             aws.String(servicePassword),
+						}}, nil
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr: credential(withExternalName(credentialID),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte(serviceUserName),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(servicePassword),
+				}},
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockServiceSpecificCredentialClient{
+					MockCreateServiceSpecificCredential: func(ctx context.Context, input *awsiam.CreateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.CreateServiceSpecificCredentialOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: credential(),
+			},
+			want: want{
+				cr:  credential(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		cr   resource.Managed
+		err  error
+		want error
+	}{
+		"Successful": {
+			cr: credential(withExternalName(credentialID), withStatus("Inactive")),
+		},
+		"ClientError": {
+			cr:   credential(withExternalName(credentialID), withStatus("Inactive")),
+			err:  errBoom,
+			want: errorutils.Wrap(errBoom, errUpdate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockServiceSpecificCredentialClient{
+				MockUpdateServiceSpecificCredential: func(ctx context.Context, input *awsiam.UpdateServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.UpdateServiceSpecificCredentialOutput, error) {
+					if aws.ToString(input.ServiceSpecificCredentialId) != credentialID || input.Status != iamtypes.StatusTypeInactive {
+						return nil, errors.New("unexpected input")
+					}
+					return &awsiam.UpdateServiceSpecificCredentialOutput{}, tc.err
+				},
+			}}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want error
+	}{
+		"Successful": {},
+		"NotFound": {
+			err: &iamtypes.NoSuchEntityException{},
+		},
+		"ClientError": {
+			err:  errBoom,
+			want: errorutils.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := credential(withExternalName(credentialID))
+			e := &external{client: &fake.MockServiceSpecificCredentialClient{
+				MockDeleteServiceSpecificCredential: func(ctx context.Context, input *awsiam.DeleteServiceSpecificCredentialInput, opts []func(*awsiam.Options)) (*awsiam.DeleteServiceSpecificCredentialOutput, error) {
+					return &awsiam.DeleteServiceSpecificCredentialOutput{}, tc.err
+				},
+			}}
+			_, err := e.Delete(context.Background(), cr)
+
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(credential(withExternalName(credentialID), withConditions(xpv1.Deleting())), cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
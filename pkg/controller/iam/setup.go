@@ -21,18 +21,25 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/accesskey"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/accountalias"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/accountpasswordpolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/group"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/grouppolicyattachment"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/groupusermembership"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/instanceprofile"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/loginprofile"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/openidconnectprovider"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/policy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/role"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/rolepolicy"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/rolepolicyattachment"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/samlprovider"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/servercertificate"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/servicelinkedrole"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/servicespecificcredential"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/user"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/userpolicyattachment"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/iam/virtualmfadevice"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -41,17 +48,24 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
 		accesskey.SetupAccessKey,
+		accountalias.SetupAccountAlias,
+		accountpasswordpolicy.SetupAccountPasswordPolicy,
 		group.SetupGroup,
 		grouppolicyattachment.SetupGroupPolicyAttachment,
 		groupusermembership.SetupGroupUserMembership,
 		instanceprofile.SetupInstanceProfile,
+		loginprofile.SetupLoginProfile,
 		openidconnectprovider.SetupOpenIDConnectProvider,
 		policy.SetupPolicy,
 		role.SetupRole,
 		rolepolicy.SetupRolePolicy,
 		rolepolicyattachment.SetupRolePolicyAttachment,
+		samlprovider.SetupSAMLProvider,
+		servercertificate.SetupServerCertificate,
 		servicelinkedrole.SetupServiceLinkedRole,
+		servicespecificcredential.SetupServiceSpecificCredential,
 		user.SetupUser,
 		userpolicyattachment.SetupUserPolicyAttachment,
+		virtualmfadevice.SetupVirtualMFADevice,
 	)
 }
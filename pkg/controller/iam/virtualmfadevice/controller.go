@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmfadevice
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a VirtualMFADevice resource"
+
+	errList       = "cannot list VirtualMFADevices in AWS"
+	errListTags   = "cannot list tags of VirtualMFADevice in AWS"
+	errCreate     = "cannot create VirtualMFADevice in AWS"
+	errEnable     = "cannot enable VirtualMFADevice in AWS"
+	errDeactivate = "cannot deactivate VirtualMFADevice in AWS"
+	errDelete     = "cannot delete VirtualMFADevice in AWS"
+	errAddTags    = "cannot add tags to VirtualMFADevice in AWS"
+	errRemoveTags = "cannot remove tags from VirtualMFADevice in AWS"
+
+	// Keys of the connection details of a VirtualMFADevice.
+	connectionSeedKey         = "seed"
+	connectionQRCodePNGKey    = "qrCodePNG"
+	connectionSerialNumberKey = "serialNumber"
+)
+
+// SetupVirtualMFADevice adds a controller that reconciles VirtualMFADevices.
+func SetupVirtualMFADevice(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.VirtualMFADeviceGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewVirtualMFADeviceClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.VirtualMFADeviceGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.VirtualMFADevice{}, &manualv1alpha1.VirtualMFADeviceList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.VirtualMFADevice{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.VirtualMFADeviceClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, connectaws.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client iam.VirtualMFADeviceClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	device, err := iam.FindVirtualMFADevice(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errList)
+	}
+	if device == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = iam.GenerateVirtualMFADeviceObservation(*device)
+	cr.SetConditions(xpv1.Available())
+
+	tags, err := e.client.ListMFADeviceTags(ctx, &awsiam.ListMFADeviceTagsInput{SerialNumber: device.SerialNumber})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errListTags)
+	}
+	_, _, tagsUpToDate := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: tagsUpToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Creating())
+
+	out, err := e.client.CreateVirtualMFADevice(ctx, &awsiam.CreateVirtualMFADeviceInput{
+		VirtualMFADeviceName: aws.String(cr.GetName()),
+		Path:                 cr.Spec.ForProvider.Path,
+		Tags:                 iam.BuildIAMTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	device := out.VirtualMFADevice
+
+	if cr.Spec.ForProvider.Username != nil {
+		if err := e.enable(ctx, cr.Spec.ForProvider.Username, device.SerialNumber, device.Base32StringSeed); err != nil {
+			// The seed is never returned again, so a device that cannot be
+			// enabled now is useless.
+			_, _ = e.client.DeleteVirtualMFADevice(ctx, &awsiam.DeleteVirtualMFADeviceInput{SerialNumber: device.SerialNumber})
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	meta.SetExternalName(cr, aws.ToString(device.SerialNumber))
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		connectionSeedKey:         device.Base32StringSeed,
+		connectionQRCodePNGKey:    device.QRCodePNG,
+		connectionSerialNumberKey: []byte(aws.ToString(device.SerialNumber)),
+	}}, nil
+}
+
+// enable enables the virtual MFA device with the given serial number and
+// seed for the given user.
+func (e *external) enable(ctx context.Context, username, serialNumber *string, seed []byte) error {
+	code1, code2, err := iam.GenerateMFAAuthenticationCodes(seed, time.Now())
+	if err != nil {
+		return errors.Wrap(err, errEnable)
+	}
+	_, err = e.client.EnableMFADevice(ctx, &awsiam.EnableMFADeviceInput{
+		UserName:            username,
+		SerialNumber:        serialNumber,
+		AuthenticationCode1: aws.String(code1),
+		AuthenticationCode2: aws.String(code2),
+	})
+	return errorutils.Wrap(err, errEnable)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	serialNumber := aws.String(meta.GetExternalName(cr))
+	tags, err := e.client.ListMFADeviceTags(ctx, &awsiam.ListMFADeviceTagsInput{SerialNumber: serialNumber})
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errListTags)
+	}
+
+	addTags, removeTags, _ := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, tags.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.TagMFADevice(ctx, &awsiam.TagMFADeviceInput{
+			SerialNumber: serialNumber,
+			Tags:         addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errAddTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.UntagMFADevice(ctx, &awsiam.UntagMFADeviceInput{
+			SerialNumber: serialNumber,
+			TagKeys:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errRemoveTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.VirtualMFADevice)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.SetConditions(xpv1.Deleting())
+
+	serialNumber := aws.String(meta.GetExternalName(cr))
+	// A virtual MFA device has to be deactivated before it can be deleted.
+	if cr.Status.AtProvider.Username != nil {
+		_, err := e.client.DeactivateMFADevice(ctx, &awsiam.DeactivateMFADeviceInput{
+			UserName:     cr.Status.AtProvider.Username,
+			SerialNumber: serialNumber,
+		})
+		if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+			return managed.ExternalDelete{}, errorutils.Wrap(err, errDeactivate)
+		}
+	}
+
+	_, err := e.client.DeleteVirtualMFADevice(ctx, &awsiam.DeleteVirtualMFADeviceInput{SerialNumber: serialNumber})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
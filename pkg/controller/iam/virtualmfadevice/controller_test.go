@@ -0,0 +1,407 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualmfadevice
+
+import (
+	"context"
+	"encoding/base32"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/iam/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	unexpectedItem resource.Managed
+
+	deviceName   = "some-device"
+	serialNumber = "arn:aws:iam::123456789012:mfa/some-device"
+	userName     = "some-user"
+	seed         = []byte(base32.StdEncoding.EncodeToString([]byte("12345678901234567890")))
+	qrCode       = []byte("png")
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam *fake.MockVirtualMFADeviceClient
+	cr  resource.Managed
+}
+
+type deviceModifier func(*manualv1alpha1.VirtualMFADevice)
+
+func withConditions(c ...xpv1.Condition) deviceModifier {
+	return func(r *manualv1alpha1.VirtualMFADevice) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(n string) deviceModifier {
+	return func(r *manualv1alpha1.VirtualMFADevice) { meta.SetExternalName(r, n) }
+}
+
+func withUsername(n string) deviceModifier {
+	return func(r *manualv1alpha1.VirtualMFADevice) { r.Spec.ForProvider.Username = aws.String(n) }
+}
+
+func withTags(tags ...v1beta1.Tag) deviceModifier {
+	return func(r *manualv1alpha1.VirtualMFADevice) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withAtProvider(o manualv1alpha1.VirtualMFADeviceObservation) deviceModifier {
+	return func(r *manualv1alpha1.VirtualMFADevice) { r.Status.AtProvider = o }
+}
+
+func virtualMFADevice(m ...deviceModifier) *manualv1alpha1.VirtualMFADevice {
+	cr := &manualv1alpha1.VirtualMFADevice{}
+	cr.SetName(deviceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listDevices(devices ...iamtypes.VirtualMFADevice) func(ctx context.Context, input *awsiam.ListVirtualMFADevicesInput, opts []func(*awsiam.Options)) (*awsiam.ListVirtualMFADevicesOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListVirtualMFADevicesInput, opts []func(*awsiam.Options)) (*awsiam.ListVirtualMFADevicesOutput, error) {
+		return &awsiam.ListVirtualMFADevicesOutput{VirtualMFADevices: devices}, nil
+	}
+}
+
+func listTags(tags ...iamtypes.Tag) func(ctx context.Context, input *awsiam.ListMFADeviceTagsInput, opts []func(*awsiam.Options)) (*awsiam.ListMFADeviceTagsOutput, error) {
+	return func(ctx context.Context, input *awsiam.ListMFADeviceTagsInput, opts []func(*awsiam.Options)) (*awsiam.ListMFADeviceTagsOutput, error) {
+		return &awsiam.ListMFADeviceTagsOutput{Tags: tags}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				cr: virtualMFADevice(),
+			},
+			want: want{
+				cr: virtualMFADevice(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: listDevices(iamtypes.VirtualMFADevice{SerialNumber: aws.String("other")}),
+				},
+				cr: virtualMFADevice(withExternalName(serialNumber)),
+			},
+			want: want{
+				cr: virtualMFADevice(withExternalName(serialNumber)),
+			},
+		},
+		"ListError": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: func(ctx context.Context, input *awsiam.ListVirtualMFADevicesInput, opts []func(*awsiam.Options)) (*awsiam.ListVirtualMFADevicesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: virtualMFADevice(withExternalName(serialNumber)),
+			},
+			want: want{
+				cr:  virtualMFADevice(withExternalName(serialNumber)),
+				err: errorutils.Wrap(errBoom, errList),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: listDevices(iamtypes.VirtualMFADevice{
+						SerialNumber: aws.String(serialNumber),
+						User:         &iamtypes.User{UserName: aws.String(userName)},
+					}),
+					MockListMFADeviceTags: listTags(),
+				},
+				cr: virtualMFADevice(withExternalName(serialNumber), withUsername(userName)),
+			},
+			want: want{
+				cr: virtualMFADevice(withExternalName(serialNumber), withUsername(userName),
+					withAtProvider(manualv1alpha1.VirtualMFADeviceObservation{
+						SerialNumber: serialNumber,
+						Username:     aws.String(userName),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsNotUpToDate": {
+			args: args{
+				iam: &fake.MockVirtualMFADeviceClient{
+					MockListVirtualMFADevices: listDevices(iamtypes.VirtualMFADevice{SerialNumber: aws.String(serialNumber)}),
+					MockListMFADeviceTags:     listTags(),
+				},
+				cr: virtualMFADevice(withExternalName(serialNumber), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: virtualMFADevice(withExternalName(serialNumber), withTags(v1beta1.Tag{Key: "k", Value: "v"}),
+					withAtProvider(manualv1alpha1.VirtualMFADeviceObservation{SerialNumber: serialNumber}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		calls  []string
+		err    error
+	}
+
+	createDevice := func(ctx context.Context, input *awsiam.CreateVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.CreateVirtualMFADeviceOutput, error) {
+		if aws.ToString(input.VirtualMFADeviceName) != deviceName {
+			return nil, errBoom
+		}
+		return &awsiam.CreateVirtualMFADeviceOutput{VirtualMFADevice: &iamtypes.VirtualMFADevice{
+			SerialNumber:     aws.String(serialNumber),
+			Base32StringSeed: seed,
+			QRCodePNG:        qrCode,
+		}}, nil
+	}
+	details := managed.ConnectionDetails{
+		connectionSeedKey:         seed,
+		connectionQRCodePNGKey:    qrCode,
+		connectionSerialNumberKey: []byte(serialNumber),
+	}
+
+	cases := map[string]struct {
+		cr        resource.Managed
+		createErr error
+		enableErr error
+		want
+	}{
+		"InvalidInput": {
+			cr: unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			cr: virtualMFADevice(),
+			want: want{
+				cr: virtualMFADevice(withExternalName(serialNumber),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: details},
+				calls:  []string{"Create"},
+			},
+		},
+		"Enabled": {
+			cr: virtualMFADevice(withUsername(userName)),
+			want: want{
+				cr: virtualMFADevice(withUsername(userName),
+					withExternalName(serialNumber),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: details},
+				calls:  []string{"Create", "Enable"},
+			},
+		},
+		"EnableError": {
+			cr:        virtualMFADevice(withUsername(userName)),
+			enableErr: errBoom,
+			want: want{
+				cr: virtualMFADevice(withUsername(userName),
+					withConditions(xpv1.Creating())),
+				calls: []string{"Create", "Enable", "Delete"},
+				err:   errorutils.Wrap(errBoom, errEnable),
+			},
+		},
+		"CreateError": {
+			cr:        virtualMFADevice(),
+			createErr: errBoom,
+			want: want{
+				cr:    virtualMFADevice(withConditions(xpv1.Creating())),
+				calls: []string{"Create"},
+				err:   errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockVirtualMFADeviceClient{
+				MockCreateVirtualMFADevice: func(ctx context.Context, input *awsiam.CreateVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.CreateVirtualMFADeviceOutput, error) {
+					calls = append(calls, "Create")
+					if tc.createErr != nil {
+						return nil, tc.createErr
+					}
+					return createDevice(ctx, input, opts)
+				},
+				MockEnableMFADevice: func(ctx context.Context, input *awsiam.EnableMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.EnableMFADeviceOutput, error) {
+					calls = append(calls, "Enable")
+					if aws.ToString(input.UserName) != userName || len(aws.ToString(input.AuthenticationCode1)) != 6 || len(aws.ToString(input.AuthenticationCode2)) != 6 {
+						return nil, errors.New("unexpected input")
+					}
+					return &awsiam.EnableMFADeviceOutput{}, tc.enableErr
+				},
+				MockDeleteVirtualMFADevice: func(ctx context.Context, input *awsiam.DeleteVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.DeleteVirtualMFADeviceOutput, error) {
+					calls = append(calls, "Delete")
+					return &awsiam.DeleteVirtualMFADeviceOutput{}, nil
+				},
+			}}
+			o, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr          *manualv1alpha1.VirtualMFADevice
+		deactivated error
+		deleted     error
+		want
+	}{
+		"NotEnabled": {
+			cr: virtualMFADevice(withExternalName(serialNumber)),
+			want: want{
+				calls: []string{"Delete"},
+			},
+		},
+		"Enabled": {
+			cr: virtualMFADevice(withExternalName(serialNumber),
+				withAtProvider(manualv1alpha1.VirtualMFADeviceObservation{Username: aws.String(userName)})),
+			want: want{
+				calls: []string{"Deactivate", "Delete"},
+			},
+		},
+		"DeactivateError": {
+			cr: virtualMFADevice(withExternalName(serialNumber),
+				withAtProvider(manualv1alpha1.VirtualMFADeviceObservation{Username: aws.String(userName)})),
+			deactivated: errBoom,
+			want: want{
+				calls: []string{"Deactivate"},
+				err:   errorutils.Wrap(errBoom, errDeactivate),
+			},
+		},
+		"NotFound": {
+			cr:      virtualMFADevice(withExternalName(serialNumber)),
+			deleted: &iamtypes.NoSuchEntityException{},
+			want: want{
+				calls: []string{"Delete"},
+			},
+		},
+		"DeleteError": {
+			cr:      virtualMFADevice(withExternalName(serialNumber)),
+			deleted: errBoom,
+			want: want{
+				calls: []string{"Delete"},
+				err:   errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockVirtualMFADeviceClient{
+				MockDeactivateMFADevice: func(ctx context.Context, input *awsiam.DeactivateMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.DeactivateMFADeviceOutput, error) {
+					calls = append(calls, "Deactivate")
+					return &awsiam.DeactivateMFADeviceOutput{}, tc.deactivated
+				},
+				MockDeleteVirtualMFADevice: func(ctx context.Context, input *awsiam.DeleteVirtualMFADeviceInput, opts []func(*awsiam.Options)) (*awsiam.DeleteVirtualMFADeviceOutput, error) {
+					calls = append(calls, "Delete")
+					return &awsiam.DeleteVirtualMFADeviceOutput{}, tc.deleted
+				},
+			}}
+			_, err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
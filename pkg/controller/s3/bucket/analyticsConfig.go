@@ -0,0 +1,203 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	analyticsListFailed   = "cannot list Bucket analytics configurations"
+	analyticsPutFailed    = "cannot put Bucket analytics configuration"
+	analyticsDeleteFailed = "cannot delete Bucket analytics configuration"
+)
+
+// AnalyticsConfigurationClient is the client for API methods and reconciling the AnalyticsConfigurations
+type AnalyticsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewAnalyticsConfigurationClient creates the client for Analytics Configurations
+func NewAnalyticsConfigurationClient(client s3.BucketClient) *AnalyticsConfigurationClient {
+	return &AnalyticsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *AnalyticsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	desired := bucket.Spec.ForProvider.AnalyticsConfigurations
+	if desired == nil {
+		return Updated, nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	put, remove := diffConfigurations(desired, observed, analyticsConfigurationID)
+	return configurationsStatus(desired, put, remove), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *AnalyticsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	desired := bucket.Spec.ForProvider.AnalyticsConfigurations
+	if desired == nil {
+		return nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := diffConfigurations(desired, observed, analyticsConfigurationID)
+	for _, c := range put {
+		if _, err := in.client.PutBucketAnalyticsConfiguration(ctx, &awss3.PutBucketAnalyticsConfigurationInput{
+			Bucket:                 pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:                     aws.String(c.ID),
+			AnalyticsConfiguration: GenerateAWSAnalyticsConfiguration(c),
+		}); err != nil {
+			return errorutils.Wrap(err, analyticsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *AnalyticsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(observed))
+	for i, c := range observed {
+		ids[i] = c.ID
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+func (in *AnalyticsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketAnalyticsConfiguration(ctx, &awss3.DeleteBucketAnalyticsConfigurationInput{
+			Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:     aws.String(id),
+		}); err != nil {
+			return errorutils.Wrap(err, analyticsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func (in *AnalyticsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]v1beta1.AnalyticsConfiguration, error) {
+	var res []v1beta1.AnalyticsConfiguration
+	input := &awss3.ListBucketAnalyticsConfigurationsInput{Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketAnalyticsConfigurations(ctx, input)
+		if err != nil {
+			return nil, errorutils.Wrap(err, analyticsListFailed)
+		}
+		for _, c := range out.AnalyticsConfigurationList {
+			res = append(res, GenerateAnalyticsConfiguration(c))
+		}
+		if !aws.ToBool(out.IsTruncated) {
+			return res, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// LateInitialize does nothing because the configurations are only managed if
+// they are specified.
+func (in *AnalyticsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *AnalyticsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.AnalyticsConfigurations != nil
+}
+
+func analyticsConfigurationID(c v1beta1.AnalyticsConfiguration) string {
+	return c.ID
+}
+
+// GenerateAWSAnalyticsConfiguration creates the AWS representation of an
+// analytics configuration
+func GenerateAWSAnalyticsConfiguration(c v1beta1.AnalyticsConfiguration) *types.AnalyticsConfiguration {
+	res := &types.AnalyticsConfiguration{
+		Id:                   aws.String(c.ID),
+		StorageClassAnalysis: &types.StorageClassAnalysis{},
+	}
+	if e := c.StorageClassAnalysis.DataExport; e != nil {
+		res.StorageClassAnalysis.DataExport = &types.StorageClassAnalysisDataExport{
+			OutputSchemaVersion: types.StorageClassAnalysisSchemaVersionV1,
+			Destination: &types.AnalyticsExportDestination{S3BucketDestination: &types.AnalyticsS3BucketDestination{
+				Bucket:          e.BucketARN,
+				BucketAccountId: e.BucketAccountID,
+				Format:          types.AnalyticsS3ExportFileFormatCsv,
+				Prefix:          e.Prefix,
+			}},
+		}
+	}
+	if f := c.Filter; f != nil {
+		switch {
+		case f.And != nil:
+			res.Filter = &types.AnalyticsFilterMemberAnd{Value: types.AnalyticsAndOperator{
+				Prefix: f.And.Prefix,
+				Tags:   s3.CopyTags(f.And.Tags),
+			}}
+		case f.Tag != nil:
+			res.Filter = &types.AnalyticsFilterMemberTag{Value: *generateAWSTag(f.Tag)}
+		case f.Prefix != nil:
+			res.Filter = &types.AnalyticsFilterMemberPrefix{Value: *f.Prefix}
+		}
+	}
+	return res
+}
+
+// GenerateAnalyticsConfiguration creates the local representation of an
+// analytics configuration
+func GenerateAnalyticsConfiguration(c types.AnalyticsConfiguration) v1beta1.AnalyticsConfiguration {
+	res := v1beta1.AnalyticsConfiguration{ID: aws.ToString(c.Id)}
+	if sca := c.StorageClassAnalysis; sca != nil && sca.DataExport != nil &&
+		sca.DataExport.Destination != nil && sca.DataExport.Destination.S3BucketDestination != nil {
+		dest := sca.DataExport.Destination.S3BucketDestination
+		res.StorageClassAnalysis.DataExport = &v1beta1.StorageClassAnalysisDataExport{
+			BucketARN:       dest.Bucket,
+			BucketAccountID: dest.BucketAccountId,
+			Prefix:          dest.Prefix,
+		}
+	}
+	switch f := c.Filter.(type) {
+	case *types.AnalyticsFilterMemberAnd:
+		res.Filter = &v1beta1.AnalyticsFilter{And: &v1beta1.AnalyticsAndOperator{
+			Prefix: f.Value.Prefix,
+			Tags:   s3.CopyAWSTags(f.Value.Tags),
+		}}
+	case *types.AnalyticsFilterMemberTag:
+		res.Filter = &v1beta1.AnalyticsFilter{Tag: generateLocalTag(&f.Value)}
+	case *types.AnalyticsFilterMemberPrefix:
+		res.Filter = &v1beta1.AnalyticsFilter{Prefix: aws.String(f.Value)}
+	}
+	return res
+}
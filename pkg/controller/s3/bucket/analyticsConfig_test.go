@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var _ SubresourceClient = &AnalyticsConfigurationClient{}
+
+func generateAnalyticsConfigs() []v1beta1.AnalyticsConfiguration {
+	return []v1beta1.AnalyticsConfiguration{
+		{
+			ID:     "export",
+			Filter: &v1beta1.AnalyticsFilter{Prefix: aws.String("data/")},
+			StorageClassAnalysis: v1beta1.StorageClassAnalysis{
+				DataExport: &v1beta1.StorageClassAnalysisDataExport{
+					BucketARN:       aws.String("arn:aws:s3:::analytics"),
+					BucketAccountID: aws.String("123456789012"),
+					Prefix:          aws.String("analytics/"),
+				},
+			},
+		},
+	}
+}
+
+func generateAWSAnalyticsConfigs() []s3types.AnalyticsConfiguration {
+	c := generateAnalyticsConfigs()
+	res := make([]s3types.AnalyticsConfiguration, len(c))
+	for i := range c {
+		res[i] = *GenerateAWSAnalyticsConfiguration(c[i])
+	}
+	return res
+}
+
+func listAnalytics(c []s3types.AnalyticsConfiguration) func(context.Context, *s3.ListBucketAnalyticsConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketAnalyticsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+		return &s3.ListBucketAnalyticsConfigurationsOutput{AnalyticsConfigurationList: c}, nil
+	}
+}
+
+func TestAnalyticsObserve(t *testing.T) {
+	type args struct {
+		cl *AnalyticsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Unmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: func(ctx context.Context, input *s3.ListBucketAnalyticsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketAnalyticsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errorutils.Wrap(errBoom, analyticsListFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfigs()),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs(generateAnalyticsConfigs())),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalytics(nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithAnalyticsConfigs([]v1beta1.AnalyticsConfiguration{})),
+				cl: NewAnalyticsConfigurationClient(fake.MockBucketClient{
+					MockListBucketAnalyticsConfigurations: listAnalytics(generateAWSAnalyticsConfigs()),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAnalyticsCreateOrUpdate(t *testing.T) {
+	var put, deleted []string
+	observed := append(generateAWSAnalyticsConfigs(), s3types.AnalyticsConfiguration{Id: aws.String("removed")})
+	cl := NewAnalyticsConfigurationClient(fake.MockBucketClient{
+		MockListBucketAnalyticsConfigurations: listAnalytics(observed),
+		MockPutBucketAnalyticsConfiguration: func(ctx context.Context, input *s3.PutBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketAnalyticsConfigurationOutput, error) {
+			put = append(put, aws.ToString(input.Id))
+			return &s3.PutBucketAnalyticsConfigurationOutput{}, nil
+		},
+		MockDeleteBucketAnalyticsConfiguration: func(ctx context.Context, input *s3.DeleteBucketAnalyticsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketAnalyticsConfigurationOutput, error) {
+			deleted = append(deleted, aws.ToString(input.Id))
+			return &s3.DeleteBucketAnalyticsConfigurationOutput{}, nil
+		},
+	})
+	desired := append(generateAnalyticsConfigs(), v1beta1.AnalyticsConfiguration{ID: "added"})
+	if err := cl.CreateOrUpdate(context.Background(), s3testing.Bucket(s3testing.WithAnalyticsConfigs(desired))); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"added"}, put); diff != "" {
+		t.Errorf("put: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"removed"}, deleted); diff != "" {
+		t.Errorf("deleted: -want, +got:\n%s", diff)
+	}
+}
@@ -22,7 +22,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
@@ -31,8 +30,10 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/util/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
@@ -40,7 +41,9 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
 	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
 	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
 	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
 )
 
@@ -51,6 +54,11 @@ const (
 	errCreateOrUpdate   = "cannot create or update"
 	errDelete           = "cannot delete"
 	errKubeUpdateFailed = "cannot update S3 custom resource"
+	errEmptyBucket      = "cannot empty the Bucket"
+
+	// forceDestroyMaxPages is the number of ListObjectVersions pages that
+	// are deleted per reconcile when emptying a bucket.
+	forceDestroyMaxPages = 10
 )
 
 // SetupBucket adds a controller that reconciles Buckets.
@@ -69,7 +77,7 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 		managed.WithPollInterval(o.PollInterval),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
 	}
 
 	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
@@ -80,11 +88,16 @@ func SetupBucket(mgr ctrl.Manager, o controller.Options) error {
 		resource.ManagedKind(v1beta1.BucketGroupVersionKind),
 		reconcilerOpts...)
 
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &v1beta1.Bucket{}, &v1beta1.BucketList{}, nil)
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1beta1.Bucket{}).
+		For(&v1beta1.Bucket{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
 		Complete(r)
 }
 
@@ -131,7 +144,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err1
 	}
 
-	cr.Status.AtProvider = s3.GenerateBucketObservation(meta.GetExternalName(cr), endpoint.PartitionID)
+	// only the ARN is observed, the deletion progress of forceDestroy has to
+	// survive across reconciles.
+	cr.Status.AtProvider.ARN = s3.GenerateBucketObservation(meta.GetExternalName(cr), endpoint.PartitionID).ARN
 
 	lateInit := false
 	current := cr.Spec.ForProvider.DeepCopy()
@@ -261,6 +276,21 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.Ext
 	}
 
 	cr.Status.SetConditions(xpv1.Deleting())
+	if aws.ToBool(cr.Spec.ForProvider.ForceDestroy) {
+		deleted, empty, err := s3.EmptyBucket(ctx, e.s3client, meta.GetExternalName(cr), aws.ToBool(cr.Spec.ForProvider.BypassGovernanceRetention), forceDestroyMaxPages)
+		if deleted > 0 {
+			cr.Status.AtProvider.DeletedObjects = aws.Int64(aws.ToInt64(cr.Status.AtProvider.DeletedObjects) + deleted)
+		}
+		if err != nil {
+			return managed.ExternalDelete{}, errorutils.Wrap(err, errEmptyBucket)
+		}
+		if !empty {
+			// the bucket is emptied incrementally, the next reconcile
+			// continues with the remaining objects.
+			e.logger.Debug("Bucket is not empty yet", "deletedObjects", aws.ToInt64(cr.Status.AtProvider.DeletedObjects))
+			return managed.ExternalDelete{}, nil
+		}
+	}
 	_, err := e.s3client.DeleteBucket(ctx, &awss3.DeleteBucketInput{Bucket: aws.String(meta.GetExternalName(cr))})
 	return managed.ExternalDelete{}, resource.Ignore(s3.IsNotFound, err)
 }
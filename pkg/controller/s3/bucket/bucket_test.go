@@ -734,11 +734,103 @@ func TestDelete(t *testing.T) {
 				cr: s3Testing.Bucket(s3Testing.WithConditions(xpv1.Deleting())),
 			},
 		},
+		"ForceDestroyEmptiesBucket": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						if input.KeyMarker == nil {
+							return &awss3.ListObjectVersionsOutput{
+								Versions:            []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+								IsTruncated:         aws.Bool(true),
+								NextKeyMarker:       aws.String("a"),
+								NextVersionIdMarker: aws.String("1"),
+							}, nil
+						}
+						return &awss3.ListObjectVersionsOutput{
+							DeleteMarkers: []awss3types.DeleteMarkerEntry{{Key: aws.String("b"), VersionId: aws.String("2")}},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						if !aws.ToBool(input.BypassGovernanceRetention) {
+							return nil, errors.New("expected governance retention to be bypassed")
+						}
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+					MockDeleteBucket: func(ctx context.Context, input *awss3.DeleteBucketInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOutput, error) {
+						return &awss3.DeleteBucketOutput{}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true, true)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true, true), s3Testing.WithDeletedObjects(2), s3Testing.WithConditions(xpv1.Deleting())),
+			},
+		},
+		"ForceDestroyContinuesInNextReconcile": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions:    []awss3types.ObjectVersion{{Key: aws.String("a"), VersionId: aws.String("1")}},
+							IsTruncated: aws.Bool(true),
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{}, nil
+					},
+					MockDeleteBucket: func(ctx context.Context, input *awss3.DeleteBucketInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOutput, error) {
+						return nil, errors.New("bucket must not be deleted before it is empty")
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true, false), s3Testing.WithDeletedObjects(5)),
+			},
+			want: want{
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true, false), s3Testing.WithDeletedObjects(5+forceDestroyMaxPages), s3Testing.WithConditions(xpv1.Deleting())),
+			},
+		},
+		"ForceDestroyObjectLocked": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return &awss3.ListObjectVersionsOutput{
+							Versions: []awss3types.ObjectVersion{
+								{Key: aws.String("a"), VersionId: aws.String("1")},
+								{Key: aws.String("b"), VersionId: aws.String("2")},
+							},
+						}, nil
+					},
+					MockDeleteObjects: func(ctx context.Context, input *awss3.DeleteObjectsInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectsOutput, error) {
+						return &awss3.DeleteObjectsOutput{
+							Errors: []awss3types.Error{{Key: aws.String("b"), VersionId: aws.String("2"), Code: aws.String("AccessDenied"), Message: aws.String("Access Denied")}},
+						}, nil
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true, false)),
+			},
+			want: want{
+				cr:  s3Testing.Bucket(s3Testing.WithForceDestroy(true, false), s3Testing.WithDeletedObjects(1), s3Testing.WithConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errors.New("cannot delete 1 object(s), first failure: b (version 2): AccessDenied: Access Denied"), errEmptyBucket),
+			},
+		},
+		"ForceDestroyListError": {
+			args: args{
+				s3: &fake.MockBucketClient{
+					MockListObjectVersions: func(ctx context.Context, input *awss3.ListObjectVersionsInput, opts []func(*awss3.Options)) (*awss3.ListObjectVersionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: s3Testing.Bucket(s3Testing.WithForceDestroy(true, false)),
+			},
+			want: want{
+				cr:  s3Testing.Bucket(s3Testing.WithForceDestroy(true, false), s3Testing.WithConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errEmptyBucket),
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{s3client: tc.s3}
+			e := &external{s3client: tc.s3, logger: logging.NewNopLogger()}
 			_, err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
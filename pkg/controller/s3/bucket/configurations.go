@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+)
+
+// configurationCompareOpts ignores references and the order of unordered
+// lists when comparing desired and observed configurations.
+var configurationCompareOpts = []cmp.Option{
+	cmpopts.EquateEmpty(),
+	cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
+	cmpopts.SortSlices(func(a, b v1beta1.Tag) bool { return a.Key < b.Key }),
+	cmpopts.SortSlices(func(a, b v1beta1.Tiering) bool { return a.AccessTier < b.AccessTier }),
+	cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+}
+
+// diffConfigurations compares the desired and observed ID-keyed
+// configurations of a bucket. It returns the desired configurations that
+// have to be put and the IDs of the observed configurations that have to be
+// deleted.
+func diffConfigurations[T any](desired, observed []T, id func(T) string) ([]T, []string) {
+	obs := make(map[string]T, len(observed))
+	for _, o := range observed {
+		obs[id(o)] = o
+	}
+	var put []T
+	for _, d := range desired {
+		o, ok := obs[id(d)]
+		if !ok || !cmp.Equal(d, o, configurationCompareOpts...) {
+			put = append(put, d)
+		}
+		delete(obs, id(d))
+	}
+	remove := make([]string, 0, len(obs))
+	for k := range obs {
+		remove = append(remove, k)
+	}
+	sort.Strings(remove)
+	return put, remove
+}
+
+// configurationsStatus returns the status of ID-keyed configurations given
+// the result of diffConfigurations.
+func configurationsStatus[T any](desired, put []T, remove []string) ResourceStatus {
+	switch {
+	case len(desired) == 0 && len(remove) > 0:
+		return NeedsDeletion
+	case len(put) > 0 || len(remove) > 0:
+		return NeedsUpdate
+	}
+	return Updated
+}
+
+func generateAWSTag(t *v1beta1.Tag) *types.Tag {
+	if t == nil {
+		return nil
+	}
+	return &types.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+}
+
+func generateLocalTag(t *types.Tag) *v1beta1.Tag {
+	if t == nil {
+		return nil
+	}
+	return &v1beta1.Tag{Key: aws.ToString(t.Key), Value: aws.ToString(t.Value)}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	intelligentTieringListFailed   = "cannot list Bucket intelligent tiering configurations"
+	intelligentTieringPutFailed    = "cannot put Bucket intelligent tiering configuration"
+	intelligentTieringDeleteFailed = "cannot delete Bucket intelligent tiering configuration"
+)
+
+// IntelligentTieringConfigurationClient is the client for API methods and reconciling the IntelligentTieringConfigurations
+type IntelligentTieringConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewIntelligentTieringConfigurationClient creates the client for Intelligent-Tiering Configurations
+func NewIntelligentTieringConfigurationClient(client s3.BucketClient) *IntelligentTieringConfigurationClient {
+	return &IntelligentTieringConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *IntelligentTieringConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	desired := bucket.Spec.ForProvider.IntelligentTieringConfigurations
+	if desired == nil {
+		return Updated, nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	put, remove := diffConfigurations(desired, observed, intelligentTieringConfigurationID)
+	return configurationsStatus(desired, put, remove), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *IntelligentTieringConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	desired := bucket.Spec.ForProvider.IntelligentTieringConfigurations
+	if desired == nil {
+		return nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := diffConfigurations(desired, observed, intelligentTieringConfigurationID)
+	for _, c := range put {
+		if _, err := in.client.PutBucketIntelligentTieringConfiguration(ctx, &awss3.PutBucketIntelligentTieringConfigurationInput{
+			Bucket:                          pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:                              aws.String(c.ID),
+			IntelligentTieringConfiguration: GenerateAWSIntelligentTieringConfiguration(c),
+		}); err != nil {
+			return errorutils.Wrap(err, intelligentTieringPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *IntelligentTieringConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(observed))
+	for i, c := range observed {
+		ids[i] = c.ID
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+func (in *IntelligentTieringConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketIntelligentTieringConfiguration(ctx, &awss3.DeleteBucketIntelligentTieringConfigurationInput{
+			Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:     aws.String(id),
+		}); err != nil {
+			return errorutils.Wrap(err, intelligentTieringDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func (in *IntelligentTieringConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]v1beta1.IntelligentTieringConfiguration, error) {
+	var res []v1beta1.IntelligentTieringConfiguration
+	input := &awss3.ListBucketIntelligentTieringConfigurationsInput{Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketIntelligentTieringConfigurations(ctx, input)
+		if err != nil {
+			return nil, errorutils.Wrap(err, intelligentTieringListFailed)
+		}
+		for _, c := range out.IntelligentTieringConfigurationList {
+			res = append(res, GenerateIntelligentTieringConfiguration(c))
+		}
+		if !aws.ToBool(out.IsTruncated) {
+			return res, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// LateInitialize does nothing because the configurations are only managed if
+// they are specified.
+func (in *IntelligentTieringConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *IntelligentTieringConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.IntelligentTieringConfigurations != nil
+}
+
+func intelligentTieringConfigurationID(c v1beta1.IntelligentTieringConfiguration) string {
+	return c.ID
+}
+
+// GenerateAWSIntelligentTieringConfiguration creates the AWS representation
+// of an Intelligent-Tiering configuration
+func GenerateAWSIntelligentTieringConfiguration(c v1beta1.IntelligentTieringConfiguration) *types.IntelligentTieringConfiguration {
+	res := &types.IntelligentTieringConfiguration{
+		Id:       aws.String(c.ID),
+		Status:   types.IntelligentTieringStatus(c.Status),
+		Tierings: make([]types.Tiering, len(c.Tierings)),
+	}
+	for i, t := range c.Tierings {
+		res.Tierings[i] = types.Tiering{AccessTier: types.IntelligentTieringAccessTier(t.AccessTier), Days: aws.Int32(t.Days)}
+	}
+	if c.Filter != nil {
+		res.Filter = &types.IntelligentTieringFilter{
+			Prefix: c.Filter.Prefix,
+			Tag:    generateAWSTag(c.Filter.Tag),
+		}
+		if c.Filter.And != nil {
+			res.Filter.And = &types.IntelligentTieringAndOperator{
+				Prefix: c.Filter.And.Prefix,
+				Tags:   s3.CopyTags(c.Filter.And.Tags),
+			}
+		}
+	}
+	return res
+}
+
+// GenerateIntelligentTieringConfiguration creates the local representation of
+// an Intelligent-Tiering configuration
+func GenerateIntelligentTieringConfiguration(c types.IntelligentTieringConfiguration) v1beta1.IntelligentTieringConfiguration {
+	res := v1beta1.IntelligentTieringConfiguration{
+		ID:       aws.ToString(c.Id),
+		Status:   string(c.Status),
+		Tierings: make([]v1beta1.Tiering, len(c.Tierings)),
+	}
+	for i, t := range c.Tierings {
+		res.Tierings[i] = v1beta1.Tiering{AccessTier: string(t.AccessTier), Days: aws.ToInt32(t.Days)}
+	}
+	if f := c.Filter; f != nil && (f.Prefix != nil || f.Tag != nil || f.And != nil) {
+		res.Filter = &v1beta1.IntelligentTieringFilter{
+			Prefix: f.Prefix,
+			Tag:    generateLocalTag(f.Tag),
+		}
+		if f.And != nil {
+			res.Filter.And = &v1beta1.IntelligentTieringAndOperator{
+				Prefix: f.And.Prefix,
+				Tags:   s3.CopyAWSTags(f.And.Tags),
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var _ SubresourceClient = &IntelligentTieringConfigurationClient{}
+
+func generateIntelligentTieringConfigs() []v1beta1.IntelligentTieringConfiguration {
+	return []v1beta1.IntelligentTieringConfiguration{
+		{
+			ID:     "archive",
+			Status: "Enabled",
+			Filter: &v1beta1.IntelligentTieringFilter{And: &v1beta1.IntelligentTieringAndOperator{
+				Prefix: aws.String("logs/"),
+				Tags:   []v1beta1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}},
+			}},
+			Tierings: []v1beta1.Tiering{
+				{AccessTier: "DEEP_ARCHIVE_ACCESS", Days: 180},
+				{AccessTier: "ARCHIVE_ACCESS", Days: 90},
+			},
+		},
+	}
+}
+
+func generateAWSIntelligentTieringConfigs() []s3types.IntelligentTieringConfiguration {
+	return []s3types.IntelligentTieringConfiguration{
+		{
+			Id:     aws.String("archive"),
+			Status: s3types.IntelligentTieringStatusEnabled,
+			Filter: &s3types.IntelligentTieringFilter{And: &s3types.IntelligentTieringAndOperator{
+				Prefix: aws.String("logs/"),
+				Tags: []s3types.Tag{
+					{Key: aws.String("a"), Value: aws.String("1")},
+					{Key: aws.String("b"), Value: aws.String("2")},
+				},
+			}},
+			Tierings: []s3types.Tiering{
+				{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: aws.Int32(90)},
+				{AccessTier: s3types.IntelligentTieringAccessTierDeepArchiveAccess, Days: aws.Int32(180)},
+			},
+		},
+	}
+}
+
+func listIntelligentTiering(c []s3types.IntelligentTieringConfiguration) func(context.Context, *s3.ListBucketIntelligentTieringConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketIntelligentTieringConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+		return &s3.ListBucketIntelligentTieringConfigurationsOutput{IntelligentTieringConfigurationList: c}, nil
+	}
+}
+
+func TestIntelligentTieringObserve(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Unmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errorutils.Wrap(errBoom, intelligentTieringListFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(generateAWSIntelligentTieringConfigs()),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"UpToDatePaginated": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(append(generateIntelligentTieringConfigs(),
+					v1beta1.IntelligentTieringConfiguration{ID: "other", Status: "Disabled", Tierings: []v1beta1.Tiering{{AccessTier: "ARCHIVE_ACCESS", Days: 90}}}))),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: func(ctx context.Context, input *s3.ListBucketIntelligentTieringConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketIntelligentTieringConfigurationsOutput, error) {
+						if input.ContinuationToken == nil {
+							return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+								IntelligentTieringConfigurationList: generateAWSIntelligentTieringConfigs(),
+								IsTruncated:                         aws.Bool(true),
+								NextContinuationToken:               aws.String("next"),
+							}, nil
+						}
+						return &s3.ListBucketIntelligentTieringConfigurationsOutput{
+							IntelligentTieringConfigurationList: []s3types.IntelligentTieringConfiguration{{
+								Id:       aws.String("other"),
+								Status:   s3types.IntelligentTieringStatusDisabled,
+								Filter:   &s3types.IntelligentTieringFilter{},
+								Tierings: []s3types.Tiering{{AccessTier: s3types.IntelligentTieringAccessTierArchiveAccess, Days: aws.Int32(90)}},
+							}},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs([]v1beta1.IntelligentTieringConfiguration{})),
+				cl: NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+					MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(generateAWSIntelligentTieringConfigs()),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringCreateOrUpdate(t *testing.T) {
+	type args struct {
+		cl *IntelligentTieringConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		put     []string
+		deleted []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PutChangedAndDeleteRemoved": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+			},
+			want: want{
+				put:     []string{"archive"},
+				deleted: []string{"removed"},
+			},
+		},
+		"PutError": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithIntelligentTieringConfigs(generateIntelligentTieringConfigs())),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, intelligentTieringPutFailed),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var put, deleted []string
+			observed := []s3types.IntelligentTieringConfiguration{
+				{Id: aws.String("archive"), Status: s3types.IntelligentTieringStatusDisabled},
+				{Id: aws.String("removed"), Status: s3types.IntelligentTieringStatusEnabled},
+			}
+			cl := NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+				MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(observed),
+				MockPutBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.PutBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketIntelligentTieringConfigurationOutput, error) {
+					if tc.want.err != nil {
+						return nil, errBoom
+					}
+					put = append(put, aws.ToString(input.Id))
+					return &s3.PutBucketIntelligentTieringConfigurationOutput{}, nil
+				},
+				MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+					deleted = append(deleted, aws.ToString(input.Id))
+					return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+				},
+			})
+			err := cl.CreateOrUpdate(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIntelligentTieringDelete(t *testing.T) {
+	var deleted []string
+	cl := NewIntelligentTieringConfigurationClient(fake.MockBucketClient{
+		MockListBucketIntelligentTieringConfigurations: listIntelligentTiering(generateAWSIntelligentTieringConfigs()),
+		MockDeleteBucketIntelligentTieringConfiguration: func(ctx context.Context, input *s3.DeleteBucketIntelligentTieringConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketIntelligentTieringConfigurationOutput, error) {
+			deleted = append(deleted, aws.ToString(input.Id))
+			return &s3.DeleteBucketIntelligentTieringConfigurationOutput{}, nil
+		},
+	})
+	if err := cl.Delete(context.Background(), s3testing.Bucket(s3testing.WithIntelligentTieringConfigs([]v1beta1.IntelligentTieringConfiguration{}))); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"archive"}, deleted); diff != "" {
+		t.Errorf("deleted: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	inventoryListFailed   = "cannot list Bucket inventory configurations"
+	inventoryPutFailed    = "cannot put Bucket inventory configuration"
+	inventoryDeleteFailed = "cannot delete Bucket inventory configuration"
+)
+
+// InventoryConfigurationClient is the client for API methods and reconciling the InventoryConfigurations
+type InventoryConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewInventoryConfigurationClient creates the client for Inventory Configurations
+func NewInventoryConfigurationClient(client s3.BucketClient) *InventoryConfigurationClient {
+	return &InventoryConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *InventoryConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	desired := bucket.Spec.ForProvider.InventoryConfigurations
+	if desired == nil {
+		return Updated, nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	put, remove := diffConfigurations(desired, observed, inventoryConfigurationID)
+	return configurationsStatus(desired, put, remove), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *InventoryConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	desired := bucket.Spec.ForProvider.InventoryConfigurations
+	if desired == nil {
+		return nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := diffConfigurations(desired, observed, inventoryConfigurationID)
+	for _, c := range put {
+		if _, err := in.client.PutBucketInventoryConfiguration(ctx, &awss3.PutBucketInventoryConfigurationInput{
+			Bucket:                 pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:                     aws.String(c.ID),
+			InventoryConfiguration: GenerateAWSInventoryConfiguration(c),
+		}); err != nil {
+			return errorutils.Wrap(err, inventoryPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *InventoryConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(observed))
+	for i, c := range observed {
+		ids[i] = c.ID
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+func (in *InventoryConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketInventoryConfiguration(ctx, &awss3.DeleteBucketInventoryConfigurationInput{
+			Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:     aws.String(id),
+		}); err != nil {
+			return errorutils.Wrap(err, inventoryDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func (in *InventoryConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]v1beta1.InventoryConfiguration, error) {
+	var res []v1beta1.InventoryConfiguration
+	input := &awss3.ListBucketInventoryConfigurationsInput{Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketInventoryConfigurations(ctx, input)
+		if err != nil {
+			return nil, errorutils.Wrap(err, inventoryListFailed)
+		}
+		for _, c := range out.InventoryConfigurationList {
+			res = append(res, GenerateInventoryConfiguration(c))
+		}
+		if !aws.ToBool(out.IsTruncated) {
+			return res, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// LateInitialize does nothing because the configurations are only managed if
+// they are specified.
+func (in *InventoryConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *InventoryConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.InventoryConfigurations != nil
+}
+
+func inventoryConfigurationID(c v1beta1.InventoryConfiguration) string {
+	return c.ID
+}
+
+// GenerateAWSInventoryConfiguration creates the AWS representation of an
+// inventory configuration
+func GenerateAWSInventoryConfiguration(c v1beta1.InventoryConfiguration) *types.InventoryConfiguration {
+	dest := &types.InventoryS3BucketDestination{
+		Bucket:    c.Destination.BucketARN,
+		AccountId: c.Destination.AccountID,
+		Format:    types.InventoryFormat(c.Destination.Format),
+		Prefix:    c.Destination.Prefix,
+	}
+	if e := c.Destination.Encryption; e != nil {
+		dest.Encryption = &types.InventoryEncryption{}
+		if e.SSES3 {
+			dest.Encryption.SSES3 = &types.SSES3{}
+		}
+		if e.SSEKMSKeyID != nil {
+			dest.Encryption.SSEKMS = &types.SSEKMS{KeyId: e.SSEKMSKeyID}
+		}
+	}
+	res := &types.InventoryConfiguration{
+		Id:                     aws.String(c.ID),
+		Destination:            &types.InventoryDestination{S3BucketDestination: dest},
+		IncludedObjectVersions: types.InventoryIncludedObjectVersions(c.IncludedObjectVersions),
+		IsEnabled:              aws.Bool(c.IsEnabled),
+		Schedule:               &types.InventorySchedule{Frequency: types.InventoryFrequency(c.Frequency)},
+	}
+	if c.Filter != nil {
+		res.Filter = &types.InventoryFilter{Prefix: aws.String(c.Filter.Prefix)}
+	}
+	for _, f := range c.OptionalFields {
+		res.OptionalFields = append(res.OptionalFields, types.InventoryOptionalField(f))
+	}
+	return res
+}
+
+// GenerateInventoryConfiguration creates the local representation of an
+// inventory configuration
+func GenerateInventoryConfiguration(c types.InventoryConfiguration) v1beta1.InventoryConfiguration {
+	res := v1beta1.InventoryConfiguration{
+		ID:                     aws.ToString(c.Id),
+		IncludedObjectVersions: string(c.IncludedObjectVersions),
+		IsEnabled:              aws.ToBool(c.IsEnabled),
+	}
+	if c.Schedule != nil {
+		res.Frequency = string(c.Schedule.Frequency)
+	}
+	if c.Destination != nil && c.Destination.S3BucketDestination != nil {
+		dest := c.Destination.S3BucketDestination
+		res.Destination = v1beta1.InventoryDestination{
+			BucketARN: dest.Bucket,
+			AccountID: dest.AccountId,
+			Format:    string(dest.Format),
+			Prefix:    dest.Prefix,
+		}
+		if e := dest.Encryption; e != nil && (e.SSES3 != nil || e.SSEKMS != nil) {
+			res.Destination.Encryption = &v1beta1.InventoryEncryption{SSES3: e.SSES3 != nil}
+			if e.SSEKMS != nil {
+				res.Destination.Encryption.SSEKMSKeyID = e.SSEKMS.KeyId
+			}
+		}
+	}
+	if c.Filter != nil {
+		res.Filter = &v1beta1.InventoryFilter{Prefix: aws.ToString(c.Filter.Prefix)}
+	}
+	for _, f := range c.OptionalFields {
+		res.OptionalFields = append(res.OptionalFields, string(f))
+	}
+	return res
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var _ SubresourceClient = &InventoryConfigurationClient{}
+
+func generateInventoryConfigs() []v1beta1.InventoryConfiguration {
+	return []v1beta1.InventoryConfiguration{
+		{
+			ID: "daily",
+			Destination: v1beta1.InventoryDestination{
+				BucketARN:  aws.String("arn:aws:s3:::inventory"),
+				AccountID:  aws.String("123456789012"),
+				Format:     "CSV",
+				Prefix:     aws.String("inventory/"),
+				Encryption: &v1beta1.InventoryEncryption{SSES3: true},
+			},
+			Filter:                 &v1beta1.InventoryFilter{Prefix: "data/"},
+			IncludedObjectVersions: "Current",
+			IsEnabled:              true,
+			OptionalFields:         []string{"Size", "ETag"},
+			Frequency:              "Daily",
+		},
+	}
+}
+
+func generateAWSInventoryConfigs() []s3types.InventoryConfiguration {
+	c := generateInventoryConfigs()
+	res := make([]s3types.InventoryConfiguration, len(c))
+	for i := range c {
+		res[i] = *GenerateAWSInventoryConfiguration(c[i])
+	}
+	return res
+}
+
+func listInventory(c []s3types.InventoryConfiguration) func(context.Context, *s3.ListBucketInventoryConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketInventoryConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+		return &s3.ListBucketInventoryConfigurationsOutput{InventoryConfigurationList: c}, nil
+	}
+}
+
+func TestInventoryObserve(t *testing.T) {
+	type args struct {
+		cl *InventoryConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Unmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: func(ctx context.Context, input *s3.ListBucketInventoryConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketInventoryConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errorutils.Wrap(errBoom, inventoryListFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventory(generateAWSInventoryConfigs()),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs(generateInventoryConfigs())),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventory(nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithInventoryConfigs([]v1beta1.InventoryConfiguration{})),
+				cl: NewInventoryConfigurationClient(fake.MockBucketClient{
+					MockListBucketInventoryConfigurations: listInventory(generateAWSInventoryConfigs()),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInventoryCreateOrUpdate(t *testing.T) {
+	var put, deleted []string
+	observed := append(generateAWSInventoryConfigs(), s3types.InventoryConfiguration{Id: aws.String("removed")})
+	cl := NewInventoryConfigurationClient(fake.MockBucketClient{
+		MockListBucketInventoryConfigurations: listInventory(observed),
+		MockPutBucketInventoryConfiguration: func(ctx context.Context, input *s3.PutBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketInventoryConfigurationOutput, error) {
+			put = append(put, aws.ToString(input.Id))
+			return &s3.PutBucketInventoryConfigurationOutput{}, nil
+		},
+		MockDeleteBucketInventoryConfiguration: func(ctx context.Context, input *s3.DeleteBucketInventoryConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketInventoryConfigurationOutput, error) {
+			deleted = append(deleted, aws.ToString(input.Id))
+			return &s3.DeleteBucketInventoryConfigurationOutput{}, nil
+		},
+	})
+	desired := append(generateInventoryConfigs(), v1beta1.InventoryConfiguration{ID: "added", Destination: v1beta1.InventoryDestination{BucketARN: aws.String("arn:aws:s3:::inventory"), Format: "Parquet"}, IncludedObjectVersions: "All", Frequency: "Weekly"})
+	if err := cl.CreateOrUpdate(context.Background(), s3testing.Bucket(s3testing.WithInventoryConfigs(desired))); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"added"}, put); diff != "" {
+		t.Errorf("put: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"removed"}, deleted); diff != "" {
+		t.Errorf("deleted: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	metricsListFailed   = "cannot list Bucket metrics configurations"
+	metricsPutFailed    = "cannot put Bucket metrics configuration"
+	metricsDeleteFailed = "cannot delete Bucket metrics configuration"
+)
+
+// MetricsConfigurationClient is the client for API methods and reconciling the MetricsConfigurations
+type MetricsConfigurationClient struct {
+	client s3.BucketClient
+}
+
+// NewMetricsConfigurationClient creates the client for Metrics Configurations
+func NewMetricsConfigurationClient(client s3.BucketClient) *MetricsConfigurationClient {
+	return &MetricsConfigurationClient{client: client}
+}
+
+// Observe checks if the resource exists and if it matches the local configuration
+func (in *MetricsConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	desired := bucket.Spec.ForProvider.MetricsConfigurations
+	if desired == nil {
+		return Updated, nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return NeedsUpdate, err
+	}
+	put, remove := diffConfigurations(desired, observed, metricsConfigurationID)
+	return configurationsStatus(desired, put, remove), nil
+}
+
+// CreateOrUpdate sends a request to have resource created on AWS
+func (in *MetricsConfigurationClient) CreateOrUpdate(ctx context.Context, bucket *v1beta1.Bucket) error {
+	desired := bucket.Spec.ForProvider.MetricsConfigurations
+	if desired == nil {
+		return nil
+	}
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	put, remove := diffConfigurations(desired, observed, metricsConfigurationID)
+	for _, c := range put {
+		if _, err := in.client.PutBucketMetricsConfiguration(ctx, &awss3.PutBucketMetricsConfigurationInput{
+			Bucket:               pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:                   aws.String(c.ID),
+			MetricsConfiguration: GenerateAWSMetricsConfiguration(c),
+		}); err != nil {
+			return errorutils.Wrap(err, metricsPutFailed)
+		}
+	}
+	return in.delete(ctx, bucket, remove)
+}
+
+// Delete creates the request to delete the resource on AWS or set it to the default value.
+func (in *MetricsConfigurationClient) Delete(ctx context.Context, bucket *v1beta1.Bucket) error {
+	observed, err := in.list(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	ids := make([]string, len(observed))
+	for i, c := range observed {
+		ids[i] = c.ID
+	}
+	return in.delete(ctx, bucket, ids)
+}
+
+func (in *MetricsConfigurationClient) delete(ctx context.Context, bucket *v1beta1.Bucket, ids []string) error {
+	for _, id := range ids {
+		if _, err := in.client.DeleteBucketMetricsConfiguration(ctx, &awss3.DeleteBucketMetricsConfigurationInput{
+			Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket)),
+			Id:     aws.String(id),
+		}); err != nil {
+			return errorutils.Wrap(err, metricsDeleteFailed)
+		}
+	}
+	return nil
+}
+
+func (in *MetricsConfigurationClient) list(ctx context.Context, bucket *v1beta1.Bucket) ([]v1beta1.MetricsConfiguration, error) {
+	var res []v1beta1.MetricsConfiguration
+	input := &awss3.ListBucketMetricsConfigurationsInput{Bucket: pointer.ToOrNilIfZeroValue(meta.GetExternalName(bucket))}
+	for {
+		out, err := in.client.ListBucketMetricsConfigurations(ctx, input)
+		if err != nil {
+			return nil, errorutils.Wrap(err, metricsListFailed)
+		}
+		for _, c := range out.MetricsConfigurationList {
+			res = append(res, GenerateMetricsConfiguration(c))
+		}
+		if !aws.ToBool(out.IsTruncated) {
+			return res, nil
+		}
+		input.ContinuationToken = out.NextContinuationToken
+	}
+}
+
+// LateInitialize does nothing because the configurations are only managed if
+// they are specified.
+func (in *MetricsConfigurationClient) LateInitialize(ctx context.Context, bucket *v1beta1.Bucket) error {
+	return nil
+}
+
+// SubresourceExists checks if the subresource this controller manages currently exists
+func (in *MetricsConfigurationClient) SubresourceExists(bucket *v1beta1.Bucket) bool {
+	return bucket.Spec.ForProvider.MetricsConfigurations != nil
+}
+
+func metricsConfigurationID(c v1beta1.MetricsConfiguration) string {
+	return c.ID
+}
+
+// GenerateAWSMetricsConfiguration creates the AWS representation of a metrics
+// configuration
+func GenerateAWSMetricsConfiguration(c v1beta1.MetricsConfiguration) *types.MetricsConfiguration {
+	res := &types.MetricsConfiguration{Id: aws.String(c.ID)}
+	if f := c.Filter; f != nil {
+		switch {
+		case f.And != nil:
+			res.Filter = &types.MetricsFilterMemberAnd{Value: types.MetricsAndOperator{
+				AccessPointArn: f.And.AccessPointARN,
+				Prefix:         f.And.Prefix,
+				Tags:           s3.CopyTags(f.And.Tags),
+			}}
+		case f.Tag != nil:
+			res.Filter = &types.MetricsFilterMemberTag{Value: *generateAWSTag(f.Tag)}
+		case f.AccessPointARN != nil:
+			res.Filter = &types.MetricsFilterMemberAccessPointArn{Value: *f.AccessPointARN}
+		case f.Prefix != nil:
+			res.Filter = &types.MetricsFilterMemberPrefix{Value: *f.Prefix}
+		}
+	}
+	return res
+}
+
+// GenerateMetricsConfiguration creates the local representation of a metrics
+// configuration
+func GenerateMetricsConfiguration(c types.MetricsConfiguration) v1beta1.MetricsConfiguration {
+	res := v1beta1.MetricsConfiguration{ID: aws.ToString(c.Id)}
+	switch f := c.Filter.(type) {
+	case *types.MetricsFilterMemberAnd:
+		res.Filter = &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{
+			AccessPointARN: f.Value.AccessPointArn,
+			Prefix:         f.Value.Prefix,
+			Tags:           s3.CopyAWSTags(f.Value.Tags),
+		}}
+	case *types.MetricsFilterMemberTag:
+		res.Filter = &v1beta1.MetricsFilter{Tag: generateLocalTag(&f.Value)}
+	case *types.MetricsFilterMemberAccessPointArn:
+		res.Filter = &v1beta1.MetricsFilter{AccessPointARN: aws.String(f.Value)}
+	case *types.MetricsFilterMemberPrefix:
+		res.Filter = &v1beta1.MetricsFilter{Prefix: aws.String(f.Value)}
+	}
+	return res
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane-contrib/provider-aws/pkg/controller/s3/testing"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var _ SubresourceClient = &MetricsConfigurationClient{}
+
+func generateMetricsConfigs() []v1beta1.MetricsConfiguration {
+	return []v1beta1.MetricsConfiguration{
+		{
+			ID: "logs",
+			Filter: &v1beta1.MetricsFilter{And: &v1beta1.MetricsAndOperator{
+				Prefix: aws.String("logs/"),
+				Tags:   []v1beta1.Tag{{Key: "team", Value: "platform"}},
+			}},
+		},
+		{
+			ID: "all",
+		},
+	}
+}
+
+func generateAWSMetricsConfigs() []s3types.MetricsConfiguration {
+	c := generateMetricsConfigs()
+	res := make([]s3types.MetricsConfiguration, len(c))
+	for i := range c {
+		res[i] = *GenerateAWSMetricsConfiguration(c[i])
+	}
+	return res
+}
+
+func listMetrics(c []s3types.MetricsConfiguration) func(context.Context, *s3.ListBucketMetricsConfigurationsInput, []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+	return func(_ context.Context, _ *s3.ListBucketMetricsConfigurationsInput, _ []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+		return &s3.ListBucketMetricsConfigurationsOutput{MetricsConfigurationList: c}, nil
+	}
+}
+
+func TestMetricsObserve(t *testing.T) {
+	type args struct {
+		cl *MetricsConfigurationClient
+		b  *v1beta1.Bucket
+	}
+
+	type want struct {
+		status ResourceStatus
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Unmanaged": {
+			args: args{
+				b:  s3testing.Bucket(),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"Error": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(generateMetricsConfigs())),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: func(ctx context.Context, input *s3.ListBucketMetricsConfigurationsInput, opts []func(*s3.Options)) (*s3.ListBucketMetricsConfigurationsOutput, error) {
+						return nil, errBoom
+					},
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+				err:    errorutils.Wrap(errBoom, metricsListFailed),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(generateMetricsConfigs())),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetrics(generateAWSMetricsConfigs()),
+				}),
+			},
+			want: want{
+				status: Updated,
+			},
+		},
+		"NeedsUpdate": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs(generateMetricsConfigs())),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetrics(nil),
+				}),
+			},
+			want: want{
+				status: NeedsUpdate,
+			},
+		},
+		"NeedsDeletion": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithMetricsConfigs([]v1beta1.MetricsConfiguration{})),
+				cl: NewMetricsConfigurationClient(fake.MockBucketClient{
+					MockListBucketMetricsConfigurations: listMetrics(generateAWSMetricsConfigs()),
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			status, err := tc.args.cl.Observe(context.Background(), tc.args.b)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.status, status); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricsCreateOrUpdate(t *testing.T) {
+	var put, deleted []string
+	observed := append(generateAWSMetricsConfigs(), s3types.MetricsConfiguration{Id: aws.String("removed")})
+	cl := NewMetricsConfigurationClient(fake.MockBucketClient{
+		MockListBucketMetricsConfigurations: listMetrics(observed),
+		MockPutBucketMetricsConfiguration: func(ctx context.Context, input *s3.PutBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.PutBucketMetricsConfigurationOutput, error) {
+			put = append(put, aws.ToString(input.Id))
+			return &s3.PutBucketMetricsConfigurationOutput{}, nil
+		},
+		MockDeleteBucketMetricsConfiguration: func(ctx context.Context, input *s3.DeleteBucketMetricsConfigurationInput, opts []func(*s3.Options)) (*s3.DeleteBucketMetricsConfigurationOutput, error) {
+			deleted = append(deleted, aws.ToString(input.Id))
+			return &s3.DeleteBucketMetricsConfigurationOutput{}, nil
+		},
+	})
+	desired := append(generateMetricsConfigs(), v1beta1.MetricsConfiguration{ID: "added", Filter: &v1beta1.MetricsFilter{Prefix: aws.String("added/")}})
+	if err := cl.CreateOrUpdate(context.Background(), s3testing.Bucket(s3testing.WithMetricsConfigs(desired))); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]string{"added"}, put); diff != "" {
+		t.Errorf("put: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"removed"}, deleted); diff != "" {
+		t.Errorf("deleted: -want, +got:\n%s", diff)
+	}
+}
@@ -51,6 +51,10 @@ func NewSubresourceClients(client s3.BucketClient) []SubresourceClient {
 		NewPublicAccessBlockClient(client),
 		NewPolicyClient(client),
 		NewObjectLockConfigurationClient(client),
+		NewIntelligentTieringConfigurationClient(client),
+		NewInventoryConfigurationClient(client),
+		NewMetricsConfigurationClient(client),
+		NewAnalyticsConfigurationClient(client),
 	}
 }
 
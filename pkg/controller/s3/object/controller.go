@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an Object resource"
+
+	errHead          = "failed to query the Object"
+	errGetContent    = "cannot get the content of the Object"
+	errGetTagging    = "failed to get the tags of the Object"
+	errPut           = "failed to put the Object"
+	errPutTagging    = "failed to put the tags of the Object"
+	errDeleteTagging = "failed to delete the tags of the Object"
+	errDelete        = "failed to delete the Object"
+)
+
+// SetupObject adds a controller that reconciles Objects.
+func SetupObject(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(manualv1alpha1.ObjectGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: s3.NewObjectClient}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(manualv1alpha1.ObjectGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &manualv1alpha1.Object{}, &manualv1alpha1.ObjectList{}, secretRefs)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&manualv1alpha1.Object{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+// secretRefs returns the Kubernetes Secrets referenced by an Object.
+// Changes of referenced ConfigMaps are picked up on the next poll.
+func secretRefs(mg resource.Managed) []types.NamespacedName {
+	cr, ok := mg.(*manualv1alpha1.Object)
+	if !ok {
+		return nil
+	}
+	return kube.SecretKeySelectorRefs(cr.Spec.ForProvider.ContentSecretRef)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.ObjectClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*manualv1alpha1.Object)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := connectaws.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client s3.ObjectClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Object)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.head(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(s3.IsNotFound, err), errHead)
+	}
+
+	cr.Status.AtProvider = s3.GenerateObjectObservation(observed)
+	cr.SetConditions(xpv1.Available())
+
+	// The content may no longer be available while the Object is deleted,
+	// e.g. if its ConfigMap is deleted along with it.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	content, err := s3.GetObjectContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetContent)
+	}
+	if !s3.IsObjectUpToDate(cr.Spec.ForProvider, observed, content) {
+		return managed.ExternalObservation{ResourceExists: true}, nil
+	}
+
+	tagging, err := e.client.GetObjectTagging(ctx, &awss3.GetObjectTaggingInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(err, errGetTagging)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: s3.AreObjectTagsUpToDate(cr.Spec.ForProvider.Tags, tagging.TagSet),
+	}, nil
+}
+
+func (e *external) head(ctx context.Context, cr *manualv1alpha1.Object) (*awss3.HeadObjectOutput, error) {
+	return e.client.HeadObject(ctx, &awss3.HeadObjectInput{
+		Bucket:       cr.Spec.ForProvider.Bucket,
+		Key:          aws.String(cr.Spec.ForProvider.Key),
+		ChecksumMode: s3types.ChecksumModeEnabled,
+	})
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*manualv1alpha1.Object)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) put(ctx context.Context, cr *manualv1alpha1.Object) error {
+	content, err := s3.GetObjectContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return errors.Wrap(err, errGetContent)
+	}
+	_, err = e.client.PutObject(ctx, s3.GeneratePutObjectInput(aws.ToString(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider, content))
+	return errorutils.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*manualv1alpha1.Object)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.head(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errHead)
+	}
+	content, err := s3.GetObjectContent(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetContent)
+	}
+
+	// Objects are immutable, so any change other than the tags requires the
+	// object to be written again. The tags are written along with it.
+	if !s3.IsObjectUpToDate(cr.Spec.ForProvider, observed, content) {
+		_, err := e.client.PutObject(ctx, s3.GeneratePutObjectInput(aws.ToString(cr.Spec.ForProvider.Bucket), cr.Spec.ForProvider, content))
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errPut)
+	}
+
+	if len(cr.Spec.ForProvider.Tags) == 0 {
+		_, err := e.client.DeleteObjectTagging(ctx, &awss3.DeleteObjectTaggingInput{
+			Bucket: cr.Spec.ForProvider.Bucket,
+			Key:    aws.String(cr.Spec.ForProvider.Key),
+		})
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeleteTagging)
+	}
+	_, err = e.client.PutObjectTagging(ctx, &awss3.PutObjectTaggingInput{
+		Bucket:  cr.Spec.ForProvider.Bucket,
+		Key:     aws.String(cr.Spec.ForProvider.Key),
+		Tagging: &s3types.Tagging{TagSet: s3.GenerateObjectTagSet(cr.Spec.ForProvider.Tags)},
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutTagging)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mgd.(*manualv1alpha1.Object)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: cr.Spec.ForProvider.Bucket,
+		Key:    aws.String(cr.Spec.ForProvider.Key),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(s3.IsNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
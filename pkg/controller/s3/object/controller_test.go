@@ -0,0 +1,495 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/s3/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	bucketName = "some-bucket"
+	objectKey  = "config/app.yaml"
+	content    = "key: value"
+	etag       = `"abc"`
+
+	deletionTimestamp = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	kube   client.Client
+	client s3.ObjectClient
+	cr     *manualv1alpha1.Object
+}
+
+type objectModifier func(*manualv1alpha1.Object)
+
+func withContent(c string) objectModifier {
+	return func(r *manualv1alpha1.Object) { r.Spec.ForProvider.Content = &c }
+}
+
+func withConfigMapRef(ref *manualv1alpha1.ConfigMapKeySelector) objectModifier {
+	return func(r *manualv1alpha1.Object) { r.Spec.ForProvider.ContentConfigMapRef = ref }
+}
+
+func withTags(tags ...v1beta1.Tag) objectModifier {
+	return func(r *manualv1alpha1.Object) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withIgnoreContentDrift() objectModifier {
+	return func(r *manualv1alpha1.Object) { r.Spec.ForProvider.IgnoreContentDrift = aws.Bool(true) }
+}
+
+func withStatus(s manualv1alpha1.ObjectObservation) objectModifier {
+	return func(r *manualv1alpha1.Object) { r.Status.AtProvider = s }
+}
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *manualv1alpha1.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDeletionTimestamp() objectModifier {
+	return func(r *manualv1alpha1.Object) { r.SetDeletionTimestamp(&deletionTimestamp) }
+}
+
+func object(m ...objectModifier) *manualv1alpha1.Object {
+	cr := &manualv1alpha1.Object{}
+	cr.Spec.ForProvider.Bucket = aws.String(bucketName)
+	cr.Spec.ForProvider.Key = objectKey
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func headOutput(checksum string) *awss3.HeadObjectOutput {
+	return &awss3.HeadObjectOutput{ETag: aws.String(etag), ChecksumSHA256: aws.String(checksum), ContentLength: aws.Int64(int64(len(content)))}
+}
+
+func observation(checksum string) manualv1alpha1.ObjectObservation {
+	return manualv1alpha1.ObjectObservation{ETag: aws.String(etag), ChecksumSHA256: aws.String(checksum), ContentLength: aws.Int64(int64(len(content)))}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	checksum := s3.ObjectContentSHA256([]byte(content))
+
+	type want struct {
+		cr     *manualv1alpha1.Object
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockGetObjectTagging: func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return &awss3.GetObjectTaggingOutput{TagSet: []s3types.Tag{{Key: aws.String("k"), Value: aws.String("v")}}}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "k", Value: "v"}),
+					withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ContentFromConfigMap": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"app.yaml": content}
+						return nil
+					},
+				},
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockGetObjectTagging: func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return &awss3.GetObjectTaggingOutput{}, nil
+					},
+				},
+				cr: object(withConfigMapRef(&manualv1alpha1.ConfigMapKeySelector{Name: "app", Namespace: "default", Key: "app.yaml"})),
+			},
+			want: want{
+				cr: object(withConfigMapRef(&manualv1alpha1.ConfigMapKeySelector{Name: "app", Namespace: "default", Key: "app.yaml"}),
+					withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ContentDrift": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+				},
+				cr: object(withContent("changed")),
+			},
+			want: want{
+				cr:     object(withContent("changed"), withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"IgnoreContentDrift": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockGetObjectTagging: func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return &awss3.GetObjectTaggingOutput{}, nil
+					},
+				},
+				cr: object(withContent("changed"), withIgnoreContentDrift()),
+			},
+			want: want{
+				cr: object(withContent("changed"), withIgnoreContentDrift(),
+					withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TagsDrift": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockGetObjectTagging: func(ctx context.Context, input *awss3.GetObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+						return &awss3.GetObjectTaggingOutput{}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "k", Value: "v"}),
+					withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"DeletingWithoutContent": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+				},
+				cr: object(withDeletionTimestamp()),
+			},
+			want: want{
+				cr:     object(withDeletionTimestamp(), withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr: object(withContent(content)),
+			},
+		},
+		"HeadFailed": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: errorutils.Wrap(errBoom, errHead),
+			},
+		},
+		"ConfigMapNotFound": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+				},
+				cr: object(withConfigMapRef(&manualv1alpha1.ConfigMapKeySelector{Name: "app", Namespace: "default", Key: "app.yaml"})),
+			},
+			want: want{
+				cr: object(withConfigMapRef(&manualv1alpha1.ConfigMapKeySelector{Name: "app", Namespace: "default", Key: "app.yaml"}),
+					withStatus(observation(checksum)), withConditions(xpv1.Available())),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get the referenced ConfigMap"), errGetContent),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.Object
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						if diff := cmp.Diff(aws.String("k=v"), input.Tagging); diff != "" {
+							t.Errorf("PutObject: -want, +got:\n%s", diff)
+						}
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+		},
+		"NoContent": {
+			args: args{
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: errors.Wrap(errors.New("one of content, contentBase64, contentConfigMapRef or contentSecretRef is required"), errGetContent),
+			},
+		},
+		"PutFailed": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				cr:  object(withContent(content)),
+				err: errorutils.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	checksum := s3.ObjectContentSHA256([]byte(content))
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ContentChanged": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				cr: object(withContent("changed")),
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockPutObjectTagging: func(ctx context.Context, input *awss3.PutObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.PutObjectTaggingOutput, error) {
+						want := []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("2")}}
+						if diff := cmp.Diff(want, input.Tagging.TagSet, cmp.AllowUnexported(s3types.Tag{})); diff != "" {
+							t.Errorf("PutObjectTagging: -want, +got:\n%s", diff)
+						}
+						return &awss3.PutObjectTaggingOutput{}, nil
+					},
+				},
+				cr: object(withContent(content), withTags(v1beta1.Tag{Key: "b", Value: "2"}, v1beta1.Tag{Key: "a", Value: "1"})),
+			},
+		},
+		"TagsRemoved": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockDeleteObjectTagging: func(ctx context.Context, input *awss3.DeleteObjectTaggingInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectTaggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent(content)),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errDeleteTagging),
+			},
+		},
+		"PutFailed": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockHeadObject: func(ctx context.Context, input *awss3.HeadObjectInput, opts []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return headOutput(checksum), nil
+					},
+					MockPutObject: func(ctx context.Context, input *awss3.PutObjectInput, opts []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(withContent("changed")),
+			},
+			want: want{
+				err: errorutils.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *manualv1alpha1.Object
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFailed": {
+			args: args{
+				client: &fake.MockObjectClient{
+					MockDeleteObject: func(ctx context.Context, input *awss3.DeleteObjectInput, opts []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
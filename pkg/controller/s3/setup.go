@@ -22,6 +22,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3/bucket"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3/object"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -31,5 +32,6 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		mgr, o,
 		bucket.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
+		object.SetupObject,
 	)
 }
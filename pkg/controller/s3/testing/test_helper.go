@@ -132,6 +132,39 @@ func WithObjectLockRule(s *v1beta1.ObjectLockRule) BucketModifier {
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.ObjectLockRule = s }
 }
 
+// WithIntelligentTieringConfigs sets IntelligentTieringConfigurations for an S3 Bucket
+func WithIntelligentTieringConfigs(c []v1beta1.IntelligentTieringConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.IntelligentTieringConfigurations = c }
+}
+
+// WithInventoryConfigs sets InventoryConfigurations for an S3 Bucket
+func WithInventoryConfigs(c []v1beta1.InventoryConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.InventoryConfigurations = c }
+}
+
+// WithMetricsConfigs sets MetricsConfigurations for an S3 Bucket
+func WithMetricsConfigs(c []v1beta1.MetricsConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.MetricsConfigurations = c }
+}
+
+// WithAnalyticsConfigs sets AnalyticsConfigurations for an S3 Bucket
+func WithAnalyticsConfigs(c []v1beta1.AnalyticsConfiguration) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.AnalyticsConfigurations = c }
+}
+
+// WithForceDestroy sets ForceDestroy and BypassGovernanceRetention for an S3 Bucket
+func WithForceDestroy(force, bypassGovernance bool) BucketModifier {
+	return func(r *v1beta1.Bucket) {
+		r.Spec.ForProvider.ForceDestroy = &force
+		r.Spec.ForProvider.BypassGovernanceRetention = &bypassGovernance
+	}
+}
+
+// WithDeletedObjects sets the DeletedObjects status of an S3 Bucket
+func WithDeletedObjects(n int64) BucketModifier {
+	return func(r *v1beta1.Bucket) { r.Status.AtProvider.DeletedObjects = &n }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
@@ -0,0 +1,428 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	s3control "github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a MultiRegionAccessPoint resource"
+	errKubeUpdateFailed = "cannot update MultiRegionAccessPoint custom resource"
+
+	errCreateSession     = "cannot create a new session"
+	errDescribeOperation = "failed to describe the MultiRegionAccessPoint operation"
+	errDescribe          = "failed to describe MultiRegionAccessPoint"
+	errCreate            = "cannot create MultiRegionAccessPoint in AWS"
+	errDelete            = "failed to delete MultiRegionAccessPoint"
+	errDescribePolicy    = "failed to describe MultiRegionAccessPoint policy"
+	errPutPolicy         = "failed to put MultiRegionAccessPoint policy"
+	errFormatPolicy      = "failed to format MultiRegionAccessPoint policy"
+	errDescribeRoutes    = "failed to describe MultiRegionAccessPoint routes"
+	errSubmitRoutes      = "failed to submit MultiRegionAccessPoint routes"
+)
+
+// SetupMultiRegionAccessPoint adds a controller that reconciles
+// MultiRegionAccessPoints.
+func SetupMultiRegionAccessPoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.MultiRegionAccessPointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.MultiRegionAccessPointGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.MultiRegionAccessPoint{}, &svcapitypes.MultiRegionAccessPointList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.MultiRegionAccessPoint{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	// Multi-Region Access Points are global, but their control plane is only
+	// served from a single Region.
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, cr, s3control.MultiRegionAccessPointControlRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: svcsdk.New(sess), sts: sts.New(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client svcsdkapi.S3ControlAPI
+	sts    stsiface.STSAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { //nolint:gocyclo
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	accountID, err := s3control.ResolveAccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	lateInitialized := cr.Spec.ForProvider.AccountID == nil
+	cr.Spec.ForProvider.AccountID = pointer.ToOrNilIfZeroValue(accountID)
+
+	// Creating, deleting and changing the policy of a Multi-Region Access
+	// Point are asynchronous. Nothing else is done until the operation that
+	// is in progress has finished.
+	if token := requestTokenARN(cr); token != nil {
+		op, err := e.client.DescribeMultiRegionAccessPointOperationWithContext(ctx, &svcsdk.DescribeMultiRegionAccessPointOperationInput{
+			AccountId:       pointer.ToOrNilIfZeroValue(accountID),
+			RequestTokenARN: token,
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, errorutils.Wrap(err, errDescribeOperation)
+		}
+		done, err := s3control.IsAsyncOperationDone(op.AsyncOperation)
+		if !done {
+			return managed.ExternalObservation{
+				ResourceExists:          true,
+				ResourceUpToDate:        true,
+				ResourceLateInitialized: lateInitialized,
+			}, nil
+		}
+		cr.Status.AtProvider.RequestTokenARN = nil
+		if _, ok := cr.GetAnnotations()[svcapitypes.AnnotationKeyCreateRequestTokenARN]; ok {
+			meta.RemoveAnnotations(cr, svcapitypes.AnnotationKeyCreateRequestTokenARN)
+			if err := e.kube.Update(ctx, cr); err != nil {
+				return managed.ExternalObservation{}, errors.Wrap(err, errKubeUpdateFailed)
+			}
+		}
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+
+	resp, err := e.client.GetMultiRegionAccessPointWithContext(ctx, &svcsdk.GetMultiRegionAccessPointInput{
+		AccountId: pointer.ToOrNilIfZeroValue(accountID),
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(s3control.IsMultiRegionAccessPointNotFound, err), errDescribe)
+	}
+	if resp.AccessPoint == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.AtProvider = s3control.GenerateMultiRegionAccessPointObservation(accountID, resp.AccessPoint)
+
+	switch pointer.StringValue(resp.AccessPoint.Status) {
+	case svcsdk.MultiRegionAccessPointStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case svcsdk.MultiRegionAccessPointStatusCreating, svcsdk.MultiRegionAccessPointStatusPartiallyCreated:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.MultiRegionAccessPointStatusDeleting, svcsdk.MultiRegionAccessPointStatusPartiallyDeleted:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	if meta.WasDeleted(cr) || pointer.StringValue(resp.AccessPoint.Status) != svcsdk.MultiRegionAccessPointStatusReady {
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: lateInitialized,
+		}, nil
+	}
+
+	policyUpToDate, err := e.isPolicyUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	routesUpToDate, err := e.areRoutesUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        policyUpToDate && routesUpToDate,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	accountID, err := s3control.ResolveAccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := e.client.CreateMultiRegionAccessPointWithContext(ctx, s3control.GenerateCreateMultiRegionAccessPointInput(meta.GetExternalName(cr), accountID, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	// The status is reset when the critical annotations are persisted after
+	// the creation, so the request token is kept in an annotation instead.
+	if resp.RequestTokenARN != nil {
+		meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyCreateRequestTokenARN: *resp.RequestTokenARN})
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	policyUpToDate, err := e.isPolicyUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !policyUpToDate {
+		// The routes are updated once the policy change has finished.
+		return managed.ExternalUpdate{}, e.putPolicy(ctx, cr)
+	}
+
+	routesUpToDate, err := e.areRoutesUpToDate(ctx, cr)
+	if err != nil || routesUpToDate {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = e.client.SubmitMultiRegionAccessPointRoutesWithContext(ctx, &svcsdk.SubmitMultiRegionAccessPointRoutesInput{
+		AccountId:    cr.Spec.ForProvider.AccountID,
+		Mrap:         cr.Status.AtProvider.ARN,
+		RouteUpdates: generateRoutes(cr.Spec.ForProvider.Routes),
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errSubmitRoutes)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*svcapitypes.MultiRegionAccessPoint)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	if requestTokenARN(cr) != nil || pointer.StringValue(cr.Status.AtProvider.Status) == svcsdk.MultiRegionAccessPointStatusDeleting {
+		return managed.ExternalDelete{}, nil
+	}
+
+	accountID, err := s3control.ResolveAccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	resp, err := e.client.DeleteMultiRegionAccessPointWithContext(ctx, &svcsdk.DeleteMultiRegionAccessPointInput{
+		AccountId: pointer.ToOrNilIfZeroValue(accountID),
+		Details: &svcsdk.DeleteMultiRegionAccessPointInput_{
+			Name: pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		},
+	})
+	if err != nil {
+		return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(s3control.IsMultiRegionAccessPointNotFound, err), errDelete)
+	}
+	cr.Status.AtProvider.RequestTokenARN = resp.RequestTokenARN
+	return managed.ExternalDelete{}, nil
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
+
+// requestTokenARN returns the request token of the asynchronous operation
+// that is in progress, if any.
+func requestTokenARN(cr *svcapitypes.MultiRegionAccessPoint) *string {
+	if token, ok := cr.GetAnnotations()[svcapitypes.AnnotationKeyCreateRequestTokenARN]; ok {
+		return &token
+	}
+	return cr.Status.AtProvider.RequestTokenARN
+}
+
+// isPolicyUpToDate returns whether the latest policy of the Multi-Region
+// Access Point matches the desired one. Policies can't be removed from a
+// Multi-Region Access Point, so an unset policy is always up to date.
+func (e *external) isPolicyUpToDate(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint) (bool, error) {
+	if cr.Spec.ForProvider.Policy == nil {
+		return true, nil
+	}
+	resp, err := e.client.GetMultiRegionAccessPointPolicyWithContext(ctx, &svcsdk.GetMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	if resource.Ignore(s3control.IsMultiRegionAccessPointPolicyNotFound, err) != nil {
+		return false, errorutils.Wrap(err, errDescribePolicy)
+	}
+	current := currentPolicy(resp)
+	if current == nil {
+		return false, nil
+	}
+	diff, err := s3.DiffParsedPolicies(cr.Spec.ForProvider.Policy, current)
+	return diff == "" && err == nil, err
+}
+
+// currentPolicy returns the proposed policy of a Multi-Region Access Point,
+// which is the one that was put last, or the established one if none is
+// proposed.
+func currentPolicy(resp *svcsdk.GetMultiRegionAccessPointPolicyOutput) *string {
+	if resp == nil || resp.Policy == nil {
+		return nil
+	}
+	if resp.Policy.Proposed != nil && resp.Policy.Proposed.Policy != nil {
+		return resp.Policy.Proposed.Policy
+	}
+	if resp.Policy.Established != nil {
+		return resp.Policy.Established.Policy
+	}
+	return nil
+}
+
+func (e *external) putPolicy(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint) error {
+	policy, err := s3.FormatPolicy(cr.Spec.ForProvider.Policy)
+	if err != nil {
+		return errors.Wrap(err, errFormatPolicy)
+	}
+	resp, err := e.client.PutMultiRegionAccessPointPolicyWithContext(ctx, &svcsdk.PutMultiRegionAccessPointPolicyInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Details: &svcsdk.PutMultiRegionAccessPointPolicyInput_{
+			Name:   pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+			Policy: policy,
+		},
+	})
+	if err != nil {
+		return errorutils.Wrap(err, errPutPolicy)
+	}
+	cr.Status.AtProvider.RequestTokenARN = resp.RequestTokenARN
+	return nil
+}
+
+// areRoutesUpToDate returns whether the traffic dial of every desired route
+// matches the observed one. Routes that are not specified are left alone.
+func (e *external) areRoutesUpToDate(ctx context.Context, cr *svcapitypes.MultiRegionAccessPoint) (bool, error) {
+	if len(cr.Spec.ForProvider.Routes) == 0 {
+		return true, nil
+	}
+	resp, err := e.client.GetMultiRegionAccessPointRoutesWithContext(ctx, &svcsdk.GetMultiRegionAccessPointRoutesInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Mrap:      cr.Status.AtProvider.ARN,
+	})
+	if err != nil {
+		return false, errorutils.Wrap(err, errDescribeRoutes)
+	}
+	for _, desired := range cr.Spec.ForProvider.Routes {
+		observed := findRoute(resp.Routes, desired)
+		if observed == nil || pointer.Int64Value(observed.TrafficDialPercentage) != desired.TrafficDialPercentage {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// findRoute returns the observed route of the bucket of the given route or,
+// if it has no bucket, of its Region.
+func findRoute(observed []*svcsdk.MultiRegionAccessPointRoute, r svcapitypes.MultiRegionAccessPointRoute) *svcsdk.MultiRegionAccessPointRoute {
+	for _, o := range observed {
+		if o == nil {
+			continue
+		}
+		if r.Bucket != nil {
+			if pointer.StringValue(o.Bucket) == *r.Bucket {
+				return o
+			}
+			continue
+		}
+		if pointer.StringValue(o.Region) == pointer.StringValue(r.Region) {
+			return o
+		}
+	}
+	return nil
+}
+
+func generateRoutes(routes []svcapitypes.MultiRegionAccessPointRoute) []*svcsdk.MultiRegionAccessPointRoute {
+	res := make([]*svcsdk.MultiRegionAccessPointRoute, len(routes))
+	for i, r := range routes {
+		res[i] = &svcsdk.MultiRegionAccessPointRoute{
+			Bucket:                r.Bucket,
+			Region:                r.Region,
+			TrafficDialPercentage: aws.Int64(r.TrafficDialPercentage),
+		}
+	}
+	return res
+}
@@ -0,0 +1,524 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package multiregionaccesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/aws/aws-sdk-go/service/sts"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	xpfake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	testName      = "test-mrap"
+	testAccountID = "123456789012"
+	testAlias     = "mfzwi23gnjvgw.mrap"
+	testARN       = "arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap"
+	testToken     = "arn:aws:s3:us-west-2:123456789012:async-request/mrap/create/1234"
+)
+
+type mrapModifier func(*svcapitypes.MultiRegionAccessPoint)
+
+func withAccountID(id *string) mrapModifier {
+	return func(cr *svcapitypes.MultiRegionAccessPoint) { cr.Spec.ForProvider.AccountID = id }
+}
+
+func withRoutes(r ...svcapitypes.MultiRegionAccessPointRoute) mrapModifier {
+	return func(cr *svcapitypes.MultiRegionAccessPoint) { cr.Spec.ForProvider.Routes = r }
+}
+
+func withObservation(o svcapitypes.MultiRegionAccessPointObservation) mrapModifier {
+	return func(cr *svcapitypes.MultiRegionAccessPoint) { cr.Status.AtProvider = o }
+}
+
+func withCreateRequestToken(token string) mrapModifier {
+	return func(cr *svcapitypes.MultiRegionAccessPoint) {
+		meta.AddAnnotations(cr, map[string]string{svcapitypes.AnnotationKeyCreateRequestTokenARN: token})
+	}
+}
+
+func withConditions(c ...xpv1.Condition) mrapModifier {
+	return func(cr *svcapitypes.MultiRegionAccessPoint) { cr.Status.SetConditions(c...) }
+}
+
+func mrap(m ...mrapModifier) *svcapitypes.MultiRegionAccessPoint {
+	cr := &svcapitypes.MultiRegionAccessPoint{
+		Spec: svcapitypes.MultiRegionAccessPointSpec{
+			ForProvider: svcapitypes.MultiRegionAccessPointParameters{
+				AccountID: aws.String(testAccountID),
+				Regions: []svcapitypes.MultiRegionAccessPointRegion{
+					{Bucket: aws.String("bucket-us-east-1")},
+					{Bucket: aws.String("bucket-eu-west-1")},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, testName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func readyObservation() svcapitypes.MultiRegionAccessPointObservation {
+	return svcapitypes.MultiRegionAccessPointObservation{
+		Alias:  aws.String(testAlias),
+		ARN:    aws.String(testARN),
+		Status: aws.String(svcsdk.MultiRegionAccessPointStatusReady),
+	}
+}
+
+func readyOutput() svcsdk.GetMultiRegionAccessPointOutput {
+	return svcsdk.GetMultiRegionAccessPointOutput{
+		AccessPoint: &svcsdk.MultiRegionAccessPointReport{
+			Alias:  aws.String(testAlias),
+			Name:   aws.String(testName),
+			Status: aws.String(svcsdk.MultiRegionAccessPointStatusReady),
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		kube   client.Client
+		client *fake.MockS3ControlClient
+		sts    *fake.MockSTSClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr     *svcapitypes.MultiRegionAccessPoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextErr: awserr.New("NoSuchMultiRegionAccessPoint", "", nil),
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ResolveAccountID": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput: readyOutput(),
+				},
+				sts: &fake.MockSTSClient{
+					GetCallerIdentityWithContextOutput: sts.GetCallerIdentityOutput{Account: aws.String(testAccountID)},
+				},
+				cr: mrap(withAccountID(nil)),
+			},
+			want: want{
+				cr: mrap(withObservation(readyObservation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"OperationInProgress": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+						AsyncOperation: &svcsdk.AsyncOperation{RequestStatus: aws.String("IN_PROGRESS")},
+					},
+				},
+				cr: mrap(withObservation(svcapitypes.MultiRegionAccessPointObservation{RequestTokenARN: aws.String(testToken)})),
+			},
+			want: want{
+				cr: mrap(withObservation(svcapitypes.MultiRegionAccessPointObservation{RequestTokenARN: aws.String(testToken)})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"OperationFailed": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+						AsyncOperation: &svcsdk.AsyncOperation{
+							Operation:     aws.String(svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint),
+							RequestStatus: aws.String("FAILED"),
+						},
+					},
+				},
+				cr: mrap(withObservation(svcapitypes.MultiRegionAccessPointObservation{RequestTokenARN: aws.String(testToken)})),
+			},
+			want: want{
+				cr:  mrap(),
+				err: errors.Errorf("asynchronous operation %s failed", svcsdk.AsyncOperationNameCreateMultiRegionAccessPoint),
+			},
+		},
+		"CreateFinished": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+						AsyncOperation: &svcsdk.AsyncOperation{RequestStatus: aws.String("SUCCEEDED")},
+					},
+					GetMultiRegionAccessPointWithContextOutput: readyOutput(),
+				},
+				cr: mrap(withCreateRequestToken(testToken)),
+			},
+			want: want{
+				cr: mrap(withObservation(readyObservation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateFinishedUpdateError": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &fake.MockS3ControlClient{
+					DescribeMultiRegionAccessPointOperationWithContextOutput: svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+						AsyncOperation: &svcsdk.AsyncOperation{RequestStatus: aws.String("SUCCEEDED")},
+					},
+				},
+				cr: mrap(withCreateRequestToken(testToken)),
+			},
+			want: want{
+				cr:  mrap(),
+				err: errors.Wrap(errBoom, errKubeUpdateFailed),
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput: svcsdk.GetMultiRegionAccessPointOutput{
+						AccessPoint: &svcsdk.MultiRegionAccessPointReport{
+							Alias:  aws.String(testAlias),
+							Status: aws.String(svcsdk.MultiRegionAccessPointStatusCreating),
+						},
+					},
+				},
+				cr: mrap(withRoutes(svcapitypes.MultiRegionAccessPointRoute{Bucket: aws.String("bucket-us-east-1")})),
+			},
+			want: want{
+				cr: mrap(
+					withRoutes(svcapitypes.MultiRegionAccessPointRoute{Bucket: aws.String("bucket-us-east-1")}),
+					withObservation(svcapitypes.MultiRegionAccessPointObservation{
+						Alias:  aws.String(testAlias),
+						ARN:    aws.String(testARN),
+						Status: aws.String(svcsdk.MultiRegionAccessPointStatusCreating),
+					}),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RoutesOutdated": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetMultiRegionAccessPointWithContextOutput: readyOutput(),
+					GetMultiRegionAccessPointRoutesWithContextOutput: svcsdk.GetMultiRegionAccessPointRoutesOutput{
+						Routes: []*svcsdk.MultiRegionAccessPointRoute{
+							{Bucket: aws.String("bucket-us-east-1"), Region: aws.String("us-east-1"), TrafficDialPercentage: aws.Int64(100)},
+							{Bucket: aws.String("bucket-eu-west-1"), Region: aws.String("eu-west-1"), TrafficDialPercentage: aws.Int64(100)},
+						},
+					},
+				},
+				cr: mrap(withRoutes(
+					svcapitypes.MultiRegionAccessPointRoute{Bucket: aws.String("bucket-us-east-1"), TrafficDialPercentage: 100},
+					svcapitypes.MultiRegionAccessPointRoute{Region: aws.String("eu-west-1"), TrafficDialPercentage: 0},
+				)),
+			},
+			want: want{
+				cr: mrap(
+					withRoutes(
+						svcapitypes.MultiRegionAccessPointRoute{Bucket: aws.String("bucket-us-east-1"), TrafficDialPercentage: 100},
+						svcapitypes.MultiRegionAccessPointRoute{Region: aws.String("eu-west-1"), TrafficDialPercentage: 0},
+					),
+					withObservation(readyObservation()),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.args.kube, client: tc.args.client, sts: tc.args.sts}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.MultiRegionAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateMultiRegionAccessPointWithContextOutput: svcsdk.CreateMultiRegionAccessPointOutput{RequestTokenARN: aws.String(testToken)},
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr: mrap(withCreateRequestToken(testToken), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					CreateMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Creating())),
+				err: errorutils.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.MultiRegionAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.MultiRegionAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextOutput: svcsdk.DeleteMultiRegionAccessPointOutput{RequestTokenARN: aws.String(testToken)},
+				},
+				cr: mrap(withObservation(readyObservation())),
+			},
+			want: want{
+				cr: mrap(
+					withObservation(svcapitypes.MultiRegionAccessPointObservation{
+						Alias:           aws.String(testAlias),
+						ARN:             aws.String(testARN),
+						Status:          aws.String(svcsdk.MultiRegionAccessPointStatusReady),
+						RequestTokenARN: aws.String(testToken),
+					}),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(withObservation(svcapitypes.MultiRegionAccessPointObservation{
+					Status: aws.String(svcsdk.MultiRegionAccessPointStatusDeleting),
+				})),
+			},
+			want: want{
+				cr: mrap(
+					withObservation(svcapitypes.MultiRegionAccessPointObservation{
+						Status: aws.String(svcsdk.MultiRegionAccessPointStatusDeleting),
+					}),
+					withConditions(xpv1.Deleting()),
+				),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteMultiRegionAccessPointWithContextErr: errBoom,
+				},
+				cr: mrap(),
+			},
+			want: want{
+				cr:  mrap(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestReconcileCreate runs Create and the Observe calls that follow it through
+// the managed reconciler, which resets the status of the resource when it
+// persists the critical annotations after the creation.
+func TestReconcileCreate(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := svcapitypes.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	cr := mrap()
+	cr.SetName(testName)
+	kube := crfake.NewClientBuilder().WithScheme(s).WithStatusSubresource(cr).WithObjects(cr).Build()
+
+	awsClient := &fake.MockS3ControlClient{
+		GetMultiRegionAccessPointWithContextErr:       awserr.New("NoSuchMultiRegionAccessPoint", "", nil),
+		CreateMultiRegionAccessPointWithContextOutput: svcsdk.CreateMultiRegionAccessPointOutput{RequestTokenARN: aws.String(testToken)},
+	}
+	r := managed.NewReconciler(&xpfake.Manager{Client: kube, Scheme: s},
+		resource.ManagedKind(svcapitypes.MultiRegionAccessPointGroupVersionKind),
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(kube)),
+		managed.WithExternalConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+			return &external{kube: kube, client: awsClient}, nil
+		})),
+		managed.WithInitializers(),
+		managed.WithConnectionPublishers(),
+	)
+	req := reconcile.Request{NamespacedName: types.NamespacedName{Name: testName}}
+	reconcileAndGet := func() *svcapitypes.MultiRegionAccessPoint {
+		t.Helper()
+		if _, err := r.Reconcile(context.Background(), req); err != nil {
+			t.Fatalf("Reconcile(...): %v", err)
+		}
+		got := &svcapitypes.MultiRegionAccessPoint{}
+		if err := kube.Get(context.Background(), req.NamespacedName, got); err != nil {
+			t.Fatalf("Get(...): %v", err)
+		}
+		return got
+	}
+
+	got := reconcileAndGet()
+	if diff := cmp.Diff(testToken, got.GetAnnotations()[svcapitypes.AnnotationKeyCreateRequestTokenARN]); diff != "" {
+		t.Errorf("create request token: -want, +got:\n%s", diff)
+	}
+
+	// The Multi-Region Access Point can't be described while it is created,
+	// so observing it without the request token fails.
+	awsClient.GetMultiRegionAccessPointWithContextErr = errBoom
+	awsClient.DescribeMultiRegionAccessPointOperationWithContextOutput = svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+		AsyncOperation: &svcsdk.AsyncOperation{RequestStatus: aws.String("IN_PROGRESS")},
+	}
+	got = reconcileAndGet()
+	if diff := cmp.Diff(xpv1.ReconcileSuccess(), got.GetCondition(xpv1.TypeSynced), test.EquateConditions()); diff != "" {
+		t.Errorf("synced condition: -want, +got:\n%s", diff)
+	}
+
+	awsClient.GetMultiRegionAccessPointWithContextErr = nil
+	awsClient.GetMultiRegionAccessPointWithContextOutput = readyOutput()
+	awsClient.DescribeMultiRegionAccessPointOperationWithContextOutput = svcsdk.DescribeMultiRegionAccessPointOperationOutput{
+		AsyncOperation: &svcsdk.AsyncOperation{RequestStatus: aws.String("SUCCEEDED")},
+	}
+	got = reconcileAndGet()
+	if _, ok := got.GetAnnotations()[svcapitypes.AnnotationKeyCreateRequestTokenARN]; ok {
+		t.Errorf("create request token annotation was not removed")
+	}
+	if diff := cmp.Diff(xpv1.Available(), got.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("ready condition: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectlambdaaccesspoint
+
+import (
+	"context"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/s3control/s3controliface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/aws/aws-sdk-go/service/sts/stsiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+	s3control "github.com/crossplane-contrib/provider-aws/pkg/clients/s3control"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/connection"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/kube"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+	custommanaged "github.com/crossplane-contrib/provider-aws/pkg/utils/reconciler/managed"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an ObjectLambdaAccessPoint resource"
+
+	errCreateSession         = "cannot create a new session"
+	errDescribe              = "failed to describe ObjectLambdaAccessPoint"
+	errCreate                = "cannot create ObjectLambdaAccessPoint in AWS"
+	errDelete                = "failed to delete ObjectLambdaAccessPoint"
+	errDescribeConfiguration = "failed to describe ObjectLambdaAccessPoint configuration"
+	errPutConfiguration      = "failed to put ObjectLambdaAccessPoint configuration"
+	errDescribePolicy        = "failed to describe ObjectLambdaAccessPoint policy"
+	errPutPolicy             = "failed to put ObjectLambdaAccessPoint policy"
+	errDeletePolicy          = "failed to delete ObjectLambdaAccessPoint policy"
+	errFormatPolicy          = "failed to format ObjectLambdaAccessPoint policy"
+)
+
+// SetupObjectLambdaAccessPoint adds a controller that reconciles
+// ObjectLambdaAccessPoints.
+func SetupObjectLambdaAccessPoint(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.ObjectLambdaAccessPointGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	reconcilerOpts := []managed.ReconcilerOption{
+		managed.WithCriticalAnnotationUpdater(custommanaged.NewRetryingCriticalAnnotationUpdater(mgr.GetClient())),
+		managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		connection.WithConnectionPublishers(mgr.GetClient(), cps...),
+	}
+
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		reconcilerOpts = append(reconcilerOpts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(svcapitypes.ObjectLambdaAccessPointGroupVersionKind),
+		reconcilerOpts...)
+
+	secretHandler, err := kube.EnqueueRequestsForReferencedSecrets(mgr, &svcapitypes.ObjectLambdaAccessPoint{}, &svcapitypes.ObjectLambdaAccessPointList{}, nil)
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&svcapitypes.ObjectLambdaAccessPoint{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, secretHandler).
+		Complete(r)
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := connectaws.GetConfigV1(ctx, c.kube, cr, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcsdk.New(sess), sts: sts.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.S3ControlAPI
+	sts    stsiface.STSAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	accountID, err := s3control.ResolveAccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	lateInitialized := cr.Spec.ForProvider.AccountID == nil
+	cr.Spec.ForProvider.AccountID = pointer.ToOrNilIfZeroValue(accountID)
+
+	resp, err := e.client.GetAccessPointForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errorutils.Wrap(resource.Ignore(s3control.IsAccessPointNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = generateObservation(cr, resp)
+	cr.SetConditions(xpv1.Available())
+
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	confUpToDate, err := e.isConfigurationUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	policy, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	policyUpToDate, err := isPolicyUpToDate(cr, policy)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        confUpToDate && policyUpToDate,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	accountID, err := s3control.ResolveAccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := e.client.CreateAccessPointForObjectLambdaWithContext(ctx, &svcsdk.CreateAccessPointForObjectLambdaInput{
+		AccountId:     pointer.ToOrNilIfZeroValue(accountID),
+		Name:          pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		Configuration: s3control.GenerateObjectLambdaConfiguration(cr.Spec.ForProvider.Configuration),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, errorutils.Wrap(err, errCreate)
+	}
+	cr.Status.AtProvider.ARN = resp.ObjectLambdaAccessPointArn
+	if resp.Alias != nil {
+		cr.Status.AtProvider.Alias = resp.Alias.Value
+		cr.Status.AtProvider.AliasStatus = resp.Alias.Status
+	}
+	// The policy is put by the next update.
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	confUpToDate, err := e.isConfigurationUpToDate(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !confUpToDate {
+		_, err := e.client.PutAccessPointConfigurationForObjectLambdaWithContext(ctx, &svcsdk.PutAccessPointConfigurationForObjectLambdaInput{
+			AccountId:     cr.Spec.ForProvider.AccountID,
+			Name:          pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+			Configuration: s3control.GenerateObjectLambdaConfiguration(cr.Spec.ForProvider.Configuration),
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutConfiguration)
+		}
+	}
+
+	policy, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	policyUpToDate, err := isPolicyUpToDate(cr, policy)
+	if err != nil || policyUpToDate {
+		return managed.ExternalUpdate{}, err
+	}
+	if cr.Spec.ForProvider.Policy == nil {
+		_, err := e.client.DeleteAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.DeleteAccessPointPolicyForObjectLambdaInput{
+			AccountId: cr.Spec.ForProvider.AccountID,
+			Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		})
+		return managed.ExternalUpdate{}, errorutils.Wrap(err, errDeletePolicy)
+	}
+	doc, err := s3.FormatPolicy(cr.Spec.ForProvider.Policy)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errFormatPolicy)
+	}
+	_, err = e.client.PutAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.PutAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+		Policy:    doc,
+	})
+	return managed.ExternalUpdate{}, errorutils.Wrap(err, errPutPolicy)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) (managed.ExternalDelete, error) {
+	cr, ok := mg.(*svcapitypes.ObjectLambdaAccessPoint)
+	if !ok {
+		return managed.ExternalDelete{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	accountID, err := s3control.ResolveAccountID(ctx, e.sts, cr.Spec.ForProvider.AccountID)
+	if err != nil {
+		return managed.ExternalDelete{}, err
+	}
+	_, err = e.client.DeleteAccessPointForObjectLambdaWithContext(ctx, &svcsdk.DeleteAccessPointForObjectLambdaInput{
+		AccountId: pointer.ToOrNilIfZeroValue(accountID),
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	return managed.ExternalDelete{}, errorutils.Wrap(resource.Ignore(s3control.IsAccessPointNotFound, err), errDelete)
+}
+
+func (e *external) Disconnect(ctx context.Context) error {
+	// Unimplemented, required by newer versions of crossplane-runtime
+	return nil
+}
+
+func (e *external) isConfigurationUpToDate(ctx context.Context, cr *svcapitypes.ObjectLambdaAccessPoint) (bool, error) {
+	resp, err := e.client.GetAccessPointConfigurationForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return false, errorutils.Wrap(err, errDescribeConfiguration)
+	}
+	return s3control.IsObjectLambdaConfigurationUpToDate(cr.Spec.ForProvider.Configuration, resp.Configuration), nil
+}
+
+// getPolicy returns the current policy of the Object Lambda Access Point or
+// nil if it has none.
+func (e *external) getPolicy(ctx context.Context, cr *svcapitypes.ObjectLambdaAccessPoint) (*string, error) {
+	resp, err := e.client.GetAccessPointPolicyForObjectLambdaWithContext(ctx, &svcsdk.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: cr.Spec.ForProvider.AccountID,
+		Name:      pointer.ToOrNilIfZeroValue(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return nil, errorutils.Wrap(resource.Ignore(s3control.IsAccessPointPolicyNotFound, err), errDescribePolicy)
+	}
+	return resp.Policy, nil
+}
+
+func isPolicyUpToDate(cr *svcapitypes.ObjectLambdaAccessPoint, current *string) (bool, error) {
+	if cr.Spec.ForProvider.Policy == nil || current == nil {
+		return cr.Spec.ForProvider.Policy == nil && current == nil, nil
+	}
+	diff, err := s3.DiffParsedPolicies(cr.Spec.ForProvider.Policy, current)
+	return diff == "" && err == nil, err
+}
+
+func generateObservation(cr *svcapitypes.ObjectLambdaAccessPoint, resp *svcsdk.GetAccessPointForObjectLambdaOutput) svcapitypes.ObjectLambdaAccessPointObservation {
+	obs := svcapitypes.ObjectLambdaAccessPointObservation{
+		ARN: cr.Status.AtProvider.ARN,
+	}
+	if obs.ARN == nil {
+		obs.ARN = pointer.ToOrNilIfZeroValue("arn:aws:s3-object-lambda:" + cr.Spec.ForProvider.Region + ":" + pointer.StringValue(cr.Spec.ForProvider.AccountID) + ":accesspoint/" + meta.GetExternalName(cr))
+	}
+	if resp.Alias != nil {
+		obs.Alias = resp.Alias.Value
+		obs.AliasStatus = resp.Alias.Status
+	}
+	if resp.CreationDate != nil {
+		obs.CreationDate = pointer.TimeToMetaTime(resp.CreationDate)
+	}
+	return obs
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package objectlambdaaccesspoint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/s3control"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/s3control/manualv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3control/fake"
+	errorutils "github.com/crossplane-contrib/provider-aws/pkg/utils/errors"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	testName              = "test-olap"
+	testAccountID         = "123456789012"
+	testAlias             = "test-olap-abcdefghijklmnopqrstuvwxyz1234--ol-s3"
+	testARN               = "arn:aws:s3-object-lambda:us-east-1:123456789012:accesspoint/test-olap"
+	testFunctionARN       = "arn:aws:lambda:us-east-1:123456789012:function:transform"
+	testSupportingAccess  = "arn:aws:s3:us-east-1:123456789012:accesspoint/test-ap"
+	testPolicyNotFoundErr = awserr.New("NoSuchAccessPointPolicy", "", nil)
+)
+
+type olapModifier func(*svcapitypes.ObjectLambdaAccessPoint)
+
+func withObservation(o svcapitypes.ObjectLambdaAccessPointObservation) olapModifier {
+	return func(cr *svcapitypes.ObjectLambdaAccessPoint) { cr.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) olapModifier {
+	return func(cr *svcapitypes.ObjectLambdaAccessPoint) { cr.Status.SetConditions(c...) }
+}
+
+func olap(m ...olapModifier) *svcapitypes.ObjectLambdaAccessPoint {
+	cr := &svcapitypes.ObjectLambdaAccessPoint{
+		Spec: svcapitypes.ObjectLambdaAccessPointSpec{
+			ForProvider: svcapitypes.ObjectLambdaAccessPointParameters{
+				Region:    "us-east-1",
+				AccountID: aws.String(testAccountID),
+				Configuration: svcapitypes.ObjectLambdaConfiguration{
+					AllowedFeatures:       []string{"GetObject-Range", "GetObject-PartNumber"},
+					SupportingAccessPoint: aws.String(testSupportingAccess),
+					TransformationConfigurations: []svcapitypes.ObjectLambdaTransformationConfiguration{{
+						Actions: []string{"GetObject"},
+						ContentTransformation: svcapitypes.ObjectLambdaContentTransformation{
+							AWSLambda: svcapitypes.AWSLambdaTransformation{FunctionARN: aws.String(testFunctionARN)},
+						},
+					}},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, testName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observation() svcapitypes.ObjectLambdaAccessPointObservation {
+	return svcapitypes.ObjectLambdaAccessPointObservation{
+		Alias:       aws.String(testAlias),
+		AliasStatus: aws.String(svcsdk.ObjectLambdaAccessPointAliasStatusReady),
+		ARN:         aws.String(testARN),
+	}
+}
+
+func observedConfiguration(functionARN string) svcsdk.GetAccessPointConfigurationForObjectLambdaOutput {
+	return svcsdk.GetAccessPointConfigurationForObjectLambdaOutput{
+		Configuration: &svcsdk.ObjectLambdaConfiguration{
+			AllowedFeatures:          aws.StringSlice([]string{"GetObject-PartNumber", "GetObject-Range"}),
+			CloudWatchMetricsEnabled: aws.Bool(false),
+			SupportingAccessPoint:    aws.String(testSupportingAccess),
+			TransformationConfigurations: []*svcsdk.ObjectLambdaTransformationConfiguration{{
+				Actions: aws.StringSlice([]string{"GetObject"}),
+				ContentTransformation: &svcsdk.ObjectLambdaContentTransformation{
+					AwsLambda: &svcsdk.AwsLambdaTransformation{FunctionArn: aws.String(functionARN)},
+				},
+			}},
+		},
+	}
+}
+
+func getOutput() svcsdk.GetAccessPointForObjectLambdaOutput {
+	return svcsdk.GetAccessPointForObjectLambdaOutput{
+		Alias: &svcsdk.ObjectLambdaAccessPointAlias{
+			Value:  aws.String(testAlias),
+			Status: aws.String(svcsdk.ObjectLambdaAccessPointAliasStatusReady),
+		},
+		Name: aws.String(testName),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+	}
+	type want struct {
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextErr: awserr.New("NoSuchAccessPoint", "", nil),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(),
+				err: errorutils.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput:              getOutput(),
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration(testFunctionARN),
+					GetAccessPointPolicyForObjectLambdaWithContextErr:           testPolicyNotFoundErr,
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ConfigurationOutdated": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput:              getOutput(),
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration("arn:aws:lambda:us-east-1:123456789012:function:old"),
+					GetAccessPointPolicyForObjectLambdaWithContextErr:           testPolicyNotFoundErr,
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"PolicyNeedsDeletion": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					GetAccessPointForObjectLambdaWithContextOutput:              getOutput(),
+					GetAccessPointConfigurationForObjectLambdaWithContextOutput: observedConfiguration(testFunctionARN),
+					GetAccessPointPolicyForObjectLambdaWithContextOutput: svcsdk.GetAccessPointPolicyForObjectLambdaOutput{
+						Policy: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+					},
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type args struct {
+		client *fake.MockS3ControlClient
+		cr     *svcapitypes.ObjectLambdaAccessPoint
+	}
+	type want struct {
+		cr  *svcapitypes.ObjectLambdaAccessPoint
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockS3ControlClient{},
+				cr:     olap(),
+			},
+			want: want{
+				cr: olap(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteAccessPointForObjectLambdaWithContextErr: awserr.New("NoSuchAccessPoint", "", nil),
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr: olap(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockS3ControlClient{
+					DeleteAccessPointForObjectLambdaWithContextErr: errBoom,
+				},
+				cr: olap(),
+			},
+			want: want{
+				cr:  olap(withConditions(xpv1.Deleting())),
+				err: errorutils.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3control/accesspoint"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3control/multiregionaccesspoint"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/s3control/objectlambdaaccesspoint"
 	"github.com/crossplane-contrib/provider-aws/pkg/utils/setup"
 )
 
@@ -29,5 +31,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 	return setup.SetupControllers(
 		mgr, o,
 		accesspoint.SetupAccessPoint,
+		multiregionaccesspoint.SetupMultiRegionAccessPoint,
+		objectlambdaaccesspoint.SetupObjectLambdaAccessPoint,
 	)
 }
@@ -135,6 +135,12 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 			return nil, err
 		}
 		return SetResolver(pc, cfg), nil
+	case v1beta1.CredentialsSourcePodIdentity:
+		cfg, err := UsePodIdentity(ctx, region, pc)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot use EKS Pod Identity")
+		}
+		return SetResolver(pc, cfg), nil
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
@@ -429,7 +435,7 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { //nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -442,6 +448,23 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+	return UseProviderConfigV1(ctx, c, pc, region)
+}
+
+// GetConfigV1ForProviderConfig constructs an AWS V1 client session from the
+// ProviderConfig with the supplied name. It is meant for callers that are not
+// managed resources, so no ProviderConfigUsage is tracked.
+func GetConfigV1ForProviderConfig(ctx context.Context, c client.Client, name, region string) (*session.Session, error) {
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name}, pc); err != nil {
+		return nil, errors.Wrap(err, "cannot get referenced ProviderConfig")
+	}
+	return UseProviderConfigV1(ctx, c, pc, region)
+}
+
+// UseProviderConfigV1 produces an AWS V1 client session that authenticates
+// with the credentials configured in the supplied ProviderConfig.
+func UseProviderConfigV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*session.Session, error) { //nolint:gocyclo
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil || pc.Spec.AssumeRole != nil {
@@ -463,6 +486,12 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 			return nil, errors.Wrap(err, "cannot use pod service account")
 		}
 		return GetSessionV1(cfg)
+	case v1beta1.CredentialsSourcePodIdentity:
+		cfg, err := UsePodIdentityV1(ctx, pc, region)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot use EKS Pod Identity")
+		}
+		return GetSessionV1(cfg)
 	default:
 		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
 		if err != nil {
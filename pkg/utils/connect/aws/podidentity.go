@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectaws
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/endpointcreds"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+// Environment variables injected into pods by the EKS Pod Identity webhook.
+const (
+	envContainerCredentialsFullURI = "AWS_CONTAINER_CREDENTIALS_FULL_URI"
+	envContainerAuthTokenFile      = "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"
+)
+
+const (
+	errNoPodIdentityEndpoint  = "no EKS Pod Identity credentials endpoint configured"
+	errNoPodIdentityTokenFile = "no EKS Pod Identity token file configured"
+	errReadPodIdentityToken   = "cannot read EKS Pod Identity token file"
+)
+
+// getPodIdentityEndpoint returns the credentials endpoint of the EKS Pod
+// Identity agent and the file its authorization token is read from.
+func getPodIdentityEndpoint(pc *v1beta1.ProviderConfig) (string, string, error) {
+	endpoint := os.Getenv(envContainerCredentialsFullURI)
+	tokenFile := os.Getenv(envContainerAuthTokenFile)
+	if o := pc.Spec.Credentials.PodIdentity; o != nil {
+		if o.Endpoint != nil {
+			endpoint = pointer.StringValue(o.Endpoint)
+		}
+		if o.TokenFile != nil {
+			tokenFile = pointer.StringValue(o.TokenFile)
+		}
+	}
+	if endpoint == "" {
+		return "", "", errors.New(errNoPodIdentityEndpoint)
+	}
+	if tokenFile == "" {
+		return "", "", errors.New(errNoPodIdentityTokenFile)
+	}
+	return endpoint, tokenFile, nil
+}
+
+// NewPodIdentityCredentialsProvider returns a credentials provider that
+// retrieves credentials from the EKS Pod Identity agent configured in the
+// supplied ProviderConfig. The token file is read on every retrieval, since
+// EKS rotates it.
+func NewPodIdentityCredentialsProvider(pc *v1beta1.ProviderConfig) (aws.CredentialsProvider, error) {
+	endpoint, tokenFile, err := getPodIdentityEndpoint(pc)
+	if err != nil {
+		return nil, err
+	}
+	return endpointcreds.New(endpoint, func(o *endpointcreds.Options) {
+		o.AuthorizationTokenProvider = endpointcreds.TokenProviderFunc(func() (string, error) {
+			token, err := os.ReadFile(tokenFile) //nolint:gosec
+			if err != nil {
+				return "", errors.Wrap(err, errReadPodIdentityToken)
+			}
+			return strings.TrimSpace(string(token)), nil
+		})
+	}), nil
+}
+
+// UsePodIdentity produces a config that authenticates with the credentials
+// of the EKS Pod Identity association of the provider pod, and assumes the
+// IAM role of the supplied ProviderConfig if one is configured.
+// https://docs.aws.amazon.com/eks/latest/userguide/pod-identities.html
+func UsePodIdentity(ctx context.Context, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	provider, err := NewPodIdentityCredentialsProvider(pc)
+	if err != nil {
+		return nil, err
+	}
+	opts := []func(*config.LoadOptions) error{
+		middlewareV2,
+		config.WithCredentialsProvider(aws.NewCredentialsCache(provider)),
+	}
+	if region != GlobalRegion {
+		opts = append(opts, config.WithRegion(region))
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	if pc.Spec.AssumeRole == nil && pc.Spec.AssumeRoleARN == nil {
+		return &cfg, nil
+	}
+
+	roleArn, err := GetAssumeRoleARN(pc.Spec.DeepCopy())
+	if err != nil {
+		return nil, err
+	}
+	cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(
+		sts.NewFromConfig(cfg),
+		pointer.StringValue(roleArn),
+		SetAssumeRoleOptions(pc),
+	))
+	return &cfg, nil
+}
+
+// UsePodIdentityV1 produces an AWS v1 config that authenticates with the
+// credentials of the EKS Pod Identity association of the provider pod, and
+// assumes the IAM role of the supplied ProviderConfig if one is configured.
+func UsePodIdentityV1(ctx context.Context, pc *v1beta1.ProviderConfig, region string) (*awsv1.Config, error) {
+	cfg, err := UsePodIdentity(ctx, region, pc)
+	if err != nil {
+		return nil, err
+	}
+	v2creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve credentials")
+	}
+	v1creds := credentialsv1.NewStaticCredentials(
+		v2creds.AccessKeyID,
+		v2creds.SecretAccessKey,
+		v2creds.SessionToken)
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(cfg.Region)), nil
+}
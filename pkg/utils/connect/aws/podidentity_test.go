@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectaws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	testPodIdentityToken = "pod-identity-token"
+	testPodAccessKeyID   = "ASIAPODIDENTITY"
+	testRoleAccessKeyID  = "ASIAASSUMEDROLE"
+)
+
+const assumeRoleResponse = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%s</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>session</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/crossplane/session</Arn>
+      <AssumedRoleId>AROA:session</AssumedRoleId>
+    </AssumedRoleUser>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`
+
+// podIdentityAgent is a local stand-in for the credentials endpoint of the EKS
+// Pod Identity agent and for STS.
+func podIdentityAgent(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/credentials":
+			if got := r.Header.Get("Authorization"); got != testPodIdentityToken {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprintf(w, `{"AccessKeyId":%q,"SecretAccessKey":"secret","Token":"session","Expiration":"2099-01-01T00:00:00Z"}`, testPodAccessKeyID)
+		case r.Method == http.MethodPost:
+			// Role assumption must be signed with the pod identity credentials.
+			if !strings.Contains(r.Header.Get("Authorization"), "Credential="+testPodAccessKeyID+"/") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			w.Header().Set("Content-Type", "text/xml")
+			fmt.Fprintf(w, assumeRoleResponse, testRoleAccessKeyID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestUsePodIdentity(t *testing.T) {
+	srv := podIdentityAgent(t)
+	defer srv.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "eks-pod-identity-token")
+	if err := os.WriteFile(tokenFile, []byte(testPodIdentityToken+"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_ENDPOINT_URL_STS", srv.URL)
+	t.Setenv(envContainerCredentialsFullURI, srv.URL+"/v1/credentials")
+	t.Setenv(envContainerAuthTokenFile, tokenFile)
+
+	type want struct {
+		accessKeyID string
+		region      string
+		err         bool
+	}
+
+	cases := map[string]struct {
+		pc   *v1beta1.ProviderConfig
+		want want
+	}{
+		"FromEnvironment": {
+			pc: &v1beta1.ProviderConfig{},
+			want: want{
+				accessKeyID: testPodAccessKeyID,
+				region:      "us-east-1",
+			},
+		},
+		"AssumeRole": {
+			pc: &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					AssumeRole: &v1beta1.AssumeRoleOptions{
+						RoleARN: pointer.ToOrNilIfZeroValue("arn:aws:iam::123456789012:role/crossplane"),
+					},
+				},
+			},
+			want: want{
+				accessKeyID: testRoleAccessKeyID,
+				region:      "us-east-1",
+			},
+		},
+		"WrongTokenFile": {
+			pc: &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						PodIdentity: &v1beta1.PodIdentityOptions{
+							TokenFile: pointer.ToOrNilIfZeroValue(filepath.Join(t.TempDir(), "missing")),
+						},
+					},
+				},
+			},
+			want: want{err: true},
+		},
+		"NoEndpoint": {
+			pc: &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						PodIdentity: &v1beta1.PodIdentityOptions{Endpoint: new(string)},
+					},
+				},
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// The v1 config is derived from the v2 one, so retrieving its
+			// credentials exercises both.
+			cfg, err := UsePodIdentityV1(context.Background(), tc.pc, "us-east-1")
+			if (err != nil) != tc.want.err {
+				t.Fatalf("UsePodIdentityV1(...): unexpected error: %v", err)
+			}
+			if err != nil {
+				return
+			}
+			creds, err := cfg.Credentials.Get()
+			if err != nil {
+				t.Fatalf("Credentials.Get(): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want.accessKeyID, creds.AccessKeyID); diff != "" {
+				t.Errorf("AccessKeyID: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.region, pointer.StringValue(cfg.Region)); diff != "" {
+				t.Errorf("Region: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
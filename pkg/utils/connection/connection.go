@@ -0,0 +1,185 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package connection implements external secret stores backed by AWS
+// services.
+package connection
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	xpconnection "github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	connectaws "github.com/crossplane-contrib/provider-aws/pkg/utils/connect/aws"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/pointer"
+)
+
+const (
+	errGetStoreConfig    = "cannot get store config"
+	errNoAWSStoreConfig  = "store config does not configure an AWS secret store"
+	errGetAWSConfig      = "cannot get AWS config for secret store"
+	errFmtUnknownAWSType = "unknown AWS secret store type: %q"
+	errMarshalSecret     = "cannot marshal secret data"
+	errUnmarshalSecret   = "cannot unmarshal secret data"
+
+	defaultProviderConfigName = "default"
+)
+
+// NewDetailsManager returns a connection DetailsManager that is able to
+// publish connection details to the AWS secret stores in addition to the
+// stores supported by crossplane-runtime.
+func NewDetailsManager(c client.Client, of schema.GroupVersionKind, o ...xpconnection.DetailsManagerOption) *xpconnection.DetailsManager {
+	return xpconnection.NewDetailsManager(c, of, append([]xpconnection.DetailsManagerOption{xpconnection.WithStoreBuilder(StoreBuilder)}, o...)...)
+}
+
+// StoreBuilder builds a Store for the supplied store config. AWS stores are
+// built from the StoreConfig referenced by the plugin config, any other store
+// is built by crossplane-runtime.
+func StoreBuilder(ctx context.Context, local client.Client, tcfg *tls.Config, cfg xpv1.SecretStoreConfig) (xpconnection.Store, error) {
+	if !isAWSStore(cfg) {
+		return xpconnection.RuntimeStoreBuilder(ctx, local, tcfg, cfg)
+	}
+
+	sc := &v1alpha1.StoreConfig{}
+	if err := local.Get(ctx, types.NamespacedName{Name: cfg.Plugin.ConfigRef.Name}, sc); err != nil {
+		return nil, errors.Wrap(err, errGetStoreConfig)
+	}
+	if sc.Spec.AWS == nil {
+		return nil, errors.New(errNoAWSStoreConfig)
+	}
+
+	pcName := defaultProviderConfigName
+	if sc.Spec.AWS.ProviderConfigReference != nil {
+		pcName = sc.Spec.AWS.ProviderConfigReference.Name
+	}
+	sess, err := connectaws.GetConfigV1ForProviderConfig(ctx, local, pcName, sc.Spec.AWS.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetAWSConfig)
+	}
+
+	switch sc.Spec.AWS.Type {
+	case v1alpha1.AWSSecretStoreSecretsManager, "":
+		return NewSecretsManagerStore(secretsmanager.New(sess), sc.Spec), nil
+	case v1alpha1.AWSSecretStoreParameterStore:
+		return NewParameterStore(ssm.New(sess), sc.Spec), nil
+	}
+	return nil, errors.Errorf(errFmtUnknownAWSType, sc.Spec.AWS.Type)
+}
+
+// isAWSStore reports whether the supplied config was produced by a StoreConfig
+// that configures an AWS secret store.
+func isAWSStore(cfg xpv1.SecretStoreConfig) bool {
+	if cfg.Type == nil || *cfg.Type != xpv1.SecretStorePlugin || cfg.Plugin == nil {
+		return false
+	}
+	ref := cfg.Plugin.ConfigRef
+	return ref.APIVersion == v1alpha1.SchemeGroupVersion.String() && ref.Kind == v1alpha1.StoreConfigKind
+}
+
+// storeOptions are the settings shared by all AWS secret stores.
+type storeOptions struct {
+	defaultScope string
+	prefix       string
+	kmsKeyID     *string
+	tags         map[string]string
+}
+
+func newStoreOptions(spec v1alpha1.StoreConfigSpec) storeOptions {
+	o := storeOptions{defaultScope: spec.DefaultScope}
+	if spec.AWS != nil {
+		o.prefix = pointer.StringValue(spec.AWS.Prefix)
+		o.kmsKeyID = spec.AWS.KMSKeyID
+		o.tags = spec.AWS.Tags
+	}
+	return o
+}
+
+// secretName returns the name of the AWS secret for the supplied scoped name.
+func (o storeOptions) secretName(n store.ScopedName) string {
+	scope := n.Scope
+	if scope == "" {
+		scope = o.defaultScope
+	}
+	return strings.Join([]string{o.prefix + scope, n.Name}, "/")
+}
+
+// tagsFor returns the tags of the AWS secret storing s. Labels of the secret,
+// including the owner UID, take precedence over the store config tags.
+func (o storeOptions) tagsFor(s *store.Secret) map[string]string {
+	tags := make(map[string]string, len(o.tags))
+	for k, v := range o.tags {
+		tags[k] = v
+	}
+	for k, v := range s.GetLabels() {
+		tags[k] = v
+	}
+	return tags
+}
+
+// missingTags returns the tags in desired that are absent from or differ in
+// current.
+func missingTags(current, desired map[string]string) map[string]string {
+	missing := map[string]string{}
+	for k, v := range desired {
+		if cv, ok := current[k]; !ok || cv != v {
+			missing[k] = v
+		}
+	}
+	return missing
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func marshalKeyValues(kv store.KeyValues) (string, error) {
+	m := make(map[string]string, len(kv))
+	for k, v := range kv {
+		m[k] = string(v)
+	}
+	b, err := json.Marshal(m)
+	return string(b), errors.Wrap(err, errMarshalSecret)
+}
+
+func unmarshalKeyValues(s string) (store.KeyValues, error) {
+	m := map[string]string{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalSecret)
+	}
+	kv := make(store.KeyValues, len(m))
+	for k, v := range m {
+		kv[k] = []byte(v)
+	}
+	return kv, nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+const (
+	errGetParameter    = "cannot get SSM parameter"
+	errListTags        = "cannot list tags of SSM parameter"
+	errPutParameter    = "cannot put SSM parameter"
+	errTagParameter    = "cannot tag SSM parameter"
+	errDeleteParameter = "cannot delete SSM parameter"
+)
+
+// ParameterStore is a secret store backed by AWS Systems Manager Parameter
+// Store. Each connection secret is stored as a JSON document in its own
+// SecureString parameter.
+type ParameterStore struct {
+	client ssmiface.SSMAPI
+	storeOptions
+}
+
+// NewParameterStore returns a new ParameterStore.
+func NewParameterStore(client ssmiface.SSMAPI, spec v1alpha1.StoreConfigSpec) *ParameterStore {
+	return &ParameterStore{client: client, storeOptions: newStoreOptions(spec)}
+}
+
+// ReadKeyValues reads the key value pairs and metadata of the parameter with
+// the supplied name. Nothing is read if the parameter does not exist.
+func (ps *ParameterStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	_, err := ps.read(ctx, n, s)
+	return err
+}
+
+// WriteKeyValues creates or updates the parameter storing s and reports
+// whether its data changed.
+func (ps *ParameterStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	name := ps.parameterName(s.ScopedName)
+	data, err := marshalKeyValues(s.Data)
+	if err != nil {
+		return false, err
+	}
+
+	current := &store.Secret{ScopedName: s.ScopedName}
+	exists, err := ps.read(ctx, s.ScopedName, current)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		_, err := ps.client.PutParameterWithContext(ctx, &ssm.PutParameterInput{
+			Name:  aws.String(name),
+			Type:  aws.String(ssm.ParameterTypeSecureString),
+			Tier:  aws.String(ssm.ParameterTierIntelligentTiering),
+			KeyId: ps.kmsKeyID,
+			Value: aws.String(data),
+			Tags:  ssmTags(ps.tagsFor(s)),
+		})
+		return err == nil, errors.Wrap(err, errPutParameter)
+	}
+
+	for _, o := range wo {
+		if err := o(ctx, current, s); err != nil {
+			return false, err
+		}
+	}
+
+	if missing := missingTags(current.GetLabels(), ps.tagsFor(s)); len(missing) > 0 {
+		if _, err := ps.client.AddTagsToResourceWithContext(ctx, &ssm.AddTagsToResourceInput{
+			ResourceId:   aws.String(name),
+			ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+			Tags:         ssmTags(missing),
+		}); err != nil {
+			return false, errors.Wrap(err, errTagParameter)
+		}
+	}
+
+	if cmp.Equal(current.Data, s.Data, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	return true, ps.put(ctx, name, data)
+}
+
+// DeleteKeyValues deletes the supplied keys from the parameter storing s. The
+// parameter is deleted if no keys are supplied or no keys are left.
+func (ps *ParameterStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	current := &store.Secret{ScopedName: s.ScopedName}
+	exists, err := ps.read(ctx, s.ScopedName, current)
+	if err != nil || !exists {
+		return err
+	}
+
+	for _, o := range do {
+		if err := o(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	for k := range s.Data {
+		delete(current.Data, k)
+	}
+	name := ps.parameterName(s.ScopedName)
+	if len(s.Data) == 0 || len(current.Data) == 0 {
+		_, err := ps.client.DeleteParameterWithContext(ctx, &ssm.DeleteParameterInput{Name: aws.String(name)})
+		return errors.Wrap(resource.Ignore(isParameterNotFound, err), errDeleteParameter)
+	}
+	data, err := marshalKeyValues(current.Data)
+	if err != nil {
+		return err
+	}
+	return ps.put(ctx, name, data)
+}
+
+func (ps *ParameterStore) put(ctx context.Context, name, data string) error {
+	_, err := ps.client.PutParameterWithContext(ctx, &ssm.PutParameterInput{
+		Name:      aws.String(name),
+		Type:      aws.String(ssm.ParameterTypeSecureString),
+		Tier:      aws.String(ssm.ParameterTierIntelligentTiering),
+		KeyId:     ps.kmsKeyID,
+		Value:     aws.String(data),
+		Overwrite: aws.Bool(true),
+	})
+	return errors.Wrap(err, errPutParameter)
+}
+
+// read reads the parameter with the supplied name into s and reports whether
+// it exists.
+func (ps *ParameterStore) read(ctx context.Context, n store.ScopedName, s *store.Secret) (bool, error) {
+	name := ps.parameterName(n)
+	p, err := ps.client.GetParameterWithContext(ctx, &ssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if isParameterNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errGetParameter)
+	}
+
+	tags, err := ps.client.ListTagsForResourceWithContext(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   aws.String(name),
+		ResourceType: aws.String(ssm.ResourceTypeForTaggingParameter),
+	})
+	if err != nil {
+		return false, errors.Wrap(err, errListTags)
+	}
+	labels := make(map[string]string, len(tags.TagList))
+	for _, t := range tags.TagList {
+		labels[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	s.Metadata = &xpv1.ConnectionSecretMetadata{Labels: labels}
+
+	if p.Parameter == nil {
+		return true, nil
+	}
+	s.Data, err = unmarshalKeyValues(aws.StringValue(p.Parameter.Value))
+	return true, err
+}
+
+// parameterName returns the fully qualified name of the parameter storing the
+// secret with the supplied name. Hierarchical parameter names must start with
+// a slash.
+func (ps *ParameterStore) parameterName(n store.ScopedName) string {
+	name := ps.secretName(n)
+	if !strings.HasPrefix(name, "/") {
+		name = "/" + name
+	}
+	return name
+}
+
+func ssmTags(tags map[string]string) []*ssm.Tag {
+	res := make([]*ssm.Tag, 0, len(tags))
+	for _, k := range sortedKeys(tags) {
+		res = append(res, &ssm.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return res
+}
+
+func isParameterNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == ssm.ErrCodeParameterNotFound
+}
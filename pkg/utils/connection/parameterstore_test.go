@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ssm/fake"
+)
+
+func parameterTags(*ssm.ListTagsForResourceInput) (*ssm.ListTagsForResourceOutput, error) {
+	return &ssm.ListTagsForResourceOutput{TagList: []*ssm.Tag{
+		{Key: aws.String(testOwnerTagKey), Value: aws.String(testOwnerUID)},
+		{Key: aws.String("team"), Value: aws.String("a")},
+	}}, nil
+}
+
+func TestParameterStoreWriteKeyValues(t *testing.T) {
+	type want struct {
+		changed bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockSSMClient
+		secret *store.Secret
+		want   want
+	}{
+		"CreateIfNotExists": {
+			client: &fake.MockSSMClient{
+				MockGetParameterWithContext: func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					return nil, awserr.New(ssm.ErrCodeParameterNotFound, "", nil)
+				},
+				MockPutParameterWithContext: func(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					want := &ssm.PutParameterInput{
+						Name:  aws.String("/" + testFullName),
+						Type:  aws.String(ssm.ParameterTypeSecureString),
+						Tier:  aws.String(ssm.ParameterTierIntelligentTiering),
+						Value: aws.String(`{"password":"secret"}`),
+						Tags: []*ssm.Tag{
+							{Key: aws.String(testOwnerTagKey), Value: aws.String(testOwnerUID)},
+							{Key: aws.String("team"), Value: aws.String("a")},
+						},
+					}
+					if diff := cmp.Diff(want, in); diff != "" {
+						t.Errorf("PutParameter: -want, +got:\n%s", diff)
+					}
+					return &ssm.PutParameterOutput{}, nil
+				},
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("secret")}),
+			want:   want{changed: true},
+		},
+		"NoChange": {
+			client: &fake.MockSSMClient{
+				MockGetParameterWithContext: func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(`{"password":"secret"}`)}}, nil
+				},
+				MockListTagsForResourceWithContext: parameterTags,
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("secret")}),
+			want:   want{changed: false},
+		},
+		"OverwriteChangedData": {
+			client: &fake.MockSSMClient{
+				MockGetParameterWithContext: func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					return &ssm.GetParameterOutput{Parameter: &ssm.Parameter{Value: aws.String(`{"password":"old"}`)}}, nil
+				},
+				MockListTagsForResourceWithContext: parameterTags,
+				MockPutParameterWithContext: func(in *ssm.PutParameterInput) (*ssm.PutParameterOutput, error) {
+					if !aws.BoolValue(in.Overwrite) {
+						t.Errorf("PutParameter: expected overwrite")
+					}
+					return &ssm.PutParameterOutput{}, nil
+				},
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("new")}),
+			want:   want{changed: true},
+		},
+		"GetFailed": {
+			client: &fake.MockSSMClient{
+				MockGetParameterWithContext: func(*ssm.GetParameterInput) (*ssm.GetParameterOutput, error) {
+					return nil, errBoom
+				},
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("new")}),
+			want: want{
+				err: errors.Wrap(errBoom, errGetParameter),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ps := NewParameterStore(tc.client, testSpec())
+			changed, err := ps.WriteKeyValues(context.Background(), tc.secret)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
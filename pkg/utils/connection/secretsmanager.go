@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+const (
+	errDescribeSecret = "cannot describe Secrets Manager secret"
+	errGetSecretValue = "cannot get Secrets Manager secret value"
+	errCreateSecret   = "cannot create Secrets Manager secret"
+	errPutSecretValue = "cannot put Secrets Manager secret value"
+	errTagSecret      = "cannot tag Secrets Manager secret"
+	errDeleteSecret   = "cannot delete Secrets Manager secret"
+)
+
+// SecretsManagerStore is a secret store backed by AWS Secrets Manager. Each
+// connection secret is stored as a JSON document in its own secret.
+type SecretsManagerStore struct {
+	client secretsmanageriface.SecretsManagerAPI
+	storeOptions
+}
+
+// NewSecretsManagerStore returns a new SecretsManagerStore.
+func NewSecretsManagerStore(client secretsmanageriface.SecretsManagerAPI, spec v1alpha1.StoreConfigSpec) *SecretsManagerStore {
+	return &SecretsManagerStore{client: client, storeOptions: newStoreOptions(spec)}
+}
+
+// ReadKeyValues reads the key value pairs and metadata of the secret with the
+// supplied name. Nothing is read if the secret does not exist.
+func (ss *SecretsManagerStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	_, err := ss.read(ctx, n, s)
+	return err
+}
+
+// WriteKeyValues creates or updates the secret storing s and reports whether
+// its data changed.
+func (ss *SecretsManagerStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) {
+	name := ss.secretName(s.ScopedName)
+	data, err := marshalKeyValues(s.Data)
+	if err != nil {
+		return false, err
+	}
+
+	current := &store.Secret{ScopedName: s.ScopedName}
+	exists, err := ss.read(ctx, s.ScopedName, current)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		_, err := ss.client.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
+			Name:         aws.String(name),
+			KmsKeyId:     ss.kmsKeyID,
+			SecretString: aws.String(data),
+			Tags:         secretsManagerTags(ss.tagsFor(s)),
+		})
+		return err == nil, errors.Wrap(err, errCreateSecret)
+	}
+
+	for _, o := range wo {
+		if err := o(ctx, current, s); err != nil {
+			return false, err
+		}
+	}
+
+	if missing := missingTags(current.GetLabels(), ss.tagsFor(s)); len(missing) > 0 {
+		if _, err := ss.client.TagResourceWithContext(ctx, &secretsmanager.TagResourceInput{
+			SecretId: aws.String(name),
+			Tags:     secretsManagerTags(missing),
+		}); err != nil {
+			return false, errors.Wrap(err, errTagSecret)
+		}
+	}
+
+	if cmp.Equal(current.Data, s.Data, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	_, err = ss.client.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(name),
+		SecretString: aws.String(data),
+	})
+	return err == nil, errors.Wrap(err, errPutSecretValue)
+}
+
+// DeleteKeyValues deletes the supplied keys from the secret storing s. The
+// secret is deleted without a recovery window if no keys are supplied or no
+// keys are left.
+func (ss *SecretsManagerStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	current := &store.Secret{ScopedName: s.ScopedName}
+	exists, err := ss.read(ctx, s.ScopedName, current)
+	if err != nil || !exists {
+		return err
+	}
+
+	for _, o := range do {
+		if err := o(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	for k := range s.Data {
+		delete(current.Data, k)
+	}
+	name := ss.secretName(s.ScopedName)
+	if len(s.Data) == 0 || len(current.Data) == 0 {
+		_, err := ss.client.DeleteSecretWithContext(ctx, &secretsmanager.DeleteSecretInput{
+			SecretId:                   aws.String(name),
+			ForceDeleteWithoutRecovery: aws.Bool(true),
+		})
+		return errors.Wrap(resource.Ignore(isSecretsManagerNotFound, err), errDeleteSecret)
+	}
+	data, err := marshalKeyValues(current.Data)
+	if err != nil {
+		return err
+	}
+	_, err = ss.client.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(name),
+		SecretString: aws.String(data),
+	})
+	return errors.Wrap(err, errPutSecretValue)
+}
+
+// read reads the secret with the supplied name into s and reports whether it
+// exists.
+func (ss *SecretsManagerStore) read(ctx context.Context, n store.ScopedName, s *store.Secret) (bool, error) {
+	name := ss.secretName(n)
+	desc, err := ss.client.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{SecretId: aws.String(name)})
+	if isSecretsManagerNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrap(err, errDescribeSecret)
+	}
+	labels := make(map[string]string, len(desc.Tags))
+	for _, t := range desc.Tags {
+		labels[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	s.Metadata = &xpv1.ConnectionSecretMetadata{Labels: labels}
+
+	val, err := ss.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(name)})
+	if err != nil {
+		return false, errors.Wrap(err, errGetSecretValue)
+	}
+	s.Data, err = unmarshalKeyValues(aws.StringValue(val.SecretString))
+	return true, err
+}
+
+func secretsManagerTags(tags map[string]string) []*secretsmanager.Tag {
+	res := make([]*secretsmanager.Tag, 0, len(tags))
+	for _, k := range sortedKeys(tags) {
+		res = append(res, &secretsmanager.Tag{Key: aws.String(k), Value: aws.String(tags[k])})
+	}
+	return res
+}
+
+func isSecretsManagerNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	xpconnection "github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/secretsmanager/fake"
+)
+
+const (
+	testOwnerUID    = "owner-uid"
+	testSecretName  = "conn"
+	testScope       = "team-a"
+	testFullName    = "crossplane/team-a/conn"
+	testOwnerTagKey = "secret.crossplane.io/owner-uid"
+)
+
+var errBoom = errors.New("boom")
+
+func testSpec() v1alpha1.StoreConfigSpec {
+	return v1alpha1.StoreConfigSpec{
+		SecretStoreConfig: xpv1.SecretStoreConfig{DefaultScope: "crossplane-system"},
+		AWS: &v1alpha1.AWSSecretStoreConfig{
+			Region: "us-east-1",
+			Prefix: aws.String("crossplane/"),
+			Tags:   map[string]string{"team": "a"},
+		},
+	}
+}
+
+func testSecret(data store.KeyValues) *store.Secret {
+	s := &store.Secret{
+		ScopedName: store.ScopedName{Name: testSecretName, Scope: testScope},
+		Metadata:   &xpv1.ConnectionSecretMetadata{},
+		Data:       data,
+	}
+	s.Metadata.SetOwnerUID(types.UID(testOwnerUID))
+	return s
+}
+
+func describeSecret(owner string) func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+	return func(in *secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+		return &secretsmanager.DescribeSecretOutput{
+			Name: in.SecretId,
+			Tags: []*secretsmanager.Tag{
+				{Key: aws.String(testOwnerTagKey), Value: aws.String(owner)},
+				{Key: aws.String("team"), Value: aws.String("a")},
+			},
+		}, nil
+	}
+}
+
+func secretValue(v string) func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	return func(*secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+		return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(v)}, nil
+	}
+}
+
+func secretNotFound(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+	return nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "", nil)
+}
+
+func TestSecretsManagerWriteKeyValues(t *testing.T) {
+	type want struct {
+		changed bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockSecretsManagerClient
+		secret *store.Secret
+		want   want
+	}{
+		"CreateIfNotExists": {
+			client: &fake.MockSecretsManagerClient{
+				MockDescribeSecretWithContext: secretNotFound,
+				MockCreateSecretWithContext: func(in *secretsmanager.CreateSecretInput) (*secretsmanager.CreateSecretOutput, error) {
+					want := &secretsmanager.CreateSecretInput{
+						Name:         aws.String(testFullName),
+						SecretString: aws.String(`{"password":"secret"}`),
+						Tags: []*secretsmanager.Tag{
+							{Key: aws.String(testOwnerTagKey), Value: aws.String(testOwnerUID)},
+							{Key: aws.String("team"), Value: aws.String("a")},
+						},
+					}
+					if diff := cmp.Diff(want, in); diff != "" {
+						t.Errorf("CreateSecret: -want, +got:\n%s", diff)
+					}
+					return &secretsmanager.CreateSecretOutput{}, nil
+				},
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("secret")}),
+			want:   want{changed: true},
+		},
+		"NoChange": {
+			client: &fake.MockSecretsManagerClient{
+				MockDescribeSecretWithContext: describeSecret(testOwnerUID),
+				MockGetSecretValueWithContext: secretValue(`{"password":"secret"}`),
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("secret")}),
+			want:   want{changed: false},
+		},
+		"UpdateChangedData": {
+			client: &fake.MockSecretsManagerClient{
+				MockDescribeSecretWithContext: describeSecret(testOwnerUID),
+				MockGetSecretValueWithContext: secretValue(`{"password":"old"}`),
+				MockPutSecretValueWithContext: func(in *secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error) {
+					if diff := cmp.Diff(`{"password":"new"}`, aws.StringValue(in.SecretString)); diff != "" {
+						t.Errorf("PutSecretValue: -want, +got:\n%s", diff)
+					}
+					return &secretsmanager.PutSecretValueOutput{}, nil
+				},
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("new")}),
+			want:   want{changed: true},
+		},
+		"NotOwned": {
+			client: &fake.MockSecretsManagerClient{
+				MockDescribeSecretWithContext: describeSecret("someone-else"),
+				MockGetSecretValueWithContext: secretValue(`{"password":"old"}`),
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("new")}),
+			want: want{
+				err: fmt.Errorf("existing secret is not owned by UID %q", testOwnerUID),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockSecretsManagerClient{
+				MockDescribeSecretWithContext: func(*secretsmanager.DescribeSecretInput) (*secretsmanager.DescribeSecretOutput, error) {
+					return nil, errBoom
+				},
+			},
+			secret: testSecret(store.KeyValues{"password": []byte("new")}),
+			want: want{
+				err: errors.Wrap(errBoom, errDescribeSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss := NewSecretsManagerStore(tc.client, testSpec())
+			owner := &metav1.ObjectMeta{UID: testOwnerUID}
+			changed, err := ss.WriteKeyValues(context.Background(), tc.secret, xpconnection.SecretToWriteMustBeOwnedBy(owner))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecretsManagerDeleteKeyValues(t *testing.T) {
+	type want struct {
+		deleted bool
+		err     error
+	}
+
+	cases := map[string]struct {
+		client func(deleted *bool) *fake.MockSecretsManagerClient
+		secret *store.Secret
+		want   want
+	}{
+		"AlreadyGone": {
+			client: func(_ *bool) *fake.MockSecretsManagerClient {
+				return &fake.MockSecretsManagerClient{MockDescribeSecretWithContext: secretNotFound}
+			},
+			secret: testSecret(nil),
+		},
+		"DeleteWholeSecret": {
+			client: func(deleted *bool) *fake.MockSecretsManagerClient {
+				return &fake.MockSecretsManagerClient{
+					MockDescribeSecretWithContext: describeSecret(testOwnerUID),
+					MockGetSecretValueWithContext: secretValue(`{"password":"secret"}`),
+					MockDeleteSecretWithContext: func(in *secretsmanager.DeleteSecretInput) (*secretsmanager.DeleteSecretOutput, error) {
+						*deleted = aws.BoolValue(in.ForceDeleteWithoutRecovery)
+						return &secretsmanager.DeleteSecretOutput{}, nil
+					},
+				}
+			},
+			secret: testSecret(nil),
+			want:   want{deleted: true},
+		},
+		"DeleteSomeKeys": {
+			client: func(_ *bool) *fake.MockSecretsManagerClient {
+				return &fake.MockSecretsManagerClient{
+					MockDescribeSecretWithContext: describeSecret(testOwnerUID),
+					MockGetSecretValueWithContext: secretValue(`{"password":"secret","username":"admin"}`),
+					MockPutSecretValueWithContext: func(in *secretsmanager.PutSecretValueInput) (*secretsmanager.PutSecretValueOutput, error) {
+						if diff := cmp.Diff(`{"username":"admin"}`, aws.StringValue(in.SecretString)); diff != "" {
+							t.Errorf("PutSecretValue: -want, +got:\n%s", diff)
+						}
+						return &secretsmanager.PutSecretValueOutput{}, nil
+					},
+				}
+			},
+			secret: testSecret(store.KeyValues{"password": nil}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			ss := NewSecretsManagerStore(tc.client(&deleted), testSpec())
+			err := ss.DeleteKeyValues(context.Background(), tc.secret)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
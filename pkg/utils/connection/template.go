@@ -0,0 +1,338 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/fieldpath"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8serrors "k8s.io/apimachinery/pkg/util/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	fieldConnectionDetailsTemplate = "spec.connectionDetailsTemplate"
+	fieldAtProvider                = "status.atProvider"
+
+	errPaveObject        = "cannot pave connection secret owner"
+	errGetTemplate       = "cannot get connection details template"
+	errGetAtProvider     = "cannot get observed state"
+	errFetchConnection   = "cannot fetch published connection details"
+	errFmtParseTemplate  = "cannot parse connection details template for key %q"
+	errFmtRenderTemplate = "cannot render connection details template for key %q"
+	errGetConnSecret     = "cannot get connection secret"
+	errRenderConnDetails = "cannot render connection details template"
+)
+
+// TypeConnectionDetailsTemplate resources report whether their connection
+// details template could be rendered.
+const TypeConnectionDetailsTemplate xpv1.ConditionType = "ConnectionDetailsTemplate"
+
+// Reasons a connection details template is or is not rendered.
+const (
+	ReasonTemplateRendered     xpv1.ConditionReason = "Rendered"
+	ReasonTemplateRenderFailed xpv1.ConditionReason = "RenderFailed"
+)
+
+// TemplateRendered returns a condition that indicates the connection details
+// template of a resource was rendered.
+func TemplateRendered() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnectionDetailsTemplate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTemplateRendered,
+	}
+}
+
+// TemplateRenderFailed returns a condition that indicates the connection
+// details template of a resource could not be rendered.
+func TemplateRenderFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeConnectionDetailsTemplate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonTemplateRenderFailed,
+		Message:            err.Error(),
+	}
+}
+
+// templateFuncs are the functions available to connection details templates
+// in addition to the Go template builtins.
+var templateFuncs = template.FuncMap{
+	"b64enc": func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+	"b64dec": func(s string) (string, error) {
+		b, err := base64.StdEncoding.DecodeString(s)
+		return string(b), err
+	},
+	"join": func(sep string, v []any) string {
+		s := make([]string, len(v))
+		for i := range v {
+			s[i] = fmt.Sprint(v[i])
+		}
+		return strings.Join(s, sep)
+	},
+	"lower": strings.ToLower,
+	"upper": strings.ToUpper,
+}
+
+// WithConnectionPublishers specifies how the reconciler should publish its
+// connection details. Each publisher additionally publishes the keys rendered
+// from the connection details template of the resource.
+func WithConnectionPublishers(kube client.Client, p ...managed.ConnectionPublisher) managed.ReconcilerOption {
+	tp := make([]managed.ConnectionPublisher, len(p))
+	for i := range p {
+		tp[i] = NewTemplatePublisher(kube, p[i])
+	}
+	return managed.WithConnectionPublishers(tp...)
+}
+
+// A TemplatePublisher renders the connection details template of a resource
+// and publishes the rendered keys along with its connection details.
+//
+// Most resources produce only some of their connection details per
+// reconcile, e.g. the password on creation and the endpoint once it is
+// observed. Templates are therefore rendered over the details that were
+// published before, overlaid with the produced ones. A key is only rendered
+// once every detail its template refers to is available.
+//
+// Produced connection details are often returned by the external API only
+// once, e.g. generated passwords or private keys, so they are always
+// published. Keys whose template cannot be rendered are skipped and the
+// failure is reported as a ConnectionDetailsTemplate condition of the
+// resource.
+type TemplatePublisher struct {
+	kube      client.Client
+	publisher managed.ConnectionPublisher
+}
+
+// NewTemplatePublisher returns a TemplatePublisher that publishes with the
+// supplied publisher.
+func NewTemplatePublisher(kube client.Client, p managed.ConnectionPublisher) *TemplatePublisher {
+	return &TemplatePublisher{kube: kube, publisher: p}
+}
+
+// PublishConnection publishes the supplied connection details and the keys
+// rendered from the connection details template of the supplied owner.
+func (t *TemplatePublisher) PublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) (bool, error) {
+	rendered, ok, err := t.render(ctx, so, c)
+	if cd, isConditioned := so.(resource.Conditioned); isConditioned && ok {
+		if err != nil {
+			cd.SetConditions(TemplateRenderFailed(errors.Wrap(err, errRenderConnDetails)))
+		} else {
+			cd.SetConditions(TemplateRendered())
+		}
+	}
+	return t.publisher.PublishConnection(ctx, so, rendered)
+}
+
+// UnpublishConnection unpublishes the supplied connection details.
+func (t *TemplatePublisher) UnpublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) error {
+	return t.publisher.UnpublishConnection(ctx, so, c)
+}
+
+// render returns the supplied connection details extended with the keys
+// rendered from the connection details template of the supplied owner, and
+// whether the owner has a template. The supplied connection details are
+// returned even if the template cannot be rendered.
+func (t *TemplatePublisher) render(ctx context.Context, so resource.ConnectionSecretOwner, c managed.ConnectionDetails) (managed.ConnectionDetails, bool, error) {
+	p, err := fieldpath.PaveObject(so)
+	if err != nil {
+		return c, false, errors.Wrap(err, errPaveObject)
+	}
+	tmpl, err := p.GetStringObject(fieldConnectionDetailsTemplate)
+	if fieldpath.IsNotFound(err) || len(tmpl) == 0 {
+		return c, false, nil
+	}
+	if err != nil {
+		return c, true, errors.Wrap(err, errGetTemplate)
+	}
+	atProvider, err := p.GetValue(fieldAtProvider)
+	if resource.Ignore(fieldpath.IsNotFound, err) != nil {
+		return c, true, errors.Wrap(err, errGetAtProvider)
+	}
+
+	published, err := t.fetch(ctx, so)
+	if err != nil {
+		return c, true, errors.Wrap(err, errFetchConnection)
+	}
+	details := make(map[string]string, len(published)+len(c))
+	for k, v := range published {
+		details[k] = string(v)
+	}
+	for k, v := range c {
+		details[k] = string(v)
+	}
+
+	out, err := RenderTemplate(tmpl, details, atProvider, c)
+	return out, true, err
+}
+
+// fetch returns the connection details that were already published.
+func (t *TemplatePublisher) fetch(ctx context.Context, so resource.ConnectionSecretOwner) (managed.ConnectionDetails, error) {
+	if f, ok := t.publisher.(managed.ConnectionDetailsFetcher); ok {
+		return f.FetchConnection(ctx, so)
+	}
+	ref := so.GetWriteConnectionSecretToReference()
+	if ref == nil {
+		return nil, nil
+	}
+	s := &corev1.Secret{}
+	err := t.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s)
+	return s.Data, errors.Wrap(resource.IgnoreNotFound(err), errGetConnSecret)
+}
+
+// RenderTemplate renders the supplied templates over the supplied connection
+// details and observed state, and returns the produced connection details
+// extended with the rendered keys. Templates that refer to unavailable details
+// are skipped. Templates that cannot be parsed or executed are skipped too,
+// and their errors are returned along with the remaining connection details.
+func RenderTemplate(tmpl map[string]string, details map[string]string, atProvider any, produced managed.ConnectionDetails) (managed.ConnectionDetails, error) {
+	data := map[string]any{
+		"Details":    details,
+		"AtProvider": atProvider,
+	}
+	out := make(managed.ConnectionDetails, len(produced)+len(tmpl))
+	for k, v := range produced {
+		out[k] = v
+	}
+	// Keys are rendered in order, so that errors are reported in a stable
+	// order.
+	keys := make([]string, 0, len(tmpl))
+	for k := range tmpl {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var errs []error
+	for _, k := range keys {
+		text := tmpl[k]
+		t, err := template.New(k).Option("missingkey=error").Funcs(templateFuncs).Parse(text)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, errFmtParseTemplate, k))
+			continue
+		}
+		if !detailsAvailable(t.Tree.Root, details) {
+			continue
+		}
+		buf := &bytes.Buffer{}
+		if err := t.Execute(buf, data); err != nil {
+			errs = append(errs, errors.Wrapf(err, errFmtRenderTemplate, k))
+			continue
+		}
+		out[k] = buf.Bytes()
+	}
+	return out, k8serrors.NewAggregate(errs)
+}
+
+// detailsAvailable reports whether every connection detail the supplied
+// template node refers to, either as .Details.key or as index .Details "key",
+// is available.
+func detailsAvailable(node parse.Node, details map[string]string) bool { //nolint:gocyclo
+	available := func(ident []string) bool {
+		if len(ident) > 0 && ident[0] == "$" {
+			ident = ident[1:]
+		}
+		if len(ident) < 2 || ident[0] != "Details" {
+			return true
+		}
+		_, ok := details[ident[1]]
+		return ok
+	}
+
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return true
+		}
+		for _, c := range n.Nodes {
+			if !detailsAvailable(c, details) {
+				return false
+			}
+		}
+	case *parse.ActionNode:
+		return detailsAvailable(n.Pipe, details)
+	case *parse.TemplateNode:
+		return n.Pipe == nil || detailsAvailable(n.Pipe, details)
+	case *parse.IfNode:
+		return detailsAvailable(&n.BranchNode, details)
+	case *parse.RangeNode:
+		return detailsAvailable(&n.BranchNode, details)
+	case *parse.WithNode:
+		return detailsAvailable(&n.BranchNode, details)
+	case *parse.BranchNode:
+		return detailsAvailable(n.Pipe, details) && detailsAvailable(n.List, details) && detailsAvailable(n.ElseList, details)
+	case *parse.PipeNode:
+		if n == nil {
+			return true
+		}
+		for _, c := range n.Cmds {
+			if !detailsAvailable(c, details) {
+				return false
+			}
+		}
+	case *parse.CommandNode:
+		if key, ok := indexedDetail(n); ok {
+			if _, ok := details[key]; !ok {
+				return false
+			}
+		}
+		for _, a := range n.Args {
+			if !detailsAvailable(a, details) {
+				return false
+			}
+		}
+	case *parse.FieldNode:
+		return available(n.Ident)
+	case *parse.VariableNode:
+		return available(n.Ident)
+	}
+	return true
+}
+
+// indexedDetail returns the key of a connection detail that the supplied
+// command looks up as index .Details "key".
+func indexedDetail(n *parse.CommandNode) (string, bool) {
+	if len(n.Args) != 3 {
+		return "", false
+	}
+	if id, ok := n.Args[0].(*parse.IdentifierNode); !ok || id.Ident != "index" {
+		return "", false
+	}
+	f, ok := n.Args[1].(*parse.FieldNode)
+	if !ok || len(f.Ident) != 1 || f.Ident[0] != "Details" {
+		return "", false
+	}
+	key, ok := n.Args[2].(*parse.StringNode)
+	if !ok {
+		return "", false
+	}
+	return key.Text, true
+}
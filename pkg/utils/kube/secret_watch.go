@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"reflect"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const (
+	// IndexKeySecretRefs is the field index of managed resources by the
+	// Kubernetes Secrets they reference, formatted as <namespace>/<name>.
+	IndexKeySecretRefs = "secretRefs"
+	// IndexKeyProviderConfig is the field index of managed resources by the
+	// name of their ProviderConfig.
+	IndexKeyProviderConfig = "providerConfigRef"
+
+	errIndexSecretRefs     = "cannot index managed resources by referenced secrets"
+	errIndexProviderConfig = "cannot index managed resources by provider config"
+)
+
+// SecretRefsFn returns the Kubernetes Secrets referenced by the supplied
+// managed resource.
+type SecretRefsFn func(mg resource.Managed) []types.NamespacedName
+
+// SecretKeySelectorRefs returns the Secrets selected by the supplied
+// selectors. Nil selectors are ignored.
+func SecretKeySelectorRefs(sel ...*xpv1.SecretKeySelector) []types.NamespacedName {
+	refs := make([]types.NamespacedName, 0, len(sel))
+	for _, s := range sel {
+		if s != nil {
+			refs = append(refs, types.NamespacedName{Namespace: s.Namespace, Name: s.Name})
+		}
+	}
+	return refs
+}
+
+// EnqueueRequestsForReferencedSecrets indexes managed resources of the
+// supplied kind by the Secrets returned by fn and by their ProviderConfig. It
+// returns an event handler for Secrets that enqueues the managed resources
+// that reference a Secret, either directly or through the credentials of
+// their ProviderConfig, whenever its data changes. fn may be nil for kinds
+// that only depend on their ProviderConfig.
+func EnqueueRequestsForReferencedSecrets(mgr ctrl.Manager, of resource.Managed, list resource.ManagedList, fn SecretRefsFn) (handler.EventHandler, error) {
+	ctx := context.Background()
+	if fn != nil {
+		if err := mgr.GetFieldIndexer().IndexField(ctx, of, IndexKeySecretRefs, indexSecretRefs(fn)); err != nil {
+			return nil, errors.Wrap(err, errIndexSecretRefs)
+		}
+	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, of, IndexKeyProviderConfig, indexProviderConfig); err != nil {
+		return nil, errors.Wrap(err, errIndexProviderConfig)
+	}
+	return &secretHandler{kube: mgr.GetClient(), list: list, indexSecretRefs: fn != nil}, nil
+}
+
+func indexSecretRefs(fn SecretRefsFn) client.IndexerFunc {
+	return func(o client.Object) []string {
+		mg, ok := o.(resource.Managed)
+		if !ok {
+			return nil
+		}
+		refs := fn(mg)
+		keys := make([]string, len(refs))
+		for i := range refs {
+			keys[i] = refs[i].String()
+		}
+		return keys
+	}
+}
+
+func indexProviderConfig(o client.Object) []string {
+	mg, ok := o.(resource.Managed)
+	if !ok || mg.GetProviderConfigReference() == nil {
+		return nil
+	}
+	return []string{mg.GetProviderConfigReference().Name}
+}
+
+// A secretHandler enqueues the managed resources that depend on a Secret.
+type secretHandler struct {
+	kube            client.Reader
+	list            resource.ManagedList
+	indexSecretRefs bool
+}
+
+// Create enqueues the managed resources that depend on the created Secret,
+// e.g. resources that were created before the Secret they reference.
+func (h *secretHandler) Create(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueue(ctx, e.Object, q)
+}
+
+// Update enqueues the managed resources that depend on the updated Secret if
+// its data changed.
+func (h *secretHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	before, ok := e.ObjectOld.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	after, ok := e.ObjectNew.(*corev1.Secret)
+	if !ok {
+		return
+	}
+	if reflect.DeepEqual(before.Data, after.Data) && reflect.DeepEqual(before.StringData, after.StringData) {
+		return
+	}
+	h.enqueue(ctx, after, q)
+}
+
+// Delete enqueues the managed resources that depend on the deleted Secret.
+func (h *secretHandler) Delete(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueue(ctx, e.Object, q)
+}
+
+// Generic does nothing.
+func (h *secretHandler) Generic(_ context.Context, _ event.GenericEvent, _ workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+}
+
+func (h *secretHandler) enqueue(ctx context.Context, s client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	key := types.NamespacedName{Namespace: s.GetNamespace(), Name: s.GetName()}
+	if h.indexSecretRefs {
+		h.enqueueMatching(ctx, client.MatchingFields{IndexKeySecretRefs: key.String()}, q)
+	}
+
+	// ProviderConfigs are few, so they are filtered here rather than indexed
+	// once per managed resource kind.
+	pcs := &v1beta1.ProviderConfigList{}
+	if err := h.kube.List(ctx, pcs); err != nil {
+		return
+	}
+	for i := range pcs.Items {
+		if usesSecret(&pcs.Items[i], key) {
+			h.enqueueMatching(ctx, client.MatchingFields{IndexKeyProviderConfig: pcs.Items[i].Name}, q)
+		}
+	}
+}
+
+func (h *secretHandler) enqueueMatching(ctx context.Context, f client.MatchingFields, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	l, ok := h.list.DeepCopyObject().(resource.ManagedList)
+	if !ok {
+		return
+	}
+	if err := h.kube.List(ctx, l, f); err != nil {
+		return
+	}
+	for _, mg := range l.GetItems() {
+		q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}})
+	}
+}
+
+func usesSecret(pc *v1beta1.ProviderConfig, key types.NamespacedName) bool {
+	ref := pc.Spec.Credentials.SecretRef
+	return pc.Spec.Credentials.Source == xpv1.CredentialsSourceSecret && ref != nil &&
+		ref.Namespace == key.Namespace && ref.Name == key.Name
+}
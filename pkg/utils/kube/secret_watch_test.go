@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kube
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane-contrib/provider-aws/apis/rds/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func dbInstanceSecretRefs(mg resource.Managed) []types.NamespacedName {
+	cr, ok := mg.(*v1alpha1.DBInstance)
+	if !ok {
+		return nil
+	}
+	return SecretKeySelectorRefs(cr.Spec.ForProvider.MasterUserPasswordSecretRef)
+}
+
+func dbInstance(name, pc string, pw *xpv1.SecretKeySelector) *v1alpha1.DBInstance {
+	cr := &v1alpha1.DBInstance{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	cr.Spec.ProviderConfigReference = &xpv1.Reference{Name: pc}
+	cr.Spec.ForProvider.MasterUserPasswordSecretRef = pw
+	return cr
+}
+
+func secret(name string, data string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: name},
+		Data:       map[string][]byte{"key": []byte(data)},
+	}
+}
+
+func TestSecretHandlerUpdate(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1alpha1.SchemeBuilder.AddToScheme(s)
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+
+	pw := &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "password"}, Key: "key"}
+	pc := &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "rotated"}}
+	pc.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+	pc.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: "creds"}, Key: "key"}
+
+	kube := fake.NewClientBuilder().
+		WithScheme(s).
+		WithIndex(&v1alpha1.DBInstance{}, IndexKeySecretRefs, indexSecretRefs(dbInstanceSecretRefs)).
+		WithIndex(&v1alpha1.DBInstance{}, IndexKeyProviderConfig, indexProviderConfig).
+		WithObjects(
+			pc,
+			dbInstance("references-password", "default", pw),
+			dbInstance("uses-rotated-config", "rotated", nil),
+			dbInstance("unrelated", "default", nil),
+		).
+		Build()
+
+	cases := map[string]struct {
+		old  *corev1.Secret
+		new  *corev1.Secret
+		want []string
+	}{
+		"ReferencedSecretChanged": {
+			old:  secret("password", "old"),
+			new:  secret("password", "new"),
+			want: []string{"references-password"},
+		},
+		"ProviderConfigSecretChanged": {
+			old:  secret("creds", "old"),
+			new:  secret("creds", "new"),
+			want: []string{"uses-rotated-config"},
+		},
+		"DataUnchanged": {
+			old: secret("password", "same"),
+			new: secret("password", "same"),
+		},
+		"UnreferencedSecretChanged": {
+			old: secret("other", "old"),
+			new: secret("other", "new"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := workqueue.NewTypedRateLimitingQueue(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer q.ShutDown()
+
+			h := &secretHandler{kube: kube, list: &v1alpha1.DBInstanceList{}, indexSecretRefs: true}
+			h.Update(context.Background(), event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new}, q)
+
+			var got []string
+			for q.Len() > 0 {
+				r, _ := q.Get()
+				got = append(got, r.Name)
+				q.Done(r)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
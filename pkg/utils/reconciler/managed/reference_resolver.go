@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Error strings.
+const (
+	errResolveReferences = "cannot resolve references"
+	errUpdateManaged     = "cannot update managed resource"
+)
+
+// A ResolveReferencesFn resolves references of a managed resource that are
+// not resolved by its generated ResolveReferences method.
+type ResolveReferencesFn func(ctx context.Context, r client.Reader, mg resource.Managed) error
+
+// An APIFnReferenceResolver resolves the generated references of a managed
+// resource and the ones resolved by a ResolveReferencesFn, and persists any
+// change. It is used for references to kinds of API packages that cannot be
+// imported by the package of the managed resource because of import cycles.
+type APIFnReferenceResolver struct {
+	client client.Client
+	fn     ResolveReferencesFn
+}
+
+// NewAPIFnReferenceResolver returns a ReferenceResolver that resolves the
+// generated references of a managed resource and the ones resolved by the
+// supplied function.
+func NewAPIFnReferenceResolver(c client.Client, fn ResolveReferencesFn) *APIFnReferenceResolver {
+	return &APIFnReferenceResolver{client: c, fn: fn}
+}
+
+// ResolveReferences of the supplied managed resource and updates it if any
+// reference was resolved.
+func (a *APIFnReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	existing := mg.DeepCopyObject()
+
+	if rr, ok := mg.(interface {
+		ResolveReferences(ctx context.Context, r client.Reader) error
+	}); ok {
+		if err := rr.ResolveReferences(ctx, a.client); err != nil {
+			return errors.Wrap(err, errResolveReferences)
+		}
+	}
+	if err := a.fn(ctx, a.client, mg); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+
+	if cmp.Equal(existing, mg) {
+		return nil
+	}
+	return errors.Wrap(a.client.Update(ctx, mg), errUpdateManaged)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managed
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Error strings.
+const (
+	errListManaged = "cannot list managed resources"
+)
+
+// A FixedExternalName initializes managed resources that represent a
+// singleton of an account, e.g. its password policy, with a fixed external
+// name.
+type FixedExternalName struct {
+	client client.Client
+	name   string
+}
+
+// NewFixedExternalName returns an Initializer that sets the external name of
+// a managed resource to the supplied name.
+func NewFixedExternalName(c client.Client, name string) *FixedExternalName {
+	return &FixedExternalName{client: c, name: name}
+}
+
+// Initialize sets the external name of the supplied managed resource and
+// updates it if the external name changed.
+func (f *FixedExternalName) Initialize(ctx context.Context, mg resource.Managed) error {
+	if meta.GetExternalName(mg) == f.name {
+		return nil
+	}
+	meta.SetExternalName(mg, f.name)
+	return errors.Wrap(f.client.Update(ctx, mg), errUpdateManaged)
+}
+
+// An AccountIDFn returns the ID of the account a managed resource belongs to
+// as recorded in its status, or an empty string if it is not known yet.
+type AccountIDFn func(mg resource.Managed) string
+
+// GetSingletonOwner returns the name of the managed resource that manages the
+// singleton represented by the supplied managed resource. Of all managed
+// resources of the supplied list kind that belong to the same account, the
+// oldest one is the owner, so that a newer one never takes over the singleton
+// of another. The account ID of the supplied managed resource must already be
+// recorded. A managed resource whose account ID is not recorded yet is
+// assumed to belong to the same account until it is.
+func GetSingletonOwner(ctx context.Context, c client.Reader, mg resource.Managed, list resource.ManagedList, accountID AccountIDFn) (string, error) {
+	if err := c.List(ctx, list); err != nil {
+		return "", errors.Wrap(err, errListManaged)
+	}
+	owner := mg
+	for _, o := range list.GetItems() {
+		if id := accountID(o); id != "" && id != accountID(mg) {
+			continue
+		}
+		if isOlder(o, owner) {
+			owner = o
+		}
+	}
+	return owner.GetName(), nil
+}
+
+func isOlder(a, b resource.Managed) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}